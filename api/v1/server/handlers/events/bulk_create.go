@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
@@ -54,6 +56,13 @@ func (t *EventService) EventCreateBulk(ctx echo.Context, request gen.EventCreate
 			), nil
 		}
 
+		// a payload does not match the schema for its event key
+		if e, ok := status.FromError(err); ok && e.Code() == codes.InvalidArgument {
+			return gen.EventCreateBulk400JSONResponse(
+				apierrors.NewAPIErrors(e.Message()),
+			), nil
+		}
+
 		return gen.EventCreateBulk400JSONResponse{}, err
 
 	}
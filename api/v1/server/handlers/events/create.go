@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
@@ -42,6 +44,13 @@ func (t *EventService) EventCreate(ctx echo.Context, request gen.EventCreateRequ
 			), nil
 		}
 
+		// the payload does not match the schema for the event key
+		if e, ok := status.FromError(err); ok && e.Code() == codes.InvalidArgument {
+			return gen.EventCreate400JSONResponse(
+				apierrors.NewAPIErrors(e.Message()),
+			), nil
+		}
+
 		return nil, err
 	}
 
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func (t *EventService) EventSchemaDelete(ctx echo.Context, request gen.EventSchemaDeleteRequestObject) (gen.EventSchemaDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	_, err := t.config.V1.EventSchemas().DeleteEventSchema(ctx.Request().Context(), tenantId, request.EventKey)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.EventSchemaDelete404JSONResponse(
+				apierrors.NewAPIErrors("event schema not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.EventSchemaDelete204Response{}, nil
+}
@@ -0,0 +1,36 @@
+package events
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *EventService) EventSchemaGet(ctx echo.Context, request gen.EventSchemaGetRequestObject) (gen.EventSchemaGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	schema, err := t.config.V1.EventSchemas().GetEventSchema(ctx.Request().Context(), tenantId, request.EventKey)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.EventSchemaGet404JSONResponse(
+				apierrors.NewAPIErrors("event schema not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.EventSchemaGet200JSONResponse(
+		transformersv1.ToEventSchema(schema),
+	), nil
+}
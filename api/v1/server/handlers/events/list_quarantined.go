@@ -0,0 +1,71 @@
+package events
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *EventService) EventListQuarantined(ctx echo.Context, request gen.EventListQuarantinedRequestObject) (gen.EventListQuarantinedResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	limit := int64(50)
+	offset := int64(0)
+
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = *request.Params.Offset
+	}
+
+	opts := v1.ListQuarantinedEventsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Keys != nil {
+		opts.Keys = *request.Params.Keys
+	}
+
+	events, count, err := t.config.V1.EventSchemas().ListQuarantinedEvents(ctx.Request().Context(), tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.QuarantinedEvent, len(events))
+
+	for i, event := range events {
+		rows[i] = transformersv1.ToQuarantinedEvent(event)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.EventListQuarantined200JSONResponse(
+		gen.QuarantinedEventList{
+			Rows: rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *EventService) EventSchemaList(ctx echo.Context, request gen.EventSchemaListRequestObject) (gen.EventSchemaListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	schemas, err := t.config.V1.EventSchemas().ListEventSchemas(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventSchemaList200JSONResponse(
+		transformersv1.ToEventSchemaList(schemas),
+	), nil
+}
@@ -0,0 +1,30 @@
+package events
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *EventService) EventListTriggerSkips(ctx echo.Context, request gen.EventListTriggerSkipsRequestObject) (gen.EventListTriggerSkipsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	skips, err := t.config.V1.Triggers().ListEventTriggerSkips(
+		ctx.Request().Context(),
+		tenantId,
+		request.Event.String(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventListTriggerSkips200JSONResponse(
+		transformersv1.ToEventTriggerSkipList(skips),
+	), nil
+}
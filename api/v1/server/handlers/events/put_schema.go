@@ -0,0 +1,58 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/schema"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+
+	transformersv1 "github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers/v1"
+)
+
+func (t *EventService) EventSchemaPut(ctx echo.Context, request gen.EventSchemaPutRequestObject) (gen.EventSchemaPutResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	schemaBytes, err := json.Marshal(request.Body.Schema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// compile the schema so that invalid schemas are rejected here, rather than when events are ingested
+	if _, err := schema.Compile(schemaBytes); err != nil {
+		return gen.EventSchemaPut400JSONResponse(
+			apierrors.NewAPIErrors(err.Error(), "schema"),
+		), nil
+	}
+
+	opts := v1.PutEventSchemaOpts{
+		Key:    request.EventKey,
+		Schema: schemaBytes,
+		Policy: sqlcv1.V1EventSchemaPolicy(request.Body.Policy),
+	}
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventSchemaPut400JSONResponse(*apiErrors), nil
+	}
+
+	eventSchema, err := t.config.V1.EventSchemas().PutEventSchema(ctx.Request().Context(), tenantId, opts)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not put event schema: %w", err)
+	}
+
+	return gen.EventSchemaPut200JSONResponse(
+		transformersv1.ToEventSchema(eventSchema),
+	), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
@@ -43,6 +44,10 @@ func (t *LogService) LogLineList(ctx echo.Context, request gen.LogLineListReques
 		listOpts.Levels = levels
 	}
 
+	if request.Params.Fields != nil {
+		listOpts.Fields = serverutils.ParseLogFieldFilters(*request.Params.Fields)
+	}
+
 	if request.Params.OrderByField != nil {
 		listOpts.OrderBy = repository.StringPtr(string(*request.Params.OrderByField))
 	}
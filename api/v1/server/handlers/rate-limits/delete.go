@@ -0,0 +1,37 @@
+package rate_limits
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func (t *RateLimitService) RateLimitDelete(ctx echo.Context, request gen.RateLimitDeleteRequestObject) (gen.RateLimitDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+
+	_, err := t.config.V1.Scheduler().RateLimit().DeleteRateLimit(ctx.Request().Context(), tenant.ID, request.RateLimitKey)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.RateLimitDelete404JSONResponse(
+				apierrors.NewAPIErrors("rate limit not found"),
+			), nil
+		}
+
+		if errors.Is(err, v1.ErrRateLimitInUse) {
+			return gen.RateLimitDelete400JSONResponse(
+				apierrors.NewAPIErrors("rate limit is used by a step or another rate limit and cannot be deleted"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.RateLimitDelete204Response{}, nil
+}
@@ -0,0 +1,65 @@
+package rate_limits
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func (t *RateLimitService) RateLimitGetMetrics(ctx echo.Context, request gen.RateLimitGetMetricsRequestObject) (gen.RateLimitGetMetricsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	lowerBound := time.Now().UTC().Add(-24 * time.Hour)
+	upperBound := time.Now().UTC()
+
+	if request.Params.Since != nil {
+		lowerBound = request.Params.Since.UTC()
+	}
+
+	if request.Params.Until != nil {
+		upperBound = request.Params.Until.UTC()
+	}
+
+	if !lowerBound.Before(upperBound) {
+		return gen.RateLimitGetMetrics400JSONResponse(
+			apierrors.NewAPIErrors("since must be before until"),
+		), nil
+	}
+
+	// rate limits are sampled every minute, so we use the same buckets as task point metrics
+	var bucketInterval time.Duration
+
+	switch {
+	case upperBound.Sub(lowerBound) < 61*time.Minute:
+		bucketInterval = time.Minute
+	case upperBound.Sub(lowerBound) < 12*time.Hour:
+		bucketInterval = 5 * time.Minute
+	case upperBound.Sub(lowerBound) < 48*time.Hour:
+		bucketInterval = 30 * time.Minute
+	case upperBound.Sub(lowerBound) < 8*24*time.Hour:
+		bucketInterval = 8 * time.Hour
+	default:
+		bucketInterval = 24 * time.Hour
+	}
+
+	lowerBound = lowerBound.Truncate(bucketInterval)
+
+	rows, err := t.config.V1.OLAP().GetRateLimitPointMetrics(ctx.Request().Context(), tenantId, request.RateLimitKey, &lowerBound, &upperBound, bucketInterval)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := transformers.ToRateLimitMetrics(rows)
+
+	return gen.RateLimitGetMetrics200JSONResponse{
+		Results: &results,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package rate_limits
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+)
+
+func (t *RateLimitService) RateLimitReset(ctx echo.Context, request gen.RateLimitResetRequestObject) (gen.RateLimitResetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+
+	rl, err := t.config.V1.Scheduler().RateLimit().ResetRateLimit(ctx.Request().Context(), tenant.ID, request.RateLimitKey)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.RateLimitReset404JSONResponse(
+				apierrors.NewAPIErrors("rate limit not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.RateLimitReset200JSONResponse(
+		*transformers.ToRateLimitFromSQLCV1(rl),
+	), nil
+}
@@ -48,8 +48,15 @@ func (t *TenantService) tenantGetStepRunQueueMetricsV1(ctx echo.Context, tenant
 		return nil, err
 	}
 
+	unavailableSlotPoolCounts, err := t.config.V1.Tasks().GetUnavailableSlotPoolCounts(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
 	resp := gen.TenantStepRunQueueMetrics{
-		Queues: &stepRunQueueCounts,
+		Queues:               &stepRunQueueCounts,
+		UnavailableSlotPools: &unavailableSlotPoolCounts,
 	}
 
 	return gen.TenantGetStepRunQueueMetrics200JSONResponse(resp), nil
@@ -12,8 +12,6 @@ import (
 func (t *TasksService) V1TaskCancel(ctx echo.Context, request gen.V1TaskCancelRequestObject) (gen.V1TaskCancelResponseObject, error) {
 	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
 
-	var err error
-
 	grpcReq := &contracts.CancelTasksRequest{}
 
 	if request.Body.ExternalIds != nil {
@@ -60,7 +58,7 @@ func (t *TasksService) V1TaskCancel(ctx echo.Context, request gen.V1TaskCancelRe
 		grpcReq.Filter = filter
 	}
 
-	_, err = t.proxyCancel.Do(
+	resp, err := t.proxyCancel.Do(
 		ctx.Request().Context(),
 		tenant,
 		grpcReq,
@@ -70,5 +68,7 @@ func (t *TasksService) V1TaskCancel(ctx echo.Context, request gen.V1TaskCancelRe
 		return nil, err
 	}
 
-	return gen.V1TaskCancel200Response{}, nil
+	return gen.V1TaskCancel200JSONResponse(
+		toV1TaskBulkActionResponse(resp.BulkOperationId),
+	), nil
 }
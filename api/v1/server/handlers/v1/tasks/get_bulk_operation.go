@@ -0,0 +1,96 @@
+package tasks
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+)
+
+func (t *TasksService) V1BulkOperationGet(ctx echo.Context, request gen.V1BulkOperationGetRequestObject) (gen.V1BulkOperationGetResponseObject, error) {
+	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
+
+	op, err := t.proxyGetBulkOperation.Do(
+		ctx.Request().Context(),
+		tenant,
+		&contracts.GetBulkOperationRequest{
+			BulkOperationId: request.BulkOperation.String(),
+		},
+	)
+
+	if err != nil {
+		if e, ok := status.FromError(err); ok {
+			switch e.Code() {
+			case codes.InvalidArgument:
+				return gen.V1BulkOperationGet400JSONResponse(
+					apierrors.NewAPIErrors(e.Message()),
+				), nil
+			case codes.NotFound:
+				return gen.V1BulkOperationGet404JSONResponse(
+					apierrors.NewAPIErrors("bulk operation not found"),
+				), nil
+			}
+		}
+
+		return nil, err
+	}
+
+	return gen.V1BulkOperationGet200JSONResponse(
+		toV1BulkOperation(op),
+	), nil
+}
+
+func toV1BulkOperation(op *contracts.BulkOperation) gen.V1BulkOperation {
+	res := gen.V1BulkOperation{
+		Metadata: gen.APIResourceMeta{
+			Id:        op.Id,
+			CreatedAt: op.CreatedAt.AsTime(),
+			UpdatedAt: op.UpdatedAt.AsTime(),
+		},
+		MatchedCount:   op.MatchedCount,
+		ProcessedCount: op.ProcessedCount,
+		FailedCount:    op.FailedCount,
+		ErrorMessage:   op.ErrorMessage,
+	}
+
+	switch op.Kind {
+	case contracts.BulkOperationKind_BULK_OPERATION_KIND_CANCEL:
+		res.Kind = gen.CANCEL
+	case contracts.BulkOperationKind_BULK_OPERATION_KIND_REPLAY:
+		res.Kind = gen.REPLAY
+	}
+
+	switch op.Status {
+	case contracts.BulkOperationStatus_BULK_OPERATION_STATUS_PENDING:
+		res.Status = gen.V1BulkOperationStatusPENDING
+	case contracts.BulkOperationStatus_BULK_OPERATION_STATUS_RUNNING:
+		res.Status = gen.V1BulkOperationStatusRUNNING
+	case contracts.BulkOperationStatus_BULK_OPERATION_STATUS_COMPLETED:
+		res.Status = gen.V1BulkOperationStatusCOMPLETED
+	case contracts.BulkOperationStatus_BULK_OPERATION_STATUS_FAILED:
+		res.Status = gen.V1BulkOperationStatusFAILED
+	}
+
+	if op.FinishedAt != nil {
+		finishedAt := op.FinishedAt.AsTime()
+		res.FinishedAt = &finishedAt
+	}
+
+	return res
+}
+
+func toV1TaskBulkActionResponse(bulkOperationId *string) gen.V1TaskBulkActionResponse {
+	res := gen.V1TaskBulkActionResponse{}
+
+	if bulkOperationId != nil {
+		id := uuid.MustParse(*bulkOperationId)
+		res.BulkOperationId = &id
+	}
+
+	return res
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/serverutils"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
@@ -17,7 +18,23 @@ func (t *TasksService) V1LogLineList(ctx echo.Context, request gen.V1LogLineList
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 	task := ctx.Get("task").(*sqlcv1.V1TasksOlap)
 
-	logLines, err := t.config.V1.Logs().ListLogLines(ctx.Request().Context(), tenantId, task.ID, task.InsertedAt, &v1.ListLogsOpts{})
+	opts := &v1.ListLogsOpts{}
+
+	if request.Params.Levels != nil {
+		levels := make([]string, len(*request.Params.Levels))
+
+		for i, level := range *request.Params.Levels {
+			levels[i] = string(level)
+		}
+
+		opts.Levels = levels
+	}
+
+	if request.Params.Fields != nil {
+		opts.Fields = serverutils.ParseLogFieldFilters(*request.Params.Fields)
+	}
+
+	logLines, err := t.config.V1.Logs().ListLogLines(ctx.Request().Context(), tenantId, task.ID, task.InsertedAt, opts)
 
 	if err != nil {
 		return nil, err
@@ -12,8 +12,6 @@ import (
 func (t *TasksService) V1TaskReplay(ctx echo.Context, request gen.V1TaskReplayRequestObject) (gen.V1TaskReplayResponseObject, error) {
 	tenant := ctx.Get("tenant").(*dbsqlc.Tenant)
 
-	var err error
-
 	grpcReq := &contracts.ReplayTasksRequest{}
 
 	if request.Body.ExternalIds != nil {
@@ -60,7 +58,7 @@ func (t *TasksService) V1TaskReplay(ctx echo.Context, request gen.V1TaskReplayRe
 		grpcReq.Filter = filter
 	}
 
-	_, err = t.proxyReplay.Do(
+	resp, err := t.proxyReplay.Do(
 		ctx.Request().Context(),
 		tenant,
 		grpcReq,
@@ -70,5 +68,7 @@ func (t *TasksService) V1TaskReplay(ctx echo.Context, request gen.V1TaskReplayRe
 		return nil, err
 	}
 
-	return gen.V1TaskReplay200Response{}, nil
+	return gen.V1TaskReplay200JSONResponse(
+		toV1TaskBulkActionResponse(resp.BulkOperationId),
+	), nil
 }
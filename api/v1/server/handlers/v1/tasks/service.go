@@ -14,6 +14,8 @@ type TasksService struct {
 	config      *server.ServerConfig
 	proxyCancel *proxy.Proxy[admincontracts.CancelTasksRequest, admincontracts.CancelTasksResponse]
 	proxyReplay *proxy.Proxy[admincontracts.ReplayTasksRequest, admincontracts.ReplayTasksResponse]
+
+	proxyGetBulkOperation *proxy.Proxy[admincontracts.GetBulkOperationRequest, admincontracts.BulkOperation]
 }
 
 func NewTasksService(config *server.ServerConfig) *TasksService {
@@ -25,9 +27,14 @@ func NewTasksService(config *server.ServerConfig) *TasksService {
 		return cli.Admin().ReplayTasks(ctx, in)
 	})
 
+	proxyGetBulkOperation := proxy.NewProxy(config, func(ctx context.Context, cli *client.GRPCClient, in *admincontracts.GetBulkOperationRequest) (*admincontracts.BulkOperation, error) {
+		return cli.Admin().GetBulkOperation(ctx, in)
+	})
+
 	return &TasksService{
-		config:      config,
-		proxyCancel: proxyCancel,
-		proxyReplay: proxyReplay,
+		config:                config,
+		proxyCancel:           proxyCancel,
+		proxyReplay:           proxyReplay,
+		proxyGetBulkOperation: proxyGetBulkOperation,
 	}
 }
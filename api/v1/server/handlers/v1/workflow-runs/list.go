@@ -130,6 +130,16 @@ func (t *V1WorkflowRunsService) WithDags(ctx echo.Context, request gen.V1Workflo
 		return nil, err
 	}
 
+	pausedWorkflowIds, err := t.config.V1.Workflows().ListPausedWorkflowIds(
+		ctx.Request().Context(),
+		tenantId,
+		pgWorkflowIds,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	taskIdToWorkflowName := make(map[int64]string)
 
 	for _, task := range tasks {
@@ -138,7 +148,7 @@ func (t *V1WorkflowRunsService) WithDags(ctx echo.Context, request gen.V1Workflo
 		}
 	}
 
-	parsedTasks := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, total, limit, offset)
+	parsedTasks := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, pausedWorkflowIds, total, limit, offset)
 
 	dagChildren := make(map[uuid.UUID][]gen.V1TaskSummary)
 
@@ -153,7 +163,7 @@ func (t *V1WorkflowRunsService) WithDags(ctx echo.Context, request gen.V1Workflo
 		}
 	}
 
-	result := transformers.ToWorkflowRunMany(dags, dagChildren, workflowNames, total, limit, offset)
+	result := transformers.ToWorkflowRunMany(dags, dagChildren, workflowNames, pausedWorkflowIds, total, limit, offset)
 
 	// Search for api errors to see how we handle errors in other cases
 	return gen.V1WorkflowRunList200JSONResponse(
@@ -236,9 +246,25 @@ func (t *V1WorkflowRunsService) OnlyTasks(ctx echo.Context, request gen.V1Workfl
 		return nil, err
 	}
 
+	pgWorkflowIds := make([]pgtype.UUID, 0, len(tasks))
+
+	for _, task := range tasks {
+		pgWorkflowIds = append(pgWorkflowIds, task.WorkflowID)
+	}
+
+	pausedWorkflowIds, err := t.config.V1.Workflows().ListPausedWorkflowIds(
+		ctx.Request().Context(),
+		tenantId,
+		pgWorkflowIds,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	taskIdToWorkflowName := make(map[int64]string)
 
-	result := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, total, limit, offset)
+	result := transformers.TaskRunDataRowToWorkflowRunsMany(tasks, taskIdToWorkflowName, pausedWorkflowIds, total, limit, offset)
 
 	// Search for api errors to see how we handle errors in other cases
 	return gen.V1WorkflowRunList200JSONResponse(
@@ -49,6 +49,12 @@ func (t *V1WorkflowRunsService) V1WorkflowRunCreate(ctx echo.Context, request ge
 		WorkflowName:       request.Body.WorkflowName,
 		Input:              inputBytes,
 		AdditionalMetadata: additionalMetadataBytes,
+		IdempotencyKey:     request.Body.IdempotencyKey,
+	}
+
+	if request.Body.IdempotencyKeyTtlSeconds != nil {
+		ttlSeconds := int32(*request.Body.IdempotencyKeyTtlSeconds) // nolint: gosec
+		grpcReq.IdempotencyKeyTtlSeconds = &ttlSeconds
 	}
 
 	resp, err := t.proxyTrigger.Do(
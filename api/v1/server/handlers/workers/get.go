@@ -127,6 +127,17 @@ func (t *WorkerService) workerGetV1(ctx echo.Context, tenant *dbsqlc.Tenant, req
 	workerResp.RecentStepRuns = &respStepRuns
 	workerResp.Slots = transformersv1.ToSlotState(slotState, slots)
 
+	slotPools, err := t.config.V1.Workers().ListWorkerSlotPools(
+		sqlchelpers.UUIDToStr(worker.Worker.TenantId),
+		[]string{sqlchelpers.UUIDToStr(worker.Worker.ID)},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	workerResp.SlotPools = transformersv1.ToWorkerSlotPools(slotPools[sqlchelpers.UUIDToStr(worker.Worker.ID)])
+
 	affinity, err := t.config.APIRepository.Worker().ListWorkerLabels(
 		sqlchelpers.UUIDToStr(worker.Worker.TenantId),
 		sqlchelpers.UUIDToStr(worker.Worker.ID),
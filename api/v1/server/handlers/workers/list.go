@@ -69,6 +69,18 @@ func (t *WorkerService) workerListV1(ctx echo.Context, tenant *dbsqlc.Tenant, re
 		return nil, err
 	}
 
+	workerIds := make([]string, len(workers))
+
+	for i, worker := range workers {
+		workerIds[i] = sqlchelpers.UUIDToStr(worker.Worker.ID)
+	}
+
+	slotPools, err := t.config.V1.Workers().ListWorkerSlotPools(tenantId, workerIds)
+
+	if err != nil {
+		return nil, err
+	}
+
 	rows := make([]gen.Worker, len(workers))
 
 	for i, worker := range workers {
@@ -76,6 +88,7 @@ func (t *WorkerService) workerListV1(ctx echo.Context, tenant *dbsqlc.Tenant, re
 		slots := int(worker.RemainingSlots)
 
 		rows[i] = *transformersv1.ToWorkerSqlc(&workerCp.Worker, &slots, &workerCp.WebhookUrl.String, nil)
+		rows[i].SlotPools = transformersv1.ToWorkerSlotPools(slotPools[workerIds[i]])
 	}
 
 	return gen.WorkerList200JSONResponse(
@@ -26,6 +26,10 @@ func (t *WorkerService) WorkerUpdate(ctx echo.Context, request gen.WorkerUpdateR
 		update.IsPaused = request.Body.IsPaused
 	}
 
+	if request.Body.IsCordoned != nil {
+		update.IsCordoned = request.Body.IsCordoned
+	}
+
 	updatedWorker, err := t.config.APIRepository.Worker().UpdateWorker(
 		sqlchelpers.UUIDToStr(worker.Worker.TenantId),
 		sqlchelpers.UUIDToStr(worker.Worker.ID),
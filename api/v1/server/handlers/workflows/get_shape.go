@@ -56,6 +56,21 @@ func (t *WorkflowService) WorkflowRunGetShape(ctx echo.Context, request gen.Work
 		return nil, err
 	}
 
+	stepIds := make([]string, len(steps))
+
+	for i := range steps {
+		stepIds[i] = sqlchelpers.UUIDToStr(steps[i].Step.ID)
+	}
+
+	stepConcurrencies, err := t.config.APIRepository.WorkflowRun().GetStepConcurrenciesForSteps(
+		reqCtx,
+		stepIds,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	// step runs
 
 	stepRuns, err := t.config.APIRepository.WorkflowRun().GetStepRunsForJobRuns(
@@ -74,6 +89,7 @@ func (t *WorkflowService) WorkflowRunGetShape(ctx echo.Context, request gen.Work
 			workflowVersion,
 			jobRuns,
 			steps,
+			stepConcurrencies,
 			stepRuns,
 		),
 	), nil
@@ -28,10 +28,12 @@ const (
 
 // Defines values for ConcurrencyLimitStrategy.
 const (
-	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	DROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	GROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	QUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	CANCELINPROGRESS   ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	CANCELNEWEST       ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	DROPNEWEST         ConcurrencyLimitStrategy = "DROP_NEWEST"
+	GROUPROUNDROBIN    ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	QUEUENEWEST        ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	WEIGHTEDROUNDROBIN ConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 // Defines values for CronWorkflowsMethod.
@@ -57,6 +59,12 @@ const (
 	EventOrderByFieldCreatedAt EventOrderByField = "createdAt"
 )
 
+// Defines values for EventSchemaPolicy.
+const (
+	QUARANTINE EventSchemaPolicy = "QUARANTINE"
+	REJECT     EventSchemaPolicy = "REJECT"
+)
+
 // Defines values for JobRunStatus.
 const (
 	JobRunStatusBACKOFF   JobRunStatus = "BACKOFF"
@@ -86,6 +94,13 @@ const (
 	LogLineOrderByFieldCreatedAt LogLineOrderByField = "createdAt"
 )
 
+// Defines values for RateLimitAlgorithm.
+const (
+	FIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	SLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+	TOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+)
+
 // Defines values for RateLimitOrderByDirection.
 const (
 	Asc  RateLimitOrderByDirection = "asc"
@@ -185,6 +200,28 @@ const (
 	V1 TenantVersion = "V1"
 )
 
+// Defines values for V1BulkOperationKind.
+const (
+	CANCEL V1BulkOperationKind = "CANCEL"
+	REPLAY V1BulkOperationKind = "REPLAY"
+)
+
+// Defines values for V1BulkOperationStatus.
+const (
+	V1BulkOperationStatusCOMPLETED V1BulkOperationStatus = "COMPLETED"
+	V1BulkOperationStatusFAILED    V1BulkOperationStatus = "FAILED"
+	V1BulkOperationStatusPENDING   V1BulkOperationStatus = "PENDING"
+	V1BulkOperationStatusRUNNING   V1BulkOperationStatus = "RUNNING"
+)
+
+// Defines values for V1LogLineLevel.
+const (
+	DEBUG V1LogLineLevel = "DEBUG"
+	ERROR V1LogLineLevel = "ERROR"
+	INFO  V1LogLineLevel = "INFO"
+	WARN  V1LogLineLevel = "WARN"
+)
+
 // Defines values for V1TaskEventType.
 const (
 	V1TaskEventTypeACKNOWLEDGED       V1TaskEventType = "ACKNOWLEDGED"
@@ -227,6 +264,7 @@ const (
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
+	CORDONED WorkerStatus = "CORDONED"
 	INACTIVE WorkerStatus = "INACTIVE"
 	PAUSED   WorkerStatus = "PAUSED"
 )
@@ -268,13 +306,13 @@ const (
 
 // Defines values for WorkflowRunStatus.
 const (
-	BACKOFF   WorkflowRunStatus = "BACKOFF"
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	QUEUED    WorkflowRunStatus = "QUEUED"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusBACKOFF   WorkflowRunStatus = "BACKOFF"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusQUEUED    WorkflowRunStatus = "QUEUED"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
 // APIError defines model for APIError.
@@ -513,9 +551,62 @@ type EventOrderByDirection string
 // EventOrderByField defines model for EventOrderByField.
 type EventOrderByField string
 
+// EventSchema defines model for EventSchema.
+type EventSchema struct {
+	// CreatedAt When the schema was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Key The event key which the schema applies to.
+	Key string `json:"key"`
+
+	// Policy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+	Policy EventSchemaPolicy `json:"policy"`
+
+	// Schema The JSON schema which event payloads must match.
+	Schema map[string]interface{} `json:"schema"`
+
+	// UpdatedAt When the schema was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// EventSchemaList defines model for EventSchemaList.
+type EventSchemaList struct {
+	Rows []EventSchema `json:"rows"`
+}
+
+// EventSchemaPolicy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+type EventSchemaPolicy string
+
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventTriggerSkip defines model for EventTriggerSkip.
+type EventTriggerSkip struct {
+	// ErrorMessage The reason the filter could not be evaluated, if it could not be evaluated.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// EventKey The key of the event.
+	EventKey string `json:"eventKey"`
+
+	// Expression The CEL filter on the event trigger which the event did not match.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// WorkflowId The id of the workflow which was not triggered.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow which was not triggered.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowVersionId The id of the workflow version which was not triggered.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// EventTriggerSkipList defines model for EventTriggerSkipList.
+type EventTriggerSkipList struct {
+	Rows []EventTriggerSkip `json:"rows"`
+}
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Cancelled The number of cancelled runs.
@@ -605,7 +696,8 @@ type ListSlackWebhooks struct {
 // LogLine defines model for LogLine.
 type LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Level     *LogLineLevel `json:"level,omitempty"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -647,6 +739,42 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PutEventSchemaRequest defines model for PutEventSchemaRequest.
+type PutEventSchemaRequest struct {
+	// Policy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+	Policy EventSchemaPolicy `json:"policy"`
+
+	// Schema The JSON schema which event payloads must match. References to other documents are not supported.
+	Schema map[string]interface{} `json:"schema"`
+}
+
+// QuarantinedEvent defines model for QuarantinedEvent.
+type QuarantinedEvent struct {
+	// AdditionalMetadata Additional metadata for the event.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// CreatedAt When the event was quarantined.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data The payload of the event, if it is valid JSON.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// ErrorMessage The reason the event did not match the schema.
+	ErrorMessage string `json:"errorMessage"`
+
+	// EventId The id of the event.
+	EventId openapi_types.UUID `json:"eventId"`
+
+	// Key The key of the event.
+	Key string `json:"key"`
+}
+
+// QuarantinedEventList defines model for QuarantinedEventList.
+type QuarantinedEventList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       []QuarantinedEvent  `json:"rows"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -661,6 +789,12 @@ type QueueMetrics struct {
 
 // RateLimit defines model for RateLimit.
 type RateLimit struct {
+	// Algorithm The algorithm used to refill the rate limit.
+	Algorithm RateLimitAlgorithm `json:"algorithm"`
+
+	// Burst The maximum number of units a token bucket rate limit can hold. Defaults to the limitValue.
+	Burst *int `json:"burst,omitempty"`
+
 	// Key The key for the rate limit.
 	Key string `json:"key"`
 
@@ -670,6 +804,9 @@ type RateLimit struct {
 	// LimitValue The maximum number of requests allowed within the window.
 	LimitValue int `json:"limitValue"`
 
+	// ParentKey The key of the parent rate limit. Consuming units of this rate limit also consumes units of the parent.
+	ParentKey *string `json:"parentKey,omitempty"`
+
 	// TenantId The ID of the tenant associated with this rate limit.
 	TenantId string `json:"tenantId"`
 
@@ -680,12 +817,32 @@ type RateLimit struct {
 	Window string `json:"window"`
 }
 
+// RateLimitAlgorithm The algorithm used to refill the rate limit.
+type RateLimitAlgorithm string
+
 // RateLimitList defines model for RateLimitList.
 type RateLimitList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]RateLimit        `json:"rows,omitempty"`
 }
 
+// RateLimitMetric defines model for RateLimitMetric.
+type RateLimitMetric struct {
+	// LimitValue The maximum number of units allowed within the window during the time bucket.
+	LimitValue int `json:"limitValue"`
+
+	// Time The start of the time bucket.
+	Time time.Time `json:"time"`
+
+	// Used The maximum number of units used within the window during the time bucket.
+	Used int `json:"used"`
+}
+
+// RateLimitMetrics defines model for RateLimitMetrics.
+type RateLimitMetrics struct {
+	Results *[]RateLimitMetric `json:"results,omitempty"`
+}
+
 // RateLimitOrderByDirection defines model for RateLimitOrderByDirection.
 type RateLimitOrderByDirection string
 
@@ -786,6 +943,9 @@ type SemaphoreSlots struct {
 	// ActionId The action id.
 	ActionId string `json:"actionId"`
 
+	// Slots The number of worker slots used by the step run.
+	Slots *int `json:"slots,omitempty"`
+
 	// StartedAt The time this slot was started.
 	StartedAt *time.Time    `json:"startedAt,omitempty"`
 	Status    StepRunStatus `json:"status"`
@@ -821,11 +981,14 @@ type SlackWebhook struct {
 
 // Step defines model for Step.
 type Step struct {
-	Action   string          `json:"action"`
-	Children *[]string       `json:"children,omitempty"`
-	JobId    string          `json:"jobId"`
-	Metadata APIResourceMeta `json:"metadata"`
-	Parents  *[]string       `json:"parents,omitempty"`
+	Action   string    `json:"action"`
+	Children *[]string `json:"children,omitempty"`
+
+	// Concurrency The concurrency limits of the step.
+	Concurrency *[]StepConcurrency `json:"concurrency,omitempty"`
+	JobId       string             `json:"jobId"`
+	Metadata    APIResourceMeta    `json:"metadata"`
+	Parents     *[]string          `json:"parents,omitempty"`
 
 	// ReadableId The readable id of the step.
 	ReadableId string `json:"readableId"`
@@ -835,6 +998,19 @@ type Step struct {
 	Timeout *string `json:"timeout,omitempty"`
 }
 
+// StepConcurrency defines model for StepConcurrency.
+type StepConcurrency struct {
+	// Expression The CEL expression which returns the concurrency key.
+	Expression    string                   `json:"expression"`
+	LimitStrategy ConcurrencyLimitStrategy `json:"limitStrategy"`
+
+	// MaxRuns The maximum number of concurrent step runs per concurrency key.
+	MaxRuns int32 `json:"maxRuns"`
+
+	// WeightExpression The CEL expression which returns the weight of a concurrency key, for weighted round-robin limits.
+	WeightExpression *string `json:"weightExpression,omitempty"`
+}
+
 // StepRun defines model for StepRun.
 type StepRun struct {
 	CancelledAt         *time.Time              `json:"cancelledAt,omitempty"`
@@ -1052,6 +1228,9 @@ type TenantResourcePolicy struct {
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
+
+	// UnavailableSlotPools The number of queued step runs for each slot pool which is not registered by any active worker. These step runs can't be assigned until a worker registers the slot pool.
+	UnavailableSlotPools *map[string]int `json:"unavailableSlotPools,omitempty"`
 }
 
 // TenantVersion defines model for TenantVersion.
@@ -1101,6 +1280,9 @@ type UpdateTenantRequest struct {
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
 type UpdateWorkerRequest struct {
+	// IsCordoned Whether the worker is cordoned. A cordoned worker is not assigned new runs, and shuts down once its running runs have finished.
+	IsCordoned *bool `json:"isCordoned,omitempty"`
+
 	// IsPaused Whether the worker is paused and cannot accept new runs.
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
@@ -1169,6 +1351,33 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// V1BulkOperation defines model for V1BulkOperation.
+type V1BulkOperation struct {
+	// ErrorMessage The reason the bulk operation failed, if it failed
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// FailedCount The number of workflow runs which could not be processed
+	FailedCount int64 `json:"failedCount"`
+
+	// FinishedAt The time the bulk operation finished
+	FinishedAt *time.Time          `json:"finishedAt,omitempty"`
+	Kind       V1BulkOperationKind `json:"kind"`
+
+	// MatchedCount The number of workflow runs which matched the filter
+	MatchedCount int64           `json:"matchedCount"`
+	Metadata     APIResourceMeta `json:"metadata"`
+
+	// ProcessedCount The number of workflow runs which were successfully processed
+	ProcessedCount int64                 `json:"processedCount"`
+	Status         V1BulkOperationStatus `json:"status"`
+}
+
+// V1BulkOperationKind defines model for V1BulkOperationKind.
+type V1BulkOperationKind string
+
+// V1BulkOperationStatus defines model for V1BulkOperationStatus.
+type V1BulkOperationStatus string
+
 // V1CancelTaskRequest defines model for V1CancelTaskRequest.
 type V1CancelTaskRequest struct {
 	// ExternalIds A list of external IDs, which can refer to either task or workflow run external IDs
@@ -1185,7 +1394,8 @@ type V1DagChildren struct {
 // V1LogLine defines model for V1LogLine.
 type V1LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Level     *V1LogLineLevel `json:"level,omitempty"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -1194,6 +1404,9 @@ type V1LogLine struct {
 	Metadata map[string]interface{} `json:"metadata"`
 }
 
+// V1LogLineLevel defines model for V1LogLineLevel.
+type V1LogLineLevel string
+
 // V1LogLineList defines model for V1LogLineList.
 type V1LogLineList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -1207,6 +1420,12 @@ type V1ReplayTaskRequest struct {
 	Filter      *V1TaskFilter         `json:"filter,omitempty"`
 }
 
+// V1TaskBulkActionResponse defines model for V1TaskBulkActionResponse.
+type V1TaskBulkActionResponse struct {
+	// BulkOperationId The id of the bulk operation which processes the tasks matching the filter, if a filter was provided
+	BulkOperationId *openapi_types.UUID `json:"bulkOperationId,omitempty"`
+}
+
 // V1TaskEvent defines model for V1TaskEvent.
 type V1TaskEvent struct {
 	ErrorMessage    *string             `json:"errorMessage,omitempty"`
@@ -1312,10 +1531,13 @@ type V1TaskSummary struct {
 	TaskInsertedAt time.Time `json:"taskInsertedAt"`
 
 	// TenantId The ID of the tenant.
-	TenantId     openapi_types.UUID `json:"tenantId"`
-	Type         V1WorkflowType     `json:"type"`
-	WorkflowId   openapi_types.UUID `json:"workflowId"`
-	WorkflowName *string            `json:"workflowName,omitempty"`
+	TenantId   openapi_types.UUID `json:"tenantId"`
+	Type       V1WorkflowType     `json:"type"`
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowIsPaused Whether the workflow is paused. Queued tasks for a paused workflow are not assigned to workers until the workflow is resumed.
+	WorkflowIsPaused *bool   `json:"workflowIsPaused,omitempty"`
+	WorkflowName     *string `json:"workflowName,omitempty"`
 
 	// WorkflowRunExternalId The external ID of the workflow run
 	WorkflowRunExternalId *openapi_types.UUID `json:"workflowRunExternalId,omitempty"`
@@ -1335,7 +1557,13 @@ type V1TaskSummaryList struct {
 // V1TriggerWorkflowRunRequest defines model for V1TriggerWorkflowRunRequest.
 type V1TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Input              map[string]interface{}  `json:"input"`
+
+	// IdempotencyKey A key which makes the trigger idempotent. If a workflow run was triggered with the same key within the idempotency window, that workflow run is returned instead of creating a new one.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+
+	// IdempotencyKeyTtlSeconds How long the idempotency key is valid for, in seconds. Defaults to 24 hours, which is also the maximum.
+	IdempotencyKeyTtlSeconds *int                   `json:"idempotencyKeyTtlSeconds,omitempty"`
+	Input                    map[string]interface{} `json:"input"`
 
 	// WorkflowName The name of the workflow.
 	WorkflowName string `json:"workflowName"`
@@ -1474,7 +1702,7 @@ type Worker struct {
 	// Actions The actions this worker can perform.
 	Actions *[]string `json:"actions,omitempty"`
 
-	// AvailableRuns The number of runs this worker can execute concurrently.
+	// AvailableRuns The number of slots of this worker which are not used by running steps.
 	AvailableRuns *int `json:"availableRuns,omitempty"`
 
 	// DispatcherId the id of the assigned dispatcher, in UUID format
@@ -1489,7 +1717,7 @@ type Worker struct {
 	// LastListenerEstablished The time this worker last sent a heartbeat.
 	LastListenerEstablished *time.Time `json:"lastListenerEstablished,omitempty"`
 
-	// MaxRuns The maximum number of runs this worker can execute concurrently.
+	// MaxRuns The number of slots of this worker. A run uses as many slots as its step declares.
 	MaxRuns  *int            `json:"maxRuns,omitempty"`
 	Metadata APIResourceMeta `json:"metadata"`
 
@@ -1500,6 +1728,9 @@ type Worker struct {
 	RecentStepRuns *[]RecentStepRuns  `json:"recentStepRuns,omitempty"`
 	RuntimeInfo    *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
 
+	// SlotPools The named slot pools of the worker. Steps which declare a slot pool only use slots from that pool, other steps use the slots in maxRuns.
+	SlotPools *[]WorkerSlotPool `json:"slotPools,omitempty"`
+
 	// Slots The semaphore slot state for the worker.
 	Slots *[]SemaphoreSlots `json:"slots,omitempty"`
 
@@ -1545,6 +1776,18 @@ type WorkerRuntimeInfo struct {
 // WorkerRuntimeSDKs defines model for WorkerRuntimeSDKs.
 type WorkerRuntimeSDKs string
 
+// WorkerSlotPool defines model for WorkerSlotPool.
+type WorkerSlotPool struct {
+	// AvailableRuns The number of slots in the slot pool which are not used by running steps.
+	AvailableRuns int `json:"availableRuns"`
+
+	// MaxRuns The number of slots in the slot pool.
+	MaxRuns int `json:"maxRuns"`
+
+	// Name The name of the slot pool.
+	Name string `json:"name"`
+}
+
 // WorkerType defines model for WorkerType.
 type WorkerType string
 
@@ -1720,7 +1963,10 @@ type WorkflowTriggerCronRef struct {
 // WorkflowTriggerEventRef defines model for WorkflowTriggerEventRef.
 type WorkflowTriggerEventRef struct {
 	EventKey *string `json:"event_key,omitempty"`
-	ParentId *string `json:"parent_id,omitempty"`
+
+	// Expression The CEL filter which events must match to trigger the workflow.
+	Expression *string `json:"expression,omitempty"`
+	ParentId   *string `json:"parent_id,omitempty"`
 }
 
 // WorkflowTriggers defines model for WorkflowTriggers.
@@ -1786,6 +2032,15 @@ type V1DagListTasksParams struct {
 	Tenant openapi_types.UUID `form:"tenant" json:"tenant"`
 }
 
+// V1LogLineListParams defines parameters for V1LogLineList.
+type V1LogLineListParams struct {
+	// Levels A list of levels to filter by
+	Levels *[]V1LogLineLevel `form:"levels,omitempty" json:"levels,omitempty"`
+
+	// Fields Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
 // V1TaskEventListParams defines parameters for V1TaskEventList.
 type V1TaskEventListParams struct {
 	// Offset The number to skip
@@ -1893,6 +2148,9 @@ type LogLineListParams struct {
 	// Levels A list of levels to filter by
 	Levels *LogLineLevelField `form:"levels,omitempty" json:"levels,omitempty"`
 
+	// Fields Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Search The search query to filter for
 	Search *LogLineSearch `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1936,6 +2194,18 @@ type EventListParams struct {
 	EventIds *[]openapi_types.UUID `form:"eventIds,omitempty" json:"eventIds,omitempty"`
 }
 
+// EventListQuarantinedParams defines parameters for EventListQuarantined.
+type EventListQuarantinedParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Keys A list of keys to filter by
+	Keys *[]EventKey `form:"keys,omitempty" json:"keys,omitempty"`
+}
+
 // TenantGetQueueMetricsParams defines parameters for TenantGetQueueMetrics.
 type TenantGetQueueMetricsParams struct {
 	// Workflows A list of workflow IDs to filter by
@@ -1963,6 +2233,15 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// RateLimitGetMetricsParams defines parameters for RateLimitGetMetrics.
+type RateLimitGetMetricsParams struct {
+	// Since The time after which to get metrics, defaults to 24 hours ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until The time before which to get metrics, defaults to now
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
 type WorkflowRunListStepRunEventsParams struct {
 	// LastId Last ID of the last event
@@ -2161,6 +2440,9 @@ type EventUpdateCancelJSONRequestBody = CancelEventRequest
 // EventUpdateReplayJSONRequestBody defines body for EventUpdateReplay for application/json ContentType.
 type EventUpdateReplayJSONRequestBody = ReplayEventRequest
 
+// EventSchemaPutJSONRequestBody defines body for EventSchemaPut for application/json ContentType.
+type EventSchemaPutJSONRequestBody = PutEventSchemaRequest
+
 // TenantInviteCreateJSONRequestBody defines body for TenantInviteCreate for application/json ContentType.
 type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 
@@ -2264,10 +2546,13 @@ type ServerInterface interface {
 	V1TaskGet(ctx echo.Context, task openapi_types.UUID) error
 	// List log lines
 	// (GET /api/v1/stable/tasks/{task}/logs)
-	V1LogLineList(ctx echo.Context, task openapi_types.UUID) error
+	V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error
 	// List events for a task
 	// (GET /api/v1/stable/tasks/{task}/task-events)
 	V1TaskEventList(ctx echo.Context, task openapi_types.UUID, params V1TaskEventListParams) error
+	// Get bulk operation
+	// (GET /api/v1/stable/tenants/{tenant}/bulk-operations/{bulk-operation})
+	V1BulkOperationGet(ctx echo.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID) error
 	// Get task metrics
 	// (GET /api/v1/stable/tenants/{tenant}/task-metrics)
 	V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error
@@ -2340,9 +2625,27 @@ type ServerInterface interface {
 	// List event keys
 	// (GET /api/v1/tenants/{tenant}/events/keys)
 	EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error
+	// List quarantined events
+	// (GET /api/v1/tenants/{tenant}/events/quarantined)
+	EventListQuarantined(ctx echo.Context, tenant openapi_types.UUID, params EventListQuarantinedParams) error
 	// Replay events
 	// (POST /api/v1/tenants/{tenant}/events/replay)
 	EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
+	// List event schemas
+	// (GET /api/v1/tenants/{tenant}/events/schemas)
+	EventSchemaList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete event schema
+	// (DELETE /api/v1/tenants/{tenant}/events/schemas/{event-key})
+	EventSchemaDelete(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error
+	// Get event schema
+	// (GET /api/v1/tenants/{tenant}/events/schemas/{event-key})
+	EventSchemaGet(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error
+	// Put event schema
+	// (PUT /api/v1/tenants/{tenant}/events/schemas/{event-key})
+	EventSchemaPut(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error
+	// List skipped event triggers
+	// (GET /api/v1/tenants/{tenant}/events/{event}/trigger-skips)
+	EventListTriggerSkips(ctx echo.Context, tenant openapi_types.UUID, event openapi_types.UUID) error
 	// List tenant invites
 	// (GET /api/v1/tenants/{tenant}/invites)
 	TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -2367,6 +2670,15 @@ type ServerInterface interface {
 	// List rate limits
 	// (GET /api/v1/tenants/{tenant}/rate-limits)
 	RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params RateLimitListParams) error
+	// Delete rate limit
+	// (DELETE /api/v1/tenants/{tenant}/rate-limits/{rate-limit-key})
+	RateLimitDelete(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string) error
+	// Get rate limit metrics
+	// (GET /api/v1/tenants/{tenant}/rate-limits/{rate-limit-key}/metrics)
+	RateLimitGetMetrics(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string, params RateLimitGetMetricsParams) error
+	// Reset rate limit
+	// (POST /api/v1/tenants/{tenant}/rate-limits/{rate-limit-key}/reset)
+	RateLimitReset(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string) error
 	// Create tenant alert email group
 	// (GET /api/v1/tenants/{tenant}/resource-policy)
 	TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error
@@ -2839,8 +3151,24 @@ func (w *ServerInterfaceWrapper) V1LogLineList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1LogLineListParams
+	// ------------- Optional query parameter "levels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.V1LogLineList(ctx, task)
+	err = w.Handler.V1LogLineList(ctx, task, params)
 	return err
 }
 
@@ -2880,6 +3208,34 @@ func (w *ServerInterfaceWrapper) V1TaskEventList(ctx echo.Context) error {
 	return err
 }
 
+// V1BulkOperationGet converts echo context to params.
+func (w *ServerInterfaceWrapper) V1BulkOperationGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "bulk-operation" -------------
+	var bulkOperation openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "bulk-operation", runtime.ParamLocationPath, ctx.Param("bulk-operation"), &bulkOperation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bulk-operation: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.V1BulkOperationGet(ctx, tenant, bulkOperation)
+	return err
+}
+
 // V1TaskListStatusMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) V1TaskListStatusMetrics(ctx echo.Context) error {
 	var err error
@@ -3306,6 +3662,13 @@ func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
 	}
 
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
@@ -3637,8 +4000,8 @@ func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	return err
 }
 
-// EventUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
+// EventListQuarantined converts echo context to params.
+func (w *ServerInterfaceWrapper) EventListQuarantined(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3652,13 +4015,36 @@ func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EventListQuarantinedParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateReplay(ctx, tenant)
+	err = w.Handler.EventListQuarantined(ctx, tenant, params)
 	return err
 }
 
-// TenantInviteList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
+// EventUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3673,12 +4059,12 @@ func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteList(ctx, tenant)
+	err = w.Handler.EventUpdateReplay(ctx, tenant)
 	return err
 }
 
-// TenantInviteCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
+// EventSchemaList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSchemaList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3693,12 +4079,12 @@ func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	err = w.Handler.EventSchemaList(ctx, tenant)
 	return err
 }
 
-// TenantInviteDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+// EventSchemaDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSchemaDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3708,12 +4094,12 @@ func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
+	// ------------- Path parameter "event-key" -------------
+	var eventKey EventKey
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-key", runtime.ParamLocationPath, ctx.Param("event-key"), &eventKey)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-key: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3721,12 +4107,12 @@ func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
+	err = w.Handler.EventSchemaDelete(ctx, tenant, eventKey)
 	return err
 }
 
-// TenantInviteUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+// EventSchemaGet converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSchemaGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3736,12 +4122,12 @@ func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
+	// ------------- Path parameter "event-key" -------------
+	var eventKey EventKey
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-key", runtime.ParamLocationPath, ctx.Param("event-key"), &eventKey)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-key: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3749,12 +4135,12 @@ func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	err = w.Handler.EventSchemaGet(ctx, tenant, eventKey)
 	return err
 }
 
-// TenantMemberList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+// EventSchemaPut converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSchemaPut(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3764,17 +4150,25 @@ func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "event-key" -------------
+	var eventKey EventKey
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-key", runtime.ParamLocationPath, ctx.Param("event-key"), &eventKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-key: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberList(ctx, tenant)
+	err = w.Handler.EventSchemaPut(ctx, tenant, eventKey)
 	return err
 }
 
-// TenantMemberDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
+// EventListTriggerSkips converts echo context to params.
+func (w *ServerInterfaceWrapper) EventListTriggerSkips(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3784,12 +4178,12 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "member" -------------
-	var member openapi_types.UUID
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3797,12 +4191,12 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
+	err = w.Handler.EventListTriggerSkips(ctx, tenant, event)
 	return err
 }
 
-// TenantGetQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
+// TenantInviteList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3816,29 +4210,13 @@ func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TenantGetQueueMetricsParams
-	// ------------- Optional query parameter "workflows" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
-	}
-
-	// ------------- Optional query parameter "additionalMetadata" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
+	err = w.Handler.TenantInviteList(ctx, tenant)
 	return err
 }
 
-// RateLimitList converts echo context to params.
-func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
+// TenantInviteCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3852,7 +4230,167 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	return err
+}
+
+// TenantInviteDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
+	return err
+}
+
+// TenantInviteUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	return err
+}
+
+// TenantMemberList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMemberList(ctx, tenant)
+	return err
+}
+
+// TenantMemberDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "member" -------------
+	var member openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
+	return err
+}
+
+// TenantGetQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TenantGetQueueMetricsParams
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
+	return err
+}
+
+// RateLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
 	var params RateLimitListParams
 	// ------------- Optional query parameter "offset" -------------
 
@@ -3894,6 +4432,106 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	return err
 }
 
+// RateLimitDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "rate-limit-key" -------------
+	var rateLimitKey string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, ctx.Param("rate-limit-key"), &rateLimitKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rate-limit-key: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RateLimitDelete(ctx, tenant, rateLimitKey)
+	return err
+}
+
+// RateLimitGetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitGetMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "rate-limit-key" -------------
+	var rateLimitKey string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, ctx.Param("rate-limit-key"), &rateLimitKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rate-limit-key: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RateLimitGetMetricsParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RateLimitGetMetrics(ctx, tenant, rateLimitKey, params)
+	return err
+}
+
+// RateLimitReset converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitReset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "rate-limit-key" -------------
+	var rateLimitKey string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, ctx.Param("rate-limit-key"), &rateLimitKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rate-limit-key: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RateLimitReset(ctx, tenant, rateLimitKey)
+	return err
+}
+
 // TenantResourcePolicyGet converts echo context to params.
 func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
 	var err error
@@ -5366,6 +6004,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/stable/tasks/:task", wrapper.V1TaskGet)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/logs", wrapper.V1LogLineList)
 	router.GET(baseURL+"/api/v1/stable/tasks/:task/task-events", wrapper.V1TaskEventList)
+	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/bulk-operations/:bulk-operation", wrapper.V1BulkOperationGet)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-metrics", wrapper.V1TaskListStatusMetrics)
 	router.GET(baseURL+"/api/v1/stable/tenants/:tenant/task-point-metrics", wrapper.V1TaskGetPointMetrics)
 	router.POST(baseURL+"/api/v1/stable/tenants/:tenant/tasks/cancel", wrapper.V1TaskCancel)
@@ -5390,7 +6029,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/bulk", wrapper.EventCreateBulk)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/cancel", wrapper.EventUpdateCancel)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events/keys", wrapper.EventKeyList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events/quarantined", wrapper.EventListQuarantined)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/replay", wrapper.EventUpdateReplay)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events/schemas", wrapper.EventSchemaList)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/events/schemas/:event-key", wrapper.EventSchemaDelete)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events/schemas/:event-key", wrapper.EventSchemaGet)
+	router.PUT(baseURL+"/api/v1/tenants/:tenant/events/schemas/:event-key", wrapper.EventSchemaPut)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/events/:event/trigger-skips", wrapper.EventListTriggerSkips)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteCreate)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteDelete)
@@ -5399,6 +6044,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/members/:member", wrapper.TenantMemberDelete)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/queue-metrics", wrapper.TenantGetQueueMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitList)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/rate-limits/:rate-limit-key", wrapper.RateLimitDelete)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits/:rate-limit-key/metrics", wrapper.RateLimitGetMetrics)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/rate-limits/:rate-limit-key/reset", wrapper.RateLimitReset)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack", wrapper.SlackWebhookList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack/start", wrapper.UserUpdateSlackOauthStart)
@@ -6006,7 +6654,8 @@ func (response V1TaskGet501JSONResponse) VisitV1TaskGetResponse(w http.ResponseW
 }
 
 type V1LogLineListRequestObject struct {
-	Task openapi_types.UUID `json:"task"`
+	Task   openapi_types.UUID `json:"task"`
+	Params V1LogLineListParams
 }
 
 type V1LogLineListResponseObject interface {
@@ -6094,53 +6743,107 @@ func (response V1TaskEventList501JSONResponse) VisitV1TaskEventListResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params V1TaskListStatusMetricsParams
+type V1BulkOperationGetRequestObject struct {
+	Tenant        openapi_types.UUID `json:"tenant"`
+	BulkOperation openapi_types.UUID `json:"bulk-operation"`
 }
 
-type V1TaskListStatusMetricsResponseObject interface {
-	VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error
+type V1BulkOperationGetResponseObject interface {
+	VisitV1BulkOperationGetResponse(w http.ResponseWriter) error
 }
 
-type V1TaskListStatusMetrics200JSONResponse V1TaskRunMetrics
+type V1BulkOperationGet200JSONResponse V1BulkOperation
 
-func (response V1TaskListStatusMetrics200JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1BulkOperationGet200JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetrics400JSONResponse APIErrors
+type V1BulkOperationGet400JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics400JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1BulkOperationGet400JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetrics403JSONResponse APIErrors
+type V1BulkOperationGet403JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics403JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1BulkOperationGet403JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskListStatusMetrics501JSONResponse APIErrors
+type V1BulkOperationGet404JSONResponse APIErrors
 
-func (response V1TaskListStatusMetrics501JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+func (response V1BulkOperationGet404JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(501)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type V1TaskGetPointMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type V1BulkOperationGet501JSONResponse APIErrors
+
+func (response V1BulkOperationGet501JSONResponse) VisitV1BulkOperationGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskListStatusMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params V1TaskListStatusMetricsParams
+}
+
+type V1TaskListStatusMetricsResponseObject interface {
+	VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error
+}
+
+type V1TaskListStatusMetrics200JSONResponse V1TaskRunMetrics
+
+func (response V1TaskListStatusMetrics200JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskListStatusMetrics400JSONResponse APIErrors
+
+func (response V1TaskListStatusMetrics400JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskListStatusMetrics403JSONResponse APIErrors
+
+func (response V1TaskListStatusMetrics403JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskListStatusMetrics501JSONResponse APIErrors
+
+func (response V1TaskListStatusMetrics501JSONResponse) VisitV1TaskListStatusMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type V1TaskGetPointMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 	Params V1TaskGetPointMetricsParams
 }
 
@@ -6193,12 +6896,13 @@ type V1TaskCancelResponseObject interface {
 	VisitV1TaskCancelResponse(w http.ResponseWriter) error
 }
 
-type V1TaskCancel200Response struct {
-}
+type V1TaskCancel200JSONResponse V1TaskBulkActionResponse
 
-func (response V1TaskCancel200Response) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+func (response V1TaskCancel200JSONResponse) VisitV1TaskCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type V1TaskCancel400JSONResponse APIErrors
@@ -6246,12 +6950,13 @@ type V1TaskReplayResponseObject interface {
 	VisitV1TaskReplayResponse(w http.ResponseWriter) error
 }
 
-type V1TaskReplay200Response struct {
-}
+type V1TaskReplay200JSONResponse V1TaskBulkActionResponse
 
-func (response V1TaskReplay200Response) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+func (response V1TaskReplay200JSONResponse) VisitV1TaskReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type V1TaskReplay400JSONResponse APIErrors
@@ -7087,6 +7792,42 @@ func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.Res
 	return json.NewEncoder(w).Encode(response)
 }
 
+type EventListQuarantinedRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params EventListQuarantinedParams
+}
+
+type EventListQuarantinedResponseObject interface {
+	VisitEventListQuarantinedResponse(w http.ResponseWriter) error
+}
+
+type EventListQuarantined200JSONResponse QuarantinedEventList
+
+func (response EventListQuarantined200JSONResponse) VisitEventListQuarantinedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListQuarantined400JSONResponse APIErrors
+
+func (response EventListQuarantined400JSONResponse) VisitEventListQuarantinedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListQuarantined403JSONResponse APIErrors
+
+func (response EventListQuarantined403JSONResponse) VisitEventListQuarantinedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type EventUpdateReplayRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 	Body   *EventUpdateReplayJSONRequestBody
@@ -7132,6 +7873,203 @@ func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(
 	return json.NewEncoder(w).Encode(response)
 }
 
+type EventSchemaListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventSchemaListResponseObject interface {
+	VisitEventSchemaListResponse(w http.ResponseWriter) error
+}
+
+type EventSchemaList200JSONResponse EventSchemaList
+
+func (response EventSchemaList200JSONResponse) VisitEventSchemaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaList400JSONResponse APIErrors
+
+func (response EventSchemaList400JSONResponse) VisitEventSchemaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaList403JSONResponse APIErrors
+
+func (response EventSchemaList403JSONResponse) VisitEventSchemaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaDeleteRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	EventKey EventKey           `json:"event-key"`
+}
+
+type EventSchemaDeleteResponseObject interface {
+	VisitEventSchemaDeleteResponse(w http.ResponseWriter) error
+}
+
+type EventSchemaDelete204Response struct {
+}
+
+func (response EventSchemaDelete204Response) VisitEventSchemaDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EventSchemaDelete400JSONResponse APIErrors
+
+func (response EventSchemaDelete400JSONResponse) VisitEventSchemaDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaDelete403JSONResponse APIErrors
+
+func (response EventSchemaDelete403JSONResponse) VisitEventSchemaDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaDelete404JSONResponse APIErrors
+
+func (response EventSchemaDelete404JSONResponse) VisitEventSchemaDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaGetRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	EventKey EventKey           `json:"event-key"`
+}
+
+type EventSchemaGetResponseObject interface {
+	VisitEventSchemaGetResponse(w http.ResponseWriter) error
+}
+
+type EventSchemaGet200JSONResponse EventSchema
+
+func (response EventSchemaGet200JSONResponse) VisitEventSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaGet400JSONResponse APIErrors
+
+func (response EventSchemaGet400JSONResponse) VisitEventSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaGet403JSONResponse APIErrors
+
+func (response EventSchemaGet403JSONResponse) VisitEventSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaGet404JSONResponse APIErrors
+
+func (response EventSchemaGet404JSONResponse) VisitEventSchemaGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaPutRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	EventKey EventKey           `json:"event-key"`
+	Body     *EventSchemaPutJSONRequestBody
+}
+
+type EventSchemaPutResponseObject interface {
+	VisitEventSchemaPutResponse(w http.ResponseWriter) error
+}
+
+type EventSchemaPut200JSONResponse EventSchema
+
+func (response EventSchemaPut200JSONResponse) VisitEventSchemaPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaPut400JSONResponse APIErrors
+
+func (response EventSchemaPut400JSONResponse) VisitEventSchemaPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSchemaPut403JSONResponse APIErrors
+
+func (response EventSchemaPut403JSONResponse) VisitEventSchemaPutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListTriggerSkipsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Event  openapi_types.UUID `json:"event"`
+}
+
+type EventListTriggerSkipsResponseObject interface {
+	VisitEventListTriggerSkipsResponse(w http.ResponseWriter) error
+}
+
+type EventListTriggerSkips200JSONResponse EventTriggerSkipList
+
+func (response EventListTriggerSkips200JSONResponse) VisitEventListTriggerSkipsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListTriggerSkips400JSONResponse APIErrors
+
+func (response EventListTriggerSkips400JSONResponse) VisitEventListTriggerSkipsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListTriggerSkips403JSONResponse APIErrors
+
+func (response EventListTriggerSkips403JSONResponse) VisitEventListTriggerSkipsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type TenantInviteListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -7298,127 +8236,253 @@ type TenantMemberDeleteRequestObject struct {
 	Member openapi_types.UUID `json:"member"`
 }
 
-type TenantMemberDeleteResponseObject interface {
-	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+type TenantMemberDeleteResponseObject interface {
+	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+}
+
+type TenantMemberDelete204JSONResponse TenantMember
+
+func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(204)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete400JSONResponse APIErrors
+
+func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete403JSONResponse APIErrors
+
+func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantMemberDelete404JSONResponse APIErrors
+
+func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params TenantGetQueueMetricsParams
+}
+
+type TenantGetQueueMetricsResponseObject interface {
+	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+}
+
+type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+
+func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics400JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics403JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params RateLimitListParams
+}
+
+type RateLimitListResponseObject interface {
+	VisitRateLimitListResponse(w http.ResponseWriter) error
+}
+
+type RateLimitList200JSONResponse RateLimitList
+
+func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitList400JSONResponse APIErrors
+
+func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitList403JSONResponse APIErrors
+
+func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RateLimitDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	RateLimitKey string             `json:"rate-limit-key"`
+}
+
+type RateLimitDeleteResponseObject interface {
+	VisitRateLimitDeleteResponse(w http.ResponseWriter) error
 }
 
-type TenantMemberDelete204JSONResponse TenantMember
+type RateLimitDelete204Response struct {
+}
 
-func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response RateLimitDelete204Response) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type TenantMemberDelete400JSONResponse APIErrors
+type RateLimitDelete400JSONResponse APIErrors
 
-func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response RateLimitDelete400JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete403JSONResponse APIErrors
+type RateLimitDelete403JSONResponse APIErrors
 
-func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response RateLimitDelete403JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete404JSONResponse APIErrors
+type RateLimitDelete404JSONResponse APIErrors
 
-func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response RateLimitDelete404JSONResponse) VisitRateLimitDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantGetQueueMetricsParams
+type RateLimitGetMetricsRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	RateLimitKey string             `json:"rate-limit-key"`
+	Params       RateLimitGetMetricsParams
 }
 
-type TenantGetQueueMetricsResponseObject interface {
-	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+type RateLimitGetMetricsResponseObject interface {
+	VisitRateLimitGetMetricsResponse(w http.ResponseWriter) error
 }
 
-type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+type RateLimitGetMetrics200JSONResponse RateLimitMetrics
 
-func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response RateLimitGetMetrics200JSONResponse) VisitRateLimitGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics400JSONResponse APIErrors
+type RateLimitGetMetrics400JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response RateLimitGetMetrics400JSONResponse) VisitRateLimitGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics403JSONResponse APIErrors
+type RateLimitGetMetrics403JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response RateLimitGetMetrics403JSONResponse) VisitRateLimitGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics404JSONResponse APIErrors
-
-func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type RateLimitListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitListParams
+type RateLimitResetRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	RateLimitKey string             `json:"rate-limit-key"`
 }
 
-type RateLimitListResponseObject interface {
-	VisitRateLimitListResponse(w http.ResponseWriter) error
+type RateLimitResetResponseObject interface {
+	VisitRateLimitResetResponse(w http.ResponseWriter) error
 }
 
-type RateLimitList200JSONResponse RateLimitList
+type RateLimitReset200JSONResponse RateLimit
 
-func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response RateLimitReset200JSONResponse) VisitRateLimitResetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList400JSONResponse APIErrors
+type RateLimitReset400JSONResponse APIErrors
 
-func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response RateLimitReset400JSONResponse) VisitRateLimitResetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList403JSONResponse APIErrors
+type RateLimitReset403JSONResponse APIErrors
 
-func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response RateLimitReset403JSONResponse) VisitRateLimitResetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type RateLimitReset404JSONResponse APIErrors
+
+func (response RateLimitReset404JSONResponse) VisitRateLimitResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type TenantResourcePolicyGetRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
@@ -9612,6 +10676,8 @@ type StrictServerInterface interface {
 
 	V1TaskEventList(ctx echo.Context, request V1TaskEventListRequestObject) (V1TaskEventListResponseObject, error)
 
+	V1BulkOperationGet(ctx echo.Context, request V1BulkOperationGetRequestObject) (V1BulkOperationGetResponseObject, error)
+
 	V1TaskListStatusMetrics(ctx echo.Context, request V1TaskListStatusMetricsRequestObject) (V1TaskListStatusMetricsResponseObject, error)
 
 	V1TaskGetPointMetrics(ctx echo.Context, request V1TaskGetPointMetricsRequestObject) (V1TaskGetPointMetricsResponseObject, error)
@@ -9660,8 +10726,20 @@ type StrictServerInterface interface {
 
 	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
 
+	EventListQuarantined(ctx echo.Context, request EventListQuarantinedRequestObject) (EventListQuarantinedResponseObject, error)
+
 	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
 
+	EventSchemaList(ctx echo.Context, request EventSchemaListRequestObject) (EventSchemaListResponseObject, error)
+
+	EventSchemaDelete(ctx echo.Context, request EventSchemaDeleteRequestObject) (EventSchemaDeleteResponseObject, error)
+
+	EventSchemaGet(ctx echo.Context, request EventSchemaGetRequestObject) (EventSchemaGetResponseObject, error)
+
+	EventSchemaPut(ctx echo.Context, request EventSchemaPutRequestObject) (EventSchemaPutResponseObject, error)
+
+	EventListTriggerSkips(ctx echo.Context, request EventListTriggerSkipsRequestObject) (EventListTriggerSkipsResponseObject, error)
+
 	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
 
 	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
@@ -9678,6 +10756,12 @@ type StrictServerInterface interface {
 
 	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
 
+	RateLimitDelete(ctx echo.Context, request RateLimitDeleteRequestObject) (RateLimitDeleteResponseObject, error)
+
+	RateLimitGetMetrics(ctx echo.Context, request RateLimitGetMetricsRequestObject) (RateLimitGetMetricsResponseObject, error)
+
+	RateLimitReset(ctx echo.Context, request RateLimitResetRequestObject) (RateLimitResetResponseObject, error)
+
 	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
 
 	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
@@ -10200,10 +11284,11 @@ func (sh *strictHandler) V1TaskGet(ctx echo.Context, task openapi_types.UUID) er
 }
 
 // V1LogLineList operation middleware
-func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID) error {
+func (sh *strictHandler) V1LogLineList(ctx echo.Context, task openapi_types.UUID, params V1LogLineListParams) error {
 	var request V1LogLineListRequestObject
 
 	request.Task = task
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.V1LogLineList(ctx, request.(V1LogLineListRequestObject))
@@ -10250,6 +11335,32 @@ func (sh *strictHandler) V1TaskEventList(ctx echo.Context, task openapi_types.UU
 	return nil
 }
 
+// V1BulkOperationGet operation middleware
+func (sh *strictHandler) V1BulkOperationGet(ctx echo.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID) error {
+	var request V1BulkOperationGetRequestObject
+
+	request.Tenant = tenant
+	request.BulkOperation = bulkOperation
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.V1BulkOperationGet(ctx, request.(V1BulkOperationGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "V1BulkOperationGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(V1BulkOperationGetResponseObject); ok {
+		return validResponse.VisitV1BulkOperationGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // V1TaskListStatusMetrics operation middleware
 func (sh *strictHandler) V1TaskListStatusMetrics(ctx echo.Context, tenant openapi_types.UUID, params V1TaskListStatusMetricsParams) error {
 	var request V1TaskListStatusMetricsRequestObject
@@ -10917,6 +12028,32 @@ func (sh *strictHandler) EventKeyList(ctx echo.Context, tenant openapi_types.UUI
 	return nil
 }
 
+// EventListQuarantined operation middleware
+func (sh *strictHandler) EventListQuarantined(ctx echo.Context, tenant openapi_types.UUID, params EventListQuarantinedParams) error {
+	var request EventListQuarantinedRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventListQuarantined(ctx, request.(EventListQuarantinedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventListQuarantined")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventListQuarantinedResponseObject); ok {
+		return validResponse.VisitEventListQuarantinedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // EventUpdateReplay operation middleware
 func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request EventUpdateReplayRequestObject
@@ -10948,6 +12085,141 @@ func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_type
 	return nil
 }
 
+// EventSchemaList operation middleware
+func (sh *strictHandler) EventSchemaList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventSchemaListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSchemaList(ctx, request.(EventSchemaListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSchemaList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSchemaListResponseObject); ok {
+		return validResponse.VisitEventSchemaListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventSchemaDelete operation middleware
+func (sh *strictHandler) EventSchemaDelete(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error {
+	var request EventSchemaDeleteRequestObject
+
+	request.Tenant = tenant
+	request.EventKey = eventKey
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSchemaDelete(ctx, request.(EventSchemaDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSchemaDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSchemaDeleteResponseObject); ok {
+		return validResponse.VisitEventSchemaDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventSchemaGet operation middleware
+func (sh *strictHandler) EventSchemaGet(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error {
+	var request EventSchemaGetRequestObject
+
+	request.Tenant = tenant
+	request.EventKey = eventKey
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSchemaGet(ctx, request.(EventSchemaGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSchemaGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSchemaGetResponseObject); ok {
+		return validResponse.VisitEventSchemaGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventSchemaPut operation middleware
+func (sh *strictHandler) EventSchemaPut(ctx echo.Context, tenant openapi_types.UUID, eventKey EventKey) error {
+	var request EventSchemaPutRequestObject
+
+	request.Tenant = tenant
+	request.EventKey = eventKey
+
+	var body EventSchemaPutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSchemaPut(ctx, request.(EventSchemaPutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSchemaPut")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSchemaPutResponseObject); ok {
+		return validResponse.VisitEventSchemaPutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// EventListTriggerSkips operation middleware
+func (sh *strictHandler) EventListTriggerSkips(ctx echo.Context, tenant openapi_types.UUID, event openapi_types.UUID) error {
+	var request EventListTriggerSkipsRequestObject
+
+	request.Tenant = tenant
+	request.Event = event
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventListTriggerSkips(ctx, request.(EventListTriggerSkipsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventListTriggerSkips")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventListTriggerSkipsResponseObject); ok {
+		return validResponse.VisitEventListTriggerSkipsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // TenantInviteList operation middleware
 func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request TenantInviteListRequestObject
@@ -11165,6 +12437,85 @@ func (sh *strictHandler) RateLimitList(ctx echo.Context, tenant openapi_types.UU
 	return nil
 }
 
+// RateLimitDelete operation middleware
+func (sh *strictHandler) RateLimitDelete(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string) error {
+	var request RateLimitDeleteRequestObject
+
+	request.Tenant = tenant
+	request.RateLimitKey = rateLimitKey
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RateLimitDelete(ctx, request.(RateLimitDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RateLimitDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RateLimitDeleteResponseObject); ok {
+		return validResponse.VisitRateLimitDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RateLimitGetMetrics operation middleware
+func (sh *strictHandler) RateLimitGetMetrics(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string, params RateLimitGetMetricsParams) error {
+	var request RateLimitGetMetricsRequestObject
+
+	request.Tenant = tenant
+	request.RateLimitKey = rateLimitKey
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RateLimitGetMetrics(ctx, request.(RateLimitGetMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RateLimitGetMetrics")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RateLimitGetMetricsResponseObject); ok {
+		return validResponse.VisitRateLimitGetMetricsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RateLimitReset operation middleware
+func (sh *strictHandler) RateLimitReset(ctx echo.Context, tenant openapi_types.UUID, rateLimitKey string) error {
+	var request RateLimitResetRequestObject
+
+	request.Tenant = tenant
+	request.RateLimitKey = rateLimitKey
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RateLimitReset(ctx, request.(RateLimitResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RateLimitReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RateLimitResetResponseObject); ok {
+		return validResponse.VisitRateLimitResetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // TenantResourcePolicyGet operation middleware
 func (sh *strictHandler) TenantResourcePolicyGet(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request TenantResourcePolicyGetRequestObject
@@ -12652,241 +14003,285 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+2/bOrI4/q8I/n6Buws4z7bnni1wf0gTt/U2TbJ20mLvuUFAS4zNE1nyEamk3iL/",
-	"+wd8ipJIifIrdiNgsSe1+BgOZ4Yzw+HMz44fT2dxBCOCO+9/drA/gVPA/jy56veSJE7o37MknsGEIMi+",
-	"+HEA6X8DiP0EzQiKo877DvD8FJN46n0GxJ9A4kHa22ONux34A0xnIey8P3p7eNjt3MfJFJDO+06KIvLb",
-	"2063Q+Yz2HnfQRGBY5h0nrv54cuzaf/27uPEIxOE+Zz6dJ2TrOEjFDBNIcZgDLNZMUlQNGaTxj6+C1H0",
-	"YJqS/u6R2CMT6AWxn05hRIABgK6H7j1EPPgDYYJz4IwRmaSjfT+eHkw4nvYC+Cj/NkF0j2AYlKGhMLBP",
-	"HpkAok3uIewBjGMfAQID7wmRCYMHzGYh8sEozG1HJwJTAyKeu50E/pWiBAad93/kpr5VjePRn9AnFEZJ",
-	"K7hMLFD9jgicsj/+/wTed953/r+DjPYOBOEdKKp7VtOAJAHzEkhiXAs0XyEBZVhAGMZPpxMQjeEVwPgp",
-	"TgyIfZpAMoGJFydeFBMvxTDBng8iz2cd6eajxJvJ/houSZJCBc4ojkMIIgoPnzaBgMBrGIGINJmUdfMi",
-	"+OQR1hc7z9iPHhHhC3ecDLEeXsy+8p8ZtSPsoQgTEPnQefYhGkfprMHkGI0jL51lrNRoypRMHEiLksUJ",
-	"bfrc7cxiTCbx2LHXlWhNO87DODqZzfoWrryi3ym7ef0ztpoUQ9aHcj2lIuLhdDaLE5JjxKPjN2/f/fbf",
-	"v+/RPwr/R3//x+HRsZFRbfR/InCS5wG2LhNVUNAFXDDw6KDYi+89ilkYEeQzQadD/EdnBDDyO93OOI7H",
-	"IaS8qHi8JMZKzGwDu09PgARIsV+QJhEVYBVcKyhHDUGloejkxRGT3BpdlQmJiUMjbugXihA+RAZjWbrX",
-	"ilMhc+ViKmTYVUakBVE2Q59jTCwUGGPyOR57J1d9b0Jb6TBOCJnh9wcHgv73xRdKnKbjB8zQFzivn+cB",
-	"znPTzCYPdxnpgpEfwHtn8h1AHKeJD81inMvE4MSyeoKmUDsUEzGW9wSwEKc5qd05Pjw+3js63jt6c330",
-	"7v3hb+/f/r7/+++/v3n3+97hu/eHhx1NXQkAgXt0AhOqkEUgoIDTjQZM10ORd3PDBQQdWgdoNDo+evv7",
-	"4X/vHb/9De69fQPe7YHjd8He26P//u0oOPLv7/9B55+CH+cwGlMmf/ObAZx0FiyKphBg4on+68BVgR8Q",
-	"nSTbVR10C29cxw/QJB5+zFACsWnJ3yeQsz8lVkK7e6L1vvMGTyEBAeAkWXNm5CjYKleuC3JFwbaf39/j",
-	"d+/qcKhg6yrxopBhRKLvwxnhOsIA/pVCLkzy+OQKAcfsctQ5RZGdWLudH3sxmKE9aiyMYbQHf5AE7BEw",
-	"ZlA8ghDRfem8VyvupikKOs8lQuLwmtb7IQ0fuA7We4QRsS4ZPkpbyElfNQxZq7nyGW6fu51Teg6FDgD1",
-	"gzxIjbcjM7hSxm1NtsdpQRRCtqQ48tMkgZE/P0dTRIYkAQSO5/z0Tqe0w+nJxWnv/K5/cXc1uPw06A2H",
-	"nW7nbHB5dXfR+94bXne6nX/d9G562T8/DS5vru4GlzcXZ3eDyw/9C22PMyj5ZkjxYMcoZ4x+ZGbIIE0y",
-	"o+5pgvwJ400uMxD2GDnudxYn4niKSITCrpyIIdQsIE64eOA68VLygY1vYowi0vAsjjAsY41IkVvGWA6s",
-	"ajD4KHY4TpM4+h4nD/dh/HSdoPEYJtZ9BEGAKBQg/KoJ5tLAfhJHvR+zBGIsdMoS4dAmF2IDysd6NEuJ",
-	"YeSS7KHNuiaotAlK4NyqpVeLAfNiC9Si2njyOFCkw5hU258MP+axGCe4DfBg0g9p/wc4t3a30AdXIxlI",
-	"GWaGF0PNKrCiiMQz5J8kNiKdgv/EkScPZo9uh/e3k8HF3+XpO7wYemyMZZhbnVBTFP3PUXcKfvzP8bvf",
-	"ykeVAtbOC9xZcBLChPSmAIWfkjid2aUabYJNIiREmNA18hbSJE1wx9leW2D5AXqEXTZjee0C1LqV1ygn",
-	"fHDjXrNPclvpWj0SC+/GSvZWrqvbSeIQ1ukIfDVf4XQEkwFtb8RHRwxWhxUrPtxUTO5FWgUW2DJwmI7N",
-	"k9Ivq5+0KzylTJg+WwxrBpQZj9npgl1lbPbrldY654nKHzZGftI8F2WvgzpiGs21hDkyhWQSB/XKrYau",
-	"r7yLpqqUZQZn28D48UkMVPPZegzLBt9gQg9O4zB2m0iBZhqoMHsOVrGl2QYq5NUS2DkysekMjFGk3FtV",
-	"6L9SLZVWxiTOUxPzRCd4JzecadM13f2s9/Hk5pzq5CdXfYsWrg1wmQQw+TD/KC8x5DCRVIZgydDPRmIa",
-	"0SZVoaU0maUYkqiLgfqTpMhqZXD7Z3nJW7wQEtdF1oVI+h+k0TCdTkEyr4OMbdX3crcKluSqnlrIrdzw",
-	"M2By+jXRUr2//XN4eeGN5gTiv9frnErbZNN/WY4G5BhbwPxqOWW+l4BuC5QVIAoJcoYS6EuQpBQB2O/w",
-	"i2K7/LBJIAfRM4Qg8SfG08hG72VnNXPxGO8smGqWUq2Qcqtq6CVplHdK2u/I7wFyGJq3ajLuDEYBXWnN",
-	"wKJZk5H/SmFaDzFv1WTcJI0iB4hFsyYj49T3IQzqgVYN3UdXVI6rPJEGA4d929ctuAV4bIkTyy7WNffm",
-	"P+ORQZBXhXUwea4FdohT7M94tL8mh3xpTEzgzF16DQmcmRBbqQoTNIVxSszLFx/rlv64rBr8qKm/0m5i",
-	"Szfptf+MR4M0qpBu/MrF7RpFdVLxRfYmAwiwxaK6RxHCk2ZT/8kpsmpHKdHylpbdW4LoEojTkBi9lJiA",
-	"hDRbDCaApNhhPfR84m0FfQ/SqBmJ081vTuX+A0yqWaDJcjWltA5k7WAu9FzebOSDSAJRu2DnmqHaJql6",
-	"XPUuzvoXnzrdzuDm4oL/Nbw5Pe31znpnnW7n40n/nP3BL0r43x9OTr9cfvxo1FaoGmcOn3ANuip2NWy2",
-	"mIRdE2D7PcFGlUd1FWzUHynEed8xfmF489DU3qxpsImJTGTGlhkC/+E7HE3i+OHFF6nBsqolxuNzFMFG",
-	"sSD0MGWfqSJBJYs8UsN47IUogk0u/nnAqHEOOpxoUKuk2HrzFgafRAFbepBEFsWqZrjNUHUOH2GYd9x8",
-	"uKGCpn/x8bLT7Xw/GVx0up3eYHA5MMsUbRxlPDntfw4CkyAR31/e9pRkZZYe/OMS9md+hIYWqOhcYYMa",
-	"EKCHBvzs8It4cjdjtHvc7UTwh/zXm24nSqfsH7jz/uiQGn15zsp1NkUQiRbejFOhmvjYyazSYDGG28Ef",
-	"5ZHfuI2crcsY+BQTEOpGLG3KPDshwoRfS2Th6ocuVpxBYv2LWrBfIUmQb5DHUTq9cjOxGR1LQ3vftt5/",
-	"OVnVfCzE46CYiW0dcOBmTvMRhVG9b0ZN7oJGgZqbpasjxCT/B4BAFk5SRqWTzzah4j+kAxhFdAgwGcB7",
-	"FFruE1k8nAiY0wdjwXIJ6whZSMgaogrZRN9AmFqOnyn4gabpVPdx8BtC7LFAbOHyFbv+hKIgfjJv+yp8",
-	"yjWIfrSvQ0oTwzqmIICui+DfzFPwb2wZdC9RpIX3ZGjmIcP3ceLDwDVgQbMTtP2S61VQ5SjtVqfrLTgM",
-	"Mx4zHofq8xIHYnGM0pHIsSmxpqHSOBr0YUSGmj1buCdi4NnomX/1TKFcugOiiYW6iEdiCW/C2lwGAqWZ",
-	"z6BkQBfDCat5RG1EV7etBSzF0Y3iH9K/Xk+w6gDOQjD/peJC+ZI0xwy2rixHDy+7Pq35u8PDmvUW4Lat",
-	"2uY40bq7C+2Cp8sVPgldQrmcMXsFWzUIf6SjFnwchgHHEJObxKJr3QzOPRJ7GEYBi8gTZi72SLyeS3fb",
-	"AZFG6C+qDQQwIugewURpk0IBEo8neOCg/uZoBMM4GkuIa2Rld51xi26uzcpYxKE/gUEaQo3Slo3ItZFU",
-	"t0N4yK/7kdYkCDcb/FZbV7A6Fy0LWqd/DE8/985u6I8mvUXNvN5QtC0NKiuvPossq75PaEobq4s5G6TR",
-	"qe5obHxhwQHY9OmlAeCyxKGTcvi91OElg/MyoqiMyysT3RYYXAY54BShZ+WgRmF65VFsRpmO42qf5RBO",
-	"wWwSJ3AYxmTFFlnO2jFfm3MXBA5j7pgRPdzd/AtaR+JG1bYs+tlLUrmwenVAvxqtXygKQxkz4L7Skmgy",
-	"OEtEE3fQCwyeoaWrW4DFe1R5f0rJR784Kl/1TEAUwdAGr/jsocDsmcJ0cO+Jj262+fkIF9Y4ejkFi6df",
-	"cJKl1FUwta2eflti6bS7fd1s8GUWvRWKtpsqLBGh0J2ni65GhsaDhsCZTe6ZI10mKAwSmL+sr7Gz1xSd",
-	"MgNJ6e1rLSQJBAEYhdC2ufK7egXPBWItmSwVNGWZwU4B2ipy5CCDPMQG8luriq1fQ5DUCenN4twNoObt",
-	"XlEoFSPC7zb/Qy0N5Lrj0ziNiBlcaIVyEddp1qcCQ0VbMxcL5hBKJCLfVPvVs12cEhuIC3Iku9o7uScw",
-	"cUfmykPTeJeKnVlC23KNyqRtbeLEQdY0WbHqUrFiqvpYIuKcDidFgWplleFnAnUniT9Bj3An5VJzo3ur",
-	"RExMLSpzpwquTyBJ5hVSdG38qJkxm2GJCotBQ4LEo9n6tNH7Nhj4eQY0XquKNpanbr6dCuze1cDcQQti",
-	"M5Cc5EGH9Yh7KdaD0g18hAki8ya9h7KPE919RAkmQ8iVZHfaOwdNezUMFOZWRg7AwswKsxqa9Mg9vr8V",
-	"xLwtr7RyZFpLyJlIlz6kQY87x+8uLu++Xw6+9Aadbvbj4OS6d3fe/9q/zpzn/YtPd9f9r72zu8sb5sca",
-	"DvufLrh7/fpkcM3+Ojn9cnH5/bx39ol75fsX/eHnvIN+0Lse/Js78HVfPR368ub6btD7OOiJPoOeNok+",
-	"9/D8krY8750M1Zj93tndh3/f3QzZUuiaPp5ffr8b3Fzc8Ww1X3r/vtOvDCxNBKBGd5qJYzSkaqGcYoGD",
-	"/nX/9OS8arSquw7x1x1Hw9feRQHxDe5CxN+8dVXsepYSs5isEyYiaULPktriu0z6F3ustfQXTFkvvG/M",
-	"8AciEM4J8vHljFympGLUzAExAdiLZwQGnjAy1SDmOdaeKMyWUGHpjAzZyyK3t8jCT++QjozBlY1uknnG",
-	"hCebzXSypjdx9oQnxjVvgcA374UpMcw43uNE2xmwK4zn/KpQNB5CQv+DN8fkPFlD78cM0V1mT0QYMNXj",
-	"8158Guw9sYyB7LWLBxLogdksiYE/QdGYpw5kCK6aXyZs4UTCAt8WhIIvWeZoLMPDIuUqcaF5dz4CFKYJ",
-	"dACFBWHogOiXApi9KzbPGQLMl2q/sMliakEkdpZd2oiH9Y7Rc+CHJLKPzO8R+XNrmKx3L5t4gMjQT0FV",
-	"q/XV2yWBEWC7XOirmLb15D56VmkiKy+bZJJQkSB6k4kzF0uwVHflIBjKdmEiP9uxxltUXZmwEXLZ+6xn",
-	"bs3BITNDZXulp82ooZ2tOUoEKTc7QfieluF/MYJyz9BCWa+u9Q2GCe9xlY5C5FeRAhuvIkeYDvPWbLrY",
-	"v0U2fSD2SVopl98vmKV1cva1f9Hpdr72vn7oDSpMiuoXOMxHju3hUSYPSjlcLSYgrMNEDg7NyVA1d5Px",
-	"inGZCgGS8nUsKtu7941bd7pVyizIywstgK0CvTm1xqTZgWRa8WyFffdYpL9ZBvMHNiT2nkDCEkGU9B3e",
-	"2/wMpNmLHvNjntW8z+Fj25dohn+5JANq2+s5VBGJ2+ucug1r/ihnCglM5NMceVTysby/oX247x15AZh3",
-	"vSPvCcIH+t9pHJHJ3xe84VfoMT7VsUtWiairOES+IdEPV8GrrFKVYp03NegFDSRrnv3qQr8FcPbVCefQ",
-	"2mVmJp2+ZW4GKZy+HXa6nW9HZqHDg9A2EIVsDWy/YVnpX2NWVn3lNa9wVpIQ1ark6IDY93+HPYet4+Jl",
-	"HRdrdCisJUF8A8fwwn5dCxd+Z9EM9ndD+Aqk2PQoXWcTHhLhIezNWGsPRIHngyiKiQdYiQpW+0omVCtu",
-	"mBE6bLIYaz0mIAgSiLHuOckpgdIULztQ6IfPAE9MUn4C8EQf8r9wYToh97kexUtHDXkVJu90Aoh1wm8w",
-	"QfeoDr3M/0Nl0KNoLsqX5WAwc8IEYHuRNOMcQFVF8zAkG7wZCRCehWCeYwS5f41dLXns3loILF9Fzp6T",
-	"Gz7Zkch4Fz5lWJMKoRn2BY57VaXumQWTVQGigKjE33IwlFL/qBp6Op5sKD+PxyhaPBv8Yvy9VHL4rcO4",
-	"XOOsDtcDOEaYVEj3bUS32wlpEQxbuFuyjpPrpulqNZ6gGd5VN2DJLbrB03wdpwyfzLRt3454CahrgB8q",
-	"6hURmEQgFI/hrYakaOb1z3BXaKM+iLwE3gv9HPEzG+AHL07yD3z0zroFutL8AN3OPQpJvVv82xHFx0fe",
-	"1qjjfTs6A+NT7elE8amQ4VFF/YwqaXkZ8ACMXTNfGIBt09c5p69TyNoC4ZVtnDHi7dsRT+/QMm9T5qUt",
-	"LBGvLH77a0WoKssqcs1+dQGkp5ovGBpbEahNN+OMnwrW99W0jZPk4JGjmIDprFnAqnxR0Oz5G2/CgdOn",
-	"1hGcIea2ehu3glczorJwa54c1h+c2jAaVY6Vi0ItRp6aw1aL0ajD3sX13bW+GLWGO57xsxQ6ezronVwX",
-	"slh86V9dWUJTc1zu6IF3D8HDKOL3V03ebcOmxJK9KSrOn0aEq5dN00zkQajn+Ko7G44EO+ddxYhq1iQx",
-	"KciC4IzSLovdNd/YoClcMP2KaGQIDnZahuHKiT9La7qzOmqcUirwboM0suHTr3yO4/COLU9ypYcvIhWa",
-	"/T1AAcKmGMmWZiD3HGyaXFSSIIv7Pr38enXeuy6Fe1dEsed165VXc2IaUZJGxoJOuiFguprnypdsxcbC",
-	"rvewtTZDjXKvztxsGVqJcnftPshrIG6GqwFr2ohplruroj5uYShW03yKwhBh6MdRgM2xAEUFz2DB0xbS",
-	"JinO4v1NxQgAAjGhv/29PhmjE/rp8LKbO/7VPXJ5DvapAuUrSbsUpdPhDDxFMDitpHatUA1vXqb7qmeK",
-	"5QH5t4YbZHlW7Lw/a0rqUtQIspfDloQuWjpcgB/2V5BKkWqnyli0cEZm6K1hdofMv2Iyg9JA+0cYJs0F",
-	"HhLd3Le0WaLifF7mTebFrCM5VehamKf5dGW12mOT9GULUJbuYnDJW2TMAVaeSVxDGyZaJoWL7lnSD8Qu",
-	"N3RlXkCDrFQyTstIW+BF3VDO03k+dwy3mbVdvC2qP+s1lO0azioVm4UKeHw72lwAV5kzqm+EZOt6R34h",
-	"E10WGpbxsjEDzHp13MZqJj1HW1WzVTWXSFXzurTB7Vc4FlQdas5qgzIgju+l8q2hIHfYZido/uTOJfpU",
-	"h7RF6J5BIkM9Cx6k+hxPeelNqWQC6vU3PREqbf8xTgzwSM2+opinfkiLyp5K8heUsOVd4RwcvKqb0WLg",
-	"LoNSW7DEpZy2vG/5I6VQK7TmcmUNDzT1KauAfSlNTj9hG2h0FoyvSrnLWTJ6GtqTT51u5/pk+MXoGhS5",
-	"Pnkk6Upf+bnFAomclSIK1aigpLY89bJvmoSN4gxFVA8d14TLHEp40ml7dOGqFomhn0DLycu/qWpKIoSJ",
-	"HgFe/96LYuLNkvgRBTDoesBLQBTEU9mJJacdQW8MI5hIVVM/yo7XhvHmaA62kwAX25tNk7KCsxbZVHBu",
-	"SfXQvPhxuijKdbEypjCs7oBl31g4D4gCraYYH2oxs8wt8b4J9Cz1PlfHTuPAQrWfr6+vPN7I8+NAUXAi",
-	"kO9Q/E3DioI5N/GtI8KrSUiWDas+RyXNy9bOz++MFLAw7ZQzt3/qXXe6navLIfvPzTXTQmwnJM9Li6vy",
-	"qWP+OlM8tPBB5M1gQulqv1FWHPAIUAhGIZTpYWuq7JenhT+gnxLo+XEkXpOGc7PdTnUcQPyJjGwp13DM",
-	"kgkDjNE4goGXdWLegZub/pkn2Gfz5lgIRjDE1U9pWRvGUjlPFD8GnIv/wOScjmPashBg8hmChIwgcEgn",
-	"L7aKvYzGFEDgTWTvddU2BJyZqXrQwwSMQuYQ2UJIp+CHnfANJRiXY4D16x12fSMpVdUzJfWmbVRlg7y5",
-	"2oCACxX8TMmM04huST+6j924YaB1YNnQYttJgGWxCl5IgTPiggspFL4whTQpx5PpQpEdq6W9kUfCyel1",
-	"/1uP1W5Wf16d3AwtARcul08cWeriiZ9M1lIQ4qzkErUAZL2vife+qdM+bwbnhuGbKqOsvVGR0IRlsyKy",
-	"Mu6adl11TYeKlAs81ULN5HZ80CVV4OHlIzatarcCcpBn/kK+BRCNU+HYdxYLw7MvmB88vLOWhKAc+GtW",
-	"jIRE6v0gCTA2wMGDfdjS4hhEuvp3eX7Cc3H++/ozy8Vy/e+r3vB00L+6NvtQMk7Whhn2zj9+vhzyQK2v",
-	"JxcnPMrze+/D58vLL9aBZF6aghtOp02jPZP94nCp1m3wNpj5PtXrYPOb0j/jkUWw0i8mgJzo85/xaKXp",
-	"IZuczVbMyZdkBvUIjBdfq/LfAaPyL5z+zctSCkZQFWyqfI1FWW4TXnTcU6lCmTKxjCHRvqskooXr1kjW",
-	"m+KPLcaQYIY7P+vqjWlfdShpPtR9ayagIUkAgePabNgahOe5fs2VzUyfzKc5yJ3OKCJvjuttdDl1cTVd",
-	"I1artqh/ZnrvogDsnxlxKHt/QVHOKv54c3F63Wfy8OxmcPLhnOpAZyefKiUZHUQedI3Ils1u4AP53Xx6",
-	"LlV6Z8MHLxP0bl4L0doaos2Y5AvMKhYYZFNMQGiiWMVjD3BuucSXw1OyrJiiYHtRngUenkEf3SM/m8T7",
-	"2wxgDAPvEQGPP2j6u5krrIhoEOHRqPJm3WWXHiqhLNyjQ60s8doq7SxWSpSXK3Gny6zUzgrPXF5C52Xq",
-	"b/K5h3p9g02DsLYa8cYyoC71W2HwYd5g8GutVzlwoaEesvZSpVlQgwb2bbUw2RJTrKqKeBX4omroGUqg",
-	"qnKnHBfDU3pM94anled0Nkqp9qge15nRck6KaZKxZpKhDOtoZXcru1vZ/VKyu6Ya9y8k2iviwhYQzWy0",
-	"PoFTe6SZxV6p72xNoTFkD2Gq37UumVcge2uz8ic0KxjQItOLL1+LLwZUgb4iIrVR66inolJPg0o86hVn",
-	"VRWe0rQL2c15gWInxuu8OCkGT8TRlSb5DVUB40hWL7eniFhXfdzvzWqEZclNqjcb80RA1pCSXGmyNbKj",
-	"5cWDmLZuEVYnAXvY3ISO5FCnvGOdFlpoXpo/YwjjG+6q5/KS6YwfBXMZv0kebf4Iv2qx12BsQm/IVcbl",
-	"ffPRit/MiHs5DmEV/QihcJpQQ+beLBcqquTeIQs31k0oKtYZZmRy5E7cDa56WmxeYXPNoIA3g+SFj6Uq",
-	"wg0GVvhZrXLP1S0z+jIN7E5cPTRHM09Su4L0tPVXUFVgaNpskWVzVxguG6LfelCjEt6DNCRXCYplZUAT",
-	"+7NG3ky0MjFw7SVBdsf2QjdnqpCuA6hYqAbXWcF4g52E/Ie5LRqDfvOwuPpwu5bTeLoBa+FCXmb7a1kX",
-	"IPTCIq7+/0qbzG4rSZiz0rz6q9d6dmD7usoLlCYE8qoQzsMDspuTPMbvE8hCliqqTU/Bj5oWDavm2nLc",
-	"8Fj3lAopqt1POYQjCBKYnKSEpddmGGWyl/2cbcqEEFad0I/jBwRlc0R3lf8kb5XfdyYsSlTLrA1m6AsU",
-	"gSdIxJoYAqB5N+/kqs8SphHmCcr/qiirc7R/uH/ICHMGIzBDnfedN/tH+4csjyuZsKUdgBk6CEVp9rEp",
-	"xv+TvJSmrSKIsae8EHQXmUuRorxzLr5/YuuSMdlsluPDw/LAnyEIyYRJ5Xem7xcxUXPmdqbz/o/bbgfL",
-	"ZD4UwqyhDE/4Q4zvT6D/0Lml/dlaEwiCef1iaTNUtdqBbLDK5TLgWPp+nnaeJOD+XpTkqlq9grZ2+Y9H",
-	"B0DUFthjKWH32LUkPvjJftZ/e+YwhpAYdPEz9jv2gCyvwEtY8MS3rHsJY4VyJXwERosJYAV4KNgVdexK",
-	"M3girULnPaPnjLtKS+no3M+9zVwuLm26Pt+W9v5tGVvD1PchxvdpGM49jtJAr9BRRt5zt/OWU4kfR0Rk",
-	"qQSzWYh8htGDP0Vx62wdNadVL0niRCQ3LkZETEFIsQADL068EQjkiwQOxpuVg2GC4mOcjFAQQK7LZvTN",
-	"6aSKzCTFi7p3t93Ojz1V7YM5xviHroEwbpkRRXxD4QSuvC9D4nyEX4PEGT18iLnsXAkxOJQyMpBJJbZI",
-	"7KUS53lsPJtF9EoWYilTXIY9JwY4oK0YcBQDnFrWJwb0A3KG9njpooOf6m92Gs5ibFAaBvAxfmAlhE+u",
-	"+rzokYj9UTMWxMQMsapK0j1Au7tICTW8RSZIWLfquEvY8gSdM+h+baLGTahakA7d2Guxc5KMs9+qKFlt",
-	"eY6C/TBOgwPdlLVru6VEP9KcYIN4KMIERKxUYZ6IT+lnGaxgV4LXj1sGiJdG6nXg1hBYjdbOEazf/oqt",
-	"/6rd1/zYk0PsxTMeOiFONG2/uXP14Cf773PVflMpxVrtlzaU+Vj5RtZKIjaEVTlhXzcqhFa32SJlSc3h",
-	"nUCSIPgoxBrHBtuxVrblSFzDTEbeHMUVUo3Tz62dwg/qxBrbFiXVamj+TAmw1073Z4yEW9rfLtqfwoXP",
-	"cOvpvbmDWyQ7akJT6kjckYN8FUc4HeOAObT5LmHrjp8jTA2g0Mu1tm0wbd3PN1zbbtO5xI5rUzbcfJkc",
-	"I7e6bSIEtfVsIwqbUN7/3CbHESIxleYHPznHPx/MkngE7calvKXzQHYRTGKP+XUZvvIPt+0Mr6a+ijEZ",
-	"pNEVm9fdN2U79JTk2vCpV0FQIskBpyeG3/2NngoXMfFASiZxgv5DoYhluhOejoG/+Su5OQlAIQw87rf3",
-	"2PZ4H4U872fbaj44cmSGQ+A/HPxk/3Hw4ntD2lC+gS9RDvsq8sa4O+1zY1qJh4G4ld75PE62SbU52gwY",
-	"N1FGwnzid5uZmKcjYlndQBjGTzAw3wgUqVaKXvZ7lYrFiS7PMRE++Ikj7MQtF0Nd6pf5JcIN2CQ/mJ1R",
-	"xMm9dWxSQEbLKFvIKCWCVaxyMaxklAgb2EQqLpq3yay60HmlSVxikcZ3Yy+mf3TtjoAHOF/UE6DBcPzu",
-	"XQ6Io1XoQLMkpv+AQXuGbRFr2oxIRCbpyAOzmaT28rHG2xT4kYBRCA8CMMYHKqWy1WjEzGpk7TwyAcQb",
-	"wTCOxvobdZXhl05a5FpW8JYOdC0qOdS7y2RFjyxXHs/Gy1jmrxQm84xnAjC+Q0H1Mbeu9wZOcqcA70sZ",
-	"Ps7Uu7JSHHqdY2OqpAo5RKeUt39s1tftJex23m1K+FErFE1nIZzCiJR0A+a8UDW35NU5wA9GCcMaHvyk",
-	"/6m5XuIZ5EdzzjdFAUIncHS1s3Gshz4FdDcd7YVk9o18Y2zZr52B3nLjZP2zXusVwuhRfh+nPCfPlvBw",
-	"xnAlHrYr9cSFxw/CeFynTMga8DLRjYCjyPJ6KfVXzfY6IhqcmuLhVHu5lj+6FPVppH8ej5enfPr/e9lr",
-	"NfsVjFYlxUr8+erkW07+3YqcViT28AOaWVTh+P4es1PdAAqKyG9vjemtqqdjud+80dwyJfvccMb1H+vZ",
-	"Xi9wi97qxu3RnpNxJgmz/DHPWmh+PCbwptmj+QrdXsCnCkUlaeSJntURr5w76KJ4bgb5Rn9X3X8sLY7I",
-	"Bh97Yx4DINFgkVi8lr4ToJXV7o35q9UbYXbdeI+iQOUgtICjXjdzn8vL+Fj4+3VGTP+FdXeRBWjx3p22",
-	"v5Ot73gpt50x/bT6+c2tP53lWlfK9phhbG+mSqq5SWl38TyLUUQchfQURSmB3miu/kogeAjip0jJ7QYy",
-	"+xMkV3TyXZfYTFaDewKTfOVvkdyvWLHjaO+Q/u/68PA9+9//WgSSzA1IB+6sRpYzSEfwPk5gAdSYwrcE",
-	"sDJ13wc2eHNw1y8bc6S2gHRkfNLKxy2Vj/ndWbmUxAc+y19lv5Hm+a2U29sk73iTnYmLW/2zzG9HHAVM",
-	"Val5h8mvEmPPl0hzenRZwdJ8oLC9MGqNYoMgKbDvysVHAmchmFc99GRlb6vEB2/yqsUHR0ET8ZFIpC0r",
-	"PvhArfRopYdBehS4d4XSQ7pS9pI0qrs1y1VHyRlg+waRUkzRvqvW1690i9AtV5SRcTX8LQbE3AcXUmPT",
-	"OrVsa/a9OUQQWJLcWuIVQRIiiAkv++sC3hq9lSEgTUBJI4LCFRjXJyr5fPZg7mHv0ZsBlDhtWZa9/k57",
-	"8mXYvYV8oZoPF++UE1eUF5Wu52oc8sar9dl2rekzYw9FfpgGLO4Q00M5jsK5/rsKhTMJpCic38kGdkYo",
-	"p+KscXXn4iIdcPYreL1FwFPT6IdWidu2S8mcAqPpUVJV8VjNjhUqVAeisMke5YY69Uq0pcOyXNHsYaRd",
-	"56pWuc6yiip4p9UvTd6U6jOKOtH8FlOgT6DOfu5oUuhFArbXK6/MJNCKrlZ0NRVdIidz7fNuD3gRfMoB",
-	"WC2aTtm906v2MwnUaUip8Tfp2GVea4nDzaUKzMsWWTSnRqaIO8aS1G5jQjXXMMNRkYFWwOB5fv75eLSn",
-	"/1L3GCJHciAKPKRnayGxOnDjiG3v/3UCRhT/1/FmYAyrZYDjQ4ocDNzgGLOeBmlQWN7OxlovwGXtyb1D",
-	"D5UcGbpbIugFWNw9Ijx7XsltDPfjXMUOO9sZv7RH1SK2jB6xX1OANQsmb2XXK5RdcCYElvzz+QAk/gQ9",
-	"wjoxJVrJAuUEzowSShQeZVm95MAOkkmOZ89lIuBtL6G28ymL2Hex5+1rlp24eldcV7h+L8ujHPtrzK+S",
-	"wtCfBmll3mnFwvUyqfFTOhd5xHWlVhq9HmnUvqz7FWWRxvjrl0QLvGmXQJVjcxo+a2/F0MtG5oTwEYZO",
-	"QR68ZW7mypy3gg5or48IhoH1ySCkB6/HZtPgqHgvyDo0BWTIexmDIgChE7Piffb1s88f5nwtDSe/1Pta",
-	"8MCnD1ACfZGNrgKKM63ZIpBk/dd7SLW5HV44t4P5GBC3cxUPQti1ARbXb5aYAF5Q6FS/LVr1bRYfnE/k",
-	"VvzqZe6vRG2lJjdWAqltJavCVZX2Ur+6bpWJotV9MyPtqvp1rPjFD4QJisbVBL47GTk3UJDOjQmzQrYv",
-	"Wnqu5ceVVZZrUEeuki/NVVarq3iALD+jpcodrqs4uStRarebLse4gOfAvgkt7+QvOCqo1Z2Zug1UtOal",
-	"WF99vJSuYa6u2qqzCnr0wtVWyydgW23VVUddqtqq2yl5gCGh/8X1ldllF092qQ7o1sgFReOh6OOag/Z1",
-	"HJMaYpY4I/U9aVkpn/3ChqaV8ZEqWVx90aYqCGO3CsWtPqmqmjF84IGYpSGfyDiO1tdXVB5VmWPcrPZx",
-	"ncK4QDnuVkdkCJC0rqmF63RhFCdt+WvFMfELFhevPnAcojp4uZF8DlNLooNmKZLbBAcvdo36AOdOl6i0",
-	"XfPEBowMvsC5y8PzDCYVKNw/w64v0LmsaAygDN3sny0IYpJGyyeJcIFwkEY8QYRwfL3IlTTbz5e5kGZT",
-	"b8F1tA6HfhldQSxZbgo49x5BmEJzhgqVAvIPym5H71nTo06X/uuY/+uYivfqTBZfV5vIIluGLJXuROes",
-	"cX8zOSzWXkW9jQJYiWUAZYynY+10VxcyG9eig7QmAEMAw0WNW5jz98uEIXBKaOLz5QUZX30U6PE/NjPr",
-	"QPCnUE/hDx/CoJzPkRsoslimM5/XGyYHozR8sIf9fEjDB0EeOJMJuFIo0D6vWDDQ5TcUDvglpQNuLh7a",
-	"KPEtkw+MTXUhgVcsJdzyRXNHhpY7KKfi2qQGDyt59emkOQLcFQphMDRLCesMaRawRf/1lBnL1PZYY9o8",
-	"+UM8+hP6DpoLQxrMHju3QmprhZRIK7sW+cTcaI4+Vu6bc/CzfoHz9lovczYuZK0zZLcWu8li94Tvd5V8",
-	"4JaYHTc7ml99qnaOgG05mlfjVstlgG8PzFdzYKLoERHYNMBa9jIHjfXZ1/aslLFiGj4WihKT2G5jw0zh",
-	"0xktrilmmk9QSeut+1uLkuYocQuO5rh90YhoDu4igdCCMFq2NEc/K75ZTaim4HP5wx7/9zNn4hASWGbn",
-	"M/Y7VoadCyvzPrtbrjHHV9Ww7Sl07PrZWsu9nEK2mXtzjMSJMCNXa7Hq3D6yc63qTWszTtidd627wgnr",
-	"fXq72Ln7Yo9vHTmXw7cznCsexTbm3KqTbwqnI8Z8jWw02cvM4l/Z19ZGk9So4WMhG01iu1UGTTZaRour",
-	"0QXFeAc/+R8OSqAHBBDefRJP6569cWr4NVRBsWwbbPzzRnn37Vp4dxEd8HVw7RZlubuwJLVTTJrbmJXJ",
-	"i79SmMI9WUC7qvQAJQ7WOlfrX8v/YRYYnyD5F+21y9X+d+plwC4Fe69fe8nR3mIvwLxHmGAUR6rMfCsT",
-	"X1gmUnGkdqdc/F9yzqIyMQEE7rELJ5dQCdqaX0/VxUoMAIHntGH7Lm2by0Ss4g2TQz3R9b1UUnS2Ba+V",
-	"irBsKn1mntcaBONo7NxG4xRsVh03mbilqPbO+a+LSlzRY28Wh8if16dskR083sElYYsMJbhiPdp0LQcm",
-	"tCzm4insRuvq2XjWIxwC/6E6UcuQNvGe4GgSxw9l5yf7/J1/bZ2fPEeLjpMm1kMB1dvEDhsqYnQTgZRM",
-	"4gT9BwZ84nebmfgrJJM4YCUCQBjGT+YCSnyDmB7IWUA/z9jHpRjxABOQECs7DulXfo5dnqRk4jFjpciQ",
-	"Nxgm/M6EAXRJEcp67iJnvjk8NuBB5x6GMnGs5LAygSAQdzxhzAkmTyvFuRlVYOinCSJzhh8/jh8QpIOy",
-	"5Me3Oj0wlOZnlIRAd2BhOqjLmzW8GBYJsCCQI9zKYSGHL4Z9HVUNJHERy60s3jpZXGYEJYkvhkuk6yoM",
-	"bGKwNjqRISDPX5VZulZHs/lJnaMMi7vaMvQWMbSV8xw5uvJEFfU49jZxZSVKhO3azdX63QUmxDTzGai6",
-	"VbmdaS9VtuFSRe1N+VJlSf+EoXpaTaF7BctozhnKWLpxR/x43W2t4LaBOosLyodWImxdgUVdRKykqKKT",
-	"nKjNqXFCCJzORHIY1tah5uuuJdNoJUhVABvCLLxfiBBOBOH2GQgvfIlXxyibYugE0o4Vb+9ZkhJXHmbN",
-	"WxbexmwASRqJrap5fIGiWcriIfjlrmm5z1uhqbS5ACrkC9vwlxAo2ZoqfQG8mWNR+E+QDPmwrWh5Oe2g",
-	"WZYri6dBDNcaFNtsUMhdWovUEHfxe09x8lD1YCwL67QGSrQxElmIOkfFd4ZUipCqWhsUGSqMnnf05Ha0",
-	"Tvxtu5XTyH/xVCFiEBsLvfrbtxz/cGxsqESOYeagUaIPubUt527f9ZvOeIs467lUrnbP0xOSC+/q2Nvs",
-	"bHj1h2WGibYS1dKmpnwClH87zXG86CWVRDQ3L5tniNRr8hgSRWqFdNp0kVq6SA0vuMZNlKt69HLJI01w",
-	"OxeZ0zxIOYJpzdOtTCqZ36PyI8NqA7WJwPmp/7PudjzHCbUnsCDTXb4sL7C+GTQdgzusJojtWvS9cnt5",
-	"bn8tnPdL178U7uZpanF+PmBXHLUuan4RwhlaB3q/hq/7bPSWuV+eubPcCFdaaQgO4zLe7DyO2Ha3Du0N",
-	"ObS/67iPXLISZJvUVGVYncTBEzCDa9IjhmzsVt7sjDLBN6zVKH4hjUJFxDuUzs5VzQ5DdeuGDbpGFeuz",
-	"51j8grwn0+23MmDlAJ4DTLz+GUtaOYFeCOQO2pKfAEz6gTX7yZtjU/aTDUTuNSmzoUueNrZmS2/sF5Al",
-	"7tf5brIQO91MsJZuGs2rTMcUwHuQhqTz/rCbExWbSMyk5n63yOS8/Ls3mntsAvOk4pP9lfgm1K72smf1",
-	"+tYqE72pMR3LdnrAGwHiT0qXPVUa06uv16nfk3BkuAYDixj18lXJqy7iGba3RzVJlzjZbOLmBh/4SRzV",
-	"ayS0lfdnPMqAIgkaj2vDJ06TOHrVasrOZI1UG4sCOu0YEqUS79ckB7YZbqtOXrxLmYErclWO5t69yIe5",
-	"spSZOp9h97SZo/n6Mmdqx+aGc2fmkLGEDtseTAY9tnQSrEmhpcfSwU/6nz35q1sxiPJR5Xw1QAlnx0tD",
-	"qNXbwMphdPPFIRyrOBg3sc3LWayqYEZTM29+niBun7tV121LMtcuB/BsMWet6ehsj81dcH03OqxXIB/c",
-	"zm9GA65+bt35Xn9739qR22xHysL4rkYka79eC3KrzVsK3AwkFGmWG90CWLzxd93HtyH4DO+xjbCJu9NN",
-	"uQVyaMMEkBRDp+JGsu0iJu2Q9RXGpQtwDygKnKBiDRuD9AVFQT00O+9BIWgKPXBPAS3FFD4BLJ/46Uvo",
-	"HB8eH+0d0v9dHx6+Z//7XwvuRfcTOoGZeANA4B6FouNaq49CPIL3cQLXCfIHNsMqYa7A8j2KEJ4sDrPs",
-	"v1E8rwrolWJ6fR7Bsvvt1foDi7pja9asJYpwPY5AFjjokiwXeAI0etDl2V/PnusYH7zL5R5bNbxVwzev",
-	"hre6ZatbvsjLALxkeVQmgNo03vXn+xpKlWbnPAU1SEN6PNZ4DVXLRfyHQ9m59SJusxdxfXaRIoCdCpdo",
-	"lalWmdoZZSpbRiaqV+Kbdao7rxhceWk3XLi9LGFar8NqtRKLBrBeveTgp/pzr5TppDYqyQxyQ51lx2OT",
-	"DDiwZvY1onprw5XMu9vGKxXjlSx4ahaQYKGNmsillTDgTlfr2SnuW+dx3B7Fux7XtF454qYYqGQGz9kb",
-	"msp6nsCL4JP9JY37Q5pr3mF30g9XW6/6K1hz9oJK0DZaadSwDU0qg1g3f6PpH5sFeepZk+3wt2Jx8+UP",
-	"ty7lpBB0VVS+nkeMmizO+ZHN8lhqBEIiu+uDJVVikEatFN6kFJY7oG1AE/lr1Rs2WKqpuTqqS+BXaWm2",
-	"4tdJ/AqFpE4nXrnI5XnM9/w4jUhNiA5rI7NCyQT84BGgEIxCyKSvJm7M1vgnSHiedHzKZtx50VuXvGvH",
-	"k/flNmtB05uTCief1htuuaPPIWmxlH559k8xTPCBnyYJrOZszK0D3tCj3Urce4Nh8gmSUzHYGumOztSQ",
-	"zhjEbSmYly8FA/00QWTOxLgfxw8InqRUdv1xS0VV4XFbntwkubPtN5DxGJFJOjrwQRiOgP9gJefTeDoL",
-	"IYGcpi/p/J7xPKIT8UIYn9jQlxSXp3L4AoG/OTyuuU/wxbxBed4JBIGo+hbGfDOMVQaVWH8uIDOHO7nA",
-	"/ByO6MMEJHZRMKRfF0Mc69ocawye9eOMQdcQYXE8DuF66I0N/YvTG0ffiuktQ9wvR28oekQEupSGlNow",
-	"78CUbqfjm45wzfr2xVxrPMX1iZziJ0KE5cbkF9jqi87HKsuOWsBeRnnXBgsxR3sHwPfhjNg9byfsO1Ye",
-	"NjFJidr0zed9OuvxJ/HB+UT1pQsrqI+v3ER/bRRAVr+fIam09+70lUCWZ7Ciphn93oy+eJ/OuiqE0cFX",
-	"QF985S191dRvp0hagL7CeIwiO1mdx2PsocgD7Gzcr1AwztlA66EldgTT8TdUY9XJjg7j8RgGHopa83mr",
-	"zOf8sU6pxtVODuNxnJIaZohT4sYNcfryvh5Bo/GWVRxqibRGGWXU40q2UzgdwQRP0KyBCaR1cjOD+BHy",
-	"NesmnhGtlcDNkza3h3QUtTbRIjaRjsF6kpwBjJ/ipCISgYtJIUk92b5KpF7JMdenY5xOQDRWE22TsuEz",
-	"yAKFqFac75A452SVp3QHJkrgmAqypMro4y1wpUai4nTWxTYSjG1iGIm89pprJ/R0SUKuOg8Ogf+wlhuG",
-	"IR15iy8YakRNwxuHR5hgAUJlcVvRTsavYJg8GnTEfnQff4Lkmxh0paU9NEizjA5H+4f7h6acEVrYyB+q",
-	"661D1Y7risUWQuUqyPk79BJI0iTKIa+gZ1MplUYRisbZFD/25JB78Yw/Uc1mk5v2BEeTOH7YE1FEBz/F",
-	"Dw7v8ehJIVqXo4z47+5P7cRA9igeNdGGg3gc365J+Npz4eXPheJ7OZ1MraE7osWtE3McCDy7GMmyqSyL",
-	"V80xQu/Brok1tpZvVhP8xqHnsW8CNRQzAzGhTeqqvKECO2q7WvbcIvZkPoHSFjXlUcWb7I9nh0rXBm2D",
-	"U5jjw1QRIVgVcGo443cn3LRx4J9YcesNK0WUll7rUKW5OoCUqdWUCok/qfB1VRIyb7UztLwGVwJDQO7c",
-	"sJ0VAgOpRNnmHrE48hqHrOU0M6cJhliG2QqnSfFlhlNmEhU+7pQKoYFdtJXPG5pk9VAAtq+rNv+6ymQO",
-	"aRSz4OOGbp2G5c4JDVSu1/DKZ8GXPS1vvTRv6U+IlmEsF7XPnbua6YFbwWDrqzzNkeH60JlrXXku27Ry",
-	"6CQRiuphKw+sCuJyzFmjJjql16eblM+jrxjvUd10WE/KBun0t4GfDSkteULKFdQbWrzakBmwcRKnM5Yn",
-	"NANBbpQVFNbpC5x3anM4rFlILJm7W14qtem7t1CbWChfeCPBJfPKWGNDZEqEppleFkrwspWS69rALvte",
-	"/555t3FKqQMGXcZVISAQE8VTCHv3kPgTGNiySWeCf8sVKUEGC2aNebFcMRq8jZLEtKlh2tQwa0gN00g0",
-	"C9mAHW61cie5k1gWsTU75IL5FeTymqWcDJhaThVs5d1WqYAZKS6qAhYD/0YQJDBRgX9dYyggiyTj8iBN",
-	"ws77Tuf59vn/BQAA///+bySVV2UCAA==",
+	"H4sIAAAAAAAC/+y9a3PbOLIA+ldQurdqd6vkZ5I5c1J1Pji2ktHGsb2Sndw9c1wuWIQljilSA4B2tCn/",
+	"91t4EiQBEtTL0oRVWzuOiEej0d1oNPrxozNKprMkRjElnfc/OmQ0QVPI/zy56vcwTjD7e4aTGcI0RPzL",
+	"KAkQ+2+AyAiHMxomced9B4JRSmgyBb9BOpogChDrDXjjbgd9h9NZhDrvj94eHnY7DwmeQtp530nDmP7y",
+	"ttPt0PkMdd53wpiiMcKdl25++PJsxr/BQ4IBnYREzGlO1znJGj4hCdMUEQLHKJuVUBzGYz5pMiJ3URg/",
+	"2qZkvwOaADpBIEhG6RTFFFoA6ILwAYQUoO8hoSQHzjikk/R+f5RMDyYCT3sBelJ/2yB6CFEUlKFhMPBP",
+	"gE4gNSYHIQGQkGQUQooC8BzSCYcHzmZROIL3UW47OjGcWhDx0u1g9GcaYhR03v+em/pWN07u/0AjymBU",
+	"tELKxIL07yFFU/7H/4vRQ+d95/85yGjvQBLegRqp86KngRjDeQkkOa4Dmi+IwjIsMIqS59MJjMfoChLy",
+	"nGALYp8niE4QBgkGcUJBShAmYARjMOId2eaHGMxUfwOXFKdIg3OfJBGCMYNHTIsRpOgaxTCmTSbl3UCM",
+	"ngHlfYn3jP34KaSINJgs5D1Awr+Knzm1hwSEMaEwHiHv2YfhOE5nDSYn4TgG6SxjpUZTpnTiQVqMLE5Y",
+	"05duZ5YQOknGnr2uZGvWcR4l8cls1ndw5RX7ztgN9M/4alKCeB/G9YyKKCDpbJZgmmPEo+M3b9/98l+/",
+	"7rE/Cv/Hfv/vw6NjK6O66P9E4iTPA3xdiNhBl3ChALBBCUgeAMMsimk44oLOhPj3zj0k4ajT7YyTZBwh",
+	"xouax0tirMTMLrD77ATAUIn9PPQoZgKsgmsl5eghmDSUnUASc8lt0FWZkLg4tOKGfWEIEUNkMJale604",
+	"lTJXLaZChl1lRFoQZbPwt4RQBwUmhP6WjMHJVR9MWCsTxgmlM/L+4EDS/778wojTdvzAWfgZzevneUTz",
+	"3DSzyeNdRrrwfhSgB2/yHSCSpHiE7GJcyMTgxLF6Gk6RcShiORZ4hkSK05zU7hwfHh/vHR3vHb0BR+/e",
+	"H/7y/u2v+7/++uubd7/uHb57f3jYMdSVAFK0xyawoSp0CIQwEHRjANMFYQxuboSAYEObAN3fHx+9/fXw",
+	"v/aO3/6C9t6+ge/24PG7YO/t0X/9chQcjR4e/pvNP4Xfz1E8Zkz+5hcLOOksWBRNESQUyP7rwFWBH0I2",
+	"SbarJugO3rhOHpFNPHyfhRgR25K/TZBgf0aslHUHsvW+9wZPEYUBpNDjzMhRsFOuXBfkioZtP7+/x+/e",
+	"1eFQw9bV4kUjw4rE0QjNqNARBujPFBFaxqdQCARml6POaRi7ibXb+b6XwFm4xy4LYxTvoe8Uwz0KxxyK",
+	"JxiFbF867/WKu2kaBp2XEiEJeG3r/ZBGj0IH6z2hmDqXjJ7UXchLX7UMWau5ihluX7qdU3YORR4A9YM8",
+	"SI23I7twpWHQcHu8FtQP5JKSeJRijOLR/DychnRIMaRoPBendzplHU5PLk5753f9i7urweWnQW847HQ7",
+	"Z4PLq7uL3rfe8LrT7fzrpnfTy/75aXB5c3U3uLy5OLsbXH7oX3S6ahTd5luv/+m3695ZrtmtZTFiz5QU",
+	"cSNe8E8/tvNtkOLs7vc8CUcTzsJCtIQEcKrd7yxO68k0pHEYddVEHO92OXIipIhQnZcSI3x8G/8UkUZm",
+	"SUxQGWtUSeYyxnJgVYMhRnHDcYqT+FuCHx+i5Pkah+Mxws59hEEQMihg9MWQ36WBRziJe99nGBEiVc8S",
+	"4bAmF3IDSh/DeJZSy8glEcWadW1QGROUwLnVS6+WFvbFFqhFtwHq1NCkw3nZ2J8MP/axOCf4DfCI5vb+",
+	"j2ju7O6gD6FtcpAyzAwvhsblwYkimszC0Ql2EekU/ieJgTq/AdsO8PeTwcU/1CE9vBgCPsYyzK0PsmkY",
+	"/89Rdwq//8/xu1/KJ5oG1s0LwqZwEiFMe1MYRp9wks6cq0esCbGJkCgklK1RtFA3V0w63te6BZYfhE+o",
+	"y2csr12CWrfyGh1GDG7da/5JbStbKzN3CB1iJXur1tXt4CRCdaqEWM0XNL1HeMDaW/HRkYPVYcWJDz9N",
+	"VBibVoEFvgwSpWP7pOzL6iftSoMqF6Yvjvs3B8qOx+x0Ib4yNvv1ymidM1jlDxsrPxkGjrJxQh8xjeZa",
+	"4tYyRXSSBPU6sIGuL6KLoaqUZYZg28D68VkOVPPZeQyrBl8RZgendRj31UmDZhuoMHsOVrml2QZq5NUS",
+	"2HloY9MZHIextoJVof9Kt9RaGZc4z01uMSbBe1nrbJtuqPhnvY8nN+dMLT+56ju0cGOASxwg/GH+Ub11",
+	"qGFipQyhkj0gG4lrRJtUhZbSZJZiSKrfD+pPkiKrlcHtn+Ulb/HdSL4qORei6H+QxsN0OoV4XgcZ36pv",
+	"5W4VLClUPb2QW7XhZ9BmG2yipYK//3N4eQHu5xSRf9TrnFrb5NN/Xo4G1BhbwPx6OWW+V4BuC5QVIEoJ",
+	"chZiNFIgKSkCyagj3pPd8sMlgTxEz5CD2MhOra2RYnmmbdrfHOkUQ4K6GSFmdgk5EX8FRuxdz0qWsyQK",
+	"R35cLFZ9JTowFU9joQwQ5zO1VA6SAHEG51ECAwKmKaFgynQ2q8CtMGXbMGmar/cXM0kLqSPXpPHitFLn",
+	"ScHOLs3JXYxWa3/jAxdAuNIbWUQXpGACZzMU88ddYYyUmxIk/E2W74OB030w6P2zd3oNMKIpjgmAsXQ1",
+	"kL4QoyhEMe2yUSIE/nVzMji5uO5f9AChCWbEpmmSnSpJSgEVNpswHgMYz4E6SLgtXnGemJTbAtWAFTyI",
+	"IB5NrBoh/y6NRMPHcObwUfginUKsBIwRJPL58CGMKGJ+LWkUcHTds8XBKGW0oDw/7F+tLIdqTxN5RrsP",
+	"E5QzW5UHOe2dK7CTOBtL7YIhJcTvQRhkhLBqFSav4JeBVa9lSFOFhI9xdpxoqAusvZhdu3idqL4U+wDk",
+	"dyHxWvaT6LG25bt1Lk2ThTuOx50I5W2WReZblWg0hmwoH79ZldbCyc3fZSLk2Ks4ZTYatl+6IcBpnH9J",
+	"dDu2PcDQY2jRqsm4MxQHbGNrBpbNmoz8Z4rSeohFqybj4jSOPSCWzZqMTNLRCKGgHmjd0H90rXOSqudD",
+	"h1rGp1hG411C+NYwvGCSfyb3lmtVlS8mv11lvyg59kdyv7+mV/TSmISimb8EGVI0syG20jBFwylKUmpf",
+	"vvxYt/SnZY1ST4bgVVZMvnSblemfyf0gjSukm9Cn/S4bupN2CnY3GXBtydrmIYxDMmk29R/Jfd2OMqIV",
+	"LR27twTRYUTSiFrfDAmFmDZbDKGQpsRjPex8Em0lfQ/SuBmJs81vTuWjR4SrWaDJcg0TUR3IxsFc6Lm8",
+	"EVcMoghE74Kba4Z6m9R15Kp3cda/+NTpdgY3Fxfir+HN6Wmvd9Y763Q7H0/6570z7Zcg/v5wcvr58uNH",
+	"672FqUJ2n0dfT+liV8tmy0n4oz1xv9pv1JSj4LFbcxjE+Zdc8srw5qGpVTcN2ORENjLjy4zg6PEbup8k",
+	"yeOrL9KAZVVLTMbnYYwaGcbYYco/M0WCSRZ1pEbJmMVfIH/zWISeUFS3bAnjOW/Lz4kKKwCDQTao1Wxc",
+	"vUULi5WrgGLT0JTFq+gZbjP8nqt1Zm8vH26YdOpffLxkflEng4tOt9MbDC4HdkFkjKPtn15EU8ReSfrI",
+	"769vPla0aBc54uMSJuT8CA2NyLJzhQnLggDTCfBHR7jc0bsZp93jbidG39W/3nQ7cTrl/yCd90eHL93C",
+	"RuQ723yFZQswE1SoJz72uosZsNgGZ59LI7/xGzlbl21kmlAYmTdf1pQ/zjBfF+FZkAWmHfpc/Sxi7iql",
+	"huHV6XexBaZ1MEAPCKN4xB8AZJyPCmQjAGLELUw6CqReShUN5Ews/SuFGMY0jFGw8ZdZnycXaYmGBPyZ",
+	"Qep/rLgFvER5zl6rDMLKIZTvlxX0RkZoi53WNNg77cz11keN2yUtq49LWLTt/sX6LTiHqZxzgIX6tuDs",
+	"KTGEt8HyXylK2T0UhyOLfhin0ys/kx8HUxn+9l2i9F9eVj4xVijokJv8nAMO/Mx7YkRp5Nu3S10TPRmo",
+	"uVm6JkJs+ugAUsR90m2RoeMEh3QyrdtLPcaJ7vHS7dynmDiU2Sn8Hk7TqbHcNA6ZsJWe4vfp6BFRgCFF",
+	"IGID82DISRIF++AMPcA0okS9s/HvX2HkQrmXX0o2k1VMsLfTAXoII4fPJPuuYofMwbhAxbyjkKZrCLDK",
+	"1u+LayxOYwJ4TKp0a5G0+xzGQfJsx+QMYs9XOdHSxCo4TWKSTpnBWmy1Dv7KkAUjkoARb4eI2UwNaN2b",
+	"VXjz1Gz/kxu7Sgm0YHcKA+SLWvHNPoX4xpfBKCyMjafJbPNFTOdDgkfWJzfrS75hE8oG6qj1aqhy9N81",
+	"xMKtKT9OTGlRXoXuBVKCAsa8gi8s7KcuCB/7/1/v7O5b/+Ls8lun27m+/Ny7uPtwc/q5xx7Ah+d9ZnpS",
+	"3213CA3bFpx3Ghb7bUt/FgdbGdjmbC5FqovHWawOY0cd9iiErp0+WQP7vNzaq3ksP46fAGP00GxNKVly",
+	"QQVukJDleIBDVXlgOlUQYRtfgDTk3nu5mupOS1zQi2OUruhCTChxYODHOhoasWuaYZQvqBMcPJegFl9B",
+	"aPcXWOh1ZpFnlSWeRNb27iFRmj18lF4BiteSauGvN6JrPhBIWIqjW1kAsb9+njDZAZpFcP6XikgVSzJe",
+	"l4hzZTl6eN31Gc3fHR7qBvb1FuB2rdr1+mN09xflhec6X/gUdDiNJbNXsFWDiEo2auGhxjLgGBF6gx1X",
+	"m5vBOVPWCIoDHuQnze5OZ9ml/fhdB0Qah38yNTdAMQ0fQoT15U30U2kbRCyime3kHkVJPFYQ18jK7jpD",
+	"If3eZyvDG5kZNEgjZFDaskG+LpLqdqRbnf+R1iSuNxv81lhXsLp3Zh4uz/4Ynv7WO7thP9r0Fj3zeqPb",
+	"tjROrbz6LFit2imiKW2sLoxtkManpk27sddFP3iN08sAwGeJQy/l8Fupw2vG+2VEURnqVya6Lbihl4Hy",
+	"u4k5OahR5F95FNelzMRx9RvqEE3hbJJgNIwSuuIbGVFDVhmxGW0gDHhbcWu/n4snGYpmzLhttzbkblJ2",
+	"v0Jht2MDcxur7OFvdFjw5iVdzlwoU8sCYR4Up6ph+o7VL5Sby0QX/5WWxF55HtXEH/Tii6dGS9e8XRYd",
+	"zZSDGSNN07Om7AszgXGMIhe88jN7oLOacwkbHDyL0e32BDGCO8JBTcEjHRacZClVGE5dq2ffllg66+5e",
+	"Nx98mUVvhRLvp2YrRGh05+mia5Ch9RCjaOaSqXZX4EkYBRjlvRlr7vDdzijLHeUg1ayBsKHrVxPGlt6e",
+	"9Ww5Rp4qGyhr8SQWTzukGVIwggFLKeCiM/XdeMNXyGiWeaGJg7tjBjcxGqvIUaZyyJW0JJyFKqjwNE8i",
+	"pYxdtXFwWRv5tqSCGmmBvh7RfN/5CGlmNatMrODKhiYsPMrg4vMeoGGj+uwlYIZw9iED2vRjenNsf4ZD",
+	"4XhCe8tiTAzD4INFQLoiMxpvgAKAkzQO9nByH8aSd+sJKJu6k+GruAUuUllD7MMJ7c2SnI+egdEVRUhw",
+	"0fnNZZGrl6Fmd3KapDG1g4ucUC7ymJD1qcBQ0fqSC/HwiBCQAS26/eoldJJSF4gLCm/uIXPyQBH2R+bK",
+	"I04wrdmZJe4IvsFWrK3r5PE4lpqsWHepWLG4ry1uLtAUqFdWGVUiUXeCR5PwCe2kXGpuhtoqEZPgAGF7",
+	"pwqux4jieYUUXRs/GpfvzbBExT3XQILCo90e46L3bTB55RnQ6pki2zi8lkduKnC/NwT2DkaYiYXkFA96",
+	"rEe+1PIejG7QE8IhnTfpPVR9vOjuY4gJHSIUN6O9c9i0V8P4P3E3zgFYmFlj1kCTGVsj9reCmLfFnzhH",
+	"prWEnIn0LLGKeC66u7i8+3Y5+NwbdLrZj4OT697def9L/zp7TmLOZ9f9L72zu8sb9vPJcNj/dCEenK5P",
+	"Btf8r5PTzxeX3857Z5/EO1X/oj/8Lf9kNehdD/4tnrTM1ys29OXN9d2g93HQk30GPWMSc+7h+SVred47",
+	"Geox+72zuw//vrsZ8qWwNX08v/x2N7i5uBOZoz/3/n1nPqI5mkhArQZmG8cYSDWCreQCB/3r/unJedVo",
+	"Va9/8q87gYYvvYsC4hu8Dsq/ReuqkNSsPE3RPRphmZm058gf+00V4EgAb62sXFPey7zrmWVbYhjNaTgi",
+	"lzN6mdKKUTOz2QQSkMzYjVLaI/Qg9jnWnrTflbV06bSnWcIAv4R/8uXKozQAhysb3SbzrFmFN5tOeE2p",
+	"LtxZha1r3gKBb98LW/blcbIniLYzYBPww8DoHcbjIaLsP2RzTC4yovZYNv0wHvPIbw5M9fiil5iG5SdD",
+	"sQiYEGFqcDbDCRxNmBMsz9PPEVw1v8qKLIhEunEvBIVYsqqXUoanZNwqwWJYdz7CMEox8gCFuyWZgJhP",
+	"WYSnC7LPyTza+fjuZ8YsqAPGcmf5U2MxFKzanxR+V0T2kfGe24w/hd/Bg2oCIFVe/pKqVvvC5JYEVoDd",
+	"cqGvvTzXk2D8RZdsqXwiVQV7xDAbLWKzWBbzuocy8dX5zKc+u7EmWlQ99PERciUynGduzcGh0q9ne2Xm",
+	"pq2hna05SiQpNztBxJ6W4X81gvJPg8xYr671DUFY9LhK7yMWm+AmBT5eRSJ+E+at2XS5f4ts+kDuk7ql",
+	"XH674Detk7MvvBTPl96XD71BxZWiOpCV28iJ22HQZkEp4ZwH+9eH4hpwGEaGqrmbjFeAKsOjonwTi/ru",
+	"3fsqbnfmrZTfIC8vDJfOCvTm1BqbZgfxtCKgin8HPPbFLoNF0CJNwDPEPL9bSd8Rve0+Ts1CSu3RpKsJ",
+	"EBVju5doh3+53GF62+s5VPX2DMSs27Dm8ZdTRBFWMWbqqBRjgb+H+2gfHIEAzrvgCDwj9Mj+O01iOvnH",
+	"gn4pGj3WqEy3ZFWIyrIcWyIIK2+lamblRlLWCxpI1jz71QVDSODcq5PGoQ3IzDSGTzCM2J2E+U1eJUnU",
+	"cEiPfKCZtwJDMoKjifDxmyVJJDX/UKS2xWgcEoqwcJ6E8Zw7Zj4h6Vy5D64niCBjwBGM/8ZTLENCwnGM",
+	"ApDGNIwAlD30iMJZQU9rS2yi0f81s7koSf2Vxah/PbJLYOGjuoEgBWfcyw1PRP4z1oEyV14TpLeSEkxO",
+	"jc8ExL3/O2xGba04r2vFWaN1ZS0lKRtYyRc2cju48BuX/e6wQnKa4CCJUVCxUxN16LCzaSTb74MT/bfx",
+	"mR1d+gCKkdjKLoBxAMgkpQQEyXMMkniEQEh1ohux4RP4hIDybLDvfEiuYEr8oZ3x1nz6EYw5cLzWrwbN",
+	"No0Vm8R23a81d8EgwIgQ0+yV0+CVHaVEB/zDb5BMbKfSBJKJOeTfSGE6eU4JJVjU4B+KRGbgdAKpc8Kv",
+	"CDP37Br0sim5zHySzdmvIc7DYN+/CSRXkJDnBPvOAcFMdgAEUfuoa3nWCkLCooZzjKv2r7GdLI/dWweB",
+	"nU5gPEYKQU6mjdGzG4lc1qDnDGtKm7fDvoB6okbm655VAqKBSB7WBkMpHav80s3hyYXy82QcxovXy1yM",
+	"v5cqn7l1GFdrnNXheiAvIDuFbr8T3SEYtnC3VEF8300zrwFkEs7IrtpwSzbtDZ7m6zhlxGS2bft6xAr8",
+	"X86QK/dDo/Sa92n0CBI1mCzFolJ5in9ZPVT5F+0tWhcumqn9QkPPFY2a4WSECEGBieyKmjI5h1rn42F5",
+	"abKff9G5MK4NtC9sxmfWhV9h6GiyBH5kf6MKlx9ulonYUruwMNDPCCNRZIaQhzSK5k131s81v4By5aJf",
+	"UVaTbYoevbA5pYXnaduDAT9LMlEWNOGCxt31rs5P/m21pNnXUJMa4/Tyy9V579p0frOPfcp93K8heXSf",
+	"xd8pwjGMZO4fp2FMNgP9M9JVvAtj9l4h7Q2h0OkheQQJzpFFrrNpUVtpOqRuR/JHLdUwfHwUba13wK9H",
+	"Z3B8akRzFqOXLXGe9TPqsq9lwAM49k30ZQF2F0oOaCh3suhAAfolyg5kI72+dpVRjtWf+uuRSKfVSo+m",
+	"0oO1YFL9ZFSsXpBH3r0p+euzlBe0GIFFdWaJxx6GPyJ0BpUqUyyL63FQ/oM/NM9w8hQG+QN5wYKKDhQ4",
+	"QkqKSqk9afs1/9VnL3q6+YKxJxWRUAyfZ0Jzd6b0YW28pLcIzSAUTmfNIkJUyF6zrAiiiQDOnNpEcIaY",
+	"2+pt3ApxlRGVQ2DlyWH90R8Nwz3UWLkwj2Johz0upBjuMexdXN9dm4vRa7gTp08pNuV00Du5LiRO+9y/",
+	"unIqj4ag83zV9fdxJ2E8Qjma9kjng5oSSxa0W5yfP5kvktksD0I9x1c5RQgkuDnvKgljZ8JoSXBWaZcF",
+	"x1g/q2zPC2T8k40s0Tdey1hBNuUyaryyeIlugzR24XNUGe/qdRs1Sa641erKOaq4TOYgbIqRbGkWcs/B",
+	"ZshFLQnsd0tTgFXeM837zcor33ClMJ9MLMOaeRmz+b4J/VO14mMRX0en2ntbzQVLn7nZMpjmJXs1qL6T",
+	"10D8jIsWrBkjppnJ0DKc/FocqgvCGEzDKAoJGiVxQOzOdvVWR95CXfCKs4C/ayc8SBGh7Ld/1Of/9kI/",
+	"G958ePbDv/ZNKs/BP1WgfCWZPuN0OpzB5xgFp5XUbhR4Fs3LdF+VB6A8oPjWcIMceTu892dNuf6KGkGW",
+	"msOR588oLQLJ4wqqQ7Fhevq+bJ/ZuOuuYXaPKipyMovSwPrHBOHmAi+U3fy3tFnRl3zlnU2mYq8jOeUu",
+	"pa6n+Qy5tdqjbu7tDcNaZ/4w+0DUjJJ2Aca2UH7KWqsKeNqbh7m881snkZ6lxcGZyjZ1ee40yfK7ADeY",
+	"liGfFJzWVLnlmaQ7lmWiZbIRmqZF8xDvisu5Sp9tke9aLhuvFQX5YV7u87yZT4Mo7vkG5d0WVbb1Xu7d",
+	"WtkqlbGFivV+PVqDI3OAprOEong0t5bSOuGFtNTj3qMy3Ak4gO5N90H/Qfp0a0soE6ayZRb5gABhah8f",
+	"NauZY4AhYxu6Io4yN2BIZGI7FDApTZGo5CheBuIxgNzFKBHPAYaEPH73Licij2xaUw4R1zQaCqWxjJLf",
+	"kmfAsn+WAGdr0kUkHxLMFVCle+YqxR2/BZMkxdq4HBJRboxmaQXlEtifnfe//vKWFZiYhrH491HXI69S",
+	"tslFIVftNKJa17/158btGt7u2VFizfC33itW41uOotX2ptPedBZMRfhzXUa2X99dUHOtUbssep3UxJbK",
+	"Ah3mvDwMZSivhOVKG2h9yyF0zxBV0SsFA2Z9Ds/cQJxKJrD++mD0GbL2HxNsgUddLJ9Uos5qfUvUH85i",
+	"/wr69PIvMQIcsirniMImCyiNBStcqmnL+5Y/UvJ7F9S87a0hAYc5ZRWwr6WUmydsA+XcgfFV6em5i7Tp",
+	"f3HCbNbXJ8PPVsu0rEDwjV9nV5rFwc9dWGbSV7Gc1vKYrspcqm+Ko0ahCNLxl41rw2UOJaLMjjsAYVWL",
+	"JGiEkePkFd90uWbp5cyOAHYDihOqXRW6AAIM4yCZqk68ZMY9AmMUI6xUTfMoO14bxpujOdhOAlxsbzZN",
+	"yhrOWmQzwen2udmoy0IOLr93ylwXJ2PKi9UddOwb9+hjwXBZ0XIx1GLXMr9SYzbQs2JjQh07TQIH1f52",
+	"fX0FRCPA4h8UBWOJfI86vwZWNMy5iW89EV5NQhKVNeeoonnV2ju9gpUCFqadcq2qT7y89tXlkP/n5ppr",
+	"Ia4TUpSoIFUVpIjIviFjMZlX3wxhRlf7jbIe6qQM7voQxvsWrziVPOSmFpYfZc5W5ahU5CmhaOa4xjOV",
+	"h3uCY9s9heac8LSVPOvEjQU3N/0zILlp87ezCN6jiFRnTuFtOIflDFMI5/ap7kKC8Dkbx7aDEST0NwQx",
+	"vUfQo+aV3DXWiyfdAxBMVO/8Dff48Ph47+h47+gNOHr3/vCX929/3f/111/fvPt17/Dd+8NDf1dlKHgb",
+	"xQj3CIX3kYgD2T5IK+ukVPMBC9hmpouUIAIg8waN57Ihe4ujRDxvBmgUQZlEb6XhI7G3TdShkOBSofHy",
+	"UKJNIc3KAiRdKGpuoWrM3sCmqB8/JH78MTA6yEJ6OseMHSdBlqSFFPADGGgqrEZuGYBZe5DE0Zxttdzh",
+	"B5xMhZ2ffe2ChL8OctnHW6mMMIQbOAWRNeR/lTTHhqyKqoFEFSsUwAs5tOCuFQof2gDRZjgLJPxbmRDV",
+	"AXlyet3/2uNO/frPq5Mb4Z55ejk4u7xwOEL5PAoLJOoHYXFkOyv3ic9AnC0FeOuNcKL3TZ1azmpAl4dv",
+	"qqXz9lYNyzg2SgrGI3Jk9GB3DhWTwrquugRfRa4x/qlu8oooMzSvwsPre1I77yMayEFe6OVhjWA8TuWL",
+	"h7c4HJ59JuIIFp2NhFOlXU3sGqOUxD0WFG1tQIJH97ClxXGITL348vxEJKH/9/VvPAnh9b+vesPTQf/q",
+	"2m5cyovDsu7cXKOVj7fFVGEL6LSNFIjitPYh/Q522yDVJpSsxlkeY24eKloBh73zj79dDoXT6peTixPh",
+	"8f6t9+G3y8vPzs1TSTALNmFzedbLdfaLxwtvk1w2Be8dq3/NH8m9Y1fZFxtAXjLhn8n9SnPRN9EDnZhT",
+	"mQ/KQ7AvC69VG5Oh9SYqX6CayVPjsUshoNLwXTw/XcTOxq2s/zhG1PiuKxYU3v5jVe5ZCJQxouXij2PW",
+	"VysChkF/W0tC5kLPvUpAFjfBUV2xa8Vq1Rb1zyxIzwDsn1lxqHoXA8c/3lycXvf5GXR2Mzj5cM5UUPbU",
+	"cVsziFIuGpGtSlVQ5AP13a6xLFUSdsPKDluFpwlNtnaGq3Am+YzmFbkReFJmG8VqHntEc8exrYZnZOmV",
+	"fkFffiEgMzQKH8JRNgn4+wwSpjM8hSr28x92rnAiooG7kT2BKsUpsoxf9/Jq+u1o+8rRIXPaWndZT+0E",
+	"02hBojaiP11mdT1XeOaKep39IIe1DdkgxdxDs5japkFYrDChh7+O6YphddopjavdND/MGwx+bfQqe9E0",
+	"1EOcfjiLZK0uD2R62Bhg31YLky25/hq+OP6HwiCNL3GA8If5WYiRLgSv7UbDU3ZM94anled0NsrHEEW5",
+	"c9/0F89oOSfFDMlYM8lQ+Ri1sruV3a3sfi3Z7ZjjLyjaK5wUFxDNfLQ+RVO326PjvlLf2ZnSaciDAqtj",
+	"/JdMM5PFHa48nHAFAzpkeoGOSpFIuhp4EZHGqHXU45X5rLbsp45oryr5WZp2oXtzXqC4ifE6L04KlIeT",
+	"+MqQ/JYS5Ek8ZFnq0qgiXY6j89LH0bdmBYmzrFnVm01EYjqnf1OuDvIa2dERfiOnrVuE00jAkzw0oSM1",
+	"1KnoWKeFFpqX5s8YwprPoip1iGI660fJXNZvikebJySpWiwz0VrQGyXYbhhpapuPVxzAJZ80BIRV9COF",
+	"wilmF5kHu1ywsrTgy7vQwY11E8ry2JYZuRy5k++xtiqNGBHifBQ57Z3r7GLcxCzjDKYpoSIhGaCJDnOs",
+	"3ZclV0nsCG2uiBS2ySLokQ68WGRgvR2rvUsI7c6Ovkzhu5MvHc3RLGpOVFSbWN2LVxUYhvJclBC5FxOf",
+	"DTEfWdgdVgSXXuEwUVXPbdKGNwIz2comL2rfJLInvVd6qEtwIFw/PUAlUhO5DqcocSTNIzQcPc5dvjfs",
+	"GyDypcXvFdDg6QasRQplVtxB/z5AmEUTfZ8bKq+A7quZglntTD54v54d+L6u8r2mCYH8VAj/JtJk6Iea",
+	"PMYfMOIOaqfuzGJT+L2mxXMzBdyVXkzEeaRMSLHLxFRAeI8gRvgkpbz6DMcol73852xTJpTyyuujJHkM",
+	"kWoesl0VP6lH7PedCTvpkVF4Bs5ClgaBu9+E0p3I4vwvuoGTqz7rGlJueMr/qimrc7R/uH/ICXOGYjgL",
+	"O+87b/aP9g95mQM64Us7gLPwIAqfkHwjL8/7Sb2Bs1YxIgRoo0diJmXtnMvvn/i6VDwCn+X48NCSzgDB",
+	"iE64VH5n+36RUD1nbmc673+/7XaIyqPGIMwaKm+I3+X4owkaPXZuWX++VoxgMK9fLGsWVq12oBqscrkc",
+	"OF6NS1Rlohg+PISj2tVraGuX/3R0AGWpsD1eMWGPv4KSgx/8Z/O3FwFjhKhF9T/jvxMAVbU03l3WheDd",
+	"SxgrVB8UI3BaxHCKKD+5fq+o0V2aAcjsMJ33nJ4z7iotpWNyvzBuC7m49E355ba092/L2BqaOf4FSgOz",
+	"4F4ZeS/dzltBJaMkpjJBMJzNonDEMXrwBxGnR7aOmtOqh3GCZe2PogPGFEYMCygACQb3MFDROAKMNysH",
+	"wwbFxwTfh0GAhC6b0begkyoyUxQva3rfsoonungf+yD6droWwrjllyg6stQVE8r7MiQuRvhrkDinhw9J",
+	"MF8ZMXhUJrWQSSW2aAJShfM8Nl7sInolC7EuwQZ7TgwIQFsx4CkGBLWsTwyYB+Qs3BOVSA9+6L/5aThL",
+	"iEVpGKCn5BEBGDMNTNQwla5GesaCmJiFvEiqMg+w7j5SQg/vkAkK1q067jBfnqRzDt1fm6hJE6qWpMM2",
+	"9lrunCLj7LcqStZbnqPgUZSkwYF5lXVru6UkV+o6wQfh6c5gPEIlIj5ln5VvhFsJXj9uOSDAKFe+NQRW",
+	"o7ULBJuPzXLrvxjPQ9/31BB7yUx4asgTzdhvYVw9+MH/+1K130xK8Vb7pQ3lNlaxkbWSiA/hVE74140K",
+	"odVttkzXU3N4Y0RxiJ6kWBPY4DvWyrYciRuYychboLhCqiHRwE3hB3VijW+Llmo1NH+mBdjPTvdnnIRb",
+	"2t8u2p+ihc9w5+m9uYNbJvpqQlNqObtykK/iCGdjHHCDttgl4txx5oQDYBSBXGvXBrPW/XzDte02m0vu",
+	"uDFlw81XiWFyq9smQtBbzzeisAnl/c9tchKHNGHS/OCH4PiXgxlO7pH7cqle6cwcxzQB3K7L8ZUP03cz",
+	"vJ76KiF0kMZXfF5/25Tr0NOSa8OnXgVBoe9olCrbCsfv/kZPBWbKhymdJDj8j0gUL1P9iPwjIsSwZOak",
+	"vIoqEHZ7wLcHfJTyvJ9tq/3gyJEZieDo8eAH/4+HFR8MWUOV5qBEOfyrzJnkb7TPjekkHg7iVlrn8zjZ",
+	"JtXmaDNg3MQZCYuJ321mYpGKS9RaiKLkGQUlVrFSrRK9/PcqFUsQXZ5jmK2PxMSLWy6GptQv80tMGrBJ",
+	"fjA3o8RkO9mkgIyWUbaQUUoEq1nlYljJKDGxsIlSXAxrk111YfOqK3GJRRq/jb2a/tF1GwKYF+iCloBG",
+	"VSIW0IF0kfb2DNsi1nRdIkM6Se8BnM0UtZePNdGmwI8sNx86COCYHOh04s5LI+G3Rt5O5D67R7yciBES",
+	"r7Nbs0mLXMvrvbOBrvlUPuYyVZgoSwwpMlFzlvkzRXie8UwAx3dhUH3MrSu8wUvuFOB9rYuPN/WurKKQ",
+	"Webfmg2rQg6xKdXrH5/157YSMuevo83dQkMWmzpFMS3pBtx4oehAP51D8miVMLzhwQ/2n5rnJT4mS74V",
+	"BhYBwibwNLXzcZyHPgN0Nw3thUIOjWxjfNk/OwO9PXy7mVmvzeKM7Ch/SNI42CIezhiuxMNupZ768PhB",
+	"lIzrlIkoGYMojJHKqyPhKLL8eTI+D2NRp2PL2b5bTk2lTswIPaGIl1CT0Vv3c4daIFp2rFpLtVxQmGID",
+	"+CgpQ4rTEU0xCsADS5GRB68LIGEXlPciTecMhpjsg6/sH4RnSpxBzK4HkIB/Di8vABnBCGLS5fnw2Xce",
+	"jSYaCAwREHIdbq4TLYqKc2Z3B1IEfHak1Cln6xXGJnk20GVkOFv75JlXKLRMMATSeTJeXh6x/9/LYgjd",
+	"D2NG3SanSNJlmXZSKBmJzWgCyGM4czBd8vBAuK5lASWM6S9vrTnOqqfjCQArhB/73HDG9Stb2V4v4NvQ",
+	"3lhahSsn42wSZnnli7cwrKv3afS4pwUXOfiR/+Gl1vtphpMxRoS/DEPAegPdW8aeP/OjnecTYje2EU82",
+	"EbGMyQkGGLHkVewfwmLEy+iq9IgWofohjR4v1W++d7xtNO0WUOUCLr8fO3sZzW1bQ/mYx1QrJzcpJ4sM",
+	"vcVX1AKZ+EpLLzHJ9cJplmCmwjAlgdIVPnEaA9mzOlxDKBFM9os8Riqfza4KOC7yZd2eBIyFA5tCg0Ox",
+	"I6HwPvEAtCIXnR2eLMFFIG6wcaDz9TrA0ak5QtetcgMPBCL5CiemvxHzrcMBtEzWwtrfqdZ3YZCDf9vt",
+	"loM0VuTf3HRpslz7DrA9AprvzVRLtVWL51kSxtRTSE/DOKWIaaPqL4zgY5A8x1puN5DZnxC9YpPvusTm",
+	"sho+UJkRSN+RZCLcYm21o71D9r/rw8P3/H//6xBIsvvJg9DoVyHLOaT36CHBqABqwuBbAliV5vYDH7w5",
+	"uOuXjTlSW0A6cj5p5eOWysf87qxcSpIDcf12u1OJXJD6zdYm70STnXHqXn1Oga9HAgVcValJIiD8YBJp",
+	"9thoxgCxW+zqfTLK52ivkRrSRNM6VLTmSYusKkiIlUsoYRKsSoTAvldKKNHkp5ZQAgVNJBRWSNsBCSVg",
+	"bQVUK6AsAqogIFYooJRBaA+ncZ3jSq4eWu4auW+RWsWiLLt6h/wrPRlXOOqIcEjk5aqj2i7irMOd+Bxp",
+	"7e0YQRBHISI8JBx5gbdGm2sEaRNQ0piG0QpMBCe63EwWs/649yS8k3wAyerV3BlR1wt4FdVZoslOmaJl",
+	"6f0w8MGhaLxay3PXmcE6AWE8itKAu/4TdijzWuzG79ob3SaQ4mh+pxq4GaGcDbvGYJ8LTfDA2V/Bdi99",
+	"jpu6urVK3LZ5oOQUGEOPUqoKUHWhV6VQHchSZnuMG+rUK9mWDcurQ3APFLfOVa1ynWU11MhOq1+GvClV",
+	"ZBZIkW+xEn0Sde5zx5BCrxIztV55ZSeBVnS1oqup6JJlEWozrAAIYvScA7BaNJ3y17Of2pQlUWcgpcak",
+	"ZWKX294VDjdp2bKVyauzu4uX0pLUbgMADOszx1GRgVbA4Hl+/vF0tGf+UhePmCM5GAcgNBOm0UQfuEnM",
+	"t/f/OgEniv/rgBkco2oZ4OnnmoNBXDjGiNqlQWF5O+tYugCXtSf3DsUKezJ0t0TQC7C4f/hPluFA3DH8",
+	"j3MdKOJ9z/hLW1QdYstqEftrCrBmkUOt7PoJZReaSYGl/nw5gHg0CZ9QnZiSraSUYt2tEkqWGmd9TtTA",
+	"HpJJjedOJybhbR+htjNuUe673PM2dHEnnt411xWe38vyKMf+BvPrvGzsp0FaWfpBs3C9TGocN+0jj4Su",
+	"1Eqjn0catWHUf0VZZDD++iXRAmllFFBl35yGmWVaMfS6njlLpdCpTDtv5M35yBLN/MTJcqw7ThBTOACf",
+	"xlh2RbQn79B0A4ail9UZBFI2Ma8b7N53/vnDXOxhw8kvzb4OPIjpgxAjkYG9Eoozo9kikGT913s4twmM",
+	"XjmBkf34E59JRTgPfy4h8tnR4Qshahmemq9kq37FE4OLifzqbr7Ou52AsNFLnURqW0Sz8ERnpKOpLplp",
+	"o2j9zs5Ju6p0Lq+79T0klCeRqSLw3UkGvoFauH5MmNXQf9Wqty0/rqyobYMStpV8aS/wXl1ADGapoR0F",
+	"dkldsetd8c673XQl6AUsJu5NaHkn/7BTQa3+zNRtoKI1rwL/0/uJmRrm6gq9e6ugR69c6L18AraF3n11",
+	"1KUKvfudkgcEUfbfmhOS7Z7qAlSXakd2g1zCeDyUfXYkNeKGjkkDMUuckeaetKyUz13iQtPK+EiVoK95",
+	"YGT17EW7aq45mYW87H2rT+qCqhwfpEEIvsknyn+ltfUVlUdGkQK1BjPoHxdXGAGMM2r3I/ZWR+QIULRu",
+	"qIXrNGEUJ235a8WxABkzNWSwqgPHw5tFVDrLJ+p2JHhoVgegTezwas/Hj2ju9XjM2jVP6MDJ4DOa+zyp",
+	"ZjBpB+n+GfGNvBeyojGAymW1f7YgiDiNl0+O4QPhII1FYgxp+HqVJ2m+n6/zIM2n3oLnaBMO8zG6gliy",
+	"nBxoDgxXiCK96ASevzN2OxJeE0edLvvXsfjXcefWvp4sg8eX1SbwyJYhirOGgRed88b9zeTuWOddYaFQ",
+	"hdYLIHb7xBlKC0fu8iZkPq5DB2mvABwBHBc1ZmHB36/jhiAooYnNF4keP7v36/F/b2bWgeRPqZ6i7yOE",
+	"gnKqTHFBUXW6vfm8/mLCK/e43X5YSkVJHiSTCaRSKLA+P7FgYMtvKBzIa0oH0lw8tN7xWyYfOJuaQoKs",
+	"WEr4ZfsWhgwjZ1JOxXVJDeFW8tMnAxcI8Fco5IVhTdl2M4ct9q/n7LLM7h5rTBeofkju/0Aj6plhHGVB",
+	"3q2Q2lohJdPprkU+cTOap41V2OY87Kyf0bx91iMHOVw0va1zZLc3dtuNHUjb7yr54M8UYhjTMEZBDTtk",
+	"clKV10QYAaM/uEcjmBJeGCfEYAbnUQIDEIQBjw/iIUR8FIEOJXxDzNZV8XLxLwPE9hGjfcSYb9jyaNDf",
+	"QkZIk0VaRcMq3iwoWq2Y8yvtQZrdQH76Yh8CAdtyA1nN60GuwEfLrj/bvUCC6aELKf1G9sjfJcVJyEuT",
+	"V94Yhrxze2lQL9oZOhrfG9TOtWer7eqgsLMWbjn4wf+994jmL4JlIkRRmXnO+O829hG36zhjnkp+EePs",
+	"bq50tUg7UBqXlXD5KcoWln1b3pccY4nNK3NWewoevt1cxj9bPhtB9vlNafSG70wjvCxD7kjQxxZy41oO",
+	"0EUSWLVcviVczvhxcRafpRVeOgkW15uR5xkMetLeF1JmuQt5DiFp/QsSu1UPYgQwYm8xYlPNq/3zBMVZ",
+	"0qBZSiYo6IIAzVAcsOAdmaJ8lkThaL4PTicwHiMCRjAGFD4iICIj3xwCgkZJLC6TbHeqhdNV2gonb+G0",
+	"eiPBVUqNzfCyE2jC37CBwFN6zlLays2Ka8dVurgE87h0iMvGi6p8sscs6j4Xdu2dbr5fMBkmB2IvGPNM",
+	"ABqvGfKX8kuGtHMncW6CvxHZQQ5c8bYhK4wM+RJ2W0i5YFI+aDtrlDD2qKllgpHmDAV5cmhNFEUThQNN",
+	"KxIbYfwUUtQ0Q4zqZY967/Ovrd2OHJTwsVCYu8J2G9xuy/+S0eKakr6ICSppvfXfN9K8CJT4ZXcRuH3V",
+	"lC4C3EUyuUjCaNnSnr5F881qck1IPlc/7Il/e5nUYQNW3nHzeZ6vqmHb0+jY9bO1lntNu/12cq/NmK33",
+	"x5V+Nr+P/FyrSsrZjBN2JzHnrnDCenOHLnbuvlr2UE/OFfDtDOeKDWnOuVUn3xQxh8WmdzTVy87iX/jX",
+	"9o5GDkr4WOiOprDdKoO2O1pGi6vRBeV4Bz/EHx5KIIASCPCAk2ld3j5BDX8NVVAu2wWb+LxR3n27Ft5d",
+	"RAf8Obh2d7w3YH5jViYv/kxRivamTHCPKs9R6ZOOUgRka+26WCkwPiH6L9bri5xiF2XGTqU22qVsNevX",
+	"XnK0t1gKO/CEMAmTWNF9KxO3wddF785UC5ZiHdtFZSKGFO1xV3KfWE/WWjie1wV7DiB765iGbWK9ra7v",
+	"vYokbLWYXGeqNU1nW5BurQjLpup/5Xmtwdu7wc7tg3vhzmriJhO3DNXgXPy6vMQ9+JH9wzsyABqg7YOB",
+	"IZGFzw7ECBAaRhFIifTXEYUvmbzGSHo1YhRzDQrGCZ0gbIwJRjCOE+bco+5KFdJ9xy/HxqqdDoT5LaqE",
+	"0PPS63k7zYBrtbAtuZkaW7IxmXDge2FNaRiF/+EoEWF1BnUnTwgDGk5RXmJwWcGvRtyzCM/BNIxTiio4",
+	"/hOiu3y93QDXW+dkuAfwgSIsxTRNwBhRpdEzF+8HmEYibdnxWzBJUkwAHCcuPSyMR8iuAQYMdDZfp9sA",
+	"tnv0kGDkAVycPDtgSmMaRs1h2ohqtNi12KCU9kJsLS5iwdDmJCNGRAhEV8YCour2CNtQUSrSBDApKP4h",
+	"msCY4ZhQiCnhH5/DOEieKwQin6WVhb4a0Bp424OpiTwjW51qe3QqzjjrU6lkjz0RKlVf2kt1kLFVPoW9",
+	"VC6GK96jLet1YEPLYi/phd1oX9Q3Xh2PRHD0WF3Qa8iagGd0P0mSx7KPCf/8TXxtfUxELS8TJ0200QKq",
+	"t4kdjjYDxk0MUzpJcPgfFIiJ321m4i+IThIR1gajKHkuJdsxeIGb2wULmIow/7gUIx5wldTJjkOhsDIB",
+	"enmS0gngb0JFhrwhCAvXNA7QJUMo77mLnPnm8LjGmMZRhoIyViYIBtKVLkoEweRppTg3pwqCRikO6Zzj",
+	"Z5QkjyFig/Ii+bcmPXCU5mdUhMB2YGE6qKuvOLwYFgmwIJBj0sphKYcvhn0TVQ0kcRHLrSzeOllcZgQt",
+	"iS+GS5R1LAxsY7A2CIwjIM9fldUcV0ez+Um9g7mKu9oy9BYxtJPzPDm68kSlaLaH03hvE56BQ4pmgzTe",
+	"NQfB9ZsLbIhpZjPgz9s4jfM701r4tsF3Te9N+XlgSfuEZF5y8EP9+VLJujCD5X4uGKpwegtC3OVMbXqF",
+	"LrAUqnZUYsgtWlA+tBJhUxIhR4vPkIDYQ0SYhzr7iW30rTt4TpNyczlRW3vphFI0nckiYrytIT5cgmPX",
+	"ii61EqTKEyskPIpaihBBBNH2XRBe2QegjlE2xdAYsY4VrgCsgzcP8+YtC29jOQWcxnKramLcw5gnIUzk",
+	"465tuS9boam0xRQq5Avf8NcQKNmaKm0BZl7YWuHCrABi2Fa0vJ520KwaosPS0KY03YELRTm56gqlhnyL",
+	"32PBeVV5ObLoOaejROsjkUUCC1R840hlCBnImVxEoaOVRUegtqM14m/bq5xB/otnZJSDuFjop399y/GP",
+	"wEbl49vhOmcOGuVTVFvbcu72Pb+ZjLeIsV5I5WrzPDsheTNS7XubnQ0//WGZYWKxdA/tVdOSaSGfokrg",
+	"eNFHKoVocb1sXmJT9ee12PetrCBryLf1No16mwZeSI2ZyMTwK1bftMHtVnzdFqQcwbTX062sypnfo3Iu",
+	"l+oLahOB88P8Z93reI4Tak9gSaa7/FheYH07aCYGd1hNkNu1aFqo9vHcnZQpb5euT8jUzdPU4vx8wJ84",
+	"ak3UvJVkaBPo/Rq+7vPRW+Z+febOUtBdYbZjNEREwbiMNTuPI77drUF7QwbtbybuY5/kb9kmNVUZVidx",
+	"yATO0Jr0iCEfu5U3O6NMiA1rNYq/kEahPeKlJ0JlvJloI1g8ivSrG7HoGlWsz8OxxAO5KKbayoB1AHgO",
+	"CQX9M14bgL2bQbWDrhyTkNB+4Ewy+ebYlmRyA557ujDkIiWcW5PI9r3YLyBL/J/z/WQh8XqZ4C39NJqf",
+	"MuutzBLWeX/YzYmKTeS/1XO/W2TyoUiDez8HfAL7pPLT6yQ7yhFW+9izUn1rlfm09Zi1IQanylv6ntdK",
+	"Lj72VGlMuxNisC4vhwwXRCDD1xlY7IrlqWTVjz0zw1LzQyt9gzTuByRXN2ApBJeLJTQ0CMm4hvb1qCbp",
+	"kiCbTbzckIMRTuJ6jYS1An8k9xlQqip0tYpyipP4p1ZTdiY5v97YMFAZWZVKvF9Tg8V1cVt1jZhdKsBS",
+	"URLgfg4eZNmBlVUmMPmM+FcnuJ+vr0CBcWxuuERBDhlL6LDtwWTRY0snwZoUWpwwgyH7z5761a/mXvmo",
+	"8n4aYISz40UG9OpdYOUwuvkafJ7lCKyb2OblLJYIsKOpmTU/TxDMLb7iuW1J5tplB54t5qw1HZ3tsbkL",
+	"pu9Gh/UK5IPf+Y1Tj1tljmK8X+/be+Q23yP520qDSyRvv94b5FZfb6+zClmOF90CWKLxN9PGtyH4LPHY",
+	"Vtjk2+mmzAI5tBEKaUqQVw1Z1XaRK+2Q95WXSx/gHsM48IKKN2wM0ucwDuqh2XkLilHZqORTyJ59ZYif",
+	"uYTO8eHx0d4h+9/14eF7/r//deBedj9hE6yh3tEaQf7AZ1glzBVYfgjjkEwWh1n13yieVwX0SjG9Potg",
+	"2fz209oDi7pje61ZixfhegyBbGCvqoQQSNDYQZdnfzN7rqd/8K6XHWzV8FYN36wa3uqWrW75KpEBZLE8",
+	"3nnjU5vGu/58t2TVXt05z0AN0ggF1Yc8c9dVLRexHw5V59aKuM1WxPXdizQB7JS7RKtMtcrUzihT2TIy",
+	"Ub0S26wGyYvBtZXWAvNaQ4dKEqa1OqxWK3FoAOvVSw5+6D/3SplOar2S7CA31Fl23DfJggMXgHZUb627",
+	"kn13W3+lor+SA0/NHBIctFHjubQSBtzpaj07xX3rPI7bo3jX/ZrWK0f8FAOdzOAli6GprOcJQYye3ZE0",
+	"/oE016LD7qQfrr69mlGw9uwFlaBttNKoZRuaVAZxbv5G0z82c/I0sya74W/F4ubLH25dykkp6KqofD1B",
+	"jIYsztmR7fJYaQRSIvvrgyVVgoVHt1J4g1JY7YCxAU3kr1Nv2GCppubqqCmBf8qbZit+vcSvVEjqdOKV",
+	"i9xnnrV8b5SkMa1x0eFtVFYo0Y8A+ATDCN5HiEtfQ9zYb+OfEH8pQJic8hl3XvTWJe/a8eR9uc1a8Oot",
+	"SEWQT2sNd7zR55C0WEq/PPunBGFyMEoxRtWcTcTtQDQErFuJe28Iwp8QPZWDrZHu2EwN6YxD3JaCef1S",
+	"MGiU4pDOuRgfJcljiE5SJrt+v325LdJ9gdwUufPtt5DxOKST9P5gBKPoHo4eneR8mrAXVYoETV+y+YH1",
+	"PGITiUIYn/jQlwyXp2r4AoG/OTyueU8YyXmD8rwTBANZ9S1KxGZYqwxqsf5SQGYOd2qB+Tk80UcoxG5R",
+	"MGRfF0Mc79ocaxye9eOMQ9cQYUkyjtB66I0P/RenN4G+FdNbhri/HL2F8VNIkU9pSKUNiw5c6fY6vtkI",
+	"17xvX861xlPcnMjLfyIKidqY/AJbfdH7WGWILmIvo7xryw0xR3sHcDRCM+q2vJ3w7wTA/CQlajM3X/Tp",
+	"rMeeJAYXE9WXLqygPrFyG/21XgBZ/X6OpNLe+9MXRjzPYEVNM/a9GX2JPp11VQhjg6+AvsTKW/qqqd/O",
+	"kLQAfUXJOIzdZHWejAkIYwD52bhfoWCc84HWQ0v8CGbjb6jGqtc9OkrGYxSAMG6vz1t1fc4f64xqfO/J",
+	"UTJOUlrDDElK/bghSWlnS2g0SWlLpDtk4xHU40u2U8RiVMgknDW4Ahmd/K5B4gj5knWTYURrJXD7pM3v",
+	"QyaK2jvRInciE4P1JDmDhDwnuMITQYhJKUmBal8lUq/UmOvTMU4nMB7ribZJ2RhxyAKNqFac75A4F2SV",
+	"p3QPJsJozAQZrrr0iRakUiPRfjrrYhsFxjYxjEJe+8y1E3q6IiFfnYdEcPS4lheGIRt5ix8YakRNwxeH",
+	"J4SJBKGyuK1sp/xXCMJPFh2xHz8knxD9KgddaWkPA9Iso8PR/uH+oS1nhOE28rvueutRteO6YrEFV7kK",
+	"cv6GAEY0xXEOeQU9m0mpNI7DeJxN8X1PDbmXzESIajab2rRndD9Jksc96UV08EP+4BGPx04K2brsZSR+",
+	"9w+1kwO5vXj0RBt24vGMXVPwtefC658LxXg5k0ydrjuyxa0XcxxIPPtcklVTVRavmmOk3kN8E2tsLd+s",
+	"xvlNQC983yRqGGYGckKX1NV5QyV29Ha17LlF7MltAqUtasqjmjf5Hy8ela4t2oagMM/AVDFGpcMpwrvK",
+	"cQL45g6mP330ktWjtBStw5TmagdS1uKFUSEdTSpsXZWELFrtDC2vwZTAEZA7N1xnhcRAqlC2uSAWT14T",
+	"kLWcZuc0yRDLMFvhNClGZnhlJlGt/VIhNLgXbWV4Q5OsHhrANrpq89FVtuuQQTELBjd06zQsf05ooHL9",
+	"DFE+C0b2tLz12rxlhhAtw1g+ap8/dzXTA7eCwdZXeVogwzfQWWhdeS7btHLoJRGK6mErD5wK4nLMWaMm",
+	"eqXXZ5uUz6OvGe9Jv3Q4T8oG6fS3gZ8tKS1FQsoV1BtavNqQHbAxTtIZzxOagaA2ygkK7/QZzTu1ORzW",
+	"LCSWzN2tHpXa9N1bqE0slC+8keBSeWWcviEqJULTTC8LJXjZSsl1bWGXfdB/4NZtkjLqQEGXc1UEKSJU",
+	"81RIwAOiLN+IK5t0Jvi3XJGSZLBg1phXyxVjwNsoSUybGqZNDbOG1DCNRLOUDcTjVSt3knuJZelbs0Mm",
+	"mL+CXF6zlJObuqQq2Mq7rVIBM1JcVAUsOv7dI4gR1o5/XasrIPckE/IgxVHnfafzcvvy/w8ARKsv0mK0",
+	"AgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
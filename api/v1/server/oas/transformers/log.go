@@ -13,6 +13,9 @@ func ToLogFromSQLC(log *dbsqlc.LogLine) *gen.LogLine {
 		Message:   log.Message,
 	}
 
+	level := gen.LogLineLevel(log.Level)
+	res.Level = &level
+
 	if log.Metadata != nil {
 		meta := map[string]interface{}{}
 
@@ -3,9 +3,12 @@ package transformers
 import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
-func ToRateLimitFromSQLC(rl *dbsqlc.ListRateLimitsForTenantNoMutateRow) (*gen.RateLimit, error) {
+func ToRateLimitFromSQLC(row *dbsqlc.ListRateLimitsForTenantNoMutateRow) (*gen.RateLimit, error) {
+	rl := row.RateLimit
+
 	res := &gen.RateLimit{
 		Key:        rl.Key,
 		TenantId:   pgUUIDToStr(rl.TenantId),
@@ -13,7 +16,65 @@ func ToRateLimitFromSQLC(rl *dbsqlc.ListRateLimitsForTenantNoMutateRow) (*gen.Ra
 		LimitValue: int(rl.LimitValue),
 		Value:      int(rl.Value),
 		Window:     rl.Window,
+		Algorithm:  gen.RateLimitAlgorithm(rl.Algorithm),
+	}
+
+	if rl.ParentKey.Valid {
+		res.ParentKey = &rl.ParentKey.String
+	}
+
+	if rl.Burst.Valid {
+		burst := int(rl.Burst.Int32)
+		res.Burst = &burst
 	}
 
 	return res, nil
 }
+
+func ToRateLimitFromSQLCV1(rl *sqlcv1.RateLimit) *gen.RateLimit {
+	res := &gen.RateLimit{
+		Key:        rl.Key,
+		TenantId:   pgUUIDToStr(rl.TenantId),
+		LastRefill: rl.LastRefill.Time,
+		LimitValue: int(rl.LimitValue),
+		Value:      int(rl.Value),
+		Window:     rl.Window,
+		Algorithm:  gen.RateLimitAlgorithm(rl.Algorithm),
+	}
+
+	if rl.ParentKey.Valid {
+		res.ParentKey = &rl.ParentKey.String
+	}
+
+	if rl.Burst.Valid {
+		burst := int(rl.Burst.Int32)
+		res.Burst = &burst
+	}
+
+	return res
+}
+
+func ToRateLimitMetrics(rows []*sqlcv1.ListRateLimitSamplesRow) []gen.RateLimitMetric {
+	res := make([]gen.RateLimitMetric, 0, len(rows))
+
+	for _, row := range rows {
+		if row == nil || !row.Bucket.Valid {
+			continue
+		}
+
+		// the value of a rate limit is the number of units remaining in the window
+		used := int(row.LimitValue - row.MinValue)
+
+		if used < 0 {
+			used = 0
+		}
+
+		res = append(res, gen.RateLimitMetric{
+			Time:       row.Bucket.Time.UTC(),
+			LimitValue: int(row.LimitValue),
+			Used:       used,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,99 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func ToEventTriggerSkipList(skips []*sqlcv1.ListEventTriggerSkipsRow) gen.EventTriggerSkipList {
+	rows := make([]gen.EventTriggerSkip, len(skips))
+
+	for i, skip := range skips {
+		rows[i] = ToEventTriggerSkip(skip)
+	}
+
+	return gen.EventTriggerSkipList{
+		Rows: rows,
+	}
+}
+
+func ToEventTriggerSkip(skip *sqlcv1.ListEventTriggerSkipsRow) gen.EventTriggerSkip {
+	res := gen.EventTriggerSkip{
+		Metadata: gen.APIResourceMeta{
+			Id:        sqlchelpers.UUIDToStr(skip.EventID),
+			CreatedAt: skip.InsertedAt.Time,
+			UpdatedAt: skip.InsertedAt.Time,
+		},
+		EventKey:          skip.EventKey,
+		WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(skip.WorkflowID)),
+		WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(skip.WorkflowVersionID)),
+		WorkflowName:      skip.WorkflowName,
+		Expression:        skip.Expression,
+	}
+
+	if skip.ErrorMessage.Valid {
+		res.ErrorMessage = &skip.ErrorMessage.String
+	}
+
+	return res
+}
+
+func ToEventSchemaList(schemas []*sqlcv1.V1EventSchema) gen.EventSchemaList {
+	rows := make([]gen.EventSchema, len(schemas))
+
+	for i, schema := range schemas {
+		rows[i] = ToEventSchema(schema)
+	}
+
+	return gen.EventSchemaList{
+		Rows: rows,
+	}
+}
+
+func ToEventSchema(schema *sqlcv1.V1EventSchema) gen.EventSchema {
+	schemaMap := make(map[string]interface{})
+
+	// schemas are validated when they are written, so this should not fail
+	_ = json.Unmarshal(schema.Schema, &schemaMap)
+
+	return gen.EventSchema{
+		Key:       schema.EventKey,
+		Schema:    schemaMap,
+		Policy:    gen.EventSchemaPolicy(schema.Policy),
+		CreatedAt: schema.CreatedAt.Time,
+		UpdatedAt: schema.UpdatedAt.Time,
+	}
+}
+
+func ToQuarantinedEvent(event *sqlcv1.V1QuarantinedEvent) gen.QuarantinedEvent {
+	res := gen.QuarantinedEvent{
+		EventId:      uuid.MustParse(sqlchelpers.UUIDToStr(event.EventID)),
+		Key:          event.EventKey,
+		ErrorMessage: event.ErrorMessage,
+		CreatedAt:    event.InsertedAt.Time,
+	}
+
+	if len(event.Data) > 0 {
+		data := make(map[string]interface{})
+
+		// payloads which are not JSON objects are omitted
+		if err := json.Unmarshal(event.Data, &data); err == nil {
+			res.Data = &data
+		}
+	}
+
+	if len(event.AdditionalMetadata) > 0 {
+		additionalMetadata := make(map[string]interface{})
+
+		if err := json.Unmarshal(event.AdditionalMetadata, &additionalMetadata); err == nil {
+			res.AdditionalMetadata = &additionalMetadata
+		}
+	}
+
+	return res
+}
@@ -13,6 +13,9 @@ func ToV1LogLine(log *sqlcv1.V1LogLine) *gen.V1LogLine {
 		Message:   log.Message,
 	}
 
+	level := gen.V1LogLineLevel(log.Level)
+	res.Level = &level
+
 	if log.Metadata != nil {
 		meta := map[string]interface{}{}
 
@@ -25,6 +25,8 @@ func ToSlotState(slots []*sqlcv1.ListSemaphoreSlotsWithStateForWorkerRow, remain
 			workflowRunId = uuid.MustParse(sqlchelpers.UUIDToStr(slot.ExternalID))
 		}
 
+		numSlots := int(slot.Slots)
+
 		resp[i] = gen.SemaphoreSlots{
 			StepRunId:     stepRunId,
 			Status:        gen.StepRunStatusRUNNING,
@@ -32,6 +34,7 @@ func ToSlotState(slots []*sqlcv1.ListSemaphoreSlotsWithStateForWorkerRow, remain
 			WorkflowRunId: workflowRunId,
 			TimeoutAt:     &slot.TimeoutAt.Time,
 			StartedAt:     &slot.InsertedAt.Time,
+			Slots:         &numSlots,
 		}
 	}
 
@@ -71,6 +74,10 @@ func ToWorkerSqlc(worker *sqlcv1.Worker, remainingSlots *int, webhookUrl *string
 		status = gen.PAUSED
 	}
 
+	if worker.IsCordoned {
+		status = gen.CORDONED
+	}
+
 	if worker.LastHeartbeatAt.Time.Add(5 * time.Second).Before(time.Now()) {
 		status = gen.INACTIVE
 	}
@@ -118,3 +125,17 @@ func ToWorkerSqlc(worker *sqlcv1.Worker, remainingSlots *int, webhookUrl *string
 
 	return res
 }
+
+func ToWorkerSlotPools(pools []*sqlcv1.ListWorkerSlotPoolsRow) *[]gen.WorkerSlotPool {
+	res := make([]gen.WorkerSlotPool, len(pools))
+
+	for i, pool := range pools {
+		res[i] = gen.WorkerSlotPool{
+			Name:          pool.Name,
+			MaxRuns:       int(pool.MaxRuns),
+			AvailableRuns: int(pool.RemainingSlots),
+		}
+	}
+
+	return &res
+}
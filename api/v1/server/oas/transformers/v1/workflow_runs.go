@@ -13,7 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
-func WorkflowRunDataToV1TaskSummary(task *v1.WorkflowRunData, workflowIdsToNames map[pgtype.UUID]string) gen.V1TaskSummary {
+func WorkflowRunDataToV1TaskSummary(task *v1.WorkflowRunData, workflowIdsToNames map[pgtype.UUID]string, pausedWorkflowIds map[pgtype.UUID]bool) gen.V1TaskSummary {
 	additionalMetadata := jsonToMap(task.AdditionalMetadata)
 
 	var finishedAt *time.Time
@@ -63,6 +63,8 @@ func WorkflowRunDataToV1TaskSummary(task *v1.WorkflowRunData, workflowIdsToNames
 		workflowName = &name
 	}
 
+	workflowIsPaused := pausedWorkflowIds[task.WorkflowID]
+
 	return gen.V1TaskSummary{
 		Metadata: gen.APIResourceMeta{
 			Id:        sqlchelpers.UUIDToStr(task.ExternalID),
@@ -87,6 +89,7 @@ func WorkflowRunDataToV1TaskSummary(task *v1.WorkflowRunData, workflowIdsToNames
 		TaskInsertedAt:     task.InsertedAt.Time,
 		Type:               gen.V1WorkflowTypeDAG,
 		WorkflowName:       workflowName,
+		WorkflowIsPaused:   &workflowIsPaused,
 		StepId:             &stepId,
 	}
 }
@@ -95,13 +98,14 @@ func ToWorkflowRunMany(
 	tasks []*v1.WorkflowRunData,
 	dagExternalIdToChildren map[uuid.UUID][]gen.V1TaskSummary,
 	workflowIdsToNames map[pgtype.UUID]string,
+	pausedWorkflowIds map[pgtype.UUID]bool,
 	total int, limit, offset int64,
 ) gen.V1TaskSummaryList {
 	toReturn := make([]gen.V1TaskSummary, len(tasks))
 
 	for i, task := range tasks {
 		dagExternalId := uuid.MustParse(sqlchelpers.UUIDToStr(task.ExternalID))
-		toReturn[i] = WorkflowRunDataToV1TaskSummary(task, workflowIdsToNames)
+		toReturn[i] = WorkflowRunDataToV1TaskSummary(task, workflowIdsToNames, pausedWorkflowIds)
 
 		children, ok := dagExternalIdToChildren[dagExternalId]
 
@@ -124,7 +128,7 @@ func ToWorkflowRunMany(
 	}
 }
 
-func PopulateTaskRunDataRowToV1TaskSummary(task *sqlcv1.PopulateTaskRunDataRow, workflowName *string) gen.V1TaskSummary {
+func PopulateTaskRunDataRowToV1TaskSummary(task *sqlcv1.PopulateTaskRunDataRow, workflowName *string, workflowIsPaused bool) gen.V1TaskSummary {
 	additionalMetadata := jsonToMap(task.AdditionalMetadata)
 
 	var finishedAt *time.Time
@@ -175,6 +179,7 @@ func PopulateTaskRunDataRowToV1TaskSummary(task *sqlcv1.PopulateTaskRunDataRow,
 		TaskInsertedAt:     task.InsertedAt.Time,
 		Type:               gen.V1WorkflowTypeTASK,
 		WorkflowName:       workflowName,
+		WorkflowIsPaused:   &workflowIsPaused,
 		StepId:             &stepId,
 	}
 }
@@ -182,13 +187,14 @@ func PopulateTaskRunDataRowToV1TaskSummary(task *sqlcv1.PopulateTaskRunDataRow,
 func TaskRunDataRowToWorkflowRunsMany(
 	tasks []*sqlcv1.PopulateTaskRunDataRow,
 	taskIdToWorkflowName map[int64]string,
+	pausedWorkflowIds map[pgtype.UUID]bool,
 	total int, limit, offset int64,
 ) gen.V1TaskSummaryList {
 	toReturn := make([]gen.V1TaskSummary, len(tasks))
 
 	for i, task := range tasks {
 		workflowName := taskIdToWorkflowName[task.ID]
-		toReturn[i] = PopulateTaskRunDataRowToV1TaskSummary(task, &workflowName)
+		toReturn[i] = PopulateTaskRunDataRowToV1TaskSummary(task, &workflowName, pausedWorkflowIds[task.WorkflowID])
 	}
 
 	currentPage := (offset / limit) + 1
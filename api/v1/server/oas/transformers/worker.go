@@ -106,6 +106,10 @@ func ToWorkerSqlc(worker *dbsqlc.Worker, remainingSlots *int, webhookUrl *string
 		status = gen.PAUSED
 	}
 
+	if worker.IsCordoned {
+		status = gen.CORDONED
+	}
+
 	if worker.LastHeartbeatAt.Time.Add(5 * time.Second).Before(time.Now()) {
 		status = gen.INACTIVE
 	}
@@ -122,10 +122,16 @@ func ToWorkflowVersion(
 			eventCp := event
 			if eventCp.ParentId.Valid {
 				parentId := sqlchelpers.UUIDToStr(eventCp.ParentId)
-				genEvents = append(genEvents, gen.WorkflowTriggerEventRef{
+				genEvent := gen.WorkflowTriggerEventRef{
 					EventKey: &eventCp.EventKey,
 					ParentId: &parentId,
-				})
+				}
+
+				if eventCp.Expression.Valid {
+					genEvent.Expression = &eventCp.Expression.String
+				}
+
+				genEvents = append(genEvents, genEvent)
 			}
 		}
 
@@ -151,7 +157,7 @@ func ToWorkflowVersionConcurrency(concurrency *WorkflowConcurrency) *gen.Workflo
 	return res
 }
 
-func ToJob(job *dbsqlc.Job, steps []*dbsqlc.GetStepsForJobsRow) *gen.Job {
+func ToJob(job *dbsqlc.Job, steps []*dbsqlc.GetStepsForJobsRow, stepConcurrencies []*dbsqlc.StepConcurrency) *gen.Job {
 	res := &gen.Job{
 		Metadata: *toAPIMetadata(
 			sqlchelpers.UUIDToStr(job.ID),
@@ -170,7 +176,7 @@ func ToJob(job *dbsqlc.Job, steps []*dbsqlc.GetStepsForJobsRow) *gen.Job {
 	for _, step := range steps {
 		stepCp := step
 		if stepCp.Step.JobId == job.ID {
-			apiSteps = append(apiSteps, *ToStep(&stepCp.Step, stepCp.Parents))
+			apiSteps = append(apiSteps, *ToStep(&stepCp.Step, stepCp.Parents, stepConcurrencies))
 		}
 	}
 
@@ -179,7 +185,7 @@ func ToJob(job *dbsqlc.Job, steps []*dbsqlc.GetStepsForJobsRow) *gen.Job {
 	return res
 }
 
-func ToStep(step *dbsqlc.Step, parents []pgtype.UUID) *gen.Step {
+func ToStep(step *dbsqlc.Step, parents []pgtype.UUID, stepConcurrencies []*dbsqlc.StepConcurrency) *gen.Step {
 	res := &gen.Step{
 		Metadata: *toAPIMetadata(
 			sqlchelpers.UUIDToStr(step.ID),
@@ -205,6 +211,28 @@ func ToStep(step *dbsqlc.Step, parents []pgtype.UUID) *gen.Step {
 
 	res.Children = &children
 
+	concurrency := make([]gen.StepConcurrency, 0)
+
+	for _, c := range stepConcurrencies {
+		if c.StepId != step.ID {
+			continue
+		}
+
+		apiConcurrency := gen.StepConcurrency{
+			Expression:    c.Expression,
+			MaxRuns:       c.MaxRuns,
+			LimitStrategy: gen.ConcurrencyLimitStrategy(c.LimitStrategy),
+		}
+
+		if c.WeightExpression.Valid {
+			apiConcurrency.WeightExpression = &c.WeightExpression.String
+		}
+
+		concurrency = append(concurrency, apiConcurrency)
+	}
+
+	res.Concurrency = &concurrency
+
 	return res
 }
 
@@ -18,6 +18,7 @@ func ToWorkflowRunShape(
 	version *dbsqlc.GetWorkflowVersionByIdRow,
 	jobs []*dbsqlc.ListJobRunsForWorkflowRunFullRow,
 	steps []*dbsqlc.GetStepsForJobsRow,
+	stepConcurrencies []*dbsqlc.StepConcurrency,
 	stepRuns []*repository.StepRunForJobRun,
 ) *gen.WorkflowRunShape {
 	res := &gen.WorkflowRunShape{
@@ -60,7 +61,7 @@ func ToWorkflowRunShape(
 
 		for _, jobRun := range jobs {
 			jobRunCp := *jobRun
-			jobRuns = append(jobRuns, *ToJobRun(&jobRunCp, steps, stepRuns))
+			jobRuns = append(jobRuns, *ToJobRun(&jobRunCp, steps, stepConcurrencies, stepRuns))
 
 		}
 
@@ -112,7 +113,7 @@ func ToWorkflowRun(
 
 		for _, jobRun := range jobs {
 			jobRunCp := *jobRun
-			jobRuns = append(jobRuns, *ToJobRun(&jobRunCp, steps, stepRuns))
+			jobRuns = append(jobRuns, *ToJobRun(&jobRunCp, steps, nil, stepRuns))
 		}
 
 		res.JobRuns = &jobRuns
@@ -136,6 +137,7 @@ func ToWorkflowRun(
 func ToJobRun(
 	jobRun *dbsqlc.ListJobRunsForWorkflowRunFullRow,
 	steps []*dbsqlc.GetStepsForJobsRow,
+	stepConcurrencies []*dbsqlc.StepConcurrency,
 	stepRuns []*repository.StepRunForJobRun,
 ) *gen.JobRun {
 
@@ -157,7 +159,7 @@ func ToJobRun(
 		TimeoutAt:       &jobRun.TimeoutAt.Time,
 	}
 
-	res.Job = ToJob(&jobRun.Job, steps)
+	res.Job = ToJob(&jobRun.Job, steps, stepConcurrencies)
 
 	resStepRuns := make([]gen.StepRun, 0)
 
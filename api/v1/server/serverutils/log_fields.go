@@ -0,0 +1,40 @@
+package serverutils
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ParseLogFieldFilters parses log field filters in the format key:value. Values are parsed as JSON scalars,
+// so that numbers and booleans match the fields of structured logs, and fall back to strings otherwise. A
+// string which looks like a scalar can be matched by quoting it, i.e. key:"42".
+func ParseLogFieldFilters(filters []string) map[string]interface{} {
+	fields := make(map[string]interface{}, len(filters))
+
+	for _, filter := range filters {
+		kvPair := strings.SplitN(filter, ":", 2)
+
+		if len(kvPair) != 2 {
+			continue
+		}
+
+		fields[kvPair[0]] = parseLogFieldValue(kvPair[1])
+	}
+
+	return fields
+}
+
+func parseLogFieldValue(value string) interface{} {
+	var parsed interface{}
+
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+
+	switch parsed.(type) {
+	case map[string]interface{}, []interface{}:
+		return value
+	default:
+		return parsed
+	}
+}
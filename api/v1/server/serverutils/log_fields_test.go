@@ -0,0 +1,34 @@
+package serverutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogFieldFilters(t *testing.T) {
+	fields := ParseLogFieldFilters([]string{
+		"user:alice",
+		"attempt:3",
+		"ratio:0.5",
+		"cached:true",
+		"parent:null",
+		"code:\"42\"",
+		"url:https://example.com",
+		"payload:{\"a\":1}",
+		"tags:[1,2]",
+		"invalid",
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"user":    "alice",
+		"attempt": float64(3),
+		"ratio":   0.5,
+		"cached":  true,
+		"parent":  nil,
+		"code":    "42",
+		"url":     "https://example.com",
+		"payload": "{\"a\":1}",
+		"tags":    "[1,2]",
+	}, fields)
+}
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_bulk_operation_kind AS ENUM ('CANCEL', 'REPLAY');
+
+CREATE TYPE v1_bulk_operation_status AS ENUM ('PENDING', 'RUNNING', 'COMPLETED', 'FAILED');
+
+CREATE TABLE v1_bulk_operation (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_operation_kind NOT NULL,
+    status v1_bulk_operation_status NOT NULL DEFAULT 'PENDING',
+    filter JSONB NOT NULL,
+    matched_count BIGINT NOT NULL DEFAULT 0,
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    last_item_id BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT v1_bulk_operation_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_bulk_operation_tenant_id_created_at_idx ON v1_bulk_operation (tenant_id ASC, created_at DESC);
+
+CREATE TABLE v1_bulk_operation_item (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    bulk_operation_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    CONSTRAINT v1_bulk_operation_item_pkey PRIMARY KEY (bulk_operation_id, id)
+);
+
+CREATE UNIQUE INDEX v1_bulk_operation_item_external_id_key ON v1_bulk_operation_item (bulk_operation_id ASC, external_id ASC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_bulk_operation_item;
+DROP TABLE v1_bulk_operation;
+DROP TYPE v1_bulk_operation_status;
+DROP TYPE v1_bulk_operation_kind;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "WorkflowTriggerEventRef" ADD COLUMN IF NOT EXISTS "expression" TEXT;
+
+CREATE TABLE v1_event_trigger_skip (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    workflow_id UUID NOT NULL,
+    workflow_version_id UUID NOT NULL,
+    expression TEXT NOT NULL,
+    error_message TEXT,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_trigger_skip_pkey PRIMARY KEY (id, inserted_at)
+) PARTITION BY RANGE(inserted_at);
+
+CREATE INDEX v1_event_trigger_skip_tenant_id_event_id_idx ON v1_event_trigger_skip (tenant_id ASC, event_id ASC);
+
+SELECT create_v1_range_partition('v1_event_trigger_skip', DATE 'today');
+SELECT create_v1_range_partition('v1_event_trigger_skip', (DATE 'today' + INTERVAL '1 day')::date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_event_trigger_skip;
+
+ALTER TABLE "WorkflowTriggerEventRef" DROP COLUMN IF EXISTS "expression";
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE v1_event_schema_policy AS ENUM ('REJECT', 'QUARANTINE');
+
+CREATE TABLE v1_event_schema (
+    tenant_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    schema JSONB NOT NULL,
+    policy v1_event_schema_policy NOT NULL DEFAULT 'REJECT',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_schema_pkey PRIMARY KEY (tenant_id, event_key)
+);
+
+CREATE TABLE v1_quarantined_event (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    error_message TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_quarantined_event_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_quarantined_event_tenant_id_inserted_at_idx ON v1_quarantined_event (tenant_id ASC, inserted_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_quarantined_event;
+DROP TABLE v1_event_schema;
+DROP TYPE v1_event_schema_policy;
+-- +goose StatementEnd
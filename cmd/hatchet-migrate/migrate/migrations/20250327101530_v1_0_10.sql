@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_event_deduplication (
+    tenant_id UUID NOT NULL,
+    deduplication_key TEXT NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT v1_event_deduplication_pkey PRIMARY KEY (tenant_id, deduplication_key, inserted_at)
+) PARTITION BY RANGE(inserted_at);
+
+SELECT create_v1_range_partition('v1_event_deduplication', DATE 'today');
+SELECT create_v1_range_partition('v1_event_deduplication', (DATE 'today' + INTERVAL '1 day')::date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_event_deduplication;
+-- +goose StatementEnd
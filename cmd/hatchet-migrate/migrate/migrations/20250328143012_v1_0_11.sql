@@ -0,0 +1,259 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_idempotency_key (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    external_id UUID NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT v1_idempotency_key_pkey PRIMARY KEY (tenant_id, key, inserted_at)
+) PARTITION BY RANGE(inserted_at);
+
+SELECT create_v1_range_partition('v1_idempotency_key', DATE 'today');
+SELECT create_v1_range_partition('v1_idempotency_key', (DATE 'today' + INTERVAL '1 day')::date);
+
+ALTER TABLE v1_task ADD COLUMN idempotency_key TEXT;
+ALTER TABLE v1_dag ADD COLUMN idempotency_key TEXT;
+ALTER TABLE v1_tasks_olap ADD COLUMN idempotency_key TEXT;
+ALTER TABLE v1_dags_olap ADD COLUMN idempotency_key TEXT;
+ALTER TABLE v1_runs_olap ADD COLUMN idempotency_key TEXT;
+
+CREATE OR REPLACE FUNCTION v1_tasks_olap_insert_function()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO v1_runs_olap (
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        kind,
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id,
+        idempotency_key
+    )
+    SELECT
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        'TASK',
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id,
+        idempotency_key
+    FROM new_rows
+    WHERE dag_id IS NULL;
+
+    INSERT INTO v1_lookup_table_olap (
+        tenant_id,
+        external_id,
+        task_id,
+        inserted_at
+    )
+    SELECT
+        tenant_id,
+        external_id,
+        id,
+        inserted_at
+    FROM new_rows
+    ON CONFLICT (external_id) DO NOTHING;
+
+    -- If the task has a dag_id and dag_inserted_at, insert into the lookup table
+    INSERT INTO v1_dag_to_task_olap (
+        dag_id,
+        dag_inserted_at,
+        task_id,
+        task_inserted_at
+    )
+    SELECT
+        dag_id,
+        dag_inserted_at,
+        id,
+        inserted_at
+    FROM new_rows
+    WHERE dag_id IS NOT NULL;
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION v1_dags_olap_insert_function()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO v1_runs_olap (
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        kind,
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id,
+        idempotency_key
+    )
+    SELECT
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        'DAG',
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id,
+        idempotency_key
+    FROM new_rows;
+
+    INSERT INTO v1_lookup_table_olap (
+        tenant_id,
+        external_id,
+        dag_id,
+        inserted_at
+    )
+    SELECT
+        tenant_id,
+        external_id,
+        id,
+        inserted_at
+    FROM new_rows
+    ON CONFLICT (external_id) DO NOTHING;
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION v1_tasks_olap_insert_function()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO v1_runs_olap (
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        kind,
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id
+    )
+    SELECT
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        'TASK',
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id
+    FROM new_rows
+    WHERE dag_id IS NULL;
+
+    INSERT INTO v1_lookup_table_olap (
+        tenant_id,
+        external_id,
+        task_id,
+        inserted_at
+    )
+    SELECT
+        tenant_id,
+        external_id,
+        id,
+        inserted_at
+    FROM new_rows
+    ON CONFLICT (external_id) DO NOTHING;
+
+    -- If the task has a dag_id and dag_inserted_at, insert into the lookup table
+    INSERT INTO v1_dag_to_task_olap (
+        dag_id,
+        dag_inserted_at,
+        task_id,
+        task_inserted_at
+    )
+    SELECT
+        dag_id,
+        dag_inserted_at,
+        id,
+        inserted_at
+    FROM new_rows
+    WHERE dag_id IS NOT NULL;
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION v1_dags_olap_insert_function()
+RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO v1_runs_olap (
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        kind,
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id
+    )
+    SELECT
+        tenant_id,
+        id,
+        inserted_at,
+        external_id,
+        readable_status,
+        'DAG',
+        workflow_id,
+        workflow_version_id,
+        additional_metadata,
+        parent_task_external_id
+    FROM new_rows;
+
+    INSERT INTO v1_lookup_table_olap (
+        tenant_id,
+        external_id,
+        dag_id,
+        inserted_at
+    )
+    SELECT
+        tenant_id,
+        external_id,
+        id,
+        inserted_at
+    FROM new_rows
+    ON CONFLICT (external_id) DO NOTHING;
+
+    RETURN NULL;
+END;
+$$
+LANGUAGE plpgsql;
+
+ALTER TABLE v1_runs_olap DROP COLUMN idempotency_key;
+ALTER TABLE v1_dags_olap DROP COLUMN idempotency_key;
+ALTER TABLE v1_tasks_olap DROP COLUMN idempotency_key;
+ALTER TABLE v1_dag DROP COLUMN idempotency_key;
+ALTER TABLE v1_task DROP COLUMN idempotency_key;
+
+DROP TABLE v1_idempotency_key;
+-- +goose StatementEnd
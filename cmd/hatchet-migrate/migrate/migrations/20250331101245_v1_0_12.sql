@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_durable_sleep (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    sleep_until TIMESTAMPTZ NOT NULL,
+    sleep_duration TEXT NOT NULL,
+    CONSTRAINT v1_durable_sleep_pkey PRIMARY KEY (tenant_id, sleep_until, id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_durable_sleep;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "StepMatchConditionAction" AS ENUM ('QUEUE', 'SKIP', 'CANCEL');
+
+CREATE TYPE "StepMatchConditionKind" AS ENUM ('SLEEP', 'USER_EVENT');
+
+CREATE TABLE "StepMatchCondition" (
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "stepId" UUID NOT NULL,
+    "orGroupId" TEXT NOT NULL,
+    "action" "StepMatchConditionAction" NOT NULL,
+    "kind" "StepMatchConditionKind" NOT NULL,
+    "readableDataKey" TEXT NOT NULL,
+    "sleepDuration" TEXT,
+    "eventKey" TEXT,
+    "expression" TEXT,
+
+    CONSTRAINT "StepMatchCondition_pkey" PRIMARY KEY ("id")
+);
+
+CREATE INDEX "StepMatchCondition_stepId_idx" ON "StepMatchCondition" ("stepId" ASC);
+
+ALTER TABLE "StepMatchCondition" ADD CONSTRAINT "StepMatchCondition_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE "StepMatchCondition";
+
+DROP TYPE "StepMatchConditionKind";
+
+DROP TYPE "StepMatchConditionAction";
+-- +goose StatementEnd
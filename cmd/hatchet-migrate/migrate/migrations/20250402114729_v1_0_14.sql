@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "skipIf" TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "skipIf";
+-- +goose StatementEnd
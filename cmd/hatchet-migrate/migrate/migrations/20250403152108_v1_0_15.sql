@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "mapExpression" TEXT;
+
+ALTER TABLE "Step" ADD COLUMN IF NOT EXISTS "mapMaxParallelism" INTEGER;
+
+ALTER TYPE v1_task_initial_state ADD VALUE IF NOT EXISTS 'MAPPED';
+
+CREATE TABLE v1_map_task (
+    tenant_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    retry_count INTEGER NOT NULL,
+    items JSONB NOT NULL,
+    item_count INTEGER NOT NULL,
+    created_count INTEGER NOT NULL DEFAULT 0,
+    completed_count INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT,
+    CONSTRAINT v1_map_task_pkey PRIMARY KEY (task_id, task_inserted_at)
+) PARTITION BY RANGE(task_inserted_at);
+
+SELECT create_v1_range_partition('v1_map_task', DATE 'today');
+SELECT create_v1_range_partition('v1_map_task', (DATE 'today' + INTERVAL '1 day')::date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_map_task;
+
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "mapMaxParallelism";
+
+ALTER TABLE "Step" DROP COLUMN IF EXISTS "mapExpression";
+-- +goose StatementEnd
@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "StepConcurrency" (
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "stepId" UUID NOT NULL,
+    "expression" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL DEFAULT 1,
+    "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
+
+    CONSTRAINT "StepConcurrency_pkey" PRIMARY KEY ("id")
+);
+
+CREATE INDEX "StepConcurrency_stepId_idx" ON "StepConcurrency" ("stepId" ASC);
+
+ALTER TABLE "StepConcurrency" ADD CONSTRAINT "StepConcurrency_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Creates the v1 concurrency strategy for a concurrency limit which is defined on a single step. These
+-- strategies don't have a parent strategy.
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency_from_step()
+RETURNS trigger AS $$
+BEGIN
+  INSERT INTO v1_step_concurrency (
+    workflow_id,
+    workflow_version_id,
+    step_id,
+    strategy,
+    expression,
+    tenant_id,
+    max_concurrency
+  )
+  SELECT
+    wf."id",
+    wv."id",
+    s."id",
+    NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+    NEW."expression",
+    NEW."tenantId",
+    NEW."maxRuns"
+  FROM "Step" s
+  JOIN "Job" j ON s."jobId" = j."id"
+  JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+  JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+  WHERE s."id" = NEW."stepId";
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_create_v1_step_concurrency_from_step
+AFTER INSERT ON "StepConcurrency"
+FOR EACH ROW
+EXECUTE FUNCTION create_v1_step_concurrency_from_step();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_create_v1_step_concurrency_from_step ON "StepConcurrency";
+
+DROP FUNCTION IF EXISTS create_v1_step_concurrency_from_step();
+
+DROP TABLE "StepConcurrency";
+-- +goose StatementEnd
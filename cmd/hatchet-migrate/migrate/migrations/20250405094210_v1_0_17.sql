@@ -0,0 +1,252 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE "ConcurrencyLimitStrategy" ADD VALUE IF NOT EXISTS 'WEIGHTED_ROUND_ROBIN';
+
+ALTER TYPE v1_concurrency_strategy ADD VALUE IF NOT EXISTS 'WEIGHTED_ROUND_ROBIN';
+
+ALTER TABLE "WorkflowConcurrency" ADD COLUMN IF NOT EXISTS "weightExpression" TEXT;
+
+ALTER TABLE "StepConcurrency" ADD COLUMN IF NOT EXISTS "weightExpression" TEXT;
+
+ALTER TABLE v1_step_concurrency ADD COLUMN IF NOT EXISTS weight_expression TEXT;
+
+CREATE TABLE v1_concurrency_key_weight (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    weight INTEGER NOT NULL,
+    CONSTRAINT v1_concurrency_key_weight_pkey PRIMARY KEY (tenant_id, key)
+);
+
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency()
+RETURNS trigger AS $$
+DECLARE
+  wf_concurrency_row v1_workflow_concurrency%ROWTYPE;
+  child_ids bigint[];
+BEGIN
+  IF NEW."concurrencyGroupExpression" IS NOT NULL THEN
+    -- Insert into v1_workflow_concurrency and capture the inserted row.
+    INSERT INTO v1_workflow_concurrency (
+      workflow_id,
+      workflow_version_id,
+      strategy,
+      expression,
+      tenant_id,
+      max_concurrency
+    )
+    SELECT
+      wf."id",
+      wv."id",
+      NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+      NEW."concurrencyGroupExpression",
+      wf."tenantId",
+      NEW."maxRuns"
+    FROM "WorkflowVersion" wv
+    JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+    WHERE wv."id" = NEW."workflowVersionId"
+    RETURNING * INTO wf_concurrency_row;
+
+    -- Insert into v1_step_concurrency and capture the inserted rows into a variable.
+    WITH inserted_steps AS (
+      INSERT INTO v1_step_concurrency (
+        parent_strategy_id,
+        workflow_id,
+        workflow_version_id,
+        step_id,
+        strategy,
+        expression,
+        tenant_id,
+        max_concurrency,
+        weight_expression
+      )
+      SELECT
+        wf_concurrency_row.id,
+        s."workflowId",
+        s."workflowVersionId",
+        s."id",
+        NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+        NEW."concurrencyGroupExpression",
+        s."tenantId",
+        NEW."maxRuns",
+        NEW."weightExpression"
+      FROM (
+        SELECT
+          s."id",
+          wf."id" AS "workflowId",
+          wv."id" AS "workflowVersionId",
+          wf."tenantId"
+        FROM "Step" s
+        JOIN "Job" j ON s."jobId" = j."id"
+        JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+        JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+        WHERE
+          wv."id" = NEW."workflowVersionId"
+          AND j."kind" = 'DEFAULT'
+      ) s
+      RETURNING *
+    )
+    SELECT array_remove(array_agg(t.id), NULL)::bigint[] INTO child_ids
+    FROM inserted_steps t;
+
+    -- Update the workflow concurrency row using its primary key.
+    UPDATE v1_workflow_concurrency
+    SET child_strategy_ids = child_ids
+    WHERE workflow_id = wf_concurrency_row.workflow_id
+      AND workflow_version_id = wf_concurrency_row.workflow_version_id
+      AND id = wf_concurrency_row.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency_from_step()
+RETURNS trigger AS $$
+BEGIN
+  INSERT INTO v1_step_concurrency (
+    workflow_id,
+    workflow_version_id,
+    step_id,
+    strategy,
+    expression,
+    tenant_id,
+    max_concurrency,
+    weight_expression
+  )
+  SELECT
+    wf."id",
+    wv."id",
+    s."id",
+    NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+    NEW."expression",
+    NEW."tenantId",
+    NEW."maxRuns",
+    NEW."weightExpression"
+  FROM "Step" s
+  JOIN "Job" j ON s."jobId" = j."id"
+  JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+  JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+  WHERE s."id" = NEW."stepId";
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency()
+RETURNS trigger AS $$
+DECLARE
+  wf_concurrency_row v1_workflow_concurrency%ROWTYPE;
+  child_ids bigint[];
+BEGIN
+  IF NEW."concurrencyGroupExpression" IS NOT NULL THEN
+    -- Insert into v1_workflow_concurrency and capture the inserted row.
+    INSERT INTO v1_workflow_concurrency (
+      workflow_id,
+      workflow_version_id,
+      strategy,
+      expression,
+      tenant_id,
+      max_concurrency
+    )
+    SELECT
+      wf."id",
+      wv."id",
+      NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+      NEW."concurrencyGroupExpression",
+      wf."tenantId",
+      NEW."maxRuns"
+    FROM "WorkflowVersion" wv
+    JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+    WHERE wv."id" = NEW."workflowVersionId"
+    RETURNING * INTO wf_concurrency_row;
+
+    -- Insert into v1_step_concurrency and capture the inserted rows into a variable.
+    WITH inserted_steps AS (
+      INSERT INTO v1_step_concurrency (
+        parent_strategy_id,
+        workflow_id,
+        workflow_version_id,
+        step_id,
+        strategy,
+        expression,
+        tenant_id,
+        max_concurrency
+      )
+      SELECT
+        wf_concurrency_row.id,
+        s."workflowId",
+        s."workflowVersionId",
+        s."id",
+        NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+        NEW."concurrencyGroupExpression",
+        s."tenantId",
+        NEW."maxRuns"
+      FROM (
+        SELECT
+          s."id",
+          wf."id" AS "workflowId",
+          wv."id" AS "workflowVersionId",
+          wf."tenantId"
+        FROM "Step" s
+        JOIN "Job" j ON s."jobId" = j."id"
+        JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+        JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+        WHERE
+          wv."id" = NEW."workflowVersionId"
+          AND j."kind" = 'DEFAULT'
+      ) s
+      RETURNING *
+    )
+    SELECT array_remove(array_agg(t.id), NULL)::bigint[] INTO child_ids
+    FROM inserted_steps t;
+
+    -- Update the workflow concurrency row using its primary key.
+    UPDATE v1_workflow_concurrency
+    SET child_strategy_ids = child_ids
+    WHERE workflow_id = wf_concurrency_row.workflow_id
+      AND workflow_version_id = wf_concurrency_row.workflow_version_id
+      AND id = wf_concurrency_row.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency_from_step()
+RETURNS trigger AS $$
+BEGIN
+  INSERT INTO v1_step_concurrency (
+    workflow_id,
+    workflow_version_id,
+    step_id,
+    strategy,
+    expression,
+    tenant_id,
+    max_concurrency
+  )
+  SELECT
+    wf."id",
+    wv."id",
+    s."id",
+    NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+    NEW."expression",
+    NEW."tenantId",
+    NEW."maxRuns"
+  FROM "Step" s
+  JOIN "Job" j ON s."jobId" = j."id"
+  JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+  JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+  WHERE s."id" = NEW."stepId";
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE v1_concurrency_key_weight;
+
+ALTER TABLE v1_step_concurrency DROP COLUMN IF EXISTS weight_expression;
+
+ALTER TABLE "StepConcurrency" DROP COLUMN IF EXISTS "weightExpression";
+
+ALTER TABLE "WorkflowConcurrency" DROP COLUMN IF EXISTS "weightExpression";
+-- +goose StatementEnd
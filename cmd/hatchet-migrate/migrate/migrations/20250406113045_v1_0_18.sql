@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE v1_rate_limit_samples_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    sampled_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    value INTEGER NOT NULL,
+    limit_value INTEGER NOT NULL,
+
+    PRIMARY KEY (tenant_id, key, sampled_at)
+) PARTITION BY RANGE(sampled_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE v1_rate_limit_samples_olap;
+-- +goose StatementEnd
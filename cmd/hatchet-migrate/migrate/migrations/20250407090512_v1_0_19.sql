@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE "StepExpressionKind" ADD VALUE IF NOT EXISTS 'DYNAMIC_RATE_LIMIT_PARENT';
+
+ALTER TABLE "RateLimit" ADD COLUMN "parentKey" TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "RateLimit" DROP COLUMN "parentKey";
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'TOKEN_BUCKET', 'SLIDING_WINDOW');
+
+ALTER TABLE "RateLimit"
+    ADD COLUMN "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    ADD COLUMN "burst" INTEGER,
+    ADD COLUMN "previousUsed" INTEGER;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "RateLimit"
+    DROP COLUMN "algorithm",
+    DROP COLUMN "burst",
+    DROP COLUMN "previousUsed";
+
+DROP TYPE "RateLimitAlgorithm";
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "Step" ADD COLUMN "slots" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE v1_task_runtime ADD COLUMN slots INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "Step" DROP COLUMN "slots";
+ALTER TABLE v1_task_runtime DROP COLUMN slots;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "WorkerSlotPool" (
+    "workerId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkerSlotPool_pkey" PRIMARY KEY ("workerId", "name")
+);
+
+ALTER TABLE "WorkerSlotPool" ADD CONSTRAINT "WorkerSlotPool_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "Step" ADD COLUMN "slotPool" TEXT;
+ALTER TABLE v1_task_runtime ADD COLUMN slot_pool TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_task_runtime DROP COLUMN slot_pool;
+ALTER TABLE "Step" DROP COLUMN "slotPool";
+DROP TABLE "WorkerSlotPool";
+-- +goose StatementEnd
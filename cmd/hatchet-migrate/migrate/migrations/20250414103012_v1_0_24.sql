@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_task ADD COLUMN event_key TEXT;
+ALTER TABLE v1_dag_data ADD COLUMN event_key TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_dag_data DROP COLUMN event_key;
+ALTER TABLE v1_task DROP COLUMN event_key;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE v1_match ADD COLUMN trigger_deferred_step_conditions BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE v1_match DROP COLUMN trigger_deferred_step_conditions;
+-- +goose StatementEnd
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type approvalRequest struct {
+	RequestID string `json:"request_id"`
+}
+
+type approvalResponse struct {
+	RequestID string `json:"request_id"`
+	Approved  bool   `json:"approved"`
+}
+
+type approvalOutput struct {
+	Message string `json:"message"`
+}
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	interrupt := cmdutils.InterruptChan()
+
+	cleanup, err := run()
+	if err != nil {
+		panic(err)
+	}
+
+	<-interrupt
+
+	if err := cleanup(); err != nil {
+		panic(fmt.Errorf("error cleaning up: %w", err))
+	}
+}
+
+func run() (func() error, error) {
+	c, err := client.New()
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %w", err)
+	}
+
+	w, err := worker.NewWorker(
+		worker.WithClient(
+			c,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating worker: %w", err)
+	}
+
+	err = w.RegisterWorkflow(
+		&worker.WorkflowJob{
+			On:          worker.Events("approval:request"),
+			Name:        "durable-approval",
+			Description: "Waits for an approval without holding a worker slot.",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (result *approvalOutput, err error) {
+					input := &approvalRequest{}
+
+					err = ctx.WorkflowInput(input)
+
+					if err != nil {
+						return nil, err
+					}
+
+					durableCtx, ok := ctx.(worker.DurableContext)
+
+					if !ok {
+						return nil, fmt.Errorf("context does not support durable waits")
+					}
+
+					// the slot is released while the task sleeps
+					if _, err := durableCtx.SleepFor(5 * time.Second); err != nil {
+						return nil, err
+					}
+
+					log.Printf("waiting for approval of request %s", input.RequestID)
+
+					// the slot is released until a matching approval event is pushed
+					res, err := durableCtx.WaitForEvent(
+						"approval:response",
+						fmt.Sprintf("input.request_id == '%s'", input.RequestID),
+					)
+
+					if err != nil {
+						return nil, err
+					}
+
+					response := &approvalResponse{}
+
+					if err := res.Unmarshal(response); err != nil {
+						return nil, err
+					}
+
+					return &approvalOutput{
+						Message: fmt.Sprintf("request %s approved: %t", input.RequestID, response.Approved),
+					}, nil
+				}).SetName("wait-for-approval"),
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error registering workflow: %w", err)
+	}
+
+	go func() {
+		requestId := "1234"
+
+		err := c.Event().Push(context.Background(), "approval:request", approvalRequest{
+			RequestID: requestId,
+		})
+
+		if err != nil {
+			panic(fmt.Errorf("error pushing event: %w", err))
+		}
+
+		time.Sleep(15 * time.Second)
+
+		log.Printf("approving request %s", requestId)
+
+		err = c.Event().Push(context.Background(), "approval:response", approvalResponse{
+			RequestID: requestId,
+			Approved:  true,
+		})
+
+		if err != nil {
+			panic(fmt.Errorf("error pushing event: %w", err))
+		}
+	}()
+
+	cleanup, err := w.Start()
+	if err != nil {
+		return nil, fmt.Errorf("error starting worker: %w", err)
+	}
+
+	return cleanup, nil
+}
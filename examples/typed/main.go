@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/joho/godotenv"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type userCreateEvent struct {
+	Username string `json:"username"`
+	UserID   string `json:"user_id"`
+}
+
+type stepOneOutput struct {
+	Message string `json:"message"`
+}
+
+type stepTwoOutput struct {
+	Message string `json:"message"`
+}
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	interrupt := cmdutils.InterruptChan()
+
+	cleanup, err := run()
+	if err != nil {
+		panic(err)
+	}
+
+	<-interrupt
+
+	if err := cleanup(); err != nil {
+		panic(fmt.Errorf("error cleaning up: %w", err))
+	}
+}
+
+func run() (func() error, error) {
+	c, err := client.New()
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %w", err)
+	}
+
+	w, err := worker.NewWorker(
+		worker.WithClient(
+			c,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating worker: %w", err)
+	}
+
+	stepOne := worker.DefineTask("step-one", func(ctx worker.HatchetContext, input *userCreateEvent) (*stepOneOutput, error) {
+		return &stepOneOutput{
+			Message: "Username is: " + input.Username,
+		}, nil
+	})
+
+	stepTwo := worker.DefineTask("step-two", func(ctx worker.HatchetContext, input *userCreateEvent) (*stepTwoOutput, error) {
+		parent, err := worker.ParentOutput(ctx, stepOne)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &stepTwoOutput{
+			Message: "Above message is: " + parent.Message,
+		}, nil
+	}).AddParents(stepOne)
+
+	wf := worker.DefineWorkflow[userCreateEvent](&worker.WorkflowJob{
+		On:          worker.NoTrigger(),
+		Name:        "typed",
+		Description: "This runs a workflow with typed inputs and outputs.",
+	}).AddTasks(stepOne, stepTwo)
+
+	err = w.RegisterWorkflow(wf)
+	if err != nil {
+		return nil, fmt.Errorf("error registering workflow: %w", err)
+	}
+
+	cleanup, err := w.Start()
+	if err != nil {
+		return nil, fmt.Errorf("error starting worker: %w", err)
+	}
+
+	go func() {
+		res, err := wf.Run(c, userCreateEvent{
+			Username: "echo-test",
+			UserID:   "1234",
+		})
+
+		if err != nil {
+			panic(fmt.Errorf("error running workflow: %w", err))
+		}
+
+		out, err := stepTwo.Output(res)
+
+		if err != nil {
+			panic(fmt.Errorf("error getting output: %w", err))
+		}
+
+		log.Printf("step-two output: %s", out.Message)
+	}()
+
+	return cleanup, nil
+}
@@ -32,6 +32,9 @@ import {
   EventKey,
   EventKeyList,
   EventList,
+  EventSchema,
+  EventSchemaList,
+  EventTriggerSkipList,
   EventOrderByDirection,
   EventOrderByField,
   EventSearch,
@@ -45,7 +48,11 @@ import {
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  PutEventSchemaRequest,
+  QuarantinedEventList,
+  RateLimit,
   RateLimitList,
+  RateLimitMetrics,
   RateLimitOrderByDirection,
   RateLimitOrderByField,
   RejectInviteRequest,
@@ -83,10 +90,13 @@ import {
   UserLoginRequest,
   UserRegisterRequest,
   UserTenantMembershipsList,
+  V1BulkOperation,
   V1CancelTaskRequest,
   V1DagChildren,
+  V1LogLineLevel,
   V1LogLineList,
   V1ReplayTaskRequest,
+  V1TaskBulkActionResponse,
   V1TaskEventList,
   V1TaskPointMetrics,
   V1TaskRunMetrics,
@@ -182,10 +192,20 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
    * @request GET:/api/v1/stable/tasks/{task}/logs
    * @secure
    */
-  v1LogLineList = (task: string, params: RequestParams = {}) =>
+  v1LogLineList = (
+    task: string,
+    query?: {
+      /** A list of levels to filter by */
+      levels?: V1LogLineLevel[];
+      /** Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars */
+      fields?: string[];
+    },
+    params: RequestParams = {},
+  ) =>
     this.request<V1LogLineList, APIErrors>({
       path: `/api/v1/stable/tasks/${task}/logs`,
       method: 'GET',
+      query: query,
       secure: true,
       format: 'json',
       ...params,
@@ -200,12 +220,13 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
    * @secure
    */
   v1TaskCancel = (tenant: string, data: V1CancelTaskRequest, params: RequestParams = {}) =>
-    this.request<void, APIErrors>({
+    this.request<V1TaskBulkActionResponse, APIErrors>({
       path: `/api/v1/stable/tenants/${tenant}/tasks/cancel`,
       method: 'POST',
       body: data,
       secure: true,
       type: ContentType.Json,
+      format: 'json',
       ...params,
     });
   /**
@@ -218,12 +239,30 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
    * @secure
    */
   v1TaskReplay = (tenant: string, data: V1ReplayTaskRequest, params: RequestParams = {}) =>
-    this.request<void, APIErrors>({
+    this.request<V1TaskBulkActionResponse, APIErrors>({
       path: `/api/v1/stable/tenants/${tenant}/tasks/replay`,
       method: 'POST',
       body: data,
       secure: true,
       type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Get the progress of a bulk operation which was started by cancelling or replaying tasks with a filter
+   *
+   * @tags Task
+   * @name V1BulkOperationGet
+   * @summary Get bulk operation
+   * @request GET:/api/v1/stable/tenants/{tenant}/bulk-operations/{bulk-operation}
+   * @secure
+   */
+  v1BulkOperationGet = (tenant: string, bulkOperation: string, params: RequestParams = {}) =>
+    this.request<V1BulkOperation, APIErrors>({
+      path: `/api/v1/stable/tenants/${tenant}/bulk-operations/${bulkOperation}`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
       ...params,
     });
   /**
@@ -1400,6 +1439,73 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Deletes a rate limit. Rate limits which are still used by a step or are the parent of another rate limit cannot be deleted.
+   *
+   * @tags Rate Limits
+   * @name RateLimitDelete
+   * @summary Delete rate limit
+   * @request DELETE:/api/v1/tenants/{tenant}/rate-limits/{rate-limit-key}
+   * @secure
+   */
+  rateLimitDelete = (tenant: string, rateLimitKey: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/rate-limits/${rateLimitKey}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Resets the value of a rate limit to its limit value and restarts its window.
+   *
+   * @tags Rate Limits
+   * @name RateLimitReset
+   * @summary Reset rate limit
+   * @request POST:/api/v1/tenants/{tenant}/rate-limits/{rate-limit-key}/reset
+   * @secure
+   */
+  rateLimitReset = (tenant: string, rateLimitKey: string, params: RequestParams = {}) =>
+    this.request<RateLimit, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/rate-limits/${rateLimitKey}/reset`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Get the utilization of a rate limit over time. Rate limits are sampled every minute.
+   *
+   * @tags Rate Limits
+   * @name RateLimitGetMetrics
+   * @summary Get rate limit metrics
+   * @request GET:/api/v1/tenants/{tenant}/rate-limits/{rate-limit-key}/metrics
+   * @secure
+   */
+  rateLimitGetMetrics = (
+    tenant: string,
+    rateLimitKey: string,
+    query?: {
+      /**
+       * The time after which to get metrics, defaults to 24 hours ago
+       * @format date-time
+       */
+      since?: string;
+      /**
+       * The time before which to get metrics, defaults to now
+       * @format date-time
+       */
+      until?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<RateLimitMetrics, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/rate-limits/${rateLimitKey}/metrics`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Gets a list of tenant members
    *
@@ -1468,6 +1574,23 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the workflows which were not triggered by an event because the event did not match the filter on the workflow's event trigger.
+   *
+   * @tags Event
+   * @name EventListTriggerSkips
+   * @summary List skipped event triggers
+   * @request GET:/api/v1/tenants/{tenant}/events/{event}/trigger-skips
+   * @secure
+   */
+  eventListTriggerSkips = (tenant: string, event: string, params: RequestParams = {}) =>
+    this.request<EventTriggerSkipList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/${event}/trigger-skips`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists all event keys for a tenant.
    *
@@ -1485,6 +1608,115 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Lists the payload schemas for the event keys of a tenant.
+   *
+   * @tags Event
+   * @name EventSchemaList
+   * @summary List event schemas
+   * @request GET:/api/v1/tenants/{tenant}/events/schemas
+   * @secure
+   */
+  eventSchemaList = (tenant: string, params: RequestParams = {}) =>
+    this.request<EventSchemaList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/schemas`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Get the payload schema for an event key.
+   *
+   * @tags Event
+   * @name EventSchemaGet
+   * @summary Get event schema
+   * @request GET:/api/v1/tenants/{tenant}/events/schemas/{event-key}
+   * @secure
+   */
+  eventSchemaGet = (tenant: string, eventKey: EventKey, params: RequestParams = {}) =>
+    this.request<EventSchema, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/schemas/${eventKey}`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates or replaces the payload schema for an event key. Events with this key which do not match the schema are rejected or quarantined when they are pushed, depending on the policy. Changes can take up to 30 seconds to apply.
+   *
+   * @tags Event
+   * @name EventSchemaPut
+   * @summary Put event schema
+   * @request PUT:/api/v1/tenants/{tenant}/events/schemas/{event-key}
+   * @secure
+   */
+  eventSchemaPut = (
+    tenant: string,
+    eventKey: EventKey,
+    data: PutEventSchemaRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<EventSchema, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/schemas/${eventKey}`,
+      method: 'PUT',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes the payload schema for an event key.
+   *
+   * @tags Event
+   * @name EventSchemaDelete
+   * @summary Delete event schema
+   * @request DELETE:/api/v1/tenants/{tenant}/events/schemas/{event-key}
+   * @secure
+   */
+  eventSchemaDelete = (tenant: string, eventKey: EventKey, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/schemas/${eventKey}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the events which were quarantined because their payload did not match the schema for their key.
+   *
+   * @tags Event
+   * @name EventListQuarantined
+   * @summary List quarantined events
+   * @request GET:/api/v1/tenants/{tenant}/events/quarantined
+   * @secure
+   */
+  eventListQuarantined = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of keys to filter by */
+      keys?: EventKey[];
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<QuarantinedEventList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/quarantined`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get all workflows for a tenant
    *
@@ -1940,6 +2172,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       limit?: number;
       /** A list of levels to filter by */
       levels?: LogLineLevelField;
+      /** Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars */
+      fields?: string[];
       /** The search query to filter for */
       search?: LogLineSearch;
       /** What to order by */
@@ -98,6 +98,8 @@ export interface V1TaskSummary {
   type: V1WorkflowType;
   /** @format uuid */
   workflowId: string;
+  /** Whether the workflow is paused. Queued tasks for a paused workflow are not assigned to workers until the workflow is resumed. */
+  workflowIsPaused?: boolean;
   workflowName?: string;
   /**
    * The external ID of the workflow run
@@ -225,6 +227,7 @@ export interface V1LogLine {
   createdAt: string;
   /** The log message. */
   message: string;
+  level?: V1LogLineLevel;
   /** The log metadata. */
   metadata: object;
 }
@@ -256,6 +259,56 @@ export interface V1ReplayTaskRequest {
   filter?: V1TaskFilter;
 }
 
+export interface V1TaskBulkActionResponse {
+  /**
+   * The id of the bulk operation which processes the tasks matching the filter, if a filter was provided
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  bulkOperationId?: string;
+}
+
+export enum V1BulkOperationKind {
+  CANCEL = 'CANCEL',
+  REPLAY = 'REPLAY',
+}
+
+export enum V1BulkOperationStatus {
+  PENDING = 'PENDING',
+  RUNNING = 'RUNNING',
+  COMPLETED = 'COMPLETED',
+  FAILED = 'FAILED',
+}
+
+export interface V1BulkOperation {
+  metadata: APIResourceMeta;
+  kind: V1BulkOperationKind;
+  status: V1BulkOperationStatus;
+  /**
+   * The number of workflow runs which matched the filter
+   * @format int64
+   */
+  matchedCount: number;
+  /**
+   * The number of workflow runs which were successfully processed
+   * @format int64
+   */
+  processedCount: number;
+  /**
+   * The number of workflow runs which could not be processed
+   * @format int64
+   */
+  failedCount: number;
+  /** The reason the bulk operation failed, if it failed */
+  errorMessage?: string;
+  /**
+   * The time the bulk operation finished
+   * @format date-time
+   */
+  finishedAt?: string;
+}
+
 export interface V1DagChildren {
   /** @format uuid */
   dagId?: string;
@@ -284,6 +337,18 @@ export interface V1TriggerWorkflowRunRequest {
   workflowName: string;
   input: object;
   additionalMetadata?: object;
+  /**
+   * A key which makes the trigger idempotent. If a workflow run was triggered with the same key within the idempotency window, that workflow run is returned instead of creating a new one.
+   * @minLength 1
+   * @maxLength 255
+   */
+  idempotencyKey?: string;
+  /**
+   * How long the idempotency key is valid for, in seconds. Defaults to 24 hours, which is also the maximum.
+   * @min 1
+   * @max 86400
+   */
+  idempotencyKeyTtlSeconds?: number;
 }
 
 export interface V1WorkflowRun {
@@ -787,6 +852,8 @@ export interface TenantQueueMetrics {
 
 export interface TenantStepRunQueueMetrics {
   queues?: Record<string, number>;
+  /** The number of queued step runs for each slot pool which is not registered by any active worker. These step runs can't be assigned until a worker registers the slot pool. */
+  unavailableSlotPools?: Record<string, number>;
 }
 
 /** The key for the event. */
@@ -862,6 +929,100 @@ export interface Event {
   additionalMetadata?: object;
 }
 
+export interface EventTriggerSkip {
+  metadata: APIResourceMeta;
+  /** The key of the event. */
+  eventKey: string;
+  /**
+   * The id of the workflow which was not triggered.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  /**
+   * The id of the workflow version which was not triggered.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /** The name of the workflow which was not triggered. */
+  workflowName: string;
+  /** The CEL filter on the event trigger which the event did not match. */
+  expression: string;
+  /** The reason the filter could not be evaluated, if it could not be evaluated. */
+  errorMessage?: string;
+}
+
+export interface EventTriggerSkipList {
+  rows: EventTriggerSkip[];
+}
+
+/** What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows. */
+export enum EventSchemaPolicy {
+  REJECT = 'REJECT',
+  QUARANTINE = 'QUARANTINE',
+}
+
+export interface EventSchema {
+  /** The event key which the schema applies to. */
+  key: string;
+  /** The JSON schema which event payloads must match. */
+  schema: object;
+  /** What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows. */
+  policy: EventSchemaPolicy;
+  /**
+   * When the schema was created.
+   * @format date-time
+   */
+  createdAt: string;
+  /**
+   * When the schema was last updated.
+   * @format date-time
+   */
+  updatedAt: string;
+}
+
+export interface EventSchemaList {
+  rows: EventSchema[];
+}
+
+export interface PutEventSchemaRequest {
+  /** The JSON schema which event payloads must match. References to other documents are not supported. */
+  schema: object;
+  /** What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows. */
+  policy: EventSchemaPolicy;
+}
+
+export interface QuarantinedEvent {
+  /**
+   * The id of the event.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  eventId: string;
+  /** The key of the event. */
+  key: string;
+  /** The payload of the event, if it is valid JSON. */
+  data?: object;
+  /** Additional metadata for the event. */
+  additionalMetadata?: object;
+  /** The reason the event did not match the schema. */
+  errorMessage: string;
+  /**
+   * When the event was quarantined.
+   * @format date-time
+   */
+  createdAt: string;
+}
+
+export interface QuarantinedEventList {
+  pagination?: PaginationResponse;
+  rows: QuarantinedEvent[];
+}
+
 export interface EventList {
   pagination?: PaginationResponse;
   rows?: Event[];
@@ -922,6 +1083,19 @@ export interface RateLimit {
    * @example "2022-12-13T15:06:48.888358-05:00"
    */
   lastRefill: string;
+  /** The key of the parent rate limit. Consuming units of this rate limit also consumes units of the parent. */
+  parentKey?: string;
+  /** The algorithm used to refill the rate limit. */
+  algorithm: RateLimitAlgorithm;
+  /** The maximum number of units a token bucket rate limit can hold. Defaults to the limitValue. */
+  burst?: number;
+}
+
+/** The algorithm used to refill the rate limit. */
+export enum RateLimitAlgorithm {
+  FIXED_WINDOW = 'FIXED_WINDOW',
+  TOKEN_BUCKET = 'TOKEN_BUCKET',
+  SLIDING_WINDOW = 'SLIDING_WINDOW',
 }
 
 export interface RateLimitList {
@@ -929,6 +1103,22 @@ export interface RateLimitList {
   rows?: RateLimit[];
 }
 
+export interface RateLimitMetric {
+  /**
+   * The start of the time bucket.
+   * @format date-time
+   */
+  time: string;
+  /** The maximum number of units allowed within the window during the time bucket. */
+  limitValue: number;
+  /** The maximum number of units used within the window during the time bucket. */
+  used: number;
+}
+
+export interface RateLimitMetrics {
+  results?: RateLimitMetric[];
+}
+
 export interface TenantMemberList {
   pagination?: PaginationResponse;
   rows?: TenantMember[];
@@ -987,6 +1177,22 @@ export interface Step {
   timeout?: string;
   children?: string[];
   parents?: string[];
+  /** The concurrency limits of the step. */
+  concurrency?: StepConcurrency[];
+}
+
+export interface StepConcurrency {
+  /** The CEL expression which returns the concurrency key. */
+  expression: string;
+  /**
+   * The maximum number of concurrent step runs per concurrency key.
+   * @format int32
+   */
+  maxRuns: number;
+  /** The strategy to use when the concurrency limit is reached. */
+  limitStrategy: ConcurrencyLimitStrategy;
+  /** The CEL expression which returns the weight of a concurrency key, for weighted round-robin limits. */
+  weightExpression?: string;
 }
 
 export interface Job {
@@ -1118,6 +1324,8 @@ export enum ConcurrencyLimitStrategy {
   DROP_NEWEST = 'DROP_NEWEST',
   QUEUE_NEWEST = 'QUEUE_NEWEST',
   GROUP_ROUND_ROBIN = 'GROUP_ROUND_ROBIN',
+  CANCEL_NEWEST = 'CANCEL_NEWEST',
+  WEIGHTED_ROUND_ROBIN = 'WEIGHTED_ROUND_ROBIN',
 }
 
 export interface WorkflowConcurrency {
@@ -1135,6 +1343,8 @@ export interface WorkflowConcurrency {
 export interface WorkflowTriggerEventRef {
   parent_id?: string;
   event_key?: string;
+  /** The CEL filter which events must match to trigger the workflow. */
+  expression?: string;
 }
 
 export interface WorkflowTriggerCronRef {
@@ -1331,6 +1541,7 @@ export interface LogLine {
   createdAt: string;
   /** The log message. */
   message: string;
+  level?: LogLineLevel;
   /** The log metadata. */
   metadata: object;
 }
@@ -1533,6 +1744,8 @@ export interface SemaphoreSlots {
    */
   workflowRunId: string;
   status: StepRunStatus;
+  /** The number of worker slots used by the step run. */
+  slots?: number;
 }
 
 export interface RecentStepRuns {
@@ -1596,11 +1809,13 @@ export interface Worker {
   /** The recent step runs for the worker. */
   recentStepRuns?: RecentStepRuns[];
   /** The status of the worker. */
-  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED';
-  /** The maximum number of runs this worker can execute concurrently. */
+  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED' | 'CORDONED';
+  /** The number of slots of this worker. A run uses as many slots as its step declares. */
   maxRuns?: number;
-  /** The number of runs this worker can execute concurrently. */
+  /** The number of slots of this worker which are not used by running steps. */
   availableRuns?: number;
+  /** The named slot pools of the worker. Steps which declare a slot pool only use slots from that pool, other steps use the slots in maxRuns. */
+  slotPools?: WorkerSlotPool[];
   /**
    * the id of the assigned dispatcher, in UUID format
    * @format uuid
@@ -1626,9 +1841,20 @@ export interface WorkerList {
   rows?: Worker[];
 }
 
+export interface WorkerSlotPool {
+  /** The name of the slot pool. */
+  name: string;
+  /** The number of slots in the slot pool. */
+  maxRuns: number;
+  /** The number of slots in the slot pool which are not used by running steps. */
+  availableRuns: number;
+}
+
 export interface UpdateWorkerRequest {
   /** Whether the worker is paused and cannot accept new runs. */
   isPaused?: boolean;
+  /** Whether the worker is cordoned. A cordoned worker is not assigned new runs, and shuts down once its running runs have finished. */
+  isCordoned?: boolean;
 }
 
 export interface WebhookWorker {
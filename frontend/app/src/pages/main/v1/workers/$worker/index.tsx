@@ -53,19 +53,21 @@ export const WorkerStatus = ({
   status = 'INACTIVE',
   health,
 }: {
-  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED';
+  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED' | 'CORDONED';
   health: string[];
 }) => {
   const label: Record<typeof status, string> = {
     ACTIVE: 'Active',
     INACTIVE: 'Inactive',
     PAUSED: 'Paused',
+    CORDONED: 'Cordoned',
   };
 
   const variant: Record<typeof status, BadgeProps['variant']> = {
     ACTIVE: 'successful',
     INACTIVE: 'failed',
     PAUSED: 'inProgress',
+    CORDONED: 'inProgress',
   };
 
   return (
@@ -155,6 +157,19 @@ export default function ExpandedWorkflowRun() {
                   {worker.status === 'PAUSED' ? 'Resume' : 'Pause'} Step Run
                   Assignment
                 </DropdownMenuItem>
+                <DropdownMenuItem
+                  disabled={
+                    worker.status === 'INACTIVE' ||
+                    worker.status === 'CORDONED'
+                  }
+                  onClick={() => {
+                    updateWorker.mutate({
+                      isCordoned: true,
+                    });
+                  }}
+                >
+                  Drain Worker
+                </DropdownMenuItem>
               </DropdownMenuContent>
             </DropdownMenu>
           </div>
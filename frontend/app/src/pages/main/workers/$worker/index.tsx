@@ -56,19 +56,21 @@ export const WorkerStatus = ({
   status = 'INACTIVE',
   health,
 }: {
-  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED';
+  status?: 'ACTIVE' | 'INACTIVE' | 'PAUSED' | 'CORDONED';
   health: string[];
 }) => {
   const label: Record<typeof status, string> = {
     ACTIVE: 'Active',
     INACTIVE: 'Inactive',
     PAUSED: 'Paused',
+    CORDONED: 'Cordoned',
   };
 
   const variant: Record<typeof status, BadgeProps['variant']> = {
     ACTIVE: 'successful',
     INACTIVE: 'failed',
     PAUSED: 'inProgress',
+    CORDONED: 'inProgress',
   };
 
   return (
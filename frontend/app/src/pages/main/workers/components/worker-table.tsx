@@ -36,7 +36,7 @@ export function WorkersTable() {
   const [columnFilters, setColumnFilters] = useState<ColumnFiltersState>([
     {
       id: 'status',
-      value: ['ACTIVE', 'PAUSED'],
+      value: ['ACTIVE', 'PAUSED', 'CORDONED'],
     },
   ]);
 
@@ -185,6 +185,7 @@ export function WorkersTable() {
           options: [
             { value: 'ACTIVE', label: 'Active' },
             { value: 'PAUSED', label: 'Paused' },
+            { value: 'CORDONED', label: 'Cordoned' },
             { value: 'INACTIVE', label: 'Inactive' },
           ],
         },
@@ -3,21 +3,26 @@ package cel
 import (
 	"crypto/sha256"
 	"fmt"
+	"reflect"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type CELParser struct {
-	workflowStrEnv *cel.Env
-	stepRunEnv     *cel.Env
+	workflowStrEnv       *cel.Env
+	stepRunEnv           *cel.Env
+	eventEnv             *cel.Env
+	concurrencyWeightEnv *cel.Env
 }
 
 var checksumDecl = decls.NewFunction("checksum",
@@ -47,29 +52,59 @@ var checksum = cel.Function("checksum",
 
 func NewCELParser() *CELParser {
 	workflowStrEnv, _ := cel.NewEnv(
-		cel.Declarations(
-			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
-			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
-			decls.NewVar("workflow_run_id", decls.String),
-			checksumDecl,
-		),
-		checksum,
+		append(
+			library(),
+			cel.Declarations(
+				decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("workflow_run_id", decls.String),
+				decls.NewVar("event_key", decls.String),
+				checksumDecl,
+			),
+		)...,
 	)
 
 	stepRunEnv, _ := cel.NewEnv(
-		cel.Declarations(
-			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
-			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
-			decls.NewVar("parents", decls.NewMapType(decls.String, decls.NewMapType(decls.String, decls.Dyn))),
-			decls.NewVar("workflow_run_id", decls.String),
-			checksumDecl,
-		),
-		checksum,
+		append(
+			library(),
+			cel.Declarations(
+				decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("parents", decls.NewMapType(decls.String, decls.NewMapType(decls.String, decls.Dyn))),
+				decls.NewVar("workflow_run_id", decls.String),
+				decls.NewVar("event_key", decls.String),
+				checksumDecl,
+			),
+		)...,
+	)
+
+	eventEnv, _ := cel.NewEnv(
+		append(
+			library(),
+			cel.Declarations(
+				decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+				decls.NewVar("event_key", decls.String),
+				checksumDecl,
+			),
+		)...,
+	)
+
+	concurrencyWeightEnv, _ := cel.NewEnv(
+		append(
+			library(),
+			cel.Declarations(
+				decls.NewVar("key", decls.String),
+				checksumDecl,
+			),
+		)...,
 	)
 
 	return &CELParser{
-		workflowStrEnv: workflowStrEnv,
-		stepRunEnv:     stepRunEnv,
+		workflowStrEnv:       workflowStrEnv,
+		stepRunEnv:           stepRunEnv,
+		eventEnv:             eventEnv,
+		concurrencyWeightEnv: concurrencyWeightEnv,
 	}
 }
 
@@ -101,8 +136,18 @@ func WithWorkflowRunID(workflowRunID string) InputOpts {
 	}
 }
 
+// WithEventKey sets the key of the event which triggered the workflow run. If it is not set, event_key
+// evaluates to an empty string.
+func WithEventKey(eventKey string) InputOpts {
+	return func(w Input) {
+		w["event_key"] = eventKey
+	}
+}
+
 func NewInput(opts ...InputOpts) Input {
-	res := make(map[string]interface{})
+	res := map[string]interface{}{
+		"event_key": "",
+	}
 
 	for _, opt := range opts {
 		opt(res)
@@ -148,11 +193,15 @@ type StepRunOutType string
 const (
 	StepRunOutTypeString StepRunOutType = "string"
 	StepRunOutTypeInt    StepRunOutType = "int"
+	StepRunOutTypeBool   StepRunOutType = "bool"
+	StepRunOutTypeList   StepRunOutType = "list"
 )
 
 type StepRunOut struct {
 	String *string
 	Int    *int
+	Bool   *bool
+	List   []interface{}
 	Type   StepRunOutType
 }
 
@@ -167,7 +216,7 @@ func (p *CELParser) ParseStepRun(stepRunExpr string) (cel.Program, error) {
 }
 
 func (p *CELParser) ParseAndEvalStepRun(stepRunExpr string, in Input) (*StepRunOut, error) {
-	prg, err := p.ParseWorkflowString(stepRunExpr)
+	prg, err := p.ParseStepRun(stepRunExpr)
 	if err != nil {
 		return nil, err
 	}
@@ -194,13 +243,118 @@ func (p *CELParser) ParseAndEvalStepRun(stepRunExpr string, in Input) (*StepRunO
 		i := int(out.Value().(float64))
 		res.Int = &i
 		res.Type = StepRunOutTypeInt
+	case cel.BoolType:
+		b := out.Value().(bool)
+		res.Bool = &b
+		res.Type = StepRunOutTypeBool
 	default:
-		return nil, fmt.Errorf("output must evaluate to a string or integer: got %s", out.Type().TypeName())
+		if _, ok := out.(traits.Lister); ok {
+			// convert the list through its JSON representation, so that nested values are plain Go values
+			list, err := out.ConvertToNative(reflect.TypeOf(&structpb.ListValue{}))
+
+			if err != nil {
+				return nil, fmt.Errorf("could not convert list output: %w", err)
+			}
+
+			res.List = list.(*structpb.ListValue).AsSlice()
+			res.Type = StepRunOutTypeList
+
+			break
+		}
+
+		return nil, fmt.Errorf("output must evaluate to a string, integer, bool or list: got %s", out.Type().TypeName())
+	}
+
+	return res, nil
+}
+
+func (p *CELParser) ParseEvent(eventExpr string) (cel.Program, error) {
+	ast, issues := p.eventEnv.Compile(eventExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("event expression must evaluate to a bool: got %s", ast.OutputType())
+	}
+
+	return p.eventEnv.Program(ast)
+}
+
+func (p *CELParser) ParseAndEvalEvent(eventExpr string, in Input) (bool, error) {
+	prg, err := p.ParseEvent(eventExpr)
+	if err != nil {
+		return false, err
+	}
+
+	return p.EvalEvent(prg, in)
+}
+
+// EvalEvent evaluates a program returned by ParseEvent. Programs are safe for concurrent use, so they can be
+// parsed once and evaluated for many events.
+func (p *CELParser) EvalEvent(prg cel.Program, in Input) (bool, error) {
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return false, err
+	}
+
+	res, ok := out.Value().(bool)
+
+	if !ok {
+		return false, fmt.Errorf("output must evaluate to a bool: got %s", out.Type().TypeName())
 	}
 
 	return res, nil
 }
 
+// ParseConcurrencyWeight parses an expression which returns the weight of a concurrency key. The key is
+// available to the expression as `key`.
+func (p *CELParser) ParseConcurrencyWeight(weightExpr string) (cel.Program, error) {
+	ast, issues := p.concurrencyWeightEnv.Compile(weightExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if ast.OutputType() != cel.IntType && ast.OutputType() != cel.DoubleType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("weight expression must evaluate to a number: got %s", ast.OutputType())
+	}
+
+	return p.concurrencyWeightEnv.Program(ast)
+}
+
+func (p *CELParser) ParseAndEvalConcurrencyWeight(weightExpr string, key string) (int, error) {
+	prg, err := p.ParseConcurrencyWeight(weightExpr)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.EvalConcurrencyWeight(prg, key)
+}
+
+// EvalConcurrencyWeight evaluates a program returned by ParseConcurrencyWeight for a concurrency key. Programs
+// are safe for concurrent use, so they can be parsed once and evaluated for many keys.
+func (p *CELParser) EvalConcurrencyWeight(prg cel.Program, key string) (int, error) {
+	out, _, err := prg.Eval(map[string]interface{}{
+		"key": key,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	switch out.Type() {
+	case cel.IntType:
+		return int(out.Value().(int64)), nil
+	case cel.DoubleType:
+		return int(out.Value().(float64)), nil
+	default:
+		return 0, fmt.Errorf("output must evaluate to a number: got %s", out.Type().TypeName())
+	}
+}
+
 func (p *CELParser) CheckStepRunOutAgainstKnown(out *StepRunOut, knownType dbsqlc.StepExpressionKind) error {
 	switch knownType {
 	case dbsqlc.StepExpressionKindDYNAMICRATELIMITKEY:
@@ -231,6 +385,16 @@ func (p *CELParser) CheckStepRunOutAgainstKnown(out *StepRunOut, knownType dbsql
 				return fmt.Errorf("%s, got int", prefix)
 			}
 
+			return fmt.Errorf("%s, got unknown type", prefix)
+		}
+	case dbsqlc.StepExpressionKindDYNAMICRATELIMITPARENT:
+		if out.String == nil {
+			prefix := "expected string output for dynamic rate limit parent"
+
+			if out.Int != nil {
+				return fmt.Errorf("%s, got int", prefix)
+			}
+
 			return fmt.Errorf("%s, got unknown type", prefix)
 		}
 	case dbsqlc.StepExpressionKindDYNAMICRATELIMITUNITS:
@@ -278,6 +442,16 @@ func (p *CELParser) CheckStepRunOutAgainstKnownV1(out *StepRunOut, knownType sql
 				return fmt.Errorf("%s, got int", prefix)
 			}
 
+			return fmt.Errorf("%s, got unknown type", prefix)
+		}
+	case sqlcv1.StepExpressionKindDYNAMICRATELIMITPARENT:
+		if out.String == nil {
+			prefix := "expected string output for dynamic rate limit parent"
+
+			if out.Int != nil {
+				return fmt.Errorf("%s, got int", prefix)
+			}
+
 			return fmt.Errorf("%s, got unknown type", prefix)
 		}
 	case sqlcv1.StepExpressionKindDYNAMICRATELIMITUNITS:
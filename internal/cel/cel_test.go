@@ -91,3 +91,293 @@ func TestCELParser(t *testing.T) {
 		})
 	}
 }
+
+func TestCELParserFunctions(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"user_id": "user-1234",
+			"email":   "Alice@Example.com",
+			"nested": map[string]interface{}{
+				"tags": []interface{}{"first", "second"},
+				"name": "nested value",
+			},
+		}),
+		cel.WithAdditionalMetadata(map[string]interface{}{
+			"region": "us-east-1",
+		}),
+		cel.WithEventKey("user:created"),
+	)
+
+	tests := []struct {
+		expression  string
+		expected    string
+		expectError bool
+	}{
+		{
+			expression: `now() > timestamp("2020-01-01T00:00:00Z") ? "after" : "before"`,
+			expected:   "after",
+		},
+		{
+			expression: `now() - duration("1h") < now() ? "ok" : "not ok"`,
+			expected:   "ok",
+		},
+		{
+			expression: `string(timestamp("2024-01-01T00:00:00Z") + duration("36h"))`,
+			expected:   "2024-01-02T12:00:00Z",
+		},
+		{
+			expression: `string(hash_mod(input.user_id, 10))`,
+			expected:   "5",
+		},
+		{
+			expression: `hash_mod(input.user_id, 10) == hash_mod("user-1234", 10) ? "stable" : "unstable"`,
+			expected:   "stable",
+		},
+		{
+			expression:  `string(hash_mod(input.user_id, 0))`,
+			expectError: true,
+		},
+		{
+			expression: `regex_extract(input.email, "@(.+)$")`,
+			expected:   "Example.com",
+		},
+		{
+			expression: `regex_extract(input.email, "[a-z]+")`,
+			expected:   "lice",
+		},
+		{
+			expression: `regex_extract(input.user_id, "(user)-([0-9]+)", 2)`,
+			expected:   "1234",
+		},
+		{
+			expression: `regex_extract(input.user_id, "^team-(.+)$")`,
+			expected:   "",
+		},
+		{
+			expression:  `regex_extract(input.user_id, "(user", 0)`,
+			expectError: true,
+		},
+		{
+			expression: `lower(input.email)`,
+			expected:   "alice@example.com",
+		},
+		{
+			expression: `upper(additional_metadata.region)`,
+			expected:   "US-EAST-1",
+		},
+		{
+			expression: `lookup(input, "nested.name", "default")`,
+			expected:   "nested value",
+		},
+		{
+			expression: `lookup(input, "nested.tags.1", "default")`,
+			expected:   "second",
+		},
+		{
+			expression: `lookup(input, "nested.tags.5", "default")`,
+			expected:   "default",
+		},
+		{
+			expression: `lookup(input, "nested.missing.value", "default")`,
+			expected:   "default",
+		},
+		{
+			expression: `lookup(input, "user_id.value", "default")`,
+			expected:   "default",
+		},
+		{
+			expression: `event_key`,
+			expected:   "user:created",
+		},
+		{
+			expression: `lower(event_key) + "-" + string(hash_mod(input.user_id, 4))`,
+			expected:   "user:created-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.ParseAndEvalWorkflowString(tt.expression, input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestCELParserStepRunParents(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{}),
+		cel.WithParents(map[string]map[string]interface{}{
+			"step1": {
+				"count": 3,
+				"key":   "parent-key",
+			},
+		}),
+	)
+
+	res, err := parser.ParseAndEvalStepRun(`lookup(parents, "step1.key", "default")`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "parent-key", *res.String)
+
+	res, err = parser.ParseAndEvalStepRun(`int(lookup(parents, "step2.count", 1))`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *res.Int)
+
+	res, err = parser.ParseAndEvalStepRun(`int(lookup(parents, "step1.count", 1))`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *res.Int)
+
+	// event_key defaults to an empty string when the run was not triggered by an event
+	res, err = parser.ParseAndEvalStepRun(`event_key == "" ? "no event" : event_key`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "no event", *res.String)
+}
+
+func TestCELParserStepRunBool(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"tier": "free",
+		}),
+		cel.WithAdditionalMetadata(map[string]interface{}{
+			"dry_run": "true",
+		}),
+		cel.WithParents(map[string]map[string]interface{}{
+			"step1": {
+				"count": 0,
+			},
+		}),
+	)
+
+	res, err := parser.ParseAndEvalStepRun(`input.tier == "free"`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, cel.StepRunOutTypeBool, res.Type)
+	assert.True(t, *res.Bool)
+
+	res, err = parser.ParseAndEvalStepRun(`additional_metadata.dry_run == "true" && parents.step1.count > 0`, input)
+
+	assert.NoError(t, err)
+	assert.False(t, *res.Bool)
+	assert.Nil(t, res.String)
+	assert.Nil(t, res.Int)
+}
+
+func TestCELParserEvent(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"amount": 150,
+			"email":  "bob@example.com",
+		}),
+		cel.WithEventKey("order:created"),
+	)
+
+	tests := []struct {
+		expression  string
+		expected    bool
+		expectError bool
+	}{
+		{
+			expression: `input.amount > 100`,
+			expected:   true,
+		},
+		{
+			expression: `event_key.startsWith("order:") && regex_extract(input.email, "@(.+)$") == "example.com"`,
+			expected:   true,
+		},
+		{
+			expression: `lookup(input, "customer.tier", "free") == "pro"`,
+			expected:   false,
+		},
+		{
+			expression:  `lower(event_key)`, // does not evaluate to a bool
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.ParseAndEvalEvent(tt.expression, input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestCELParserStepRunList(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	input := cel.NewInput(
+		cel.WithInput(map[string]interface{}{}),
+		cel.WithParents(map[string]map[string]interface{}{
+			"list-users": {
+				"users": []interface{}{
+					map[string]interface{}{"id": "a", "age": 30},
+					map[string]interface{}{"id": "b", "age": 20},
+				},
+			},
+		}),
+	)
+
+	res, err := parser.ParseAndEvalStepRun(`parents["list-users"].users`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, cel.StepRunOutTypeList, res.Type)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "a", "age": float64(30)},
+		map[string]interface{}{"id": "b", "age": float64(20)},
+	}, res.List)
+
+	res, err = parser.ParseAndEvalStepRun(`parents["list-users"].users.filter(u, u.age > 25).map(u, u.id)`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a"}, res.List)
+
+	res, err = parser.ParseAndEvalStepRun(`[1, 2, 3]`, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{float64(1), float64(2), float64(3)}, res.List)
+}
+
+func TestCELParserConcurrencyWeight(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	weight, err := parser.ParseAndEvalConcurrencyWeight(`key == "tier=gold" ? 3 : 1`, "tier=gold")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, weight)
+
+	weight, err = parser.ParseAndEvalConcurrencyWeight(`key.startsWith("tier=gold") ? 3 : 1`, "tier=free")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, weight)
+
+	_, err = parser.ParseConcurrencyWeight(`key`)
+
+	assert.Error(t, err, "string expressions should not be valid weights")
+
+	_, err = parser.ParseConcurrencyWeight(`input.tier == "gold" ? 3 : 1`)
+
+	assert.Error(t, err, "weight expressions can only reference the key")
+}
@@ -0,0 +1,223 @@
+package cel
+
+import (
+	"hash/fnv"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+)
+
+// now returns the current time as a timestamp, which supports the standard CEL timestamp and duration
+// arithmetic, for example `now() - duration("1h")` or `now().getHours()`.
+var now = cel.Function("now",
+	cel.Overload(
+		"now_timestamp",
+		[]*cel.Type{},
+		cel.TimestampType,
+		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+			return types.Timestamp{Time: time.Now().UTC()}
+		}),
+	),
+)
+
+// hashMod returns a stable hash of the string modulo n, which is useful for sharding keys into a fixed
+// number of buckets, for example `"shard-" + string(hash_mod(input.user_id, 10))`.
+var hashMod = cel.Function("hash_mod",
+	cel.Overload(
+		"hash_mod_string_int",
+		[]*cel.Type{cel.StringType, cel.IntType},
+		cel.IntType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			str, ok := lhs.(types.String)
+
+			if !ok {
+				return types.NewErr("hash_mod: first argument must be a string")
+			}
+
+			n, ok := rhs.(types.Int)
+
+			if !ok || n <= 0 {
+				return types.NewErr("hash_mod: second argument must be a positive integer")
+			}
+
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(str))
+
+			return types.Int(int64(h.Sum32()) % int64(n))
+		}),
+	),
+)
+
+// regexExtract returns the first match of the pattern in the string. If the pattern contains a capture group,
+// the first capture group is returned instead of the whole match. An empty string is returned if there is
+// no match. The three-argument form returns the capture group with the given index.
+var regexExtract = cel.Function("regex_extract",
+	cel.Overload(
+		"regex_extract_string_string",
+		[]*cel.Type{cel.StringType, cel.StringType},
+		cel.StringType,
+		cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+			return extract(lhs, rhs, -1)
+		}),
+	),
+	cel.Overload(
+		"regex_extract_string_string_int",
+		[]*cel.Type{cel.StringType, cel.StringType, cel.IntType},
+		cel.StringType,
+		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+			group, ok := args[2].(types.Int)
+
+			if !ok || group < 0 {
+				return types.NewErr("regex_extract: group must be a non-negative integer")
+			}
+
+			return extract(args[0], args[1], int(group))
+		}),
+	),
+)
+
+func extract(strVal, patternVal ref.Val, group int) ref.Val {
+	str, ok := strVal.(types.String)
+
+	if !ok {
+		return types.NewErr("regex_extract: first argument must be a string")
+	}
+
+	pattern, ok := patternVal.(types.String)
+
+	if !ok {
+		return types.NewErr("regex_extract: pattern must be a string")
+	}
+
+	re, err := regexp.Compile(string(pattern))
+
+	if err != nil {
+		return types.NewErr("regex_extract: invalid pattern: %s", err.Error())
+	}
+
+	matches := re.FindStringSubmatch(string(str))
+
+	if matches == nil {
+		return types.String("")
+	}
+
+	if group < 0 {
+		if len(matches) > 1 {
+			return types.String(matches[1])
+		}
+
+		return types.String(matches[0])
+	}
+
+	if group >= len(matches) {
+		return types.NewErr("regex_extract: pattern has no group %d", group)
+	}
+
+	return types.String(matches[group])
+}
+
+var lower = cel.Function("lower",
+	cel.Overload(
+		"lower_string",
+		[]*cel.Type{cel.StringType},
+		cel.StringType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			str, ok := arg.(types.String)
+
+			if !ok {
+				return types.NewErr("lower: argument must be a string")
+			}
+
+			return types.String(strings.ToLower(string(str)))
+		}),
+	),
+)
+
+var upper = cel.Function("upper",
+	cel.Overload(
+		"upper_string",
+		[]*cel.Type{cel.StringType},
+		cel.StringType,
+		cel.UnaryBinding(func(arg ref.Val) ref.Val {
+			str, ok := arg.(types.String)
+
+			if !ok {
+				return types.NewErr("upper: argument must be a string")
+			}
+
+			return types.String(strings.ToUpper(string(str)))
+		}),
+	),
+)
+
+// lookup safely reads a nested value using a dot-separated path, and returns the default if any part of the
+// path does not exist. List elements can be accessed with numeric path segments. For example,
+// `lookup(input, "user.tags.0", "none")` or `lookup(parents, "step1.result", 0)`.
+var lookup = cel.Function("lookup",
+	cel.Overload(
+		"lookup_dyn_string_dyn",
+		[]*cel.Type{cel.DynType, cel.StringType, cel.DynType},
+		cel.DynType,
+		cel.FunctionBinding(func(args ...ref.Val) ref.Val {
+			path, ok := args[1].(types.String)
+
+			if !ok {
+				return types.NewErr("lookup: path must be a string")
+			}
+
+			curr := args[0]
+
+			for _, part := range strings.Split(string(path), ".") {
+				next, found := lookupPart(curr, part)
+
+				if !found {
+					return args[2]
+				}
+
+				curr = next
+			}
+
+			if types.IsUnknownOrError(curr) || curr == types.NullValue {
+				return args[2]
+			}
+
+			return curr
+		}),
+	),
+)
+
+func lookupPart(val ref.Val, part string) (ref.Val, bool) {
+	switch v := val.(type) {
+	case traits.Mapper:
+		return v.Find(types.String(part))
+	case traits.Lister:
+		i, err := strconv.Atoi(part)
+
+		if err != nil || i < 0 || int64(i) >= int64(v.Size().(types.Int)) {
+			return nil, false
+		}
+
+		return v.Get(types.Int(i)), true
+	default:
+		return nil, false
+	}
+}
+
+// library returns the functions which are available in every CEL environment
+func library() []cel.EnvOption {
+	return []cel.EnvOption{
+		checksum,
+		now,
+		hashMod,
+		regexExtract,
+		lower,
+		upper,
+		lookup,
+	}
+}
@@ -0,0 +1,318 @@
+// Package msgqueuetest contains a conformance suite which is shared by the implementations of the v1
+// message queue, so that each backend is tested against the same semantics.
+package msgqueuetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+)
+
+type testMessagePayload struct {
+	Key string `json:"key"`
+}
+
+type Backend struct {
+	// New returns a new message queue. The message queue should be cleaned up with t.Cleanup.
+	New func(t *testing.T) msgqueue.MessageQueue
+
+	// DeleteQueue removes a durable queue which was created by the suite. It is optional.
+	DeleteQueue func(q msgqueue.Queue) error
+
+	// DeadLetterBackoff is the time a rejected message waits before it is retried. If it is zero, the
+	// backend does not support dead lettering and those tests are skipped.
+	DeadLetterBackoff time.Duration
+}
+
+// Run runs the conformance suite against a message queue backend.
+func Run(t *testing.T, b Backend) {
+	t.Run("static queue", func(t *testing.T) {
+		testStaticQueue(t, b)
+	})
+
+	t.Run("tenant exchange", func(t *testing.T) {
+		testTenantExchange(t, b)
+	})
+
+	t.Run("consumer queue", func(t *testing.T) {
+		testConsumerQueue(t, b)
+	})
+
+	t.Run("buffered subscriber", func(t *testing.T) {
+		testBufferedSubscriber(t, b)
+	})
+
+	t.Run("dead lettering retries", func(t *testing.T) {
+		testDeadLetteringRetries(t, b)
+	})
+
+	t.Run("dead lettering drops", func(t *testing.T) {
+		testDeadLetteringDrops(t, b)
+	})
+}
+
+func newStaticQueue(t *testing.T, b Backend) msgqueue.Queue {
+	q := msgqueue.NewRandomStaticQueue()
+
+	if b.DeleteQueue != nil {
+		t.Cleanup(func() {
+			if err := b.DeleteQueue(q); err != nil {
+				t.Errorf("error deleting queue: %v", err)
+			}
+		})
+	}
+
+	return q
+}
+
+func newMessage(t *testing.T, tenantId string) *msgqueue.Message {
+	msg, err := msgqueue.NewTenantMessage(tenantId, uuid.NewString(), false, true, &testMessagePayload{
+		Key: "value",
+	})
+
+	require.NoError(t, err, "creating message should not error")
+
+	return msg
+}
+
+func subscribe(t *testing.T, mq msgqueue.MessageQueue, q msgqueue.Queue, preAck, postAck msgqueue.AckHook) {
+	cleanup, err := mq.Subscribe(q, preAck, postAck)
+	require.NoError(t, err, "subscribing should not error")
+
+	t.Cleanup(func() {
+		if err := cleanup(); err != nil {
+			t.Errorf("error cleaning up subscriber: %v", err)
+		}
+	})
+}
+
+// sendUntilReceived sends a message until it has been received. Subscribers of non-durable queues may not
+// receive messages which are sent before they are ready, so the message is resent until it is received.
+func sendUntilReceived(ctx context.Context, t *testing.T, mq msgqueue.MessageQueue, q msgqueue.Queue, msg *msgqueue.Message, received <-chan struct{}) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		require.NoError(t, mq.SendMessage(ctx, q, msg), "sending message should not error")
+
+		select {
+		case <-received:
+			return
+		case <-ctx.Done():
+			t.Fatalf("message was not received: %v", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func testStaticQueue(t *testing.T, b Backend) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mq := b.New(t)
+	q := newStaticQueue(t, b)
+	msg := newMessage(t, uuid.NewString())
+
+	var preAcked atomic.Bool
+	done := make(chan struct{})
+	once := sync.Once{}
+
+	subscribe(t, mq, q, func(received *msgqueue.Message) error {
+		assert.Equal(t, msg.ID, received.ID, "received message ID should match sent message ID")
+		preAcked.Store(true)
+		return nil
+	}, func(received *msgqueue.Message) error {
+		assert.True(t, preAcked.Load(), "post-ack should be called after pre-ack")
+		once.Do(func() { close(done) })
+		return nil
+	})
+
+	require.NoError(t, mq.SendMessage(ctx, q, msg), "sending message should not error")
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatalf("message was not received: %v", ctx.Err())
+	}
+}
+
+func testTenantExchange(t *testing.T, b Backend) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mq := b.New(t)
+	tenantId := uuid.NewString()
+
+	require.NoError(t, mq.RegisterTenant(ctx, tenantId), "registering tenant should not error")
+
+	q := newStaticQueue(t, b)
+	msg := newMessage(t, tenantId)
+
+	received := make(chan struct{})
+	once := sync.Once{}
+
+	subscribe(t, mq, msgqueue.TenantEventConsumerQueue(tenantId), func(receivedMsg *msgqueue.Message) error {
+		assert.Equal(t, msg.ID, receivedMsg.ID, "received tenant message ID should match sent message ID")
+		once.Do(func() { close(received) })
+		return nil
+	}, msgqueue.NoOpHook)
+
+	// messages sent to any queue for the tenant are published to the tenant exchange
+	sendUntilReceived(ctx, t, mq, q, msg, received)
+}
+
+func testConsumerQueue(t *testing.T, b Backend) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mq := b.New(t)
+	q := msgqueue.QueueTypeFromDispatcherID(uuid.NewString())
+	msg := newMessage(t, "")
+
+	received := make(chan struct{})
+	once := sync.Once{}
+
+	subscribe(t, mq, q, func(receivedMsg *msgqueue.Message) error {
+		assert.Equal(t, msg.ID, receivedMsg.ID, "received message ID should match sent message ID")
+		once.Do(func() { close(received) })
+		return nil
+	}, msgqueue.NoOpHook)
+
+	sendUntilReceived(ctx, t, mq, q, msg, received)
+}
+
+func testBufferedSubscriber(t *testing.T, b Backend) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mq := b.New(t)
+	mq.SetQOS(5)
+
+	q := newStaticQueue(t, b)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(10)
+
+	buf := msgqueue.NewMQSubBuffer(q, mq, func(tenantId, msgId string, payloads [][]byte) error {
+		for _, payload := range msgqueue.JSONConvert[testMessagePayload](payloads) {
+			assert.Equal(t, "value", payload.Key, "received payload should match sent payload")
+			wg.Done()
+		}
+
+		return nil
+	})
+
+	cleanup, err := buf.Start()
+	require.NoError(t, err, "starting buffer should not error")
+
+	defer func() {
+		if err := cleanup(); err != nil {
+			t.Errorf("error cleaning up buffer: %v", err)
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, mq.SendMessage(ctx, q, newMessage(t, "test-tenant-v1")), "sending message should not error")
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatalf("messages were not received: %v", ctx.Err())
+	}
+}
+
+func testDeadLetteringRetries(t *testing.T, b Backend) {
+	if b.DeadLetterBackoff == 0 {
+		t.Skip("backend does not support dead lettering")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*b.DeadLetterBackoff+10*time.Second)
+	defer cancel()
+
+	mq := b.New(t)
+	q := newStaticQueue(t, b)
+	msg := newMessage(t, "")
+	msg.Retries = 2
+
+	var attempts atomic.Int32
+	done := make(chan struct{})
+
+	subscribe(t, mq, q, func(received *msgqueue.Message) error {
+		if received.ID != msg.ID {
+			return nil
+		}
+
+		attempt := attempts.Add(1)
+
+		if attempt <= 2 {
+			return fmt.Errorf("intentional error on attempt %d", attempt)
+		}
+
+		return nil
+	}, func(received *msgqueue.Message) error {
+		close(done)
+		return nil
+	})
+
+	require.NoError(t, mq.SendMessage(ctx, q, msg), "sending message should not error")
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatalf("message was not processed after %d attempts: %v", attempts.Load(), ctx.Err())
+	}
+
+	assert.Equal(t, int32(3), attempts.Load(), "message should be processed on the third attempt")
+}
+
+func testDeadLetteringDrops(t *testing.T, b Backend) {
+	if b.DeadLetterBackoff == 0 {
+		t.Skip("backend does not support dead lettering")
+	}
+
+	mq := b.New(t)
+	q := newStaticQueue(t, b)
+	msg := newMessage(t, "")
+	msg.Retries = 1
+
+	var attempts atomic.Int32
+
+	subscribe(t, mq, q, func(received *msgqueue.Message) error {
+		if received.ID != msg.ID {
+			return nil
+		}
+
+		attempt := attempts.Add(1)
+
+		return fmt.Errorf("intentional error on attempt %d", attempt)
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, mq.SendMessage(context.Background(), q, msg), "sending message should not error")
+
+	// the message is attempted once, and then retried once from the dead letter queue
+	require.Eventually(t, func() bool {
+		return attempts.Load() == 2
+	}, 4*b.DeadLetterBackoff+5*time.Second, 100*time.Millisecond, "message should be attempted twice")
+
+	// wait for another backoff to ensure that the message is not retried again
+	time.Sleep(3 * b.DeadLetterBackoff)
+
+	assert.Equal(t, int32(2), attempts.Load(), "message should not be retried after exceeding its retries")
+}
@@ -0,0 +1,845 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	natsclient "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+	"golang.org/x/exp/rand"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+)
+
+const RETRY_INTERVAL = 2 * time.Second
+const RETRY_RESET_INTERVAL = 30 * time.Second
+
+// the time a message can stay unacknowledged before it is redelivered, equivalent to the RabbitMQ consumer timeout
+const ACK_WAIT = 5 * time.Minute
+
+// the time after which consumers of non-durable queues are removed once they have no subscribers
+const INACTIVE_CONSUMER_THRESHOLD = 30 * time.Second
+
+// messages which are published with ImmediatelyExpire are dropped if they are not delivered within this window
+const IMMEDIATE_EXPIRY_WINDOW = 1 * time.Second
+
+const (
+	// durable queues are each stored on their own file-backed work queue stream
+	queueSubjectPrefix = "hatchet.queue"
+
+	// non-durable consumer queues are stored on a single memory-backed stream, which only retains messages
+	// while a consumer is interested in them
+	consumerQueuesStream  = "hatchet_consumer_queues_v1"
+	consumerSubjectPrefix = "hatchet.consumer"
+
+	// tenant exchanges are stored on a single memory-backed stream. Each subscriber to a tenant exchange
+	// creates its own consumer, so every subscriber receives a copy of each message.
+	tenantExchangesStream = "hatchet_tenant_exchanges_v1"
+	tenantSubjectPrefix   = "hatchet.tenant"
+
+	// the consumer name which is shared by all subscribers to a durable queue
+	durableConsumerName = "hatchet"
+
+	// the number of times a message has been dead-lettered
+	deathCountHeader = "Hatchet-Death-Count"
+
+	// the unix timestamp, in milliseconds, before which a dead-lettered message should not be processed
+	notBeforeHeader = "Hatchet-Not-Before"
+)
+
+// MessageQueueImpl implements MessageQueue interface using NATS JetStream.
+type MessageQueueImpl struct {
+	ctx      context.Context
+	configFs []MessageQueueImplOpt
+
+	qos int
+
+	l *zerolog.Logger
+
+	disableTenantExchangePubs bool
+
+	// lru cache for tenant ids
+	tenantIdCache *lru.Cache[string, bool]
+
+	nc *natsclient.Conn
+	js jetstream.JetStream
+
+	initializedStreams   map[string]bool
+	initializedStreamsMu sync.RWMutex
+
+	deadLetterBackoff time.Duration
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	return t.nc.IsConnected()
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                         *zerolog.Logger
+	url                       string
+	qos                       int
+	disableTenantExchangePubs bool
+	deadLetterBackoff         time.Duration
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("nats")
+
+	return &MessageQueueImplOpts{
+		l:                         &l,
+		url:                       natsclient.DefaultURL,
+		disableTenantExchangePubs: false,
+		deadLetterBackoff:         5 * time.Second,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithURL(url string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.url = url
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantExchangePubs = disable
+	}
+}
+
+func WithDeadLetterBackoff(backoff time.Duration) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.deadLetterBackoff = backoff
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "nats").Logger()
+	opts.l = &newLogger
+
+	nc, err := natsclient.Connect(
+		opts.url,
+		natsclient.Name("hatchet"),
+		natsclient.MaxReconnects(-1),
+		natsclient.ReconnectWait(RETRY_INTERVAL),
+		natsclient.DisconnectErrHandler(func(_ *natsclient.Conn, err error) {
+			if err != nil {
+				opts.l.Error().Msgf("disconnected from nats: %v", err)
+			}
+		}),
+		natsclient.ReconnectHandler(func(_ *natsclient.Conn) {
+			opts.l.Info().Msg("reconnected to nats")
+		}),
+	)
+
+	if err != nil {
+		opts.l.Error().Msgf("cannot connect to nats: %v", err)
+		cancel()
+		return nil, nil
+	}
+
+	js, err := jetstream.New(nc)
+
+	if err != nil {
+		opts.l.Error().Msgf("cannot create jetstream context: %v", err)
+		nc.Close()
+		cancel()
+		return nil, nil
+	}
+
+	t := &MessageQueueImpl{
+		ctx:                       ctx,
+		l:                         opts.l,
+		qos:                       opts.qos,
+		configFs:                  fs,
+		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+		nc:                        nc,
+		js:                        js,
+		initializedStreams:        make(map[string]bool),
+		deadLetterBackoff:         opts.deadLetterBackoff,
+	}
+
+	// create a new lru cache for tenant ids
+	t.tenantIdCache, _ = lru.New[string, bool](2000) // nolint: errcheck - this only returns an error if the size is less than 0
+
+	// init the queues in a blocking fashion
+	for _, q := range []msgqueue.Queue{msgqueue.TASK_PROCESSING_QUEUE, msgqueue.OLAP_QUEUE} {
+		if err := t.initQueue(ctx, q); err != nil {
+			t.l.Error().Msgf("error initializing queue: %v", err)
+			nc.Close()
+			cancel()
+			return nil, nil
+		}
+	}
+
+	return func() error {
+		cancel()
+		nc.Close()
+		return nil
+	}, t
+}
+
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue) {
+	return New(t.configFs...)
+}
+
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+func (t *MessageQueueImpl) SendMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	return t.pubMessage(ctx, q, msg)
+}
+
+func (t *MessageQueueImpl) pubMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	otelCarrier := telemetry.GetCarrier(ctx)
+
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "publish-message", otelCarrier)
+	defer span.End()
+
+	msg.SetOtelCarrier(otelCarrier)
+
+	if err := t.initQueue(ctx, q); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		t.l.Error().Msgf("error marshaling msg queue: %v", err)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	t.l.Debug().Msgf("publishing msg to queue %s", q.Name())
+
+	if _, err := t.js.Publish(ctx, queueSubject(q), body); err != nil {
+		// the stream may have been removed, for example if a memory-backed stream was lost on a server
+		// restart, so it is redeclared on the next publish
+		if errors.Is(err, jetstream.ErrNoStreamResponse) {
+			t.forgetStreams()
+		}
+
+		return err
+	}
+
+	// if this is a tenant msg, publish to the tenant exchange
+	if !t.disableTenantExchangePubs && msg.TenantID != "" {
+		// determine if the tenant exchange exists
+		if _, ok := t.tenantIdCache.Get(msg.TenantID); !ok {
+			// register the tenant exchange
+			err = t.RegisterTenant(ctx, msg.TenantID)
+
+			if err != nil {
+				t.l.Error().Msgf("error registering tenant exchange: %v", err)
+				return err
+			}
+		}
+
+		t.l.Debug().Msgf("publishing tenant msg to exchange %s", msg.TenantID)
+
+		_, err = t.js.Publish(ctx, tenantSubject(msgqueue.GetTenantExchangeName(msg.TenantID)), body)
+
+		if err != nil {
+			if errors.Is(err, jetstream.ErrNoStreamResponse) {
+				t.forgetStreams()
+			}
+
+			t.l.Error().Msgf("error publishing tenant msg: %v", err)
+			return err
+		}
+	}
+
+	t.l.Debug().Msgf("published msg to queue %s", q.Name())
+
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	cleanupSub, err := t.subscribe(ctx, q, preAck, postAck)
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if q.DLQ() != nil {
+		cleanupSubDLQ, err := t.subscribe(ctx, q.DLQ(), preAck, postAck)
+
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		f1 := cleanupSub
+		f2 := cleanupSubDLQ
+
+		cleanupSub = func() error {
+			if err := f1(); err != nil {
+				t.l.Error().Msgf("error cleaning up subscriber: %v", err)
+			}
+
+			if err := f2(); err != nil {
+				t.l.Error().Msgf("error cleaning up subscriber: %v", err)
+			}
+
+			return nil
+		}
+	}
+
+	return func() error {
+		cancel()
+
+		if err := cleanupSub(); err != nil {
+			t.l.Error().Msgf("error cleaning up subscriber: %v", err)
+			return nil
+		}
+
+		return nil
+	}, nil
+}
+
+// RegisterTenant ensures that the tenant exchange stream exists. Tenant exchanges share a single stream and
+// are separated by subject, so there is nothing to declare for each tenant.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	t.l.Debug().Msgf("registering tenant exchange: %s", tenantId)
+
+	if err := t.initTenantExchanges(ctx); err != nil {
+		t.l.Error().Msgf("cannot declare tenant exchange stream: %q, %v", tenantId, err)
+		return err
+	}
+
+	t.tenantIdCache.Add(tenantId, true)
+
+	return nil
+}
+
+func (t *MessageQueueImpl) initTenantExchanges(ctx context.Context) error {
+	return t.initStream(ctx, tenantExchangesStream, jetstream.StreamConfig{
+		Name:      tenantExchangesStream,
+		Subjects:  []string{tenantSubjectPrefix + ".>"},
+		Retention: jetstream.InterestPolicy,
+		Storage:   jetstream.MemoryStorage,
+	})
+}
+
+// initQueue creates the stream which stores the queue's messages, along with the stream for its dead
+// letter queue.
+func (t *MessageQueueImpl) initQueue(ctx context.Context, q msgqueue.Queue) error {
+	if q.FanoutExchangeKey() != "" {
+		return t.initTenantExchanges(ctx)
+	}
+
+	if !q.Durable() {
+		return t.initStream(ctx, consumerQueuesStream, jetstream.StreamConfig{
+			Name:      consumerQueuesStream,
+			Subjects:  []string{consumerSubjectPrefix + ".>"},
+			Retention: jetstream.InterestPolicy,
+			Storage:   jetstream.MemoryStorage,
+		})
+	}
+
+	if !q.IsDLQ() && q.DLQ() != nil {
+		if err := t.initQueue(ctx, q.DLQ()); err != nil {
+			return err
+		}
+	}
+
+	name := streamName(q)
+
+	return t.initStream(ctx, name, jetstream.StreamConfig{
+		Name:      name,
+		Subjects:  []string{queueSubject(q)},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	})
+}
+
+func (t *MessageQueueImpl) initStream(ctx context.Context, name string, cfg jetstream.StreamConfig) error {
+	t.initializedStreamsMu.RLock()
+
+	if _, exists := t.initializedStreams[name]; exists {
+		t.initializedStreamsMu.RUnlock()
+		return nil
+	}
+
+	t.initializedStreamsMu.RUnlock()
+
+	if _, err := t.js.CreateOrUpdateStream(ctx, cfg); err != nil {
+		t.l.Error().Msgf("cannot declare stream: %q, %v", name, err)
+		return err
+	}
+
+	t.initializedStreamsMu.Lock()
+	defer t.initializedStreamsMu.Unlock()
+
+	t.initializedStreams[name] = true
+
+	return nil
+}
+
+// forgetStreams clears the streams which have been declared, so that they are declared again on their
+// next use.
+func (t *MessageQueueImpl) forgetStreams() {
+	t.initializedStreamsMu.Lock()
+	defer t.initializedStreamsMu.Unlock()
+
+	t.initializedStreams = make(map[string]bool)
+}
+
+// deleteQueue is a helper function for removing durable queues which are used for tests.
+func (t *MessageQueueImpl) deleteQueue(q msgqueue.Queue) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	queues := []msgqueue.Queue{q}
+
+	if q.DLQ() != nil {
+		queues = append(queues, q.DLQ())
+	}
+
+	for _, q := range queues {
+		name := streamName(q)
+
+		if err := t.js.DeleteStream(ctx, name); err != nil && !errors.Is(err, jetstream.ErrStreamNotFound) {
+			t.l.Error().Msgf("cannot delete stream: %q, %v", name, err)
+			return err
+		}
+
+		t.initializedStreamsMu.Lock()
+		delete(t.initializedStreams, name)
+		t.initializedStreamsMu.Unlock()
+	}
+
+	return nil
+}
+
+// consumerConfig returns the stream and consumer for a queue. Subscribers to a durable queue or a consumer
+// queue share a named consumer, so each message is processed by a single subscriber. Subscribers to a
+// fanout queue each get an ephemeral consumer, which is the equivalent of a RabbitMQ queue bound to a
+// fanout exchange.
+func (t *MessageQueueImpl) consumerConfig(q msgqueue.Queue) (string, jetstream.ConsumerConfig) {
+	cfg := jetstream.ConsumerConfig{
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       ACK_WAIT,
+		FilterSubject: queueSubject(q),
+	}
+
+	// the server stops delivering once this many messages are unacked, which caps the in-flight
+	// messages for the consumer the same way the prefetch count does for RabbitMQ
+	if t.qos > 0 {
+		cfg.MaxAckPending = t.qos
+	}
+
+	switch {
+	case q.FanoutExchangeKey() != "":
+		cfg.DeliverPolicy = jetstream.DeliverNewPolicy
+		cfg.InactiveThreshold = INACTIVE_CONSUMER_THRESHOLD
+
+		return tenantExchangesStream, cfg
+	case !q.Durable():
+		cfg.Durable = sanitizeName(q.Name())
+		cfg.DeliverPolicy = jetstream.DeliverNewPolicy
+		cfg.InactiveThreshold = INACTIVE_CONSUMER_THRESHOLD
+
+		return consumerQueuesStream, cfg
+	default:
+		cfg.Durable = durableConsumerName
+
+		return streamName(q), cfg
+	}
+}
+
+func (t *MessageQueueImpl) subscribe(
+	ctx context.Context,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	sessionCount := 0
+
+	wg := sync.WaitGroup{}
+
+	// bounds the number of messages handled at once, since the pull consumer may buffer more
+	// messages than the qos while earlier ones are still being processed
+	var sem chan struct{}
+
+	if t.qos > 0 {
+		sem = make(chan struct{}, t.qos)
+	}
+
+	if err := t.initQueue(ctx, q); err != nil {
+		return nil, fmt.Errorf("error initializing queue: %v", err)
+	}
+
+	innerFn := func() error {
+		if err := t.initQueue(ctx, q); err != nil {
+			return err
+		}
+
+		stream, cfg := t.consumerConfig(q)
+
+		consumer, err := t.js.CreateOrUpdateConsumer(ctx, stream, cfg)
+
+		if err != nil {
+			// the stream may have been removed while the connection was down, so it is redeclared
+			// on the next session
+			if errors.Is(err, jetstream.ErrStreamNotFound) {
+				t.forgetStreams()
+			}
+
+			return err
+		}
+
+		errCh := make(chan error, 1)
+
+		consumeOpts := []jetstream.PullConsumeOpt{
+			jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+				// these errors are terminal for the consumer, so we start a new session
+				if errors.Is(err, jetstream.ErrConsumerDeleted) || errors.Is(err, jetstream.ErrConsumerNotFound) {
+					select {
+					case errCh <- err:
+					default:
+					}
+
+					return
+				}
+
+				t.l.Debug().Msgf("consume error on queue %s: %v", q.Name(), err)
+			}),
+		}
+
+		if t.qos > 0 {
+			consumeOpts = append(consumeOpts, jetstream.PullMaxMessages(t.qos))
+		}
+
+		wg.Add(1) // we add an extra delta for the consumer to be stopped
+		defer wg.Done()
+
+		cc, err := consumer.Consume(func(natsMsg jetstream.Msg) {
+			if sem != nil {
+				sem <- struct{}{}
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if sem != nil {
+					defer func() { <-sem }()
+				}
+
+				t.handleMessage(q, natsMsg, sessionCount, preAck, postAck)
+			}()
+		}, consumeOpts...)
+
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			cc.Stop()
+
+			// ephemeral consumers are not shared with other subscribers, so they can be removed immediately
+			if cfg.Durable == "" {
+				deleteCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				if err := t.js.DeleteConsumer(deleteCtx, stream, consumer.CachedInfo().Name); err != nil {
+					t.l.Debug().Msgf("error deleting consumer: %v", err)
+				}
+			}
+		}()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errCh:
+			return err
+		}
+	}
+
+	go func() {
+		retryCount := 0
+		lastRetry := time.Now()
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			sessionCount++
+
+			if err := innerFn(); err != nil {
+				if time.Since(lastRetry) > RETRY_RESET_INTERVAL {
+					retryCount = 0
+				}
+
+				t.l.Error().Msgf("could not run inner loop: %v", err)
+				sleepWithExponentialBackoff(10*time.Millisecond, 5*time.Second, retryCount)
+				lastRetry = time.Now()
+				retryCount++
+				continue
+			}
+		}
+	}()
+
+	cleanup := func() error {
+		t.l.Debug().Msgf("shutting down subscriber: %s", q.Name())
+		wg.Wait()
+		t.l.Debug().Msgf("successfully shut down subscriber: %s", q.Name())
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (t *MessageQueueImpl) handleMessage(
+	q msgqueue.Queue,
+	natsMsg jetstream.Msg,
+	sessionCount int,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) {
+	msg := &msgqueue.Message{}
+
+	if len(natsMsg.Data()) == 0 {
+		t.l.Error().Msgf("empty message body for message on subject: %s", natsMsg.Subject())
+
+		// reject this message
+		if err := natsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if err := json.Unmarshal(natsMsg.Data(), msg); err != nil {
+		t.l.Error().Msgf("error unmarshalling message: %v", err)
+
+		// reject this message
+		if err := natsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if msg.ImmediatelyExpire {
+		if meta, err := natsMsg.Metadata(); err == nil && time.Since(meta.Timestamp) > IMMEDIATE_EXPIRY_WINDOW {
+			t.l.Debug().Msgf("message %s has expired, not processing", msg.ID)
+
+			if err := natsMsg.Ack(); err != nil {
+				t.l.Error().Msgf("error acknowledging message: %v", err)
+			}
+
+			return
+		}
+	}
+
+	deathCount := getDeathCount(natsMsg)
+
+	if q.IsDLQ() {
+		// dead-lettered messages are redelivered once their backoff has passed
+		if notBefore := getNotBefore(natsMsg); time.Now().Before(notBefore) {
+			if err := natsMsg.NakWithDelay(time.Until(notBefore)); err != nil {
+				t.l.Error().Msgf("error delaying message: %v", err)
+			}
+
+			return
+		}
+
+		t.l.Debug().Msgf("message has been rejected %d times", deathCount)
+
+		// determine if we've hit the max number of retries
+		if deathCount > msg.Retries {
+			t.l.Debug().Msgf("message has been rejected %d times, not requeuing", deathCount)
+
+			// acknowledge so it's removed from the queue
+			if err := natsMsg.Ack(); err != nil {
+				t.l.Error().Msgf("error acknowledging message: %v", err)
+			}
+
+			return
+		}
+	}
+
+	t.l.Debug().Msgf("(session: %d) got msg", sessionCount)
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Msgf("error in pre-ack on msg %s: %v", msg.ID, err)
+
+		t.reject(q, natsMsg, deathCount)
+
+		return
+	}
+
+	if err := natsMsg.Ack(); err != nil {
+		t.l.Error().Msgf("error acknowledging message: %v", err)
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Msgf("error in post-ack: %v", err)
+		return
+	}
+}
+
+// reject dead-letters a message which could not be processed. Messages on queues without a dead letter
+// queue are dropped, which matches a RabbitMQ reject without requeueing.
+func (t *MessageQueueImpl) reject(q msgqueue.Queue, natsMsg jetstream.Msg, deathCount int) {
+	dlq := q.DLQ()
+
+	if q.IsDLQ() {
+		dlq = q
+	}
+
+	if dlq == nil {
+		if err := natsMsg.Term(); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(t.ctx, 5*time.Second)
+	defer cancel()
+
+	dlMsg := natsclient.NewMsg(queueSubject(dlq))
+	dlMsg.Data = natsMsg.Data()
+	dlMsg.Header.Set(deathCountHeader, strconv.Itoa(deathCount+1))
+	dlMsg.Header.Set(notBeforeHeader, strconv.FormatInt(time.Now().Add(t.deadLetterBackoff).UnixMilli(), 10))
+
+	if _, err := t.js.PublishMsg(ctx, dlMsg); err != nil {
+		t.l.Error().Msgf("error dead-lettering message: %v", err)
+
+		// the message stays on its queue and is retried after the backoff
+		if err := natsMsg.NakWithDelay(t.deadLetterBackoff); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if err := natsMsg.Ack(); err != nil {
+		t.l.Error().Msgf("error acknowledging message: %v", err)
+	}
+}
+
+func getDeathCount(natsMsg jetstream.Msg) int {
+	count, err := strconv.Atoi(natsMsg.Headers().Get(deathCountHeader))
+
+	if err != nil {
+		return 0
+	}
+
+	return count
+}
+
+func getNotBefore(natsMsg jetstream.Msg) time.Time {
+	ms, err := strconv.ParseInt(natsMsg.Headers().Get(notBeforeHeader), 10, 64)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms)
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// sanitizeName replaces characters which are not valid in stream names, consumer names or subject tokens.
+func sanitizeName(name string) string {
+	return invalidNameChars.ReplaceAllString(name, "_")
+}
+
+func streamName(q msgqueue.Queue) string {
+	return sanitizeName(q.Name())
+}
+
+func queueSubject(q msgqueue.Queue) string {
+	if q.FanoutExchangeKey() != "" {
+		return tenantSubject(q.FanoutExchangeKey())
+	}
+
+	if !q.Durable() {
+		return fmt.Sprintf("%s.%s", consumerSubjectPrefix, sanitizeName(q.Name()))
+	}
+
+	return fmt.Sprintf("%s.%s", queueSubjectPrefix, sanitizeName(q.Name()))
+}
+
+func tenantSubject(exchangeName string) string {
+	return fmt.Sprintf("%s.%s", tenantSubjectPrefix, sanitizeName(exchangeName))
+}
+
+// sleepWithExponentialBackoff sleeps for a duration calculated using exponential backoff and jitter,
+// based on the retry count. The base sleep time and maximum sleep time are provided as inputs.
+// retryCount determines the exponential backoff multiplier.
+func sleepWithExponentialBackoff(base, max time.Duration, retryCount int) { // nolint: revive
+	if retryCount < 0 {
+		retryCount = 0
+	}
+
+	// Calculate exponential backoff
+	backoff := base * (1 << retryCount)
+	if backoff > max {
+		backoff = max
+	}
+
+	backoffInterval := backoff / 2
+
+	if backoffInterval < 1*time.Millisecond {
+		backoffInterval = 1 * time.Millisecond
+	}
+
+	// Apply jitter
+	jitter := time.Duration(rand.Int63n(int64(backoffInterval))) // nolint: gosec
+	sleepDuration := backoffInterval + jitter
+
+	time.Sleep(sleepDuration)
+}
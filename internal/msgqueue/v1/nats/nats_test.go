@@ -0,0 +1,192 @@
+package nats
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/require"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/v1/msgqueuetest"
+)
+
+// runServer starts an in-process NATS server with JetStream enabled.
+func runServer(t *testing.T) string {
+	t.Helper()
+
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+
+	require.NoError(t, err, "creating nats server should not error")
+
+	s.Start()
+
+	t.Cleanup(s.Shutdown)
+
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server was not ready for connections")
+	}
+
+	return s.ClientURL()
+}
+
+func TestMessageQueueConformance(t *testing.T) {
+	url := runServer(t)
+	deadLetterBackoff := 500 * time.Millisecond
+
+	var cleanupMq *MessageQueueImpl
+
+	newMq := func(t *testing.T) msgqueue.MessageQueue {
+		cleanup, mq := New(
+			WithURL(url),
+			WithQos(100),
+			WithDeadLetterBackoff(deadLetterBackoff),
+		)
+
+		require.NotNil(t, mq, "message queue implementation should not be nil")
+
+		t.Cleanup(func() {
+			if err := cleanup(); err != nil {
+				t.Errorf("error cleaning up message queue: %v", err)
+			}
+		})
+
+		cleanupMq = mq
+
+		return mq
+	}
+
+	msgqueuetest.Run(t, msgqueuetest.Backend{
+		New: newMq,
+		DeleteQueue: func(q msgqueue.Queue) error {
+			return cleanupMq.deleteQueue(q)
+		},
+		DeadLetterBackoff: deadLetterBackoff,
+	})
+}
+
+func TestTenantExchangeFanout(t *testing.T) {
+	url := runServer(t)
+
+	cleanup, mq := New(WithURL(url))
+	require.NotNil(t, mq, "message queue implementation should not be nil")
+
+	defer cleanup() // nolint: errcheck
+
+	tenantId := "fanout-tenant"
+	q := msgqueue.TenantEventConsumerQueue(tenantId)
+
+	received := make(chan string, 10)
+
+	for _, subscriber := range []string{"a", "b"} {
+		cleanupSub, err := mq.Subscribe(q, func(msg *msgqueue.Message) error {
+			select {
+			case received <- subscriber:
+			default:
+			}
+
+			return nil
+		}, msgqueue.NoOpHook)
+
+		require.NoError(t, err, "subscribing should not error")
+
+		defer cleanupSub() // nolint: errcheck
+	}
+
+	msg, err := msgqueue.NewTenantMessage(tenantId, "fanout", false, true, map[string]interface{}{"key": "value"})
+	require.NoError(t, err, "creating message should not error")
+
+	// every subscriber to the tenant exchange should receive its own copy of the message
+	seen := make(map[string]bool)
+	deadline := time.After(10 * time.Second)
+
+	for len(seen) < 2 {
+		require.NoError(t, mq.SendMessage(context.Background(), msgqueue.TASK_PROCESSING_QUEUE, msg), "sending message should not error")
+
+		select {
+		case subscriber := <-received:
+			seen[subscriber] = true
+		case <-time.After(500 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("only %d subscribers received the message", len(seen))
+		}
+	}
+}
+
+func TestQosCapsInFlightMessages(t *testing.T) {
+	url := runServer(t)
+	qos := 2
+
+	cleanup, mq := New(WithURL(url), WithQos(qos))
+	require.NotNil(t, mq, "message queue implementation should not be nil")
+
+	defer cleanup() // nolint: errcheck
+
+	q := msgqueue.TASK_PROCESSING_QUEUE
+	numMessages := 20
+
+	var inFlight, maxInFlight, processed atomic.Int32
+	done := make(chan struct{})
+
+	cleanupSub, err := mq.Subscribe(q, func(msg *msgqueue.Message) error {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			prev := maxInFlight.Load()
+
+			if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		time.Sleep(50 * time.Millisecond)
+
+		if processed.Add(1) == int32(numMessages) { // nolint: gosec
+			close(done)
+		}
+
+		return nil
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err, "subscribing should not error")
+
+	defer cleanupSub() // nolint: errcheck
+
+	for i := 0; i < numMessages; i++ {
+		msg, err := msgqueue.NewTenantMessage("qos-tenant", "qos", false, true, map[string]interface{}{"index": i})
+		require.NoError(t, err, "creating message should not error")
+
+		require.NoError(t, mq.SendMessage(context.Background(), q, msg), "sending message should not error")
+	}
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("only %d of %d messages were processed", processed.Load(), numMessages)
+	}
+
+	require.LessOrEqual(t, maxInFlight.Load(), int32(qos), "in-flight messages should not exceed the qos") // nolint: gosec
+	require.Greater(t, maxInFlight.Load(), int32(1), "messages should be processed concurrently")
+
+	consumer, err := mq.js.Consumer(context.Background(), streamName(q), durableConsumerName)
+	require.NoError(t, err, "getting consumer should not error")
+	require.Equal(t, qos, consumer.CachedInfo().Config.MaxAckPending, "max ack pending should be set from the qos")
+}
+
+func TestSanitizeName(t *testing.T) {
+	require.Equal(t, "tenant_v1", sanitizeName("tenant_v1"))
+	require.Equal(t, "a_b_c_d", sanitizeName("a.b*c>d"))
+	require.Equal(t, "hatchet.consumer.dispatcher_v1", queueSubject(msgqueue.QueueTypeFromDispatcherID("dispatcher")))
+	require.Equal(t, "hatchet.queue.task_processing_queue_v2_dlq", queueSubject(msgqueue.TASK_PROCESSING_QUEUE.DLQ()))
+	require.Equal(t, "hatchet.tenant.tenant_v1", queueSubject(msgqueue.TenantEventConsumerQueue("tenant")))
+}
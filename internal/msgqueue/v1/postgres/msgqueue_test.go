@@ -0,0 +1,27 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"testing"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/v1/msgqueuetest"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/v1/postgres"
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+)
+
+func TestMessageQueueConformance(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		// the Postgres message queue does not dead letter messages which fail to be processed, so the
+		// dead lettering tests are skipped
+		msgqueuetest.Run(t, msgqueuetest.Backend{
+			New: func(t *testing.T) msgqueue.MessageQueue {
+				return postgres.NewPostgresMQ(conf.EngineRepository.MessageQueue())
+			},
+		})
+
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// the location which compiled schemas are registered under. Schemas are compiled in isolation, so every
+// schema uses the same location.
+const schemaLocation = "hatchet://schema.json"
+
+// Compile compiles a JSON schema. References to other documents are not resolved, so that schemas cannot
+// be used to read files or make requests from the server; only local references (for example,
+// `#/$defs/address`) and the standard meta-schemas are supported.
+func Compile(schemaBytes []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaBytes))
+
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+
+	// an empty loader rejects all external references
+	c.UseLoader(jsonschema.SchemeURLLoader{})
+
+	if err := c.AddResource(schemaLocation, doc); err != nil {
+		return nil, fmt.Errorf("could not add schema: %w", err)
+	}
+
+	s, err := c.Compile(schemaLocation)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", formatValidationError(err))
+	}
+
+	return s, nil
+}
+
+// Validate validates JSON bytes against a compiled schema.
+func Validate(s *jsonschema.Schema, data []byte) error {
+	inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+
+	if err != nil {
+		return fmt.Errorf("payload is not valid JSON: %w", err)
+	}
+
+	if err := s.Validate(inst); err != nil {
+		return formatValidationError(err)
+	}
+
+	return nil
+}
+
+// formatValidationError collapses the multi-line output of a validation error into a single line, for
+// example `at '/age': minimum: got -1, want 0; at '/name': got number, want string`.
+func formatValidationError(err error) error {
+	var validationErr *jsonschema.ValidationError
+
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	lines := strings.Split(validationErr.Error(), "\n")
+	causes := make([]string, 0, len(lines))
+
+	// the first line only contains the location of the schema
+	for _, line := range lines[1:] {
+		causes = append(causes, strings.TrimPrefix(strings.TrimSpace(line), "- "))
+	}
+
+	if len(causes) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%s", strings.Join(causes, "; "))
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileAndValidate(t *testing.T) {
+	s, err := Compile([]byte(`{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"age": {"type": "integer", "minimum": 0}
+		}
+	}`))
+
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid payload",
+			data: `{"name": "alice", "age": 30}`,
+		},
+		{
+			name:    "missing property",
+			data:    `{"age": 30}`,
+			wantErr: "at '': missing property 'name'",
+		},
+		{
+			name:    "multiple errors",
+			data:    `{"age": -1}`,
+			wantErr: "at '': missing property 'name'; at '/age': minimum: got -1, want 0",
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"name":`,
+			wantErr: "payload is not valid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(s, []byte(tt.data))
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompileInvalidSchema(t *testing.T) {
+	_, err := Compile([]byte(`{"type": "foo"}`))
+	assert.ErrorContains(t, err, "invalid schema")
+
+	_, err = Compile([]byte(`{"type":`))
+	assert.ErrorContains(t, err, "schema is not valid JSON")
+}
+
+func TestCompileRejectsExternalRefs(t *testing.T) {
+	_, err := Compile([]byte(`{"$ref": "file:///etc/passwd"}`))
+	assert.Error(t, err)
+
+	_, err = Compile([]byte(`{"$ref": "https://example.com/schema.json"}`))
+	assert.Error(t, err)
+
+	// local references are supported
+	_, err = Compile([]byte(`{"$defs": {"name": {"type": "string"}}, "properties": {"name": {"$ref": "#/$defs/name"}}}`))
+	assert.NoError(t, err)
+}
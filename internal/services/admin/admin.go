@@ -3,9 +3,13 @@ package admin
 import (
 	"fmt"
 
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	msgqueuev1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
@@ -24,6 +28,8 @@ type AdminServiceImpl struct {
 	mq           msgqueue.MessageQueue
 	mqv1         msgqueuev1.MessageQueue
 	v            validator.Validator
+	celParser    *cel.CELParser
+	l            *zerolog.Logger
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -35,13 +41,16 @@ type AdminServiceOpts struct {
 	mq           msgqueue.MessageQueue
 	mqv1         msgqueuev1.MessageQueue
 	v            validator.Validator
+	l            *zerolog.Logger
 }
 
 func defaultAdminServiceOpts() *AdminServiceOpts {
 	v := validator.NewDefaultValidator()
+	logger := logger.NewDefaultLogger("admin")
 
 	return &AdminServiceOpts{
 		v: v,
+		l: &logger,
 	}
 }
 
@@ -81,6 +90,12 @@ func WithValidator(v validator.Validator) AdminServiceOpt {
 	}
 }
 
+func WithLogger(l *zerolog.Logger) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.l = l
+	}
+}
+
 func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	opts := defaultAdminServiceOpts()
 
@@ -111,5 +126,7 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 		mq:           opts.mq,
 		mqv1:         opts.mqv1,
 		v:            opts.v,
+		l:            opts.l,
+		celParser:    cel.NewCELParser(),
 	}, nil
 }
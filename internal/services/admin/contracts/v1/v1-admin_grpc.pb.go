@@ -25,6 +25,11 @@ type AdminServiceClient interface {
 	CancelTasks(ctx context.Context, in *CancelTasksRequest, opts ...grpc.CallOption) (*CancelTasksResponse, error)
 	ReplayTasks(ctx context.Context, in *ReplayTasksRequest, opts ...grpc.CallOption) (*ReplayTasksResponse, error)
 	TriggerWorkflowRun(ctx context.Context, in *TriggerWorkflowRunRequest, opts ...grpc.CallOption) (*TriggerWorkflowRunResponse, error)
+	PauseWorkflow(ctx context.Context, in *PauseWorkflowRequest, opts ...grpc.CallOption) (*PauseWorkflowResponse, error)
+	ResumeWorkflow(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*ResumeWorkflowResponse, error)
+	SetConcurrencyKeyWeight(ctx context.Context, in *SetConcurrencyKeyWeightRequest, opts ...grpc.CallOption) (*SetConcurrencyKeyWeightResponse, error)
+	DeleteConcurrencyKeyWeight(ctx context.Context, in *DeleteConcurrencyKeyWeightRequest, opts ...grpc.CallOption) (*DeleteConcurrencyKeyWeightResponse, error)
+	GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*BulkOperation, error)
 }
 
 type adminServiceClient struct {
@@ -62,6 +67,51 @@ func (c *adminServiceClient) TriggerWorkflowRun(ctx context.Context, in *Trigger
 	return out, nil
 }
 
+func (c *adminServiceClient) PauseWorkflow(ctx context.Context, in *PauseWorkflowRequest, opts ...grpc.CallOption) (*PauseWorkflowResponse, error) {
+	out := new(PauseWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/PauseWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeWorkflow(ctx context.Context, in *ResumeWorkflowRequest, opts ...grpc.CallOption) (*ResumeWorkflowResponse, error) {
+	out := new(ResumeWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/ResumeWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetConcurrencyKeyWeight(ctx context.Context, in *SetConcurrencyKeyWeightRequest, opts ...grpc.CallOption) (*SetConcurrencyKeyWeightResponse, error) {
+	out := new(SetConcurrencyKeyWeightResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/SetConcurrencyKeyWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteConcurrencyKeyWeight(ctx context.Context, in *DeleteConcurrencyKeyWeightRequest, opts ...grpc.CallOption) (*DeleteConcurrencyKeyWeightResponse, error) {
+	out := new(DeleteConcurrencyKeyWeightResponse)
+	err := c.cc.Invoke(ctx, "/AdminService/DeleteConcurrencyKeyWeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetBulkOperation(ctx context.Context, in *GetBulkOperationRequest, opts ...grpc.CallOption) (*BulkOperation, error) {
+	out := new(BulkOperation)
+	err := c.cc.Invoke(ctx, "/AdminService/GetBulkOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -69,6 +119,11 @@ type AdminServiceServer interface {
 	CancelTasks(context.Context, *CancelTasksRequest) (*CancelTasksResponse, error)
 	ReplayTasks(context.Context, *ReplayTasksRequest) (*ReplayTasksResponse, error)
 	TriggerWorkflowRun(context.Context, *TriggerWorkflowRunRequest) (*TriggerWorkflowRunResponse, error)
+	PauseWorkflow(context.Context, *PauseWorkflowRequest) (*PauseWorkflowResponse, error)
+	ResumeWorkflow(context.Context, *ResumeWorkflowRequest) (*ResumeWorkflowResponse, error)
+	SetConcurrencyKeyWeight(context.Context, *SetConcurrencyKeyWeightRequest) (*SetConcurrencyKeyWeightResponse, error)
+	DeleteConcurrencyKeyWeight(context.Context, *DeleteConcurrencyKeyWeightRequest) (*DeleteConcurrencyKeyWeightResponse, error)
+	GetBulkOperation(context.Context, *GetBulkOperationRequest) (*BulkOperation, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -85,6 +140,21 @@ func (UnimplementedAdminServiceServer) ReplayTasks(context.Context, *ReplayTasks
 func (UnimplementedAdminServiceServer) TriggerWorkflowRun(context.Context, *TriggerWorkflowRunRequest) (*TriggerWorkflowRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerWorkflowRun not implemented")
 }
+func (UnimplementedAdminServiceServer) PauseWorkflow(context.Context, *PauseWorkflowRequest) (*PauseWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflow not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeWorkflow(context.Context, *ResumeWorkflowRequest) (*ResumeWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflow not implemented")
+}
+func (UnimplementedAdminServiceServer) SetConcurrencyKeyWeight(context.Context, *SetConcurrencyKeyWeightRequest) (*SetConcurrencyKeyWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConcurrencyKeyWeight not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteConcurrencyKeyWeight(context.Context, *DeleteConcurrencyKeyWeightRequest) (*DeleteConcurrencyKeyWeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConcurrencyKeyWeight not implemented")
+}
+func (UnimplementedAdminServiceServer) GetBulkOperation(context.Context, *GetBulkOperationRequest) (*BulkOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkOperation not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +222,96 @@ func _AdminService_TriggerWorkflowRun_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_PauseWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/PauseWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflow(ctx, req.(*PauseWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/ResumeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeWorkflow(ctx, req.(*ResumeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetConcurrencyKeyWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConcurrencyKeyWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetConcurrencyKeyWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/SetConcurrencyKeyWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetConcurrencyKeyWeight(ctx, req.(*SetConcurrencyKeyWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteConcurrencyKeyWeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConcurrencyKeyWeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteConcurrencyKeyWeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/DeleteConcurrencyKeyWeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteConcurrencyKeyWeight(ctx, req.(*DeleteConcurrencyKeyWeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetBulkOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetBulkOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AdminService/GetBulkOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetBulkOperation(ctx, req.(*GetBulkOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +331,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TriggerWorkflowRun",
 			Handler:    _AdminService_TriggerWorkflowRun_Handler,
 		},
+		{
+			MethodName: "PauseWorkflow",
+			Handler:    _AdminService_PauseWorkflow_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflow",
+			Handler:    _AdminService_ResumeWorkflow_Handler,
+		},
+		{
+			MethodName: "SetConcurrencyKeyWeight",
+			Handler:    _AdminService_SetConcurrencyKeyWeight_Handler,
+		},
+		{
+			MethodName: "DeleteConcurrencyKeyWeight",
+			Handler:    _AdminService_DeleteConcurrencyKeyWeight_Handler,
+		},
+		{
+			MethodName: "GetBulkOperation",
+			Handler:    _AdminService_GetBulkOperation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1-admin.proto",
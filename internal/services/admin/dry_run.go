@@ -0,0 +1,618 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/dagutils"
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+// putWorkflowDryRun returns a diff of the validated workflow options against the latest workflow version
+// without writing anything to the database.
+func (a *AdminServiceImpl) putWorkflowDryRun(ctx context.Context, tenantId string, createOpts *repository.CreateWorkflowVersionOpts) (*contracts.WorkflowVersion, error) {
+	currWorkflow, err := a.repo.Workflow().GetWorkflowByName(
+		ctx,
+		tenantId,
+		createOpts.Name,
+	)
+
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		return &contracts.WorkflowVersion{
+			Diff: diffWorkflowVersionOpts(nil, createOpts),
+		}, nil
+	}
+
+	latestVersion, err := a.repo.Workflow().GetLatestWorkflowVersion(
+		ctx,
+		tenantId,
+		sqlchelpers.UUIDToStr(currWorkflow.ID),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionOpts, err := a.repo.Workflow().ListWorkflowVersionOpts(ctx, tenantId, []*dbsqlc.GetWorkflowVersionForEngineRow{latestVersion})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest workflow version definition: %w", err)
+	}
+
+	oldOpts := versionOpts[sqlchelpers.UUIDToStr(latestVersion.WorkflowVersion.ID)]
+
+	newCS, err := createOpts.Checksum()
+
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffWorkflowVersionOpts(oldOpts, createOpts)
+	diff.HasChanges = latestVersion.WorkflowVersion.Checksum != newCS
+
+	resp := toWorkflowVersion(latestVersion, nil)
+	resp.Diff = diff
+
+	return resp, nil
+}
+
+// workflowValidationError returns an InvalidArgument error which lists every validation error.
+func workflowValidationError(validationErrs []string) error {
+	var sb strings.Builder
+
+	sb.WriteString("Validation failed with the following errors:\n")
+
+	for i, validationErr := range validationErrs {
+		sb.WriteString(fmt.Sprintf("%d: %s\n", i, validationErr))
+	}
+
+	return status.Error(
+		codes.InvalidArgument,
+		sb.String(),
+	)
+}
+
+// validateWorkflowOpts performs all validation which would otherwise only surface while writing the workflow
+// version, and returns every validation error instead of only the first one.
+func (a *AdminServiceImpl) validateWorkflowOpts(ctx context.Context, tenantId string, opts *repository.CreateWorkflowVersionOpts) ([]string, error) {
+	res := make([]string, 0)
+
+	apiErrors, err := a.v.ValidateAPI(opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErrors != nil {
+		for _, apiErr := range apiErrors.Errors {
+			res = append(res, apiErr.Description)
+		}
+	}
+
+	res = append(res, a.validateEventTriggerFilters(opts)...)
+	res = append(res, validateStepConditions(opts)...)
+	res = append(res, validateMapSteps(opts)...)
+	res = append(res, validateStepParents(opts)...)
+
+	for _, cronTrigger := range opts.CronTriggers {
+		if _, err := cron.ParseStandard(cronTrigger); err != nil {
+			res = append(res, fmt.Sprintf("invalid cron trigger %q: %s", cronTrigger, err.Error()))
+		}
+	}
+
+	if opts.Concurrency != nil {
+		if opts.Concurrency.Expression != nil {
+			if _, err := a.celParser.ParseWorkflowString(*opts.Concurrency.Expression); err != nil {
+				res = append(res, fmt.Sprintf("invalid concurrency expression %q: %s", *opts.Concurrency.Expression, err.Error()))
+			}
+		}
+
+		if opts.Concurrency.MaxRuns != nil && *opts.Concurrency.MaxRuns < 1 {
+			res = append(res, "concurrency max runs must be at least 1")
+		}
+	}
+
+	jobs := opts.Jobs
+
+	if opts.OnFailureJob != nil {
+		jobs = append(slices.Clone(jobs), *opts.OnFailureJob)
+	}
+
+	staticRateLimitKeys := make([]string, 0)
+
+	for _, job := range jobs {
+		for _, step := range job.Steps {
+			for _, rateLimit := range step.RateLimits {
+				exprs := map[string]*string{
+					"key":   rateLimit.KeyExpr,
+					"units": rateLimit.UnitsExpr,
+					"limit": rateLimit.LimitExpr,
+				}
+
+				isDynamic := false
+
+				for kind, expr := range exprs {
+					if expr == nil {
+						continue
+					}
+
+					isDynamic = true
+
+					if _, err := a.celParser.ParseStepRun(*expr); err != nil {
+						res = append(res, fmt.Sprintf("step %s: invalid rate limit %s expression %q: %s", step.ReadableId, kind, *expr, err.Error()))
+					}
+				}
+
+				if !isDynamic && !slices.Contains(staticRateLimitKeys, rateLimit.Key) {
+					staticRateLimitKeys = append(staticRateLimitKeys, rateLimit.Key)
+				}
+
+				// the parent of a dynamic rate limit must be created with PutRateLimit as well
+				if isDynamic && rateLimit.ParentKey != nil && !slices.Contains(staticRateLimitKeys, *rateLimit.ParentKey) {
+					staticRateLimitKeys = append(staticRateLimitKeys, *rateLimit.ParentKey)
+				}
+			}
+		}
+	}
+
+	if len(staticRateLimitKeys) > 0 {
+		existingKeys, err := a.repo.RateLimit().ListExistingRateLimitKeys(ctx, tenantId, staticRateLimitKeys)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range staticRateLimitKeys {
+			if !slices.Contains(existingKeys, key) {
+				res = append(res, fmt.Sprintf("rate limit %s does not exist, static rate limits must be created with PutRateLimit first", key))
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// validateEventTriggerFilters checks that every event trigger filter belongs to an event trigger and is a
+// valid CEL expression which evaluates to a bool.
+func (a *AdminServiceImpl) validateEventTriggerFilters(opts *repository.CreateWorkflowVersionOpts) []string {
+	res := make([]string, 0)
+
+	for _, eventKey := range sortedKeys(opts.EventTriggerFilters) {
+		expr := opts.EventTriggerFilters[eventKey]
+
+		if !slices.Contains(opts.EventTriggers, eventKey) {
+			res = append(res, fmt.Sprintf("event trigger filter %q does not match an event trigger", eventKey))
+			continue
+		}
+
+		if _, err := a.celParser.ParseEvent(expr); err != nil {
+			res = append(res, fmt.Sprintf("invalid filter for event trigger %s %q: %s", eventKey, expr, err.Error()))
+		}
+	}
+
+	return res
+}
+
+// validateStepConditions checks that every step condition waits on exactly one of a sleep or a user event, and
+// that root steps can be queued, since a step with conditions is only queued once its QUEUE groups are satisfied.
+func validateStepConditions(opts *repository.CreateWorkflowVersionOpts) []string {
+	res := make([]string, 0)
+
+	for _, job := range opts.Jobs {
+		for _, step := range job.Steps {
+			if len(step.Conditions) == 0 {
+				continue
+			}
+
+			hasQueueCondition := false
+
+			for _, condition := range step.Conditions {
+				if (condition.SleepFor == nil) == (condition.EventKey == nil) {
+					res = append(res, fmt.Sprintf("step %s: condition %s must set exactly one of a sleep duration or a user event key", step.ReadableId, condition.ReadableDataKey))
+				}
+
+				if condition.Expression != nil && condition.EventKey == nil {
+					res = append(res, fmt.Sprintf("step %s: condition %s can only set an expression when waiting for a user event", step.ReadableId, condition.ReadableDataKey))
+				}
+
+				if condition.Action == "QUEUE" {
+					hasQueueCondition = true
+				}
+			}
+
+			if len(step.Parents) == 0 && !hasQueueCondition {
+				res = append(res, fmt.Sprintf("step %s has no parents, so it needs at least one QUEUE condition", step.ReadableId))
+			}
+
+			if count := countStepMatchConditions(step); count > maxStepMatchConditions {
+				res = append(res, fmt.Sprintf("step %s would create %d match conditions for every run, which is more than the maximum of %d. reduce the number of SKIP and CANCEL groups or parents of the step", step.ReadableId, count, maxStepMatchConditions))
+			}
+		}
+	}
+
+	if opts.OnFailureJob != nil {
+		for _, step := range opts.OnFailureJob.Steps {
+			if len(step.Conditions) > 0 {
+				res = append(res, fmt.Sprintf("on-failure step %s does not support conditions", step.ReadableId))
+			}
+		}
+	}
+
+	return res
+}
+
+// maxStepMatchConditions is the maximum number of match conditions which may be created for a step on every run.
+const maxStepMatchConditions = 250
+
+// countStepMatchConditions returns an upper bound for the number of match conditions which are created for a step
+// on every run. A step is skipped or cancelled when either its parents' groups or its own groups for the action
+// are satisfied, which is distributed over the groups, so every SKIP and CANCEL group of the step is combined
+// with the conditions of all of its parents.
+func countStepMatchConditions(step repository.CreateWorkflowStepOpts) int {
+	groups := make(map[string]map[string]struct{})
+	counts := make(map[string]int)
+
+	for _, condition := range step.Conditions {
+		if _, ok := groups[condition.Action]; !ok {
+			groups[condition.Action] = make(map[string]struct{})
+		}
+
+		groups[condition.Action][condition.OrGroupId] = struct{}{}
+		counts[condition.Action]++
+	}
+
+	numParents := len(step.Parents)
+
+	// every parent has one QUEUE condition, one SKIP condition and two CANCEL conditions
+	res := numParents + counts["QUEUE"]
+
+	for action, parentConditions := range map[string]int{"SKIP": numParents, "CANCEL": 2 * numParents} {
+		numGroups := len(groups[action])
+
+		if numGroups == 0 || parentConditions == 0 {
+			res += parentConditions + counts[action]
+			continue
+		}
+
+		res += numGroups*parentConditions + counts[action]
+	}
+
+	return res
+}
+
+// validateMapSteps checks that map steps are not used as on-failure steps, since an on-failure step is queued
+// with the errors of the failed steps rather than a list of items.
+func validateMapSteps(opts *repository.CreateWorkflowVersionOpts) []string {
+	res := make([]string, 0)
+
+	if opts.OnFailureJob != nil {
+		for _, step := range opts.OnFailureJob.Steps {
+			if step.Map != nil {
+				res = append(res, fmt.Sprintf("on-failure step %s can't be a map step", step.ReadableId))
+			}
+		}
+	}
+
+	return res
+}
+
+// getStepSlotPools returns the sorted, unique names of the slot pools which the steps of the workflow use.
+func getStepSlotPools(opts *repository.CreateWorkflowVersionOpts) []string {
+	jobs := opts.Jobs
+
+	if opts.OnFailureJob != nil {
+		jobs = append(slices.Clone(jobs), *opts.OnFailureJob)
+	}
+
+	res := make([]string, 0)
+
+	for _, job := range jobs {
+		for _, step := range job.Steps {
+			if step.SlotPool != nil && *step.SlotPool != "" && !slices.Contains(res, *step.SlotPool) {
+				res = append(res, *step.SlotPool)
+			}
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// validateStepParents checks that the parents of every step refer to other steps in the same job and that the
+// steps of each job form a DAG. Unknown parents would otherwise be dropped silently when the version is written.
+func validateStepParents(opts *repository.CreateWorkflowVersionOpts) []string {
+	res := make([]string, 0)
+
+	jobs := opts.Jobs
+
+	if opts.OnFailureJob != nil {
+		jobs = append(slices.Clone(jobs), *opts.OnFailureJob)
+	}
+
+	for _, job := range jobs {
+		readableIds := make(map[string]bool, len(job.Steps))
+
+		for _, step := range job.Steps {
+			readableIds[step.ReadableId] = true
+		}
+
+		hasSelfParent := false
+
+		for _, step := range job.Steps {
+			for _, parent := range step.Parents {
+				switch {
+				case parent == step.ReadableId:
+					res = append(res, fmt.Sprintf("step %s can't be its own parent", step.ReadableId))
+					hasSelfParent = true
+				case !readableIds[parent]:
+					res = append(res, fmt.Sprintf("step %s has unknown parent %s", step.ReadableId, parent))
+				}
+			}
+		}
+
+		// self-parents are already reported above
+		if !hasSelfParent && dagutils.HasCycle(job.Steps) {
+			res = append(res, fmt.Sprintf("job %s has a cycle", job.Name))
+		}
+	}
+
+	return res
+}
+
+// diffWorkflowVersionOpts compares the definition of the latest workflow version with a new definition. If oldOpts
+// is nil, the workflow does not exist yet.
+func diffWorkflowVersionOpts(oldOpts, newOpts *repository.CreateWorkflowVersionOpts) *contracts.WorkflowVersionDiff {
+	diff := &contracts.WorkflowVersionDiff{
+		Concurrency: toCreateWorkflowVersionOpts(newOpts).Concurrency,
+	}
+
+	newSteps := stepsByReadableId(newOpts)
+
+	if oldOpts == nil {
+		diff.IsNewWorkflow = true
+		diff.HasChanges = true
+		diff.AddedSteps = sortedKeys(newSteps)
+		diff.AddedEventTriggers = newOpts.EventTriggers
+		diff.AddedCronTriggers = newOpts.CronTriggers
+		diff.ConcurrencyChanged = newOpts.Concurrency != nil
+
+		return diff
+	}
+
+	oldSteps := stepsByReadableId(oldOpts)
+
+	for _, readableId := range sortedKeys(newSteps) {
+		oldStep, ok := oldSteps[readableId]
+
+		if !ok {
+			diff.AddedSteps = append(diff.AddedSteps, readableId)
+			continue
+		}
+
+		if !equalJSON(normalizeStepOpts(oldStep), normalizeStepOpts(newSteps[readableId])) {
+			diff.ChangedSteps = append(diff.ChangedSteps, readableId)
+		}
+	}
+
+	for _, readableId := range sortedKeys(oldSteps) {
+		if _, ok := newSteps[readableId]; !ok {
+			diff.RemovedSteps = append(diff.RemovedSteps, readableId)
+		}
+	}
+
+	diff.AddedEventTriggers, diff.RemovedEventTriggers = diffStrings(oldOpts.EventTriggers, newOpts.EventTriggers)
+	diff.AddedCronTriggers, diff.RemovedCronTriggers = diffStrings(oldOpts.CronTriggers, newOpts.CronTriggers)
+
+	diff.PreviousConcurrency = toCreateWorkflowVersionOpts(oldOpts).Concurrency
+	diff.ConcurrencyChanged = !equalJSON(normalizeConcurrencyOpts(oldOpts.Concurrency), normalizeConcurrencyOpts(newOpts.Concurrency))
+
+	return diff
+}
+
+// stepsByReadableId returns all steps in the workflow, including the on-failure steps, keyed by readable id.
+// On-failure steps are prefixed with the on-failure job name, as they may share readable ids with other steps.
+func stepsByReadableId(opts *repository.CreateWorkflowVersionOpts) map[string]repository.CreateWorkflowStepOpts {
+	res := make(map[string]repository.CreateWorkflowStepOpts)
+
+	for _, job := range opts.Jobs {
+		for _, step := range job.Steps {
+			res[step.ReadableId] = step
+		}
+	}
+
+	if opts.OnFailureJob != nil {
+		for _, step := range opts.OnFailureJob.Steps {
+			res[fmt.Sprintf("%s.%s", opts.OnFailureJob.Name, step.ReadableId)] = step
+		}
+	}
+
+	return res
+}
+
+// normalizeStepOpts fills in the defaults which are applied when a step is written to the database, so that
+// a step which is read back from the database compares equal to the step which created it.
+func normalizeStepOpts(step repository.CreateWorkflowStepOpts) repository.CreateWorkflowStepOpts {
+	if step.Retries != nil && *step.Retries == 0 {
+		step.Retries = nil
+	}
+
+	if step.Slots != nil && *step.Slots == 1 {
+		step.Slots = nil
+	}
+
+	if step.UserData != nil && (*step.UserData == "" || *step.UserData == "null" || *step.UserData == "{}") {
+		step.UserData = nil
+	}
+
+	parents := slices.Clone(step.Parents)
+	sort.Strings(parents)
+	step.Parents = parents
+
+	if len(step.Parents) == 0 {
+		step.Parents = nil
+	}
+
+	if len(step.DesiredWorkerLabels) > 0 {
+		labels := make(map[string]repository.DesiredWorkerLabelOpts, len(step.DesiredWorkerLabels))
+
+		for key, label := range step.DesiredWorkerLabels {
+			label.Key = key
+
+			if label.Required == nil {
+				label.Required = repository.BoolPtr(false)
+			}
+
+			if label.Weight == nil {
+				weight := int32(100)
+				label.Weight = &weight
+			}
+
+			if label.Comparator == nil {
+				label.Comparator = repository.StringPtr("EQUAL")
+			}
+
+			labels[key] = label
+		}
+
+		step.DesiredWorkerLabels = labels
+	} else {
+		step.DesiredWorkerLabels = nil
+	}
+
+	if len(step.Conditions) == 0 {
+		step.Conditions = nil
+	}
+
+	if len(step.Concurrency) > 0 {
+		concurrency := make([]repository.CreateStepConcurrencyOpts, len(step.Concurrency))
+
+		for i, c := range step.Concurrency {
+			if c.MaxRuns == nil {
+				maxRuns := int32(1)
+				c.MaxRuns = &maxRuns
+			}
+
+			if c.LimitStrategy == nil || *c.LimitStrategy == "" {
+				c.LimitStrategy = repository.StringPtr("CANCEL_IN_PROGRESS")
+			}
+
+			if c.WeightExpression != nil && *c.WeightExpression == "" {
+				c.WeightExpression = nil
+			}
+
+			concurrency[i] = c
+		}
+
+		step.Concurrency = concurrency
+	} else {
+		step.Concurrency = nil
+	}
+
+	if len(step.RateLimits) > 0 {
+		rateLimits := make([]repository.CreateWorkflowStepRateLimitOpts, len(step.RateLimits))
+
+		for i, rateLimit := range step.RateLimits {
+			isDynamic := rateLimit.KeyExpr != nil || rateLimit.UnitsExpr != nil || rateLimit.LimitExpr != nil
+
+			if !isDynamic {
+				// static rate limits use the duration of the rate limit itself
+				rateLimit.Duration = nil
+			} else if rateLimit.Duration == nil {
+				rateLimit.Duration = repository.StringPtr("MINUTE")
+			}
+
+			rateLimits[i] = rateLimit
+		}
+
+		sort.SliceStable(rateLimits, func(i, j int) bool {
+			return rateLimits[i].Key < rateLimits[j].Key
+		})
+
+		step.RateLimits = rateLimits
+	} else {
+		step.RateLimits = nil
+	}
+
+	return step
+}
+
+func normalizeConcurrencyOpts(concurrency *repository.CreateWorkflowConcurrencyOpts) *repository.CreateWorkflowConcurrencyOpts {
+	if concurrency == nil {
+		return nil
+	}
+
+	res := *concurrency
+
+	if res.LimitStrategy == nil || *res.LimitStrategy == "" {
+		res.LimitStrategy = repository.StringPtr("CANCEL_IN_PROGRESS")
+	}
+
+	if res.WeightExpression != nil && *res.WeightExpression == "" {
+		res.WeightExpression = nil
+	}
+
+	return &res
+}
+
+func equalJSON(a, b any) bool {
+	aMap, err := datautils.ToJSONMap(a)
+
+	if err != nil {
+		return false
+	}
+
+	bMap, err := datautils.ToJSONMap(b)
+
+	if err != nil {
+		return false
+	}
+
+	return fmt.Sprintf("%v", aMap) == fmt.Sprintf("%v", bMap)
+}
+
+// diffStrings returns the strings which were added to and removed from the old set
+func diffStrings(old, new []string) (added []string, removed []string) {
+	for _, s := range new {
+		if !slices.Contains(old, s) {
+			added = append(added, s)
+		}
+	}
+
+	for _, s := range old {
+		if !slices.Contains(new, s) {
+			removed = append(removed, s)
+		}
+	}
+
+	return added, removed
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,282 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestValidateStepParents(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []repository.CreateWorkflowStepOpts
+		expected []string
+	}{
+		{
+			name: "valid dag",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "step-one"},
+				{ReadableId: "step-two", Parents: []string{"step-one"}},
+				{ReadableId: "step-three", Parents: []string{"step-one", "step-two"}},
+			},
+			expected: []string{},
+		},
+		{
+			name: "unknown parent",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "step-one"},
+				{ReadableId: "step-two", Parents: []string{"step-zero"}},
+			},
+			expected: []string{"step step-two has unknown parent step-zero"},
+		},
+		{
+			name: "self parent",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "step-one", Parents: []string{"step-one"}},
+			},
+			expected: []string{"step step-one can't be its own parent"},
+		},
+		{
+			name: "cycle",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "step-one", Parents: []string{"step-three"}},
+				{ReadableId: "step-two", Parents: []string{"step-one"}},
+				{ReadableId: "step-three", Parents: []string{"step-two"}},
+			},
+			expected: []string{"job job has a cycle"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &repository.CreateWorkflowVersionOpts{
+				Jobs: []repository.CreateWorkflowJobOpts{
+					{Name: "job", Steps: tt.steps},
+				},
+			}
+
+			assert.Equal(t, tt.expected, validateStepParents(opts))
+		})
+	}
+}
+
+func TestValidateStepParents_OnFailureJob(t *testing.T) {
+	opts := &repository.CreateWorkflowVersionOpts{
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{Name: "job", Steps: []repository.CreateWorkflowStepOpts{{ReadableId: "step-one"}}},
+		},
+		OnFailureJob: &repository.CreateWorkflowJobOpts{
+			Name: "on-failure",
+			// parents may only refer to steps in the same job
+			Steps: []repository.CreateWorkflowStepOpts{{ReadableId: "on-failure", Parents: []string{"step-one"}}},
+		},
+	}
+
+	assert.Equal(t, []string{"step on-failure has unknown parent step-one"}, validateStepParents(opts))
+}
+
+func TestCountStepMatchConditions(t *testing.T) {
+	step := repository.CreateWorkflowStepOpts{
+		ReadableId: "step",
+		Parents:    []string{"a", "b"},
+		Conditions: []repository.CreateStepMatchConditionOpts{
+			{OrGroupId: "queue", Action: "QUEUE", ReadableDataKey: "sleep", SleepFor: repository.StringPtr("1m")},
+			{OrGroupId: "skip-1", Action: "SKIP", ReadableDataKey: "skip-1", EventKey: repository.StringPtr("skip-1")},
+			{OrGroupId: "skip-2", Action: "SKIP", ReadableDataKey: "skip-2", EventKey: repository.StringPtr("skip-2")},
+		},
+	}
+
+	// 2 parent QUEUE conditions + 1 QUEUE condition, 2 SKIP groups which each include the 2 parent SKIP
+	// conditions, and 4 parent CANCEL conditions
+	assert.Equal(t, 2+1+(2*2+2)+4, countStepMatchConditions(step))
+}
+
+func TestValidateStepConditions_TooManyMatchConditions(t *testing.T) {
+	parents := make([]string, 0, 50)
+	steps := make([]repository.CreateWorkflowStepOpts, 0, 51)
+
+	for i := 0; i < 50; i++ {
+		parent := fmt.Sprintf("parent-%d", i)
+
+		parents = append(parents, parent)
+		steps = append(steps, repository.CreateWorkflowStepOpts{ReadableId: parent})
+	}
+
+	conditions := make([]repository.CreateStepMatchConditionOpts, 0, 5)
+
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("cancel-%d", i)
+
+		conditions = append(conditions, repository.CreateStepMatchConditionOpts{
+			OrGroupId:       key,
+			Action:          "CANCEL",
+			ReadableDataKey: key,
+			EventKey:        repository.StringPtr(key),
+		})
+	}
+
+	steps = append(steps, repository.CreateWorkflowStepOpts{
+		ReadableId: "child",
+		Parents:    parents,
+		Conditions: conditions,
+	})
+
+	res := validateStepConditions(&repository.CreateWorkflowVersionOpts{
+		Jobs: []repository.CreateWorkflowJobOpts{{Name: "job", Steps: steps}},
+	})
+
+	require.Len(t, res, 1)
+	assert.Contains(t, res[0], "step child would create 605 match conditions for every run")
+}
+
+func TestNormalizeStepOpts(t *testing.T) {
+	retries := 0
+	slots := 1
+
+	step := repository.CreateWorkflowStepOpts{
+		ReadableId: "step-one",
+		Retries:    &retries,
+		Slots:      &slots,
+		UserData:   repository.StringPtr("{}"),
+		Parents:    []string{"b", "a"},
+		DesiredWorkerLabels: map[string]repository.DesiredWorkerLabelOpts{
+			"gpu": {StrValue: repository.StringPtr("a100")},
+		},
+		Concurrency: []repository.CreateStepConcurrencyOpts{
+			{Expression: "input.user_id", WeightExpression: repository.StringPtr("")},
+		},
+		RateLimits: []repository.CreateWorkflowStepRateLimitOpts{
+			{Key: "static", Duration: repository.StringPtr("SECOND")},
+			{Key: "dynamic", KeyExpr: repository.StringPtr("input.user_id")},
+		},
+	}
+
+	res := normalizeStepOpts(step)
+
+	assert.Nil(t, res.Retries)
+	assert.Nil(t, res.Slots)
+	assert.Nil(t, res.UserData)
+	assert.Equal(t, []string{"a", "b"}, res.Parents)
+
+	// the input is not modified
+	assert.Equal(t, []string{"b", "a"}, step.Parents)
+
+	label := res.DesiredWorkerLabels["gpu"]
+	assert.Equal(t, "gpu", label.Key)
+	assert.False(t, *label.Required)
+	assert.Equal(t, int32(100), *label.Weight)
+	assert.Equal(t, "EQUAL", *label.Comparator)
+
+	assert.Equal(t, int32(1), *res.Concurrency[0].MaxRuns)
+	assert.Equal(t, "CANCEL_IN_PROGRESS", *res.Concurrency[0].LimitStrategy)
+	assert.Nil(t, res.Concurrency[0].WeightExpression)
+
+	assert.Equal(t, "dynamic", res.RateLimits[0].Key)
+	assert.Equal(t, "MINUTE", *res.RateLimits[0].Duration)
+	assert.Equal(t, "static", res.RateLimits[1].Key)
+	assert.Nil(t, res.RateLimits[1].Duration)
+
+	assert.True(t, equalJSON(normalizeStepOpts(res), res), "normalizing is idempotent")
+}
+
+func TestDiffWorkflowVersionOpts_NewWorkflow(t *testing.T) {
+	newOpts := &repository.CreateWorkflowVersionOpts{
+		Name:          "workflow",
+		EventTriggers: []string{"user:create"},
+		CronTriggers:  []string{"* * * * *"},
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{Name: "job", Steps: []repository.CreateWorkflowStepOpts{{ReadableId: "step-two"}, {ReadableId: "step-one"}}},
+		},
+	}
+
+	diff := diffWorkflowVersionOpts(nil, newOpts)
+
+	assert.True(t, diff.IsNewWorkflow)
+	assert.True(t, diff.HasChanges)
+	assert.Equal(t, []string{"step-one", "step-two"}, diff.AddedSteps)
+	assert.Equal(t, []string{"user:create"}, diff.AddedEventTriggers)
+	assert.Equal(t, []string{"* * * * *"}, diff.AddedCronTriggers)
+	assert.False(t, diff.ConcurrencyChanged)
+}
+
+func TestDiffWorkflowVersionOpts(t *testing.T) {
+	retries := 0
+
+	oldOpts := &repository.CreateWorkflowVersionOpts{
+		Name:          "workflow",
+		EventTriggers: []string{"user:create", "user:update"},
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{Name: "job", Steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "unchanged", Action: "job:unchanged"},
+				{ReadableId: "changed", Action: "job:changed", Timeout: repository.StringPtr("60s")},
+				{ReadableId: "removed", Action: "job:removed"},
+			}},
+		},
+		OnFailureJob: &repository.CreateWorkflowJobOpts{
+			Name:  "on-failure",
+			Steps: []repository.CreateWorkflowStepOpts{{ReadableId: "on-failure", Action: "job:on-failure"}},
+		},
+	}
+
+	newOpts := &repository.CreateWorkflowVersionOpts{
+		Name:          "workflow",
+		EventTriggers: []string{"user:update", "user:delete"},
+		Concurrency: &repository.CreateWorkflowConcurrencyOpts{
+			Expression: repository.StringPtr("input.user_id"),
+		},
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{Name: "job", Steps: []repository.CreateWorkflowStepOpts{
+				// the defaults which are applied on write are not a change
+				{ReadableId: "unchanged", Action: "job:unchanged", Retries: &retries},
+				{ReadableId: "changed", Action: "job:changed", Timeout: repository.StringPtr("120s")},
+				{ReadableId: "added", Action: "job:added"},
+			}},
+		},
+	}
+
+	diff := diffWorkflowVersionOpts(oldOpts, newOpts)
+
+	assert.False(t, diff.IsNewWorkflow)
+	assert.Equal(t, []string{"added"}, diff.AddedSteps)
+	assert.Equal(t, []string{"changed"}, diff.ChangedSteps)
+	assert.Equal(t, []string{"on-failure.on-failure", "removed"}, diff.RemovedSteps)
+	assert.Equal(t, []string{"user:delete"}, diff.AddedEventTriggers)
+	assert.Equal(t, []string{"user:create"}, diff.RemovedEventTriggers)
+	assert.True(t, diff.ConcurrencyChanged)
+	assert.Nil(t, diff.PreviousConcurrency)
+	assert.Equal(t, "input.user_id", diff.Concurrency.GetExpression())
+}
+
+func TestGetStepSlotPools(t *testing.T) {
+	gpu := "gpu"
+	io := "io"
+	empty := ""
+
+	opts := &repository.CreateWorkflowVersionOpts{
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{ReadableId: "step-one", SlotPool: &io},
+					{ReadableId: "step-two", SlotPool: &gpu},
+					{ReadableId: "step-three", SlotPool: &io},
+					{ReadableId: "step-four", SlotPool: &empty},
+					{ReadableId: "step-five"},
+				},
+			},
+		},
+		OnFailureJob: &repository.CreateWorkflowJobOpts{
+			Name: "on-failure",
+			Steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "on-failure", SlotPool: &gpu},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"gpu", "io"}, getStepSlotPools(opts))
+	assert.Empty(t, getStepSlotPools(&repository.CreateWorkflowVersionOpts{}))
+}
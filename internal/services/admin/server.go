@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 )
 
 func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.TriggerWorkflowRequest) (*contracts.TriggerWorkflowResponse, error) {
@@ -190,16 +192,22 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		return nil, err
 	}
 
-	// validate createOpts
-	if apiErrors, err := a.v.ValidateAPI(createOpts); err != nil {
+	validationErrs, err := a.validateWorkflowOpts(ctx, tenantId, createOpts)
+
+	if err != nil {
 		return nil, err
-	} else if apiErrors != nil {
-		return nil, status.Error(
-			codes.InvalidArgument,
-			apiErrors.String(),
-		)
 	}
 
+	if len(validationErrs) > 0 {
+		return nil, workflowValidationError(validationErrs)
+	}
+
+	if req.DryRun != nil && *req.DryRun {
+		return a.putWorkflowDryRun(ctx, tenantId, createOpts)
+	}
+
+	a.warnUnregisteredSlotPools(tenantId, createOpts)
+
 	// determine if workflow already exists
 	var workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow
 	var oldWorkflowVersion *dbsqlc.GetWorkflowVersionForEngineRow
@@ -280,6 +288,30 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 	return resp, nil
 }
 
+// warnUnregisteredSlotPools logs a warning for every slot pool used by the workflow which no worker has
+// registered. The workflow is not rejected, since workers usually register their workflows before they
+// register themselves, but runs of steps in an unregistered slot pool stay queued until a worker registers it.
+func (a *AdminServiceImpl) warnUnregisteredSlotPools(tenantId string, opts *repository.CreateWorkflowVersionOpts) {
+	pools := getStepSlotPools(opts)
+
+	if len(pools) == 0 {
+		return
+	}
+
+	registered, err := a.repov1.Workers().ListRegisteredSlotPools(tenantId, pools)
+
+	if err != nil {
+		a.l.Warn().Err(err).Msgf("could not check slot pools of workflow %s", opts.Name)
+		return
+	}
+
+	for _, pool := range pools {
+		if !slices.Contains(registered, pool) {
+			a.l.Warn().Msgf("workflow %s uses slot pool %q, which is not registered by any worker in tenant %s. runs of its steps will stay queued until a worker registers the slot pool", opts.Name, pool, tenantId)
+		}
+	}
+}
+
 func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.ScheduleWorkflowRequest) (*contracts.WorkflowVersion, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -402,19 +434,324 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 	duration := req.Duration.String()
 
 	createOpts := &repository.UpsertRateLimitOpts{
-		Limit:    limit,
-		Duration: &duration,
+		Limit:     limit,
+		Duration:  &duration,
+		ParentKey: req.ParentKey,
+	}
+
+	if req.Algorithm != nil {
+		algorithm := req.Algorithm.String()
+		createOpts.Algorithm = &algorithm
+	}
+
+	if req.Burst != nil {
+		if req.GetAlgorithm() != contracts.RateLimitAlgorithm_TOKEN_BUCKET {
+			return nil, status.Error(
+				codes.InvalidArgument,
+				"burst can only be set for TOKEN_BUCKET rate limits",
+			)
+		}
+
+		burst := int(*req.Burst)
+		createOpts.Burst = &burst
 	}
 
 	_, err := a.repo.RateLimit().UpsertRateLimit(ctx, tenantId, req.Key, createOpts)
 
 	if err != nil {
+		if errors.Is(err, repository.ErrRateLimitParentNotFound) || errors.Is(err, repository.ErrRateLimitParentCycle) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, err
 	}
 
 	return &contracts.PutRateLimitResponse{}, nil
 }
 
+func (a *AdminServiceImpl) DeleteRateLimit(ctx context.Context, req *contracts.DeleteRateLimitRequest) (*contracts.DeleteRateLimitResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	if req.Key == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"key is required",
+		)
+	}
+
+	_, err := a.repov1.Scheduler().RateLimit().DeleteRateLimit(ctx, tenant.ID, req.Key)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(
+				codes.NotFound,
+				"rate limit not found",
+			)
+		}
+
+		if errors.Is(err, v1.ErrRateLimitInUse) {
+			return nil, status.Error(
+				codes.FailedPrecondition,
+				"rate limit is used by a step or another rate limit and cannot be deleted",
+			)
+		}
+
+		return nil, err
+	}
+
+	return &contracts.DeleteRateLimitResponse{}, nil
+}
+
+func (a *AdminServiceImpl) ResetRateLimit(ctx context.Context, req *contracts.ResetRateLimitRequest) (*contracts.ResetRateLimitResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	if req.Key == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"key is required",
+		)
+	}
+
+	_, err := a.repov1.Scheduler().RateLimit().ResetRateLimit(ctx, tenant.ID, req.Key)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(
+				codes.NotFound,
+				"rate limit not found",
+			)
+		}
+
+		return nil, err
+	}
+
+	return &contracts.ResetRateLimitResponse{}, nil
+}
+
+func (a *AdminServiceImpl) GetWorkflow(ctx context.Context, req *contracts.GetWorkflowRequest) (*contracts.Workflow, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersion, err := a.repo.Workflow().GetLatestWorkflowVersion(
+		ctx,
+		tenantId,
+		sqlchelpers.UUIDToStr(workflow.ID),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	versions, err := a.toWorkflowVersionsWithOpts(ctx, tenantId, []*dbsqlc.GetWorkflowVersionForEngineRow{latestVersion})
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toWorkflow(workflow)
+	resp.LatestVersion = versions[0]
+
+	return resp, nil
+}
+
+func (a *AdminServiceImpl) ListWorkflows(ctx context.Context, req *contracts.ListWorkflowsRequest) (*contracts.ListWorkflowsResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	limit := 50
+	offset := 0
+
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+
+	if req.Offset != nil {
+		offset = int(*req.Offset)
+	}
+
+	if limit <= 0 || limit > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 1 and 1000")
+	}
+
+	if offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must be non-negative")
+	}
+
+	listResp, err := a.repo.Workflow().ListWorkflows(ctx, tenantId, &repository.ListWorkflowsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+		Name:   req.Search,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflows: %w", err)
+	}
+
+	workflowIds := make([]string, len(listResp.Rows))
+
+	for i, workflow := range listResp.Rows {
+		workflowIds[i] = sqlchelpers.UUIDToStr(workflow.ID)
+	}
+
+	workflowIdsToVersions := make(map[string]*contracts.WorkflowVersion, len(workflowIds))
+
+	if len(workflowIds) > 0 {
+		latestVersions, err := a.repo.Workflow().GetLatestWorkflowVersions(ctx, tenantId, workflowIds)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get latest workflow versions: %w", err)
+		}
+
+		versions, err := a.toWorkflowVersionsWithOpts(ctx, tenantId, latestVersions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, version := range latestVersions {
+			workflowIdsToVersions[sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)] = versions[i]
+		}
+	}
+
+	workflows := make([]*contracts.Workflow, len(listResp.Rows))
+
+	for i, workflow := range listResp.Rows {
+		workflows[i] = toWorkflow(workflow)
+		workflows[i].LatestVersion = workflowIdsToVersions[workflowIds[i]]
+	}
+
+	return &contracts.ListWorkflowsResponse{
+		Workflows: workflows,
+		Total:     int64(listResp.Count),
+	}, nil
+}
+
+func (a *AdminServiceImpl) DeleteWorkflow(ctx context.Context, req *contracts.DeleteWorkflowRequest) (*contracts.Workflow, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := a.repo.Workflow().DeleteWorkflow(ctx, tenantId, sqlchelpers.UUIDToStr(workflow.ID))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete workflow: %w", err)
+	}
+
+	return toWorkflow(deleted), nil
+}
+
+func (a *AdminServiceImpl) ListWorkflowVersions(ctx context.Context, req *contracts.ListWorkflowVersionsRequest) (*contracts.ListWorkflowVersionsResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	limit := 50
+	offset := 0
+
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+
+	if req.Offset != nil {
+		offset = int(*req.Offset)
+	}
+
+	if limit <= 0 || limit > 1000 {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 1 and 1000")
+	}
+
+	if offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must be non-negative")
+	}
+
+	workflow, err := a.getWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, err := a.repo.Workflow().ListWorkflowVersions(ctx, tenantId, sqlchelpers.UUIDToStr(workflow.ID), &repository.ListWorkflowVersionsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow versions: %w", err)
+	}
+
+	versions, err := a.toWorkflowVersionsWithOpts(ctx, tenantId, listResp.Rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.ListWorkflowVersionsResponse{
+		Versions: versions,
+		Total:    int64(listResp.Count),
+	}, nil
+}
+
+func (a *AdminServiceImpl) getWorkflowByName(ctx context.Context, tenantId, name string) (*dbsqlc.Workflow, error) {
+	if name == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"name is required",
+		)
+	}
+
+	workflow, err := a.repo.Workflow().GetWorkflowByName(
+		ctx,
+		tenantId,
+		name,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"workflow %s not found",
+				name,
+			)
+		}
+
+		return nil, fmt.Errorf("could not get workflow by name: %w", err)
+	}
+
+	return workflow, nil
+}
+
+// toWorkflowVersionsWithOpts converts the workflow versions along with their definitions, which are
+// loaded for all of the versions at once.
+func (a *AdminServiceImpl) toWorkflowVersionsWithOpts(ctx context.Context, tenantId string, workflowVersions []*dbsqlc.GetWorkflowVersionForEngineRow) ([]*contracts.WorkflowVersion, error) {
+	opts, err := a.repo.Workflow().ListWorkflowVersionOpts(ctx, tenantId, workflowVersions)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version definitions: %w", err)
+	}
+
+	res := make([]*contracts.WorkflowVersion, len(workflowVersions))
+
+	for i, workflowVersion := range workflowVersions {
+		res[i] = toWorkflowVersion(workflowVersion, nil)
+
+		if versionOpts, ok := opts[sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID)]; ok {
+			res[i].Opts = toCreateWorkflowVersionOpts(versionOpts)
+		}
+	}
+
+	return res, nil
+}
+
 func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.CreateWorkflowVersionOpts, error) {
 	jobs := make([]repository.CreateWorkflowJobOpts, len(req.Opts.Jobs))
 
@@ -479,10 +816,11 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 		}
 
 		concurrency = &repository.CreateWorkflowConcurrencyOpts{
-			Action:        req.Opts.Concurrency.Action,
-			LimitStrategy: limitStrategy,
-			Expression:    req.Opts.Concurrency.Expression,
-			MaxRuns:       req.Opts.Concurrency.MaxRuns,
+			Action:           req.Opts.Concurrency.Action,
+			LimitStrategy:    limitStrategy,
+			Expression:       req.Opts.Concurrency.Expression,
+			MaxRuns:          req.Opts.Concurrency.MaxRuns,
+			WeightExpression: req.Opts.Concurrency.WeightExpression,
 		}
 	}
 
@@ -499,20 +837,21 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 	}
 
 	return &repository.CreateWorkflowVersionOpts{
-		Name:              req.Opts.Name,
-		Concurrency:       concurrency,
-		Description:       &req.Opts.Description,
-		Version:           &req.Opts.Version,
-		EventTriggers:     req.Opts.EventTriggers,
-		CronTriggers:      req.Opts.CronTriggers,
-		CronInput:         cronInput,
-		ScheduledTriggers: scheduledTriggers,
-		Jobs:              jobs,
-		OnFailureJob:      onFailureJob,
-		ScheduleTimeout:   req.Opts.ScheduleTimeout,
-		Sticky:            sticky,
-		Kind:              kind,
-		DefaultPriority:   req.Opts.DefaultPriority,
+		Name:                req.Opts.Name,
+		Concurrency:         concurrency,
+		Description:         &req.Opts.Description,
+		Version:             &req.Opts.Version,
+		EventTriggers:       req.Opts.EventTriggers,
+		EventTriggerFilters: req.Opts.EventTriggerFilters,
+		CronTriggers:        req.Opts.CronTriggers,
+		CronInput:           cronInput,
+		ScheduledTriggers:   scheduledTriggers,
+		Jobs:                jobs,
+		OnFailureJob:        onFailureJob,
+		ScheduleTimeout:     req.Opts.ScheduleTimeout,
+		Sticky:              sticky,
+		Kind:                kind,
+		DefaultPriority:     req.Opts.DefaultPriority,
 	}, nil
 }
 
@@ -589,6 +928,7 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 				KeyExpr:   rateLimit.KeyExpr,
 				LimitExpr: rateLimit.LimitValuesExpr,
 				UnitsExpr: rateLimit.UnitsExpr,
+				ParentKey: rateLimit.ParentKey,
 			}
 
 			if rateLimit.Duration != nil {
@@ -607,6 +947,55 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 		if stepCp.UserData != "" {
 			steps[j].UserData = &stepCp.UserData
 		}
+
+		if stepCp.SkipIf != nil && *stepCp.SkipIf != "" {
+			steps[j].SkipIf = stepCp.SkipIf
+		}
+
+		if stepCp.Map != nil {
+			steps[j].Map = &repository.CreateStepMapOpts{
+				Expression: stepCp.Map.Expression,
+			}
+
+			if stepCp.Map.MaxParallelism != nil {
+				maxParallelism := int(*stepCp.Map.MaxParallelism)
+				steps[j].Map.MaxParallelism = &maxParallelism
+			}
+		}
+
+		if stepCp.Slots != nil {
+			slots := int(*stepCp.Slots)
+			steps[j].Slots = &slots
+		}
+
+		if stepCp.SlotPool != nil && *stepCp.SlotPool != "" {
+			steps[j].SlotPool = stepCp.SlotPool
+		}
+
+		for _, concurrency := range stepCp.Concurrency {
+			concurrencyOpts := repository.CreateStepConcurrencyOpts{
+				Expression:       concurrency.Expression,
+				MaxRuns:          concurrency.MaxRuns,
+				WeightExpression: concurrency.WeightExpression,
+			}
+
+			if concurrency.LimitStrategy != nil {
+				concurrencyOpts.LimitStrategy = repository.StringPtr(concurrency.LimitStrategy.String())
+			}
+
+			steps[j].Concurrency = append(steps[j].Concurrency, concurrencyOpts)
+		}
+
+		for _, condition := range stepCp.Conditions {
+			steps[j].Conditions = append(steps[j].Conditions, repository.CreateStepMatchConditionOpts{
+				OrGroupId:       condition.OrGroupId,
+				Action:          condition.Action.String(),
+				ReadableDataKey: condition.ReadableDataKey,
+				SleepFor:        condition.SleepFor,
+				EventKey:        condition.UserEventKey,
+				Expression:      condition.Expression,
+			})
+		}
 	}
 
 	// Check if parents are in the map
@@ -652,6 +1041,230 @@ func toWorkflowVersion(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow, s
 	return version
 }
 
+func toWorkflow(workflow *dbsqlc.Workflow) *contracts.Workflow {
+	return &contracts.Workflow{
+		Id:          sqlchelpers.UUIDToStr(workflow.ID),
+		CreatedAt:   timestamppb.New(workflow.CreatedAt.Time),
+		UpdatedAt:   timestamppb.New(workflow.UpdatedAt.Time),
+		Name:        workflow.Name,
+		Description: workflow.Description.String,
+		IsPaused:    workflow.IsPaused.Valid && workflow.IsPaused.Bool,
+	}
+}
+
+// toCreateWorkflowVersionOpts is the inverse of getCreateWorkflowOpts
+func toCreateWorkflowVersionOpts(opts *repository.CreateWorkflowVersionOpts) *contracts.CreateWorkflowVersionOpts {
+	res := &contracts.CreateWorkflowVersionOpts{
+		Name:                opts.Name,
+		EventTriggers:       opts.EventTriggers,
+		EventTriggerFilters: opts.EventTriggerFilters,
+		CronTriggers:        opts.CronTriggers,
+		ScheduleTimeout:     opts.ScheduleTimeout,
+		DefaultPriority:     opts.DefaultPriority,
+	}
+
+	if opts.Description != nil {
+		res.Description = *opts.Description
+	}
+
+	if opts.Version != nil {
+		res.Version = *opts.Version
+	}
+
+	if len(opts.CronInput) > 0 {
+		cronInput := string(opts.CronInput)
+		res.CronInput = &cronInput
+	}
+
+	for _, scheduled := range opts.ScheduledTriggers {
+		res.ScheduledTriggers = append(res.ScheduledTriggers, timestamppb.New(scheduled))
+	}
+
+	for i := range opts.Jobs {
+		res.Jobs = append(res.Jobs, toCreateWorkflowJobOpts(&opts.Jobs[i]))
+	}
+
+	if opts.OnFailureJob != nil {
+		res.OnFailureJob = toCreateWorkflowJobOpts(opts.OnFailureJob)
+	}
+
+	if opts.Concurrency != nil {
+		res.Concurrency = &contracts.WorkflowConcurrencyOpts{
+			Action:           opts.Concurrency.Action,
+			MaxRuns:          opts.Concurrency.MaxRuns,
+			Expression:       opts.Concurrency.Expression,
+			WeightExpression: opts.Concurrency.WeightExpression,
+		}
+
+		if opts.Concurrency.LimitStrategy != nil {
+			if v, ok := contracts.ConcurrencyLimitStrategy_value[*opts.Concurrency.LimitStrategy]; ok {
+				strategy := contracts.ConcurrencyLimitStrategy(v)
+				res.Concurrency.LimitStrategy = &strategy
+			}
+		}
+	}
+
+	if opts.Sticky != nil {
+		if v, ok := contracts.StickyStrategy_value[*opts.Sticky]; ok {
+			sticky := contracts.StickyStrategy(v)
+			res.Sticky = &sticky
+		}
+	}
+
+	if opts.Kind != nil {
+		if v, ok := contracts.WorkflowKind_value[*opts.Kind]; ok {
+			kind := contracts.WorkflowKind(v)
+			res.Kind = &kind
+		}
+	}
+
+	return res
+}
+
+func toCreateWorkflowJobOpts(job *repository.CreateWorkflowJobOpts) *contracts.CreateWorkflowJobOpts {
+	res := &contracts.CreateWorkflowJobOpts{
+		Name:  job.Name,
+		Steps: make([]*contracts.CreateWorkflowStepOpts, len(job.Steps)),
+	}
+
+	if job.Description != nil {
+		res.Description = *job.Description
+	}
+
+	for i, step := range job.Steps {
+		stepOpts := &contracts.CreateWorkflowStepOpts{
+			ReadableId: step.ReadableId,
+			Action:     step.Action,
+			Parents:    step.Parents,
+		}
+
+		if step.Timeout != nil {
+			stepOpts.Timeout = *step.Timeout
+		}
+
+		if step.UserData != nil {
+			stepOpts.UserData = *step.UserData
+		}
+
+		if step.Retries != nil {
+			stepOpts.Retries = int32(*step.Retries) // nolint: gosec
+		}
+
+		if step.RetryBackoffFactor != nil {
+			backoffFactor := float32(*step.RetryBackoffFactor)
+			stepOpts.BackoffFactor = &backoffFactor
+		}
+
+		if step.RetryBackoffMaxSeconds != nil {
+			backoffMaxSeconds := int32(*step.RetryBackoffMaxSeconds) // nolint: gosec
+			stepOpts.BackoffMaxSeconds = &backoffMaxSeconds
+		}
+
+		for _, rateLimit := range step.RateLimits {
+			rateLimitOpts := &contracts.CreateStepRateLimit{
+				Key:             rateLimit.Key,
+				KeyExpr:         rateLimit.KeyExpr,
+				UnitsExpr:       rateLimit.UnitsExpr,
+				LimitValuesExpr: rateLimit.LimitExpr,
+				ParentKey:       rateLimit.ParentKey,
+			}
+
+			if rateLimit.Units != nil {
+				units := int32(*rateLimit.Units) // nolint: gosec
+				rateLimitOpts.Units = &units
+			}
+
+			if rateLimit.Duration != nil {
+				if v, ok := contracts.RateLimitDuration_value[*rateLimit.Duration]; ok {
+					duration := contracts.RateLimitDuration(v)
+					rateLimitOpts.Duration = &duration
+				}
+			}
+
+			stepOpts.RateLimits = append(stepOpts.RateLimits, rateLimitOpts)
+		}
+
+		if len(step.DesiredWorkerLabels) > 0 {
+			stepOpts.WorkerLabels = make(map[string]*contracts.DesiredWorkerLabels, len(step.DesiredWorkerLabels))
+
+			for key, label := range step.DesiredWorkerLabels {
+				labelOpts := &contracts.DesiredWorkerLabels{
+					StrValue: label.StrValue,
+					IntValue: label.IntValue,
+					Required: label.Required,
+					Weight:   label.Weight,
+				}
+
+				if label.Comparator != nil {
+					if v, ok := contracts.WorkerLabelComparator_value[*label.Comparator]; ok {
+						comparator := contracts.WorkerLabelComparator(v)
+						labelOpts.Comparator = &comparator
+					}
+				}
+
+				stepOpts.WorkerLabels[key] = labelOpts
+			}
+		}
+
+		for _, condition := range step.Conditions {
+			conditionOpts := &contracts.StepMatchCondition{
+				OrGroupId:       condition.OrGroupId,
+				ReadableDataKey: condition.ReadableDataKey,
+				SleepFor:        condition.SleepFor,
+				UserEventKey:    condition.EventKey,
+				Expression:      condition.Expression,
+			}
+
+			if v, ok := contracts.StepMatchConditionAction_value[condition.Action]; ok {
+				conditionOpts.Action = contracts.StepMatchConditionAction(v)
+			}
+
+			stepOpts.Conditions = append(stepOpts.Conditions, conditionOpts)
+		}
+
+		stepOpts.SkipIf = step.SkipIf
+
+		if step.Map != nil {
+			stepOpts.Map = &contracts.StepMap{
+				Expression: step.Map.Expression,
+			}
+
+			if step.Map.MaxParallelism != nil {
+				maxParallelism := int32(*step.Map.MaxParallelism) // nolint: gosec
+				stepOpts.Map.MaxParallelism = &maxParallelism
+			}
+		}
+
+		for _, concurrency := range step.Concurrency {
+			concurrencyOpts := &contracts.StepConcurrencyOpts{
+				Expression:       concurrency.Expression,
+				MaxRuns:          concurrency.MaxRuns,
+				WeightExpression: concurrency.WeightExpression,
+			}
+
+			if concurrency.LimitStrategy != nil {
+				if v, ok := contracts.ConcurrencyLimitStrategy_value[*concurrency.LimitStrategy]; ok {
+					strategy := contracts.ConcurrencyLimitStrategy(v)
+					concurrencyOpts.LimitStrategy = &strategy
+				}
+			}
+
+			stepOpts.Concurrency = append(stepOpts.Concurrency, concurrencyOpts)
+		}
+
+		if step.Slots != nil {
+			slots := int32(*step.Slots) // nolint: gosec
+			stepOpts.Slots = &slots
+		}
+
+		stepOpts.SlotPool = step.SlotPool
+
+		res.Steps[i] = stepOpts
+	}
+
+	return res
+}
+
 func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest, a *AdminServiceImpl) ([]*repository.CreateWorkflowRunOpts, []string, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -668,6 +1281,10 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 	childWorkflowMap := make(map[string]*dbsqlc.WorkflowRun)
 
 	for _, req := range requests {
+		if req.IdempotencyKey != nil {
+			return nil, nil, errIdempotencyKeyNotSupported
+		}
+
 		isParentTriggered := req.ParentId != nil
 
 		if isParentTriggered {
@@ -718,6 +1335,10 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 	}
 
 	for _, req := range requests {
+		if req.IdempotencyKey != nil {
+			return nil, nil, errIdempotencyKeyNotSupported
+		}
+
 		isParentTriggered := req.ParentId != nil
 
 		if isParentTriggered {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
@@ -16,6 +17,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var errIdempotencyKeyNotSupported = status.Error(codes.FailedPrecondition, "idempotency keys are only supported by the v1 engine")
+
 func (a *AdminServiceImpl) triggerWorkflowV1(ctx context.Context, req *contracts.TriggerWorkflowRequest) (*contracts.TriggerWorkflowResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -39,6 +42,7 @@ func (a *AdminServiceImpl) triggerWorkflowV1(ctx context.Context, req *contracts
 	)
 
 	if err != nil {
+		a.releaseIdempotencyKeys(ctx, tenantId, opt)
 		return nil, fmt.Errorf("could not trigger workflow: %w", err)
 	}
 
@@ -76,6 +80,7 @@ func (a *AdminServiceImpl) bulkTriggerWorkflowV1(ctx context.Context, req *contr
 	)
 
 	if err != nil {
+		a.releaseIdempotencyKeys(ctx, tenantId, opts...)
 		return nil, err
 	}
 
@@ -131,6 +136,24 @@ func (i *AdminServiceImpl) newTriggerOpt(
 		t.ChildKey = req.ChildKey
 	}
 
+	if req.IdempotencyKey != nil {
+		// child workflows are already deduplicated by their child index and key
+		if t.ParentExternalId != nil {
+			return nil, status.Error(codes.InvalidArgument, "idempotency keys cannot be set on child workflows, use a child key instead")
+		}
+
+		t.IdempotencyKey = req.IdempotencyKey
+
+		if req.IdempotencyKeyTtlSeconds != nil {
+			ttl := time.Duration(*req.IdempotencyKeyTtlSeconds) * time.Second
+			t.IdempotencyKeyTTL = &ttl
+		}
+	}
+
+	if err := i.v.Validate(t); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
+	}
+
 	return &v1.WorkflowNameTriggerOpts{
 		TriggerTaskData: t,
 	}, nil
@@ -140,6 +163,22 @@ func (i *AdminServiceImpl) generateExternalIds(ctx context.Context, tenantId str
 	return i.repov1.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, opts)
 }
 
+// releaseIdempotencyKeys releases the idempotency keys which were claimed by workflow runs that could not be
+// triggered, so that the trigger can be retried with the same key.
+func (i *AdminServiceImpl) releaseIdempotencyKeys(ctx context.Context, tenantId string, opts ...*v1.WorkflowNameTriggerOpts) {
+	externalIds := make([]string, 0)
+
+	for _, opt := range opts {
+		if opt.IdempotencyKey != nil && !opt.ShouldSkip {
+			externalIds = append(externalIds, opt.ExternalId)
+		}
+	}
+
+	if err := i.repov1.Triggers().ReleaseIdempotencyKeys(ctx, tenantId, externalIds); err != nil {
+		i.l.Error().Err(err).Msg("could not release idempotency keys")
+	}
+}
+
 func (i *AdminServiceImpl) ingest(ctx context.Context, tenantId string, opts ...*v1.WorkflowNameTriggerOpts) error {
 	optsToSend := make([]*v1.WorkflowNameTriggerOpts, 0)
 
@@ -3,8 +3,11 @@ package v1
 import (
 	"fmt"
 
+	"github.com/rs/zerolog"
+
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts/v1"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
 	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
@@ -19,6 +22,7 @@ type AdminServiceImpl struct {
 	repo v1.Repository
 	mq   msgqueue.MessageQueue
 	v    validator.Validator
+	l    *zerolog.Logger
 }
 
 type AdminServiceOpt func(*AdminServiceOpts)
@@ -27,13 +31,16 @@ type AdminServiceOpts struct {
 	repo v1.Repository
 	mq   msgqueue.MessageQueue
 	v    validator.Validator
+	l    *zerolog.Logger
 }
 
 func defaultAdminServiceOpts() *AdminServiceOpts {
 	v := validator.NewDefaultValidator()
+	logger := logger.NewDefaultLogger("admin-v1")
 
 	return &AdminServiceOpts{
 		v: v,
+		l: &logger,
 	}
 }
 
@@ -55,6 +62,12 @@ func WithValidator(v validator.Validator) AdminServiceOpt {
 	}
 }
 
+func WithLogger(l *zerolog.Logger) AdminServiceOpt {
+	return func(opts *AdminServiceOpts) {
+		opts.l = l
+	}
+}
+
 func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 	opts := defaultAdminServiceOpts()
 
@@ -74,5 +87,6 @@ func NewAdminService(fs ...AdminServiceOpt) (AdminService, error) {
 		repo: opts.repo,
 		mq:   opts.mq,
 		v:    opts.v,
+		l:    opts.l,
 	}, nil
 }
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	contracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts/v1"
@@ -22,84 +24,28 @@ import (
 
 func (a *AdminServiceImpl) CancelTasks(ctx context.Context, req *contracts.CancelTasksRequest) (*contracts.CancelTasksResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	externalIds := req.ExternalIds
+	resp := &contracts.CancelTasksResponse{
+		CancelledTasks: req.ExternalIds,
+	}
 
+	// tasks matching a filter are cancelled asynchronously in batches by the tasks controller
 	if req.Filter != nil {
-		var (
-			statuses = []sqlcv1.V1ReadableStatusOlap{
-				sqlcv1.V1ReadableStatusOlapQUEUED,
-				sqlcv1.V1ReadableStatusOlapRUNNING,
-				sqlcv1.V1ReadableStatusOlapFAILED,
-				sqlcv1.V1ReadableStatusOlapCOMPLETED,
-				sqlcv1.V1ReadableStatusOlapCANCELLED,
-			}
-			since       = req.Filter.Since.AsTime()
-			until       *time.Time
-			workflowIds       = []uuid.UUID{}
-			limit       int64 = 20000
-			offset      int64
-		)
-
-		if len(req.Filter.Statuses) > 0 {
-			statuses = []sqlcv1.V1ReadableStatusOlap{}
-
-			for _, status := range req.Filter.Statuses {
-				statuses = append(statuses, sqlcv1.V1ReadableStatusOlap(status))
-			}
-		}
-
-		if len(req.Filter.WorkflowIds) > 0 {
-			for _, id := range req.Filter.WorkflowIds {
-				workflowIds = append(workflowIds, uuid.MustParse(id))
-			}
-		}
-
-		if req.Filter.Until != nil {
-			t := req.Filter.Until.AsTime()
-			until = &t
-		}
-
-		var additionalMetadataFilters map[string]interface{}
-
-		if len(req.Filter.AdditionalMetadata) > 0 {
-			additionalMetadataFilters := make(map[string]interface{})
-			for _, v := range req.Filter.AdditionalMetadata {
-				kv_pairs := strings.Split(v, ":")
-				if len(kv_pairs) == 2 {
-					additionalMetadataFilters[kv_pairs[0]] = kv_pairs[1]
-				} else {
-					return nil, status.Errorf(codes.InvalidArgument, "invalid additional metadata filter: %s", v)
-				}
-			}
-		}
-
-		opts := v1.ListWorkflowRunOpts{
-			CreatedAfter:       since,
-			FinishedBefore:     until,
-			Statuses:           statuses,
-			WorkflowIds:        workflowIds,
-			Limit:              limit,
-			Offset:             offset,
-			AdditionalMetadata: additionalMetadataFilters,
-		}
-
-		runs, _, err := a.repo.OLAP().ListWorkflowRuns(ctx, sqlchelpers.UUIDToStr(tenant.ID), opts)
+		bulkOperationId, err := a.createBulkOperation(ctx, tenantId, sqlcv1.V1BulkOperationKindCANCEL, req.Filter)
 
 		if err != nil {
 			return nil, err
 		}
 
-		runExternalIds := make([]string, len(runs))
-
-		for i, run := range runs {
-			runExternalIds[i] = sqlchelpers.UUIDToStr(run.ExternalID)
-		}
+		resp.BulkOperationId = &bulkOperationId
+	}
 
-		externalIds = append(externalIds, runExternalIds...)
+	if len(req.ExternalIds) == 0 {
+		return resp, nil
 	}
 
-	tasks, err := a.repo.Tasks().FlattenExternalIds(ctx, sqlchelpers.UUIDToStr(tenant.ID), externalIds)
+	tasks, err := a.repo.Tasks().FlattenExternalIds(ctx, tenantId, req.ExternalIds)
 
 	if err != nil {
 		return nil, err
@@ -121,7 +67,7 @@ func (a *AdminServiceImpl) CancelTasks(ctx context.Context, req *contracts.Cance
 	}
 
 	msg, err := msgqueue.NewTenantMessage(
-		sqlchelpers.UUIDToStr(tenant.ID),
+		tenantId,
 		"cancel-tasks",
 		false,
 		true,
@@ -138,93 +84,35 @@ func (a *AdminServiceImpl) CancelTasks(ctx context.Context, req *contracts.Cance
 		return nil, err
 	}
 
-	return &contracts.CancelTasksResponse{
-		CancelledTasks: externalIds,
-	}, nil
+	return resp, nil
 }
 
 func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.ReplayTasksRequest) (*contracts.ReplayTasksResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	externalIds := req.ExternalIds
+	resp := &contracts.ReplayTasksResponse{
+		ReplayedTasks: req.ExternalIds,
+	}
 
+	// tasks matching a filter are replayed asynchronously in batches by the tasks controller
 	if req.Filter != nil {
-		var (
-			statuses = []sqlcv1.V1ReadableStatusOlap{
-				sqlcv1.V1ReadableStatusOlapQUEUED,
-				sqlcv1.V1ReadableStatusOlapRUNNING,
-				sqlcv1.V1ReadableStatusOlapFAILED,
-				sqlcv1.V1ReadableStatusOlapCOMPLETED,
-				sqlcv1.V1ReadableStatusOlapCANCELLED,
-			}
-			since       = req.Filter.Since.AsTime()
-			until       *time.Time
-			workflowIds       = []uuid.UUID{}
-			limit       int64 = 1000
-			offset      int64
-		)
-
-		if len(req.Filter.Statuses) > 0 {
-			statuses = []sqlcv1.V1ReadableStatusOlap{}
-
-			for _, status := range req.Filter.Statuses {
-				statuses = append(statuses, sqlcv1.V1ReadableStatusOlap(status))
-			}
-		}
-
-		if len(req.Filter.WorkflowIds) > 0 {
-			for _, id := range req.Filter.WorkflowIds {
-				workflowIds = append(workflowIds, uuid.MustParse(id))
-			}
-		}
-
-		if req.Filter.Until != nil {
-			t := req.Filter.Until.AsTime()
-			until = &t
-		}
-
-		var additionalMetadataFilters map[string]interface{}
-
-		if len(req.Filter.AdditionalMetadata) > 0 {
-			additionalMetadataFilters := make(map[string]interface{})
-			for _, v := range req.Filter.AdditionalMetadata {
-				kv_pairs := strings.Split(v, ":")
-				if len(kv_pairs) == 2 {
-					additionalMetadataFilters[kv_pairs[0]] = kv_pairs[1]
-				} else {
-					return nil, status.Errorf(codes.InvalidArgument, "invalid additional metadata filter: %s", v)
-				}
-			}
-		}
-
-		opts := v1.ListWorkflowRunOpts{
-			CreatedAfter:       since,
-			FinishedBefore:     until,
-			Statuses:           statuses,
-			WorkflowIds:        workflowIds,
-			Limit:              limit,
-			Offset:             offset,
-			AdditionalMetadata: additionalMetadataFilters,
-		}
-
-		runs, _, err := a.repo.OLAP().ListWorkflowRuns(ctx, sqlchelpers.UUIDToStr(tenant.ID), opts)
+		bulkOperationId, err := a.createBulkOperation(ctx, tenantId, sqlcv1.V1BulkOperationKindREPLAY, req.Filter)
 
 		if err != nil {
 			return nil, err
 		}
 
-		runExternalIds := make([]string, len(runs))
-
-		for i, run := range runs {
-			runExternalIds[i] = sqlchelpers.UUIDToStr(run.ExternalID)
-		}
+		resp.BulkOperationId = &bulkOperationId
+	}
 
-		externalIds = append(externalIds, runExternalIds...)
+	if len(req.ExternalIds) == 0 {
+		return resp, nil
 	}
 
 	tasksToReplay := []v1.TaskIdInsertedAtRetryCount{}
 
-	tasks, err := a.repo.Tasks().FlattenExternalIds(ctx, sqlchelpers.UUIDToStr(tenant.ID), externalIds)
+	tasks, err := a.repo.Tasks().FlattenExternalIds(ctx, tenantId, req.ExternalIds)
 
 	if err != nil {
 		return nil, err
@@ -238,15 +126,13 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 		})
 	}
 
-	// FIXME: group tasks by their workflow run id, and send in batches of 50 workflow run ids...
-
 	// send the payload to the tasks controller, and send the list of tasks back to the client
 	toReplay := tasktypes.ReplayTasksPayload{
 		Tasks: tasksToReplay,
 	}
 
 	msg, err := msgqueue.NewTenantMessage(
-		sqlchelpers.UUIDToStr(tenant.ID),
+		tenantId,
 		"replay-tasks",
 		false,
 		true,
@@ -263,9 +149,142 @@ func (a *AdminServiceImpl) ReplayTasks(ctx context.Context, req *contracts.Repla
 		return nil, err
 	}
 
-	return &contracts.ReplayTasksResponse{
-		ReplayedTasks: externalIds,
-	}, nil
+	return resp, nil
+}
+
+func (a *AdminServiceImpl) GetBulkOperation(ctx context.Context, req *contracts.GetBulkOperationRequest) (*contracts.BulkOperation, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if _, err := uuid.Parse(req.BulkOperationId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "bulk operation id must be a valid uuid")
+	}
+
+	op, err := a.repo.BulkOperations().GetBulkOperation(ctx, tenantId, req.BulkOperationId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "bulk operation %s not found", req.BulkOperationId)
+		}
+
+		return nil, fmt.Errorf("could not get bulk operation: %w", err)
+	}
+
+	return toBulkOperation(op), nil
+}
+
+// createBulkOperation stores the filter on a new bulk operation, and kicks off the bulk operation in the
+// tasks controller.
+func (a *AdminServiceImpl) createBulkOperation(ctx context.Context, tenantId string, kind sqlcv1.V1BulkOperationKind, f *contracts.TasksFilter) (string, error) {
+	filter, err := toBulkOperationFilter(f)
+
+	if err != nil {
+		return "", err
+	}
+
+	op, err := a.repo.BulkOperations().CreateBulkOperation(ctx, tenantId, v1.CreateBulkOperationOpts{
+		Kind:   kind,
+		Filter: *filter,
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not create bulk operation: %w", err)
+	}
+
+	bulkOperationId := sqlchelpers.UUIDToStr(op.ID)
+
+	msg, err := tasktypes.BulkOperationMessage(tenantId, op.Kind, bulkOperationId, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	err = a.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+
+	if err != nil {
+		return "", err
+	}
+
+	return bulkOperationId, nil
+}
+
+func toBulkOperationFilter(f *contracts.TasksFilter) (*v1.BulkOperationFilter, error) {
+	filter := &v1.BulkOperationFilter{
+		Since: f.Since.AsTime(),
+	}
+
+	for _, readableStatus := range f.Statuses {
+		filter.Statuses = append(filter.Statuses, sqlcv1.V1ReadableStatusOlap(readableStatus))
+	}
+
+	for _, id := range f.WorkflowIds {
+		workflowId, err := uuid.Parse(id)
+
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid workflow id: %s", id)
+		}
+
+		filter.WorkflowIds = append(filter.WorkflowIds, workflowId)
+	}
+
+	if f.Until != nil {
+		t := f.Until.AsTime()
+		filter.Until = &t
+	}
+
+	if len(f.AdditionalMetadata) > 0 {
+		filter.AdditionalMetadata = make(map[string]interface{})
+
+		for _, v := range f.AdditionalMetadata {
+			kv_pairs := strings.Split(v, ":")
+			if len(kv_pairs) == 2 {
+				filter.AdditionalMetadata[kv_pairs[0]] = kv_pairs[1]
+			} else {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid additional metadata filter: %s", v)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+func toBulkOperation(op *sqlcv1.V1BulkOperation) *contracts.BulkOperation {
+	res := &contracts.BulkOperation{
+		Id:             sqlchelpers.UUIDToStr(op.ID),
+		MatchedCount:   op.MatchedCount,
+		ProcessedCount: op.ProcessedCount,
+		FailedCount:    op.FailedCount,
+		CreatedAt:      timestamppb.New(op.CreatedAt.Time),
+		UpdatedAt:      timestamppb.New(op.UpdatedAt.Time),
+	}
+
+	switch op.Kind {
+	case sqlcv1.V1BulkOperationKindCANCEL:
+		res.Kind = contracts.BulkOperationKind_BULK_OPERATION_KIND_CANCEL
+	case sqlcv1.V1BulkOperationKindREPLAY:
+		res.Kind = contracts.BulkOperationKind_BULK_OPERATION_KIND_REPLAY
+	}
+
+	switch op.Status {
+	case sqlcv1.V1BulkOperationStatusPENDING:
+		res.Status = contracts.BulkOperationStatus_BULK_OPERATION_STATUS_PENDING
+	case sqlcv1.V1BulkOperationStatusRUNNING:
+		res.Status = contracts.BulkOperationStatus_BULK_OPERATION_STATUS_RUNNING
+	case sqlcv1.V1BulkOperationStatusCOMPLETED:
+		res.Status = contracts.BulkOperationStatus_BULK_OPERATION_STATUS_COMPLETED
+	case sqlcv1.V1BulkOperationStatusFAILED:
+		res.Status = contracts.BulkOperationStatus_BULK_OPERATION_STATUS_FAILED
+	}
+
+	if op.ErrorMessage.Valid {
+		res.ErrorMessage = &op.ErrorMessage.String
+	}
+
+	if op.FinishedAt.Valid {
+		res.FinishedAt = timestamppb.New(op.FinishedAt.Time)
+	}
+
+	return res
 }
 
 func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contracts.TriggerWorkflowRunRequest) (*contracts.TriggerWorkflowRunResponse, error) {
@@ -291,6 +310,7 @@ func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contract
 	)
 
 	if err != nil {
+		a.releaseIdempotencyKeys(ctx, tenantId, opt)
 		return nil, err
 	}
 
@@ -299,6 +319,94 @@ func (a *AdminServiceImpl) TriggerWorkflowRun(ctx context.Context, req *contract
 	}, nil
 }
 
+func (a *AdminServiceImpl) PauseWorkflow(ctx context.Context, req *contracts.PauseWorkflowRequest) (*contracts.PauseWorkflowResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.setWorkflowPaused(ctx, tenantId, req.WorkflowName, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.PauseWorkflowResponse{
+		WorkflowId: sqlchelpers.UUIDToStr(workflow.ID),
+		IsPaused:   workflow.IsPaused.Bool,
+	}, nil
+}
+
+func (a *AdminServiceImpl) ResumeWorkflow(ctx context.Context, req *contracts.ResumeWorkflowRequest) (*contracts.ResumeWorkflowResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	workflow, err := a.setWorkflowPaused(ctx, tenantId, req.WorkflowName, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// queue items which were skipped while the workflow was paused are picked up on the next
+	// queue poll, so there's no need to notify the scheduler here
+	return &contracts.ResumeWorkflowResponse{
+		WorkflowId: sqlchelpers.UUIDToStr(workflow.ID),
+		IsPaused:   workflow.IsPaused.Bool,
+	}, nil
+}
+
+func (a *AdminServiceImpl) SetConcurrencyKeyWeight(ctx context.Context, req *contracts.SetConcurrencyKeyWeightRequest) (*contracts.SetConcurrencyKeyWeightResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	if req.Weight < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "weight must be at least 1, got %d", req.Weight)
+	}
+
+	err := a.repo.Scheduler().Concurrency().UpsertConcurrencyKeyWeight(ctx, tenant.ID, req.Key, req.Weight)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not set concurrency key weight: %w", err)
+	}
+
+	return &contracts.SetConcurrencyKeyWeightResponse{}, nil
+}
+
+func (a *AdminServiceImpl) DeleteConcurrencyKeyWeight(ctx context.Context, req *contracts.DeleteConcurrencyKeyWeightRequest) (*contracts.DeleteConcurrencyKeyWeightResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	if req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	err := a.repo.Scheduler().Concurrency().DeleteConcurrencyKeyWeight(ctx, tenant.ID, req.Key)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete concurrency key weight: %w", err)
+	}
+
+	return &contracts.DeleteConcurrencyKeyWeightResponse{}, nil
+}
+
+func (a *AdminServiceImpl) setWorkflowPaused(ctx context.Context, tenantId, workflowName string, isPaused bool) (*sqlcv1.Workflow, error) {
+	if workflowName == "" {
+		return nil, status.Error(codes.InvalidArgument, "workflow name is required")
+	}
+
+	workflow, err := a.repo.Workflows().SetWorkflowPaused(ctx, tenantId, workflowName, isPaused)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "workflow %s not found", workflowName)
+		}
+
+		return nil, fmt.Errorf("could not update workflow: %w", err)
+	}
+
+	return workflow, nil
+}
+
 func (i *AdminServiceImpl) newTriggerOpt(
 	ctx context.Context,
 	tenantId string,
@@ -308,6 +416,16 @@ func (i *AdminServiceImpl) newTriggerOpt(
 		WorkflowName:       req.WorkflowName,
 		Data:               req.Input,
 		AdditionalMetadata: req.AdditionalMetadata,
+		IdempotencyKey:     req.IdempotencyKey,
+	}
+
+	if req.IdempotencyKeyTtlSeconds != nil {
+		ttl := time.Duration(*req.IdempotencyKeyTtlSeconds) * time.Second
+		t.IdempotencyKeyTTL = &ttl
+	}
+
+	if err := i.v.Validate(t); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
 	}
 
 	return &v1.WorkflowNameTriggerOpts{
@@ -319,6 +437,22 @@ func (i *AdminServiceImpl) generateExternalIds(ctx context.Context, tenantId str
 	return i.repo.Triggers().PopulateExternalIdsForWorkflow(ctx, tenantId, opts)
 }
 
+// releaseIdempotencyKeys releases the idempotency keys which were claimed by workflow runs that could not be
+// triggered, so that the trigger can be retried with the same key.
+func (i *AdminServiceImpl) releaseIdempotencyKeys(ctx context.Context, tenantId string, opts ...*v1.WorkflowNameTriggerOpts) {
+	externalIds := make([]string, 0)
+
+	for _, opt := range opts {
+		if opt.IdempotencyKey != nil && !opt.ShouldSkip {
+			externalIds = append(externalIds, opt.ExternalId)
+		}
+	}
+
+	if err := i.repo.Triggers().ReleaseIdempotencyKeys(ctx, tenantId, externalIds); err != nil {
+		i.l.Error().Err(err).Msg("could not release idempotency keys")
+	}
+}
+
 func (i *AdminServiceImpl) ingest(ctx context.Context, tenantId string, opts ...*v1.WorkflowNameTriggerOpts) error {
 	optsToSend := make([]*v1.WorkflowNameTriggerOpts, 0)
 
@@ -0,0 +1,240 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	contracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestToBulkOperationFilter(t *testing.T) {
+	since := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+	workflowId := uuid.New()
+
+	filter, err := toBulkOperationFilter(&contracts.TasksFilter{
+		Statuses:           []string{"FAILED", "CANCELLED"},
+		Since:              timestamppb.New(since),
+		Until:              timestamppb.New(until),
+		WorkflowIds:        []string{workflowId.String()},
+		AdditionalMetadata: []string{"customer:acme"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []sqlcv1.V1ReadableStatusOlap{sqlcv1.V1ReadableStatusOlapFAILED, sqlcv1.V1ReadableStatusOlapCANCELLED}, filter.Statuses)
+	assert.Equal(t, since, filter.Since)
+	require.NotNil(t, filter.Until)
+	assert.Equal(t, until, *filter.Until)
+	assert.Equal(t, []uuid.UUID{workflowId}, filter.WorkflowIds)
+	assert.Equal(t, map[string]interface{}{"customer": "acme"}, filter.AdditionalMetadata)
+}
+
+func TestToBulkOperationFilter_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *contracts.TasksFilter
+	}{
+		{
+			name: "invalid workflow id",
+			filter: &contracts.TasksFilter{
+				Since:       timestamppb.Now(),
+				WorkflowIds: []string{"not-a-uuid"},
+			},
+		},
+		{
+			name: "invalid additional metadata",
+			filter: &contracts.TasksFilter{
+				Since:              timestamppb.Now(),
+				AdditionalMetadata: []string{"customer"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toBulkOperationFilter(tt.filter)
+			require.Error(t, err)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+type fakeRepository struct {
+	v1.Repository
+
+	scheduler *fakeSchedulerRepository
+	workflows *fakeWorkflowRepository
+}
+
+func (r *fakeRepository) Scheduler() v1.SchedulerRepository {
+	return r.scheduler
+}
+
+func (r *fakeRepository) Workflows() v1.WorkflowRepository {
+	return r.workflows
+}
+
+type fakeSchedulerRepository struct {
+	v1.SchedulerRepository
+
+	concurrency *fakeConcurrencyRepository
+}
+
+func (r *fakeSchedulerRepository) Concurrency() v1.ConcurrencyRepository {
+	return r.concurrency
+}
+
+type fakeConcurrencyRepository struct {
+	v1.ConcurrencyRepository
+
+	weights map[string]int32
+}
+
+func (r *fakeConcurrencyRepository) UpsertConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string, weight int32) error {
+	r.weights[sqlchelpers.UUIDToStr(tenantId)+"/"+key] = weight
+	return nil
+}
+
+func (r *fakeConcurrencyRepository) DeleteConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string) error {
+	delete(r.weights, sqlchelpers.UUIDToStr(tenantId)+"/"+key)
+	return nil
+}
+
+// fakeWorkflowRepository stores workflows keyed by tenant id and workflow name.
+type fakeWorkflowRepository struct {
+	v1.WorkflowRepository
+
+	workflows map[string]*sqlcv1.Workflow
+}
+
+func (r *fakeWorkflowRepository) SetWorkflowPaused(ctx context.Context, tenantId, workflowName string, isPaused bool) (*sqlcv1.Workflow, error) {
+	workflow, ok := r.workflows[tenantId+"/"+workflowName]
+
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	workflow.IsPaused = pgtype.Bool{Bool: isPaused, Valid: true}
+
+	return workflow, nil
+}
+
+func newTestAdminService(t *testing.T) (*AdminServiceImpl, *fakeConcurrencyRepository) {
+	t.Helper()
+
+	concurrency := &fakeConcurrencyRepository{weights: make(map[string]int32)}
+
+	a := &AdminServiceImpl{
+		repo: &fakeRepository{
+			scheduler: &fakeSchedulerRepository{concurrency: concurrency},
+		},
+	}
+
+	return a, concurrency
+}
+
+func TestConcurrencyKeyWeights(t *testing.T) {
+	a, concurrency := newTestAdminService(t)
+
+	tenantId := uuid.New().String()
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(tenantId)}) // nolint: staticcheck
+
+	_, err := a.SetConcurrencyKeyWeight(ctx, &contracts.SetConcurrencyKeyWeightRequest{Key: "tier=gold", Weight: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int32{tenantId + "/tier=gold": 3}, concurrency.weights)
+
+	_, err = a.DeleteConcurrencyKeyWeight(ctx, &contracts.DeleteConcurrencyKeyWeightRequest{Key: "tier=gold"})
+	require.NoError(t, err)
+
+	assert.Empty(t, concurrency.weights)
+}
+
+func TestConcurrencyKeyWeights_Invalid(t *testing.T) {
+	a, concurrency := newTestAdminService(t)
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}) // nolint: staticcheck
+
+	_, err := a.SetConcurrencyKeyWeight(ctx, &contracts.SetConcurrencyKeyWeightRequest{Key: "tier=gold", Weight: 0})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = a.SetConcurrencyKeyWeight(ctx, &contracts.SetConcurrencyKeyWeightRequest{Weight: 2})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = a.DeleteConcurrencyKeyWeight(ctx, &contracts.DeleteConcurrencyKeyWeightRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Empty(t, concurrency.weights)
+}
+
+func newTestAdminServiceWithWorkflows(t *testing.T, workflows map[string]*sqlcv1.Workflow) *AdminServiceImpl {
+	t.Helper()
+
+	return &AdminServiceImpl{
+		repo: &fakeRepository{
+			workflows: &fakeWorkflowRepository{workflows: workflows},
+		},
+	}
+}
+
+func TestPauseAndResumeWorkflow(t *testing.T) {
+	tenantId := uuid.New().String()
+	workflowId := uuid.New().String()
+
+	a := newTestAdminServiceWithWorkflows(t, map[string]*sqlcv1.Workflow{
+		tenantId + "/my-workflow": {ID: sqlchelpers.UUIDFromStr(workflowId), Name: "my-workflow"},
+	})
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(tenantId)}) // nolint: staticcheck
+
+	paused, err := a.PauseWorkflow(ctx, &contracts.PauseWorkflowRequest{WorkflowName: "my-workflow"})
+	require.NoError(t, err)
+
+	assert.Equal(t, workflowId, paused.WorkflowId)
+	assert.True(t, paused.IsPaused)
+
+	resumed, err := a.ResumeWorkflow(ctx, &contracts.ResumeWorkflowRequest{WorkflowName: "my-workflow"})
+	require.NoError(t, err)
+
+	assert.Equal(t, workflowId, resumed.WorkflowId)
+	assert.False(t, resumed.IsPaused)
+}
+
+func TestPauseAndResumeWorkflow_NotFound(t *testing.T) {
+	tenantId := uuid.New().String()
+	otherTenantId := uuid.New().String()
+
+	a := newTestAdminServiceWithWorkflows(t, map[string]*sqlcv1.Workflow{
+		otherTenantId + "/other-workflow": {ID: sqlchelpers.UUIDFromStr(uuid.New().String()), Name: "other-workflow"},
+	})
+
+	ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(tenantId)}) // nolint: staticcheck
+
+	for _, name := range []string{"unknown-workflow", "other-workflow"} {
+		_, err := a.PauseWorkflow(ctx, &contracts.PauseWorkflowRequest{WorkflowName: name})
+		assert.Equal(t, codes.NotFound, status.Code(err), name)
+
+		_, err = a.ResumeWorkflow(ctx, &contracts.ResumeWorkflowRequest{WorkflowName: name})
+		assert.Equal(t, codes.NotFound, status.Code(err), name)
+	}
+
+	_, err := a.PauseWorkflow(ctx, &contracts.PauseWorkflowRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.False(t, a.repo.(*fakeRepository).workflows.workflows[otherTenantId+"/other-workflow"].IsPaused.Bool)
+}
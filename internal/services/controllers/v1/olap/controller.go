@@ -41,6 +41,7 @@ type OLAPControllerImpl struct {
 	updateTaskStatusOperations   *queueutils.OperationPool
 	updateDAGStatusOperations    *queueutils.OperationPool
 	processTenantAlertOperations *queueutils.OperationPool
+	sampleRateLimitOperations    *queueutils.OperationPool
 }
 
 type OLAPControllerOpt func(*OLAPControllerOpts)
@@ -157,6 +158,7 @@ func New(fs ...OLAPControllerOpt) (*OLAPControllerImpl, error) {
 	o.updateTaskStatusOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "update task statuses", o.updateTaskStatuses)
 	o.updateDAGStatusOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "update dag statuses", o.updateDAGStatuses)
 	o.processTenantAlertOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "process tenant alerts", o.processTenantAlerts)
+	o.sampleRateLimitOperations = queueutils.NewOperationPool(opts.l, time.Second*15, "sample rate limits", o.sampleTenantRateLimits)
 
 	return o, nil
 }
@@ -228,6 +230,18 @@ func (o *OLAPControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule process tenant alerts: %w", err)
 	}
 
+	_, err = o.s.NewJob(
+		gocron.DurationJob(time.Second*60),
+		gocron.NewTask(
+			o.runTenantSampleRateLimits(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule sample rate limits: %w", err)
+	}
+
 	cleanupBuffer, err := mqBuffer.Start()
 
 	if err != nil {
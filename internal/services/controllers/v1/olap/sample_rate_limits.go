@@ -0,0 +1,38 @@
+package olap
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func (o *OLAPControllerImpl) runTenantSampleRateLimits(ctx context.Context) func() {
+	return func() {
+		o.l.Debug().Msgf("partition: sampling rate limits")
+
+		// list all tenants
+		tenants, err := o.p.ListTenantsForController(ctx, dbsqlc.TenantMajorEngineVersionV1)
+
+		if err != nil {
+			o.l.Error().Err(err).Msg("could not list tenants")
+			return
+		}
+
+		o.sampleRateLimitOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			o.sampleRateLimitOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
+func (o *OLAPControllerImpl) sampleTenantRateLimits(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "sample-rate-limits")
+	defer span.End()
+
+	return false, o.repo.OLAP().SampleRateLimits(ctx, tenantId)
+}
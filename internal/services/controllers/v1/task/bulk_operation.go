@@ -0,0 +1,246 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	tasktypes "github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// the number of runs to read from the OLAP tables per message while matching the filter of a bulk operation
+const BULK_OPERATION_MATCH_PAGE_SIZE = 1000
+
+// the number of runs to cancel or replay per message while a bulk operation is running
+const BULK_OPERATION_BATCH_SIZE = 100
+
+// processBulkOperation processes a single step of a bulk operation, and then sends a message to process the
+// next step. A bulk operation is processed in two phases:
+//
+//  1. While the bulk operation is PENDING, runs which match its filter are read from the OLAP tables page by
+//     page and written to the bulk operation items. Pages are read with a cursor on (inserted_at, id), so runs
+//     whose status changes while matching do not shift later pages. This way, the set of runs is fixed before
+//     any run is modified.
+//  2. While the bulk operation is RUNNING, the items are cancelled or replayed in batches. A batch is only
+//     recorded once it has been processed, so a batch which was interrupted is processed again when the
+//     message is redelivered.
+func (tc *TasksControllerImpl) processBulkOperation(ctx context.Context, tenantId string, payload *tasktypes.BulkOperationPayload) error {
+	op, err := tc.repov1.BulkOperations().GetBulkOperation(ctx, tenantId, payload.BulkOperationId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			tc.l.Warn().Msgf("bulk operation %s not found", payload.BulkOperationId)
+			return nil
+		}
+
+		return fmt.Errorf("could not get bulk operation: %w", err)
+	}
+
+	switch op.Status {
+	case sqlcv1.V1BulkOperationStatusPENDING:
+		return tc.matchBulkOperationRuns(ctx, tenantId, op, payload.MatchAfter)
+	case sqlcv1.V1BulkOperationStatusRUNNING:
+		return tc.processBulkOperationBatch(ctx, tenantId, op)
+	default:
+		// the bulk operation has already finished
+		return nil
+	}
+}
+
+func (tc *TasksControllerImpl) matchBulkOperationRuns(ctx context.Context, tenantId string, op *sqlcv1.V1BulkOperation, after *v1.WorkflowRunCursor) error {
+	bulkOperationId := sqlchelpers.UUIDToStr(op.ID)
+
+	filter := v1.BulkOperationFilter{}
+
+	if err := json.Unmarshal(op.Filter, &filter); err != nil {
+		return tc.failBulkOperation(ctx, tenantId, bulkOperationId, fmt.Sprintf("invalid filter: %s", err.Error()))
+	}
+
+	runs, err := tc.repov1.OLAP().ListWorkflowRunIds(ctx, tenantId, filter.ToListWorkflowRunOpts(BULK_OPERATION_MATCH_PAGE_SIZE, after))
+
+	if err != nil {
+		return fmt.Errorf("could not list workflow runs for bulk operation: %w", err)
+	}
+
+	externalIds := make([]pgtype.UUID, 0, len(runs))
+
+	for _, run := range runs {
+		externalIds = append(externalIds, run.ExternalID)
+	}
+
+	isLastPage := len(runs) < BULK_OPERATION_MATCH_PAGE_SIZE
+
+	op, err = tc.repov1.BulkOperations().AddMatchedItems(ctx, tenantId, bulkOperationId, externalIds, isLastPage)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the bulk operation is no longer pending, so this page was already matched
+			return nil
+		}
+
+		return fmt.Errorf("could not add matched runs to bulk operation: %w", err)
+	}
+
+	var next *v1.WorkflowRunCursor
+
+	if !isLastPage {
+		lastRun := runs[len(runs)-1]
+
+		next = &v1.WorkflowRunCursor{
+			InsertedAt: lastRun.InsertedAt.Time,
+			Id:         lastRun.ID,
+		}
+	}
+
+	return tc.sendBulkOperationMessage(ctx, tenantId, op, next)
+}
+
+func (tc *TasksControllerImpl) processBulkOperationBatch(ctx context.Context, tenantId string, op *sqlcv1.V1BulkOperation) error {
+	bulkOperationId := sqlchelpers.UUIDToStr(op.ID)
+
+	items, err := tc.repov1.BulkOperations().ListNextItems(ctx, bulkOperationId, op.LastItemID, BULK_OPERATION_BATCH_SIZE)
+
+	if err != nil {
+		return fmt.Errorf("could not list bulk operation items: %w", err)
+	}
+
+	if len(items) == 0 {
+		_, err := tc.repov1.BulkOperations().FinishBulkOperation(ctx, tenantId, bulkOperationId, nil)
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		return nil
+	}
+
+	externalIds := make([]string, 0, len(items))
+
+	for _, item := range items {
+		externalIds = append(externalIds, sqlchelpers.UUIDToStr(item.ExternalID))
+	}
+
+	// runs which can't be cancelled or replayed are recorded as failed on the bulk operation rather than
+	// retried, so that a single bad run does not block the rest of the bulk operation
+	failed, err := tc.processBulkOperationItems(ctx, tenantId, op.Kind, externalIds)
+
+	if err != nil {
+		return fmt.Errorf("could not process bulk operation batch: %w", err)
+	}
+
+	op, err = tc.repov1.BulkOperations().CompleteBatch(ctx, tenantId, bulkOperationId, v1.CompleteBulkOperationBatchOpts{
+		PreviousLastItemId: op.LastItemID,
+		LastItemId:         items[len(items)-1].ID,
+		ProcessedCount:     int64(len(items)) - failed,
+		FailedCount:        failed,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the batch was already completed by a redelivered message
+			return nil
+		}
+
+		return fmt.Errorf("could not complete bulk operation batch: %w", err)
+	}
+
+	return tc.sendBulkOperationMessage(ctx, tenantId, op, nil)
+}
+
+// processBulkOperationItems cancels or replays the runs of a batch, and returns the number of runs which could
+// not be processed. The batch is processed with a single call, and if that call fails, each run is processed
+// on its own so that only the runs which fail are counted.
+func (tc *TasksControllerImpl) processBulkOperationItems(ctx context.Context, tenantId string, kind sqlcv1.V1BulkOperationKind, externalIds []string) (int64, error) {
+	tasks, err := tc.repov1.Tasks().FlattenExternalIds(ctx, tenantId, externalIds)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not flatten external ids: %w", err)
+	}
+
+	// runs which no longer exist have nothing to cancel or replay, and count as processed
+	runIds := make([]string, 0, len(externalIds))
+	runTasks := make(map[string][]v1.TaskIdInsertedAtRetryCount, len(externalIds))
+	taskIdRetryCounts := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	for _, task := range tasks {
+		runId := sqlchelpers.UUIDToStr(task.WorkflowRunExternalID)
+
+		if _, ok := runTasks[runId]; !ok {
+			runIds = append(runIds, runId)
+		}
+
+		taskIdRetryCount := v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		}
+
+		runTasks[runId] = append(runTasks[runId], taskIdRetryCount)
+		taskIdRetryCounts = append(taskIdRetryCounts, taskIdRetryCount)
+	}
+
+	if len(taskIdRetryCounts) == 0 {
+		return 0, nil
+	}
+
+	err = tc.processBulkOperationTasks(ctx, tenantId, kind, taskIdRetryCounts)
+
+	if err == nil {
+		return 0, nil
+	}
+
+	if len(runIds) == 1 {
+		tc.l.Error().Err(err).Msgf("could not process run %s for bulk operation", runIds[0])
+		return 1, nil
+	}
+
+	var failed int64
+
+	for _, runId := range runIds {
+		if err := tc.processBulkOperationTasks(ctx, tenantId, kind, runTasks[runId]); err != nil {
+			tc.l.Error().Err(err).Msgf("could not process run %s for bulk operation", runId)
+			failed++
+		}
+	}
+
+	return failed, nil
+}
+
+func (tc *TasksControllerImpl) processBulkOperationTasks(ctx context.Context, tenantId string, kind sqlcv1.V1BulkOperationKind, tasks []v1.TaskIdInsertedAtRetryCount) error {
+	switch kind {
+	case sqlcv1.V1BulkOperationKindCANCEL:
+		return tc.cancelTasks(ctx, tenantId, tasks)
+	case sqlcv1.V1BulkOperationKindREPLAY:
+		return tc.replayTasks(ctx, tenantId, tasks)
+	default:
+		return fmt.Errorf("unknown bulk operation kind: %s", kind)
+	}
+}
+
+func (tc *TasksControllerImpl) failBulkOperation(ctx context.Context, tenantId, bulkOperationId, errorMessage string) error {
+	_, err := tc.repov1.BulkOperations().FinishBulkOperation(ctx, tenantId, bulkOperationId, &errorMessage)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("could not mark bulk operation as failed: %w", err)
+	}
+
+	return nil
+}
+
+func (tc *TasksControllerImpl) sendBulkOperationMessage(ctx context.Context, tenantId string, op *sqlcv1.V1BulkOperation, matchAfter *v1.WorkflowRunCursor) error {
+	msg, err := tasktypes.BulkOperationMessage(tenantId, op.Kind, sqlchelpers.UUIDToStr(op.ID), matchAfter)
+
+	if err != nil {
+		return fmt.Errorf("could not create bulk operation message: %w", err)
+	}
+
+	return tc.mq.SendMessage(ctx, msgqueue.TASK_PROCESSING_QUEUE, msg)
+}
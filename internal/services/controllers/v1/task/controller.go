@@ -52,6 +52,7 @@ type TasksControllerImpl struct {
 	timeoutTaskOperations  *queueutils.OperationPool
 	reassignTaskOperations *queueutils.OperationPool
 	retryTaskOperations    *queueutils.OperationPool
+	durableSleepOperations *queueutils.OperationPool
 }
 
 type TasksControllerOpt func(*TasksControllerOpts)
@@ -195,6 +196,7 @@ func New(fs ...TasksControllerOpt) (*TasksControllerImpl, error) {
 	t.timeoutTaskOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "timeout step runs", t.processTaskTimeouts)
 	t.reassignTaskOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "reassign step runs", t.processTaskReassignments)
 	t.retryTaskOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "retry step runs", t.processTaskRetryQueueItems)
+	t.durableSleepOperations = queueutils.NewOperationPool(opts.l, time.Second*5, "process durable sleeps", t.processDurableSleeps)
 
 	return t, nil
 }
@@ -257,6 +259,18 @@ func (tc *TasksControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run reassignment: %w", err)
 	}
 
+	_, err = tc.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			tc.runTenantDurableSleeps(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule durable sleeps: %w", err)
+	}
+
 	_, err = tc.s.NewJob(
 		gocron.DurationJob(time.Minute*15),
 		gocron.NewTask(
@@ -539,18 +553,40 @@ func (tc *TasksControllerImpl) handleCancelTasks(ctx context.Context, tenantId s
 	// sure would be nice if we could use our own durable execution primitives here, but that's a bootstrapping
 	// problem that we don't have a clean way to solve (yet)
 	msgs := msgqueue.JSONConvert[tasktypes.CancelTasksPayload](payloads)
-	pubPayloads := make([]tasktypes.CancelledTaskPayload, 0)
+	tasks := make([]v1.TaskIdInsertedAtRetryCount, 0)
+
+	var outerErr error
 
 	for _, msg := range msgs {
-		for _, task := range msg.Tasks {
-			pubPayloads = append(pubPayloads, tasktypes.CancelledTaskPayload{
-				TaskId:       task.Id,
-				InsertedAt:   task.InsertedAt,
-				RetryCount:   task.RetryCount,
-				EventType:    sqlcv1.V1EventTypeOlapCANCELLED,
-				ShouldNotify: true,
-			})
+		if msg.BulkOperation != nil {
+			if err := tc.processBulkOperation(ctx, tenantId, msg.BulkOperation); err != nil {
+				outerErr = multierror.Append(outerErr, fmt.Errorf("could not process bulk operation: %w", err))
+			}
+
+			continue
 		}
+
+		tasks = append(tasks, msg.Tasks...)
+	}
+
+	if err := tc.cancelTasks(ctx, tenantId, tasks); err != nil {
+		outerErr = multierror.Append(outerErr, err)
+	}
+
+	return outerErr
+}
+
+func (tc *TasksControllerImpl) cancelTasks(ctx context.Context, tenantId string, tasks []v1.TaskIdInsertedAtRetryCount) error {
+	pubPayloads := make([]tasktypes.CancelledTaskPayload, 0, len(tasks))
+
+	for _, task := range tasks {
+		pubPayloads = append(pubPayloads, tasktypes.CancelledTaskPayload{
+			TaskId:       task.Id,
+			InsertedAt:   task.InsertedAt,
+			RetryCount:   task.RetryCount,
+			EventType:    sqlcv1.V1EventTypeOlapCANCELLED,
+			ShouldNotify: true,
+		})
 	}
 
 	// Batch tasks to cancel in groups of 50 and publish to the message queue. This is a form of backpressure
@@ -583,7 +619,17 @@ func (tc *TasksControllerImpl) handleReplayTasks(ctx context.Context, tenantId s
 
 	taskIdRetryCounts := make([]v1.TaskIdInsertedAtRetryCount, 0)
 
+	var outerErr error
+
 	for _, msg := range msgs {
+		if msg.BulkOperation != nil {
+			if err := tc.processBulkOperation(ctx, tenantId, msg.BulkOperation); err != nil {
+				outerErr = multierror.Append(outerErr, fmt.Errorf("could not process bulk operation: %w", err))
+			}
+
+			continue
+		}
+
 		for _, task := range msg.Tasks {
 			taskIdRetryCounts = append(taskIdRetryCounts, v1.TaskIdInsertedAtRetryCount{
 				Id:         task.Id,
@@ -593,6 +639,16 @@ func (tc *TasksControllerImpl) handleReplayTasks(ctx context.Context, tenantId s
 		}
 	}
 
+	if len(taskIdRetryCounts) > 0 {
+		if err := tc.replayTasks(ctx, tenantId, taskIdRetryCounts); err != nil {
+			outerErr = multierror.Append(outerErr, err)
+		}
+	}
+
+	return outerErr
+}
+
+func (tc *TasksControllerImpl) replayTasks(ctx context.Context, tenantId string, taskIdRetryCounts []v1.TaskIdInsertedAtRetryCount) error {
 	replayRes, err := tc.repov1.Tasks().ReplayTasks(ctx, tenantId, taskIdRetryCounts)
 
 	if err != nil {
@@ -778,7 +834,31 @@ func (tc *TasksControllerImpl) handleProcessUserEventTrigger(ctx context.Context
 
 // handleProcessUserEventMatches is responsible for signaling or creating tasks based on user event matches.
 func (tc *TasksControllerImpl) handleProcessUserEventMatches(ctx context.Context, tenantId string, payloads []*tasktypes.UserEventTaskPayload) error {
-	// tc.l.Error().Msg("not implemented")
+	candidateMatches := make([]v1.CandidateEventMatch, 0, len(payloads))
+
+	for _, payload := range payloads {
+		candidateMatches = append(candidateMatches, v1.CandidateEventMatch{
+			ID:             payload.EventId,
+			EventTimestamp: time.Now(),
+			Key:            payload.EventKey,
+			Data:           payload.EventData,
+		})
+	}
+
+	matchResult, err := tc.repov1.Matches().ProcessUserEventMatches(ctx, tenantId, candidateMatches)
+
+	if err != nil {
+		return fmt.Errorf("could not process user event matches: %w", err)
+	}
+
+	if len(matchResult.CreatedTasks) > 0 {
+		err = tc.signalTasksCreated(ctx, tenantId, matchResult.CreatedTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not signal created tasks: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -858,6 +938,15 @@ func (tc *TasksControllerImpl) processInternalEvents(ctx context.Context, tenant
 		}
 	}
 
+	// signaled tasks may be map tasks whose items have finished
+	if len(matchResult.SignaledTasks) > 0 {
+		err = tc.expandMapTasks(ctx, tenantId, matchResult.SignaledTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not expand map tasks: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -895,6 +984,7 @@ func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId
 	failedTasks := make([]*sqlcv1.V1Task, 0)
 	cancelledTasks := make([]*sqlcv1.V1Task, 0)
 	skippedTasks := make([]*sqlcv1.V1Task, 0)
+	mappedTasks := make([]*sqlcv1.V1Task, 0)
 
 	for _, task := range tasks {
 		switch task.InitialState {
@@ -906,6 +996,8 @@ func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId
 			cancelledTasks = append(cancelledTasks, task)
 		case sqlcv1.V1TaskInitialStateSKIPPED:
 			skippedTasks = append(skippedTasks, task)
+		case sqlcv1.V1TaskInitialStateMAPPED:
+			mappedTasks = append(mappedTasks, task)
 		}
 
 		msg, err := tasktypes.CreatedTaskMessage(tenantId, task)
@@ -978,6 +1070,18 @@ func (tc *TasksControllerImpl) signalTasksCreated(ctx context.Context, tenantId
 		})
 	}
 
+	if len(mappedTasks) > 0 {
+		eg.Go(func() error {
+			err := tc.signalTasksCreatedAndMapped(ctx, tenantId, mappedTasks)
+
+			if err != nil {
+				return fmt.Errorf("could not signal created tasks: %w", err)
+			}
+
+			return nil
+		})
+	}
+
 	return eg.Wait()
 }
 
@@ -987,6 +1091,7 @@ func (tc *TasksControllerImpl) signalTasksUpdated(ctx context.Context, tenantId
 	failedTasks := make([]*sqlcv1.V1Task, 0)
 	cancelledTasks := make([]*sqlcv1.V1Task, 0)
 	skippedTasks := make([]*sqlcv1.V1Task, 0)
+	mappedTasks := make([]*sqlcv1.V1Task, 0)
 
 	for _, task := range tasks {
 		switch task.InitialState {
@@ -998,6 +1103,8 @@ func (tc *TasksControllerImpl) signalTasksUpdated(ctx context.Context, tenantId
 			cancelledTasks = append(cancelledTasks, task)
 		case sqlcv1.V1TaskInitialStateSKIPPED:
 			skippedTasks = append(skippedTasks, task)
+		case sqlcv1.V1TaskInitialStateMAPPED:
+			mappedTasks = append(mappedTasks, task)
 		}
 	}
 
@@ -1051,6 +1158,18 @@ func (tc *TasksControllerImpl) signalTasksUpdated(ctx context.Context, tenantId
 		})
 	}
 
+	if len(mappedTasks) > 0 {
+		eg.Go(func() error {
+			err := tc.signalTasksCreatedAndMapped(ctx, tenantId, mappedTasks)
+
+			if err != nil {
+				return fmt.Errorf("could not signal created tasks: %w", err)
+			}
+
+			return nil
+		})
+	}
+
 	return eg.Wait()
 }
 
@@ -1297,6 +1416,107 @@ func (tc *TasksControllerImpl) signalTasksCreatedAndSkipped(ctx context.Context,
 	return nil
 }
 
+func (tc *TasksControllerImpl) signalTasksCreatedAndMapped(ctx context.Context, tenantId string, tasks []*sqlcv1.V1Task) error {
+	taskIds := make([]v1.TaskIdInsertedAtRetryCount, 0, len(tasks))
+
+	// map tasks are running while their items are running
+	// TODO: make this transactionally safe?
+	for _, task := range tasks {
+		taskIds = append(taskIds, v1.TaskIdInsertedAtRetryCount{
+			Id:         task.ID,
+			InsertedAt: task.InsertedAt,
+			RetryCount: task.RetryCount,
+		})
+
+		msg, err := tasktypes.MonitoringEventMessageFromInternal(tenantId, tasktypes.CreateMonitoringEventPayload{
+			TaskId:         task.ID,
+			RetryCount:     task.RetryCount,
+			EventType:      sqlcv1.V1EventTypeOlapSTARTED,
+			EventTimestamp: time.Now(),
+		})
+
+		if err != nil {
+			tc.l.Err(err).Msg("could not create message for olap queue")
+			continue
+		}
+
+		err = tc.pubBuffer.Pub(
+			ctx,
+			msgqueue.OLAP_QUEUE,
+			msg,
+			false,
+		)
+
+		if err != nil {
+			tc.l.Err(err).Msg("could not add message to olap queue")
+			continue
+		}
+	}
+
+	return tc.expandMapTasks(ctx, tenantId, taskIds)
+}
+
+// expandMapTasks creates the next items of map tasks, and finishes any map tasks whose items have all finished.
+// Tasks which aren't map tasks are ignored.
+func (tc *TasksControllerImpl) expandMapTasks(ctx context.Context, tenantId string, tasks []v1.TaskIdInsertedAtRetryCount) error {
+	res, err := tc.repov1.Triggers().ExpandMapTasks(ctx, tenantId, tasks)
+
+	if err != nil {
+		return err
+	}
+
+	if len(res.CreatedTasks) > 0 {
+		err = tc.signalTasksCreated(ctx, tenantId, res.CreatedTasks)
+
+		if err != nil {
+			return fmt.Errorf("could not signal created map items: %w", err)
+		}
+	}
+
+	err = tc.sendInternalEvents(ctx, tenantId, res.InternalEvents)
+
+	if err != nil {
+		return err
+	}
+
+	// TODO: make this transactionally safe?
+	for _, task := range res.FinishedTasks {
+		payload := tasktypes.CreateMonitoringEventPayload{
+			TaskId:         task.TaskId,
+			RetryCount:     task.RetryCount,
+			EventType:      sqlcv1.V1EventTypeOlapFINISHED,
+			EventTimestamp: time.Now(),
+			EventPayload:   string(task.Output),
+		}
+
+		if task.IsFailed() {
+			payload.EventType = sqlcv1.V1EventTypeOlapFAILED
+			payload.EventPayload = task.ErrorMessage
+		}
+
+		msg, err := tasktypes.MonitoringEventMessageFromInternal(tenantId, payload)
+
+		if err != nil {
+			tc.l.Err(err).Msg("could not create message for olap queue")
+			continue
+		}
+
+		err = tc.pubBuffer.Pub(
+			ctx,
+			msgqueue.OLAP_QUEUE,
+			msg,
+			false,
+		)
+
+		if err != nil {
+			tc.l.Err(err).Msg("could not add message to olap queue")
+			continue
+		}
+	}
+
+	return nil
+}
+
 func (tc *TasksControllerImpl) signalTasksReplayed(ctx context.Context, tenantId string, tasks []v1.TaskIdInsertedAtRetryCount) error {
 	// notify that tasks have been created
 	// TODO: make this transactionally safe?
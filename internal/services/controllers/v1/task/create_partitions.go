@@ -41,5 +41,17 @@ func (tc *TasksControllerImpl) createTablePartition(ctx context.Context) error {
 		return fmt.Errorf("could not create table partition: %w", err)
 	}
 
+	err = tc.repov1.Events().UpdateTablePartitions(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not create event table partition: %w", err)
+	}
+
+	err = tc.repov1.Triggers().UpdateTablePartitions(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not create idempotency key table partition: %w", err)
+	}
+
 	return nil
 }
@@ -562,7 +562,8 @@ func (wc *WorkflowsControllerImpl) bumpQueue(ctx context.Context, tenantId strin
 		switch workflowVersion.ConcurrencyLimitStrategy.ConcurrencyLimitStrategy {
 		case dbsqlc.ConcurrencyLimitStrategyCANCELINPROGRESS:
 			err = wc.queueByCancelInProgress(ctx, tenantId, workflowVersion)
-		case dbsqlc.ConcurrencyLimitStrategyGROUPROUNDROBIN:
+		case dbsqlc.ConcurrencyLimitStrategyGROUPROUNDROBIN, dbsqlc.ConcurrencyLimitStrategyWEIGHTEDROUNDROBIN:
+			// weights are only supported by the v1 engine, so weighted round-robin is the same as group round-robin here
 			err = wc.queueByGroupRoundRobin(ctx, tenantId, workflowVersion)
 		case dbsqlc.ConcurrencyLimitStrategyCANCELNEWEST:
 			err = wc.queueByCancelNewest(ctx, tenantId, workflowVersion)
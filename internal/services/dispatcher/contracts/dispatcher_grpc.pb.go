@@ -35,9 +35,16 @@ type DispatcherClient interface {
 	SendGroupKeyActionEvent(ctx context.Context, in *GroupKeyActionEvent, opts ...grpc.CallOption) (*ActionEventResponse, error)
 	PutOverridesData(ctx context.Context, in *OverridesData, opts ...grpc.CallOption) (*OverridesDataResponse, error)
 	Unsubscribe(ctx context.Context, in *WorkerUnsubscribeRequest, opts ...grpc.CallOption) (*WorkerUnsubscribeResponse, error)
+	// Cordon stops the scheduler from assigning new runs to a worker, while the worker finishes its running tasks
+	Cordon(ctx context.Context, in *WorkerCordonRequest, opts ...grpc.CallOption) (*WorkerCordonResponse, error)
 	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(ctx context.Context, in *ReleaseSlotRequest, opts ...grpc.CallOption) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(ctx context.Context, in *UpsertWorkerLabelsRequest, opts ...grpc.CallOption) (*UpsertWorkerLabelsResponse, error)
+	// RegisterDurableEvent registers a set of wait conditions for a durable task and releases the task's
+	// slot on the worker until one of the conditions is satisfied
+	RegisterDurableEvent(ctx context.Context, in *RegisterDurableEventRequest, opts ...grpc.CallOption) (*RegisterDurableEventResponse, error)
+	// ListenForDurableEvent waits for the conditions registered with RegisterDurableEvent to be satisfied
+	ListenForDurableEvent(ctx context.Context, in *ListenForDurableEventRequest, opts ...grpc.CallOption) (Dispatcher_ListenForDurableEventClient, error)
 }
 
 type dispatcherClient struct {
@@ -229,6 +236,15 @@ func (c *dispatcherClient) Unsubscribe(ctx context.Context, in *WorkerUnsubscrib
 	return out, nil
 }
 
+func (c *dispatcherClient) Cordon(ctx context.Context, in *WorkerCordonRequest, opts ...grpc.CallOption) (*WorkerCordonResponse, error) {
+	out := new(WorkerCordonResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/Cordon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dispatcherClient) RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error) {
 	out := new(RefreshTimeoutResponse)
 	err := c.cc.Invoke(ctx, "/Dispatcher/RefreshTimeout", in, out, opts...)
@@ -256,6 +272,47 @@ func (c *dispatcherClient) UpsertWorkerLabels(ctx context.Context, in *UpsertWor
 	return out, nil
 }
 
+func (c *dispatcherClient) RegisterDurableEvent(ctx context.Context, in *RegisterDurableEventRequest, opts ...grpc.CallOption) (*RegisterDurableEventResponse, error) {
+	out := new(RegisterDurableEventResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/RegisterDurableEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) ListenForDurableEvent(ctx context.Context, in *ListenForDurableEventRequest, opts ...grpc.CallOption) (Dispatcher_ListenForDurableEventClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Dispatcher_ServiceDesc.Streams[4], "/Dispatcher/ListenForDurableEvent", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherListenForDurableEventClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dispatcher_ListenForDurableEventClient interface {
+	Recv() (*DurableEvent, error)
+	grpc.ClientStream
+}
+
+type dispatcherListenForDurableEventClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherListenForDurableEventClient) Recv() (*DurableEvent, error) {
+	m := new(DurableEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
@@ -273,9 +330,16 @@ type DispatcherServer interface {
 	SendGroupKeyActionEvent(context.Context, *GroupKeyActionEvent) (*ActionEventResponse, error)
 	PutOverridesData(context.Context, *OverridesData) (*OverridesDataResponse, error)
 	Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error)
+	// Cordon stops the scheduler from assigning new runs to a worker, while the worker finishes its running tasks
+	Cordon(context.Context, *WorkerCordonRequest) (*WorkerCordonResponse, error)
 	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(context.Context, *ReleaseSlotRequest) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error)
+	// RegisterDurableEvent registers a set of wait conditions for a durable task and releases the task's
+	// slot on the worker until one of the conditions is satisfied
+	RegisterDurableEvent(context.Context, *RegisterDurableEventRequest) (*RegisterDurableEventResponse, error)
+	// ListenForDurableEvent waits for the conditions registered with RegisterDurableEvent to be satisfied
+	ListenForDurableEvent(*ListenForDurableEventRequest, Dispatcher_ListenForDurableEventServer) error
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -313,6 +377,9 @@ func (UnimplementedDispatcherServer) PutOverridesData(context.Context, *Override
 func (UnimplementedDispatcherServer) Unsubscribe(context.Context, *WorkerUnsubscribeRequest) (*WorkerUnsubscribeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
 }
+func (UnimplementedDispatcherServer) Cordon(context.Context, *WorkerCordonRequest) (*WorkerCordonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cordon not implemented")
+}
 func (UnimplementedDispatcherServer) RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshTimeout not implemented")
 }
@@ -322,6 +389,12 @@ func (UnimplementedDispatcherServer) ReleaseSlot(context.Context, *ReleaseSlotRe
 func (UnimplementedDispatcherServer) UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkerLabels not implemented")
 }
+func (UnimplementedDispatcherServer) RegisterDurableEvent(context.Context, *RegisterDurableEventRequest) (*RegisterDurableEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDurableEvent not implemented")
+}
+func (UnimplementedDispatcherServer) ListenForDurableEvent(*ListenForDurableEventRequest, Dispatcher_ListenForDurableEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListenForDurableEvent not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -532,6 +605,24 @@ func _Dispatcher_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_Cordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerCordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).Cordon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/Cordon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).Cordon(ctx, req.(*WorkerCordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dispatcher_RefreshTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshTimeoutRequest)
 	if err := dec(in); err != nil {
@@ -586,6 +677,45 @@ func _Dispatcher_UpsertWorkerLabels_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_RegisterDurableEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDurableEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).RegisterDurableEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/RegisterDurableEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).RegisterDurableEvent(ctx, req.(*RegisterDurableEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_ListenForDurableEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListenForDurableEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DispatcherServer).ListenForDurableEvent(m, &dispatcherListenForDurableEventServer{stream})
+}
+
+type Dispatcher_ListenForDurableEventServer interface {
+	Send(*DurableEvent) error
+	grpc.ServerStream
+}
+
+type dispatcherListenForDurableEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherListenForDurableEventServer) Send(m *DurableEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -617,6 +747,10 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unsubscribe",
 			Handler:    _Dispatcher_Unsubscribe_Handler,
 		},
+		{
+			MethodName: "Cordon",
+			Handler:    _Dispatcher_Cordon_Handler,
+		},
 		{
 			MethodName: "RefreshTimeout",
 			Handler:    _Dispatcher_RefreshTimeout_Handler,
@@ -629,6 +763,10 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertWorkerLabels",
 			Handler:    _Dispatcher_UpsertWorkerLabels_Handler,
 		},
+		{
+			MethodName: "RegisterDurableEvent",
+			Handler:    _Dispatcher_RegisterDurableEvent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -652,6 +790,11 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ListenForDurableEvent",
+			Handler:       _Dispatcher_ListenForDurableEvent_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dispatcher.proto",
 }
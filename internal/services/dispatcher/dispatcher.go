@@ -56,9 +56,10 @@ type DispatcherImpl struct {
 
 	entitlements repository.EntitlementsRepository
 
-	dispatcherId string
-	workers      *workers
-	a            *hatcheterrors.Wrapped
+	dispatcherId  string
+	workers       *workers
+	durableEvents *durableEventListener
+	a             *hatcheterrors.Wrapped
 }
 
 var ErrWorkerNotFound = fmt.Errorf("worker not found")
@@ -255,7 +256,7 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 
 	pubBuffer := msgqueuev1.NewMQPubBuffer(opts.mqv1)
 
-	return &DispatcherImpl{
+	d := &DispatcherImpl{
 		mq:           opts.mq,
 		mqv1:         opts.mqv1,
 		pubBuffer:    pubBuffer,
@@ -270,7 +271,11 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 		s:            s,
 		a:            a,
 		cache:        opts.cache,
-	}, nil
+	}
+
+	d.durableEvents = newDurableEventListener(opts.l, opts.repov1.Matches().ResumeDurableEvents, d.releaseDurableEventV1)
+
+	return d, nil
 }
 
 func (d *DispatcherImpl) Start() (func() error, error) {
@@ -305,6 +310,8 @@ func (d *DispatcherImpl) Start() (func() error, error) {
 
 	d.s.Start()
 
+	go d.durableEvents.start(ctx)
+
 	wg := sync.WaitGroup{}
 
 	f := func(task *msgqueue.Message) error {
@@ -0,0 +1,203 @@
+package dispatcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+// durableEventPollInterval is how often the dispatcher checks whether the waits of durable tasks
+// have been satisfied.
+const durableEventPollInterval = 1 * time.Second
+
+// durableEventReleaseTimeout bounds how long releasing an undelivered result may take, as it runs after the
+// listener's stream has been closed.
+const durableEventReleaseTimeout = 10 * time.Second
+
+type resumeDurableEventsFunc func(ctx context.Context, tenantId string, opts []v1.ResumeDurableEventOpts) (*v1.ResumeDurableEventsResult, error)
+
+// releaseDurableEventFunc unassigns a resumed task whose result could not be delivered to its worker, so
+// that the wait is resumed again once the worker listens for it.
+type releaseDurableEventFunc func(ctx context.Context, tenantId string, res *v1.DurableEventResult) error
+
+type durableEventWaiter struct {
+	opts v1.ResumeDurableEventOpts
+
+	// receives the result once the wait is satisfied, or nil if the task is no longer running
+	resCh chan *v1.DurableEventResult
+}
+
+// durableEventListener polls for satisfied durable event waits on behalf of every listener which is
+// connected to the dispatcher, so each tenant is checked with a single query per interval instead of
+// one query per waiting task.
+type durableEventListener struct {
+	l *zerolog.Logger
+
+	resume resumeDurableEventsFunc
+
+	release releaseDurableEventFunc
+
+	// tenant id -> signal event key -> waiter
+	waiters   map[string]map[string]*durableEventWaiter
+	waitersMu sync.Mutex
+}
+
+func newDurableEventListener(l *zerolog.Logger, resume resumeDurableEventsFunc, release releaseDurableEventFunc) *durableEventListener {
+	return &durableEventListener{
+		l:       l,
+		resume:  resume,
+		release: release,
+		waiters: make(map[string]map[string]*durableEventWaiter),
+	}
+}
+
+func durableEventWaiterKey(opts v1.ResumeDurableEventOpts) string {
+	return opts.TaskExternalId + "." + opts.SignalKey
+}
+
+// add registers a waiter for the durable event. If a waiter already exists for the same wait, for
+// example because the worker reconnected, the new waiter replaces it.
+func (d *durableEventListener) add(tenantId string, opts v1.ResumeDurableEventOpts) *durableEventWaiter {
+	w := &durableEventWaiter{
+		opts:  opts,
+		resCh: make(chan *v1.DurableEventResult, 1),
+	}
+
+	d.waitersMu.Lock()
+	defer d.waitersMu.Unlock()
+
+	if _, ok := d.waiters[tenantId]; !ok {
+		d.waiters[tenantId] = make(map[string]*durableEventWaiter)
+	}
+
+	d.waiters[tenantId][durableEventWaiterKey(opts)] = w
+
+	return w
+}
+
+// remove unregisters the waiter, if it has not been replaced by another waiter. A result which was sent to
+// the waiter but never read is released, as it was not delivered to the worker.
+func (d *durableEventListener) remove(tenantId string, w *durableEventWaiter) {
+	d.waitersMu.Lock()
+
+	if tenantWaiters, ok := d.waiters[tenantId]; ok {
+		key := durableEventWaiterKey(w.opts)
+
+		if tenantWaiters[key] == w {
+			delete(tenantWaiters, key)
+		}
+
+		if len(tenantWaiters) == 0 {
+			delete(d.waiters, tenantId)
+		}
+	}
+
+	d.waitersMu.Unlock()
+
+	// the waiter can no longer be notified, so the channel holds at most one unread result
+	select {
+	case res := <-w.resCh:
+		if res != nil {
+			d.releaseUndelivered(tenantId, res)
+		}
+	default:
+	}
+}
+
+func (d *durableEventListener) start(ctx context.Context) {
+	ticker := time.NewTicker(durableEventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.poll(ctx)
+		}
+	}
+}
+
+func (d *durableEventListener) poll(ctx context.Context) {
+	d.waitersMu.Lock()
+
+	tenantOpts := make(map[string][]v1.ResumeDurableEventOpts, len(d.waiters))
+
+	for tenantId, tenantWaiters := range d.waiters {
+		opts := make([]v1.ResumeDurableEventOpts, 0, len(tenantWaiters))
+
+		for _, w := range tenantWaiters {
+			opts = append(opts, w.opts)
+		}
+
+		tenantOpts[tenantId] = opts
+	}
+
+	d.waitersMu.Unlock()
+
+	for tenantId, opts := range tenantOpts {
+		res, err := d.resume(ctx, tenantId, opts)
+
+		if err != nil {
+			d.l.Error().Err(err).Msgf("could not resume durable events for tenant %s", tenantId)
+			continue
+		}
+
+		for _, resumed := range res.Resumed {
+			// the listener may have disconnected while the tasks were being resumed
+			if !d.notify(tenantId, durableEventWaiterKey(v1.ResumeDurableEventOpts{
+				TaskExternalId: resumed.TaskExternalId,
+				SignalKey:      resumed.SignalKey,
+			}), resumed) {
+				d.releaseUndelivered(tenantId, resumed)
+			}
+		}
+
+		for _, notRunning := range res.NotRunning {
+			d.notify(tenantId, durableEventWaiterKey(notRunning), nil)
+		}
+	}
+}
+
+// notify sends the result to the waiter of the key, and returns false if there is no such waiter.
+func (d *durableEventListener) notify(tenantId, key string, res *v1.DurableEventResult) bool {
+	d.waitersMu.Lock()
+	defer d.waitersMu.Unlock()
+
+	tenantWaiters, ok := d.waiters[tenantId]
+
+	if !ok {
+		return false
+	}
+
+	w, ok := tenantWaiters[key]
+
+	if !ok {
+		return false
+	}
+
+	// a waiter only receives a single result, so it is removed as soon as it has been notified
+	delete(tenantWaiters, key)
+
+	if len(tenantWaiters) == 0 {
+		delete(d.waiters, tenantId)
+	}
+
+	w.resCh <- res
+
+	return true
+}
+
+// releaseUndelivered releases a resumed task whose result was not delivered to its worker.
+func (d *durableEventListener) releaseUndelivered(tenantId string, res *v1.DurableEventResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), durableEventReleaseTimeout)
+	defer cancel()
+
+	if err := d.release(ctx, tenantId, res); err != nil {
+		d.l.Error().Err(err).Msgf("could not release undelivered durable event for task %s", res.TaskExternalId)
+	}
+}
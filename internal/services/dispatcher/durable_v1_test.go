@@ -0,0 +1,155 @@
+package dispatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+func TestDurableEventListener_Poll(t *testing.T) {
+	l := zerolog.Nop()
+	calls := make(map[string]int)
+
+	resumed := v1.ResumeDurableEventOpts{TaskExternalId: "task-1", SignalKey: "signal", WorkerId: "worker"}
+	notRunning := v1.ResumeDurableEventOpts{TaskExternalId: "task-2", SignalKey: "signal", WorkerId: "worker"}
+	waiting := v1.ResumeDurableEventOpts{TaskExternalId: "task-3", SignalKey: "signal", WorkerId: "worker"}
+
+	listener := newDurableEventListener(&l, func(ctx context.Context, tenantId string, opts []v1.ResumeDurableEventOpts) (*v1.ResumeDurableEventsResult, error) {
+		calls[tenantId]++
+
+		res := &v1.ResumeDurableEventsResult{}
+
+		for _, opt := range opts {
+			switch opt.TaskExternalId {
+			case resumed.TaskExternalId:
+				res.Resumed = append(res.Resumed, &v1.DurableEventResult{
+					TaskExternalId: opt.TaskExternalId,
+					SignalKey:      opt.SignalKey,
+					Data:           []byte(`{"key":"value"}`),
+				})
+			case notRunning.TaskExternalId:
+				res.NotRunning = append(res.NotRunning, opt)
+			}
+		}
+
+		return res, nil
+	}, nil)
+
+	resumedWaiter := listener.add("tenant-1", resumed)
+	notRunningWaiter := listener.add("tenant-1", notRunning)
+	waitingWaiter := listener.add("tenant-1", waiting)
+	otherTenantWaiter := listener.add("tenant-2", waiting)
+
+	listener.poll(context.Background())
+
+	// every tenant is checked with a single call, regardless of the number of waiters
+	assert.Equal(t, map[string]int{"tenant-1": 1, "tenant-2": 1}, calls)
+
+	select {
+	case res := <-resumedWaiter.resCh:
+		require.NotNil(t, res)
+		assert.Equal(t, []byte(`{"key":"value"}`), res.Data)
+	default:
+		t.Fatal("resumed waiter was not notified")
+	}
+
+	select {
+	case res := <-notRunningWaiter.resCh:
+		assert.Nil(t, res)
+	default:
+		t.Fatal("not running waiter was not notified")
+	}
+
+	assert.Empty(t, waitingWaiter.resCh)
+	assert.Empty(t, otherTenantWaiter.resCh)
+
+	// notified waiters are no longer polled
+	assert.Len(t, listener.waiters["tenant-1"], 1)
+
+	listener.remove("tenant-1", waitingWaiter)
+	listener.remove("tenant-2", otherTenantWaiter)
+
+	assert.Empty(t, listener.waiters)
+
+	listener.poll(context.Background())
+
+	assert.Equal(t, map[string]int{"tenant-1": 1, "tenant-2": 1}, calls, "tenants without waiters are not polled")
+}
+
+func TestDurableEventListener_ReplaceWaiter(t *testing.T) {
+	l := zerolog.Nop()
+
+	listener := newDurableEventListener(&l, nil, nil)
+	opts := v1.ResumeDurableEventOpts{TaskExternalId: "task-1", SignalKey: "signal", WorkerId: "worker"}
+
+	first := listener.add("tenant-1", opts)
+	second := listener.add("tenant-1", opts)
+
+	// removing the replaced waiter does not remove the waiter which replaced it
+	listener.remove("tenant-1", first)
+
+	assert.Same(t, second, listener.waiters["tenant-1"][durableEventWaiterKey(opts)])
+
+	listener.remove("tenant-1", second)
+
+	assert.Empty(t, listener.waiters)
+}
+
+func TestDurableEventListener_ReleaseUndelivered(t *testing.T) {
+	l := zerolog.Nop()
+	released := make([]string, 0)
+
+	opts := v1.ResumeDurableEventOpts{TaskExternalId: "task-1", SignalKey: "signal", WorkerId: "worker"}
+
+	var listener *durableEventListener
+
+	listener = newDurableEventListener(&l, func(ctx context.Context, tenantId string, opts []v1.ResumeDurableEventOpts) (*v1.ResumeDurableEventsResult, error) {
+		res := &v1.ResumeDurableEventsResult{}
+
+		for _, opt := range opts {
+			// the listener disconnects while the tasks are being resumed
+			for _, w := range listener.waiters[tenantId] {
+				listener.remove(tenantId, w)
+			}
+
+			res.Resumed = append(res.Resumed, &v1.DurableEventResult{
+				TaskExternalId: opt.TaskExternalId,
+				SignalKey:      opt.SignalKey,
+			})
+		}
+
+		return res, nil
+	}, func(ctx context.Context, tenantId string, res *v1.DurableEventResult) error {
+		released = append(released, res.TaskExternalId)
+		return nil
+	})
+
+	// a result which is resolved after the waiter was removed is released
+	listener.add("tenant-1", opts)
+	listener.poll(context.Background())
+
+	assert.Equal(t, []string{"task-1"}, released)
+
+	// a result which was sent to the waiter but never read is released when the waiter is removed
+	w := listener.add("tenant-1", opts)
+
+	require.True(t, listener.notify("tenant-1", durableEventWaiterKey(opts), &v1.DurableEventResult{TaskExternalId: "task-1"}))
+
+	listener.remove("tenant-1", w)
+
+	assert.Equal(t, []string{"task-1", "task-1"}, released)
+
+	// a waiter which was told the task is not running has nothing to release
+	w = listener.add("tenant-1", opts)
+
+	require.True(t, listener.notify("tenant-1", durableEventWaiterKey(opts), nil))
+
+	listener.remove("tenant-1", w)
+
+	assert.Len(t, released, 2)
+}
@@ -239,6 +239,14 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		opts.MaxRuns = &mr
 	}
 
+	if len(request.SlotPools) > 0 {
+		opts.SlotPools = make(map[string]int, len(request.SlotPools))
+
+		for name, maxRuns := range request.SlotPools {
+			opts.SlotPools[name] = int(maxRuns)
+		}
+	}
+
 	if apiErrors, err := s.v.ValidateAPI(opts); err != nil {
 		return nil, err
 	} else if apiErrors != nil {
@@ -573,7 +581,27 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 		return nil, err
 	}
 
-	return &contracts.HeartbeatResponse{}, nil
+	// a cordoned worker is drained once no tasks are assigned to it, so that tasks which were assigned right
+	// before the worker was cordoned are not lost when the worker unsubscribes. Assignments are only tracked
+	// for v1 tenants.
+	isDrained := worker.IsCordoned
+
+	if worker.IsCordoned && tenant.Version == dbsqlc.TenantMajorEngineVersionV1 {
+		hasAssignedTasks, err := s.repov1.Workers().HasAssignedTasks(ctx, tenantId, req.WorkerId)
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(telemetry_codes.Error, "could not check for assigned tasks")
+			return nil, err
+		}
+
+		isDrained = !hasAssignedTasks
+	}
+
+	return &contracts.HeartbeatResponse{
+		IsCordoned: worker.IsCordoned,
+		IsDrained:  isDrained,
+	}, nil
 }
 
 func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.ReleaseSlotRequest) (*contracts.ReleaseSlotResponse, error) {
@@ -589,6 +617,28 @@ func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.Release
 	}
 }
 
+func (s *DispatcherImpl) RegisterDurableEvent(ctx context.Context, req *contracts.RegisterDurableEventRequest) (*contracts.RegisterDurableEventResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	switch tenant.Version {
+	case dbsqlc.TenantMajorEngineVersionV1:
+		return s.registerDurableEventV1(ctx, tenant, req)
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "RegisterDurableEvent is not implemented in engine version %s", string(tenant.Version))
+	}
+}
+
+func (s *DispatcherImpl) ListenForDurableEvent(req *contracts.ListenForDurableEventRequest, stream contracts.Dispatcher_ListenForDurableEventServer) error {
+	tenant := stream.Context().Value("tenant").(*dbsqlc.Tenant)
+
+	switch tenant.Version {
+	case dbsqlc.TenantMajorEngineVersionV1:
+		return s.listenForDurableEventV1(tenant, req, stream)
+	default:
+		return status.Errorf(codes.Unimplemented, "ListenForDurableEvent is not implemented in engine version %s", string(tenant.Version))
+	}
+}
+
 func (s *DispatcherImpl) releaseSlotV0(ctx context.Context, tenant *dbsqlc.Tenant, req *contracts.ReleaseSlotRequest) (*contracts.ReleaseSlotResponse, error) {
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
@@ -1185,6 +1235,37 @@ func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.Wor
 	}, nil
 }
 
+// Cordon is used to stop assigning new runs to a worker, so that the worker can finish its running
+// tasks and shut down without runs being reassigned
+func (s *DispatcherImpl) Cordon(ctx context.Context, request *contracts.WorkerCordonRequest) (*contracts.WorkerCordonResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	// verify that the worker belongs to the tenant
+	_, err := s.repo.Worker().GetWorkerForEngine(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker %s not found", request.WorkerId)
+		}
+
+		return nil, err
+	}
+
+	_, err = s.repo.Worker().UpdateWorker(ctx, tenantId, request.WorkerId, &repository.UpdateWorkerOpts{
+		IsCordoned: &request.IsCordoned,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.WorkerCordonResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
 func (d *DispatcherImpl) RefreshTimeout(ctx context.Context, request *contracts.RefreshTimeoutRequest) (*contracts.RefreshTimeoutResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 
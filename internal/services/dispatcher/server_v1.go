@@ -449,6 +449,203 @@ func (d *DispatcherImpl) releaseSlotV1(ctx context.Context, tenant *dbsqlc.Tenan
 	return &contracts.ReleaseSlotResponse{}, nil
 }
 
+func (d *DispatcherImpl) registerDurableEventV1(ctx context.Context, tenant *dbsqlc.Tenant, request *contracts.RegisterDurableEventRequest) (*contracts.RegisterDurableEventResponse, error) {
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	opts := v1.RegisterDurableEventOpts{
+		TaskExternalId:      request.TaskId,
+		SignalKey:           request.SignalKey,
+		SleepConditions:     make([]v1.DurableSleepCondition, 0, len(request.SleepConditions)),
+		UserEventConditions: make([]v1.DurableUserEventCondition, 0, len(request.UserEventConditions)),
+	}
+
+	for _, condition := range request.SleepConditions {
+		opts.SleepConditions = append(opts.SleepConditions, v1.DurableSleepCondition{
+			ReadableDataKey: condition.ReadableDataKey,
+			SleepFor:        condition.SleepFor,
+		})
+	}
+
+	for _, condition := range request.UserEventConditions {
+		opts.UserEventConditions = append(opts.UserEventConditions, v1.DurableUserEventCondition{
+			ReadableDataKey: condition.ReadableDataKey,
+			EventKey:        condition.UserEventKey,
+			Expression:      condition.GetExpression(),
+		})
+	}
+
+	if apiErrors, err := d.v.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	if len(opts.SleepConditions) == 0 && len(opts.UserEventConditions) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: at least one sleep or user event condition is required")
+	}
+
+	res, err := d.repov1.Matches().RegisterDurableEvent(ctx, tenantId, opts)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "task %s is not running", request.TaskId)
+		}
+
+		return nil, err
+	}
+
+	// send to the OLAP repository
+	msg, err := tasktypes.MonitoringEventMessageFromInternal(
+		tenantId,
+		tasktypes.CreateMonitoringEventPayload{
+			TaskId:         res.TaskId,
+			RetryCount:     res.RetryCount,
+			WorkerId:       res.ReleasedWorkerId,
+			EventTimestamp: time.Now(),
+			EventType:      sqlcv1.V1EventTypeOlapSLOTRELEASED,
+			EventMessage:   "Slot released while waiting for a durable event",
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.pubBuffer.Pub(ctx, msgqueue.OLAP_QUEUE, msg, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.RegisterDurableEventResponse{}, nil
+}
+
+func (d *DispatcherImpl) listenForDurableEventV1(tenant *dbsqlc.Tenant, request *contracts.ListenForDurableEventRequest, stream contracts.Dispatcher_ListenForDurableEventServer) error {
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	ctx := stream.Context()
+
+	opts := v1.ResumeDurableEventOpts{
+		TaskExternalId: request.TaskId,
+		SignalKey:      request.SignalKey,
+		WorkerId:       request.WorkerId,
+	}
+
+	if apiErrors, err := d.v.ValidateAPI(opts); err != nil {
+		return err
+	} else if apiErrors != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	// verify that the worker belongs to the tenant, as the task is assigned back to it once the wait is satisfied
+	_, err := d.repo.Worker().GetWorkerForEngine(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "worker %s not found", request.WorkerId)
+		}
+
+		return err
+	}
+
+	w := d.durableEvents.add(tenantId, opts)
+
+	// a result which arrives after the stream is closed is released when the waiter is removed
+	defer d.durableEvents.remove(tenantId, w)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case res := <-w.resCh:
+		if res == nil {
+			return status.Errorf(codes.NotFound, "task %s is not running", request.TaskId)
+		}
+
+		// the task fills slots on the worker again, which the scheduler doesn't know about
+		d.notifySchedulerV1(ctx, tenant, func() (*msgqueue.Message, error) {
+			return tasktypes.NotifySlotsUsed(tenantId, []tasktypes.WorkerSlots{
+				{
+					WorkerId: res.WorkerId,
+					SlotPool: res.SlotPool,
+					Slots:    int(res.Slots),
+				},
+			})
+		})
+
+		err = stream.Send(&contracts.DurableEvent{
+			TaskId:    request.TaskId,
+			SignalKey: request.SignalKey,
+			Data:      res.Data,
+		})
+
+		if err != nil {
+			// the worker never received the result, so the task is released and resumed again when the worker
+			// listens for the wait
+			releaseCtx, cancel := context.WithTimeout(context.Background(), durableEventReleaseTimeout)
+			defer cancel()
+
+			if releaseErr := d.releaseDurableEventV1(releaseCtx, tenantId, res); releaseErr != nil {
+				d.l.Error().Err(releaseErr).Msgf("could not release undelivered durable event for task %s", request.TaskId)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+// releaseDurableEventV1 unassigns a resumed durable task whose result could not be delivered to its worker.
+// The wait stays satisfied, so the task is resumed again once the worker listens for the wait.
+func (d *DispatcherImpl) releaseDurableEventV1(ctx context.Context, tenantId string, res *v1.DurableEventResult) error {
+	released, err := d.repov1.Matches().UnassignResumedDurableTask(ctx, tenantId, res)
+
+	if err != nil {
+		return err
+	}
+
+	// the task has already moved on, for example because it was cancelled or timed out
+	if !released {
+		return nil
+	}
+
+	tenant, err := d.repo.Tenant().GetTenantByID(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not get tenant: %w", err)
+	}
+
+	d.notifySchedulerV1(ctx, tenant, func() (*msgqueue.Message, error) {
+		return tasktypes.NotifySlotsReleased(tenantId)
+	})
+
+	return nil
+}
+
+// notifySchedulerV1 sends a message to the scheduler partition of the tenant. Failures are only logged, as
+// the scheduler reloads the slots of workers from the database periodically.
+func (d *DispatcherImpl) notifySchedulerV1(ctx context.Context, tenant *dbsqlc.Tenant, newMsg func() (*msgqueue.Message, error)) {
+	if !tenant.SchedulerPartitionId.Valid {
+		return
+	}
+
+	msg, err := newMsg()
+
+	if err != nil {
+		d.l.Err(err).Msg("could not create message for scheduler partition queue")
+		return
+	}
+
+	err = d.mqv1.SendMessage(
+		ctx,
+		msgqueue.QueueTypeFromPartitionIDAndController(tenant.SchedulerPartitionId.String, msgqueue.Scheduler),
+		msg,
+	)
+
+	if err != nil {
+		d.l.Err(err).Msg("could not add message to scheduler partition queue")
+	}
+}
+
 func (s *DispatcherImpl) subscribeToWorkflowEventsV1(request *contracts.SubscribeToWorkflowEventsRequest, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
 	if request.WorkflowRunId != nil {
 		return s.subscribeToWorkflowEventsByWorkflowRunIdV1(*request.WorkflowRunId, stream)
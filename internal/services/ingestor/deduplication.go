@@ -0,0 +1,84 @@
+package ingestor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+)
+
+// the TTL of an event deduplication key, if one is not set when the event is pushed
+const DEFAULT_EVENT_DEDUPLICATION_TTL = 10 * time.Minute
+
+var errDeduplicationNotSupported = status.Error(codes.FailedPrecondition, "deduplication keys are only supported by the v1 engine")
+
+// deduplicateEvents claims the deduplication keys of the events which have one. It returns the original event
+// for each event whose key was already claimed, by index, along with the ids of the events which claimed
+// their key.
+func (i *IngestorImpl) deduplicateEvents(ctx context.Context, tenantId string, eventOpts []*repository.CreateEventOpts, eventIds []string) ([]*dbsqlc.Event, []string, error) {
+	results := make([]*dbsqlc.Event, len(eventOpts))
+	opts := make([]v1.DeduplicateEventOpts, 0)
+	optIdxToEventIdx := make([]int, 0)
+
+	for idx, event := range eventOpts {
+		if event.DeduplicationKey == nil {
+			continue
+		}
+
+		ttl := DEFAULT_EVENT_DEDUPLICATION_TTL
+
+		if event.DeduplicationTTL != nil {
+			ttl = *event.DeduplicationTTL
+		}
+
+		opts = append(opts, v1.DeduplicateEventOpts{
+			DeduplicationKey:   *event.DeduplicationKey,
+			TTL:                ttl,
+			EventId:            eventIds[idx],
+			Key:                event.Key,
+			Data:               event.Data,
+			AdditionalMetadata: event.AdditionalMetadata,
+		})
+
+		optIdxToEventIdx = append(optIdxToEventIdx, idx)
+	}
+
+	if len(opts) == 0 {
+		return results, nil, nil
+	}
+
+	originals, err := i.repov1.Events().DeduplicateEvents(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not deduplicate events: %w", err)
+	}
+
+	claimed := make([]string, 0, len(opts))
+
+	for optIdx, original := range originals {
+		eventIdx := optIdxToEventIdx[optIdx]
+
+		if original == nil {
+			claimed = append(claimed, eventIds[eventIdx])
+			continue
+		}
+
+		results[eventIdx] = &dbsqlc.Event{
+			ID:                 original.EventID,
+			CreatedAt:          sqlchelpers.TimestampFromTime(original.InsertedAt.Time),
+			TenantId:           original.TenantID,
+			Key:                original.EventKey,
+			Data:               original.Data,
+			AdditionalMetadata: original.AdditionalMetadata,
+		}
+	}
+
+	return results, claimed, nil
+}
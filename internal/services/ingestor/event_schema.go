@@ -0,0 +1,158 @@
+package ingestor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/schema"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// schemas are cached by the ingestor, so changes to a schema can take up to this long to apply
+const EVENT_SCHEMA_CACHE_TTL = 30 * time.Second
+
+type eventSchema struct {
+	schema *jsonschema.Schema
+	policy sqlcv1.V1EventSchemaPolicy
+}
+
+func eventSchemaCacheKey(tenantId, key string) string {
+	return fmt.Sprintf("%s:%s", tenantId, key)
+}
+
+// getEventSchemas returns the compiled schemas for the given event keys. Keys without a schema are omitted.
+func (i *IngestorImpl) getEventSchemas(ctx context.Context, tenantId string, keys []string) (map[string]*eventSchema, error) {
+	res := make(map[string]*eventSchema)
+	uncached := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+
+		// a nil schema is cached for keys without a schema, so the database is not queried for every event
+		if s, ok := i.eventSchemaCache.Get(eventSchemaCacheKey(tenantId, key)); ok {
+			if s != nil {
+				res[key] = s
+			}
+
+			continue
+		}
+
+		uncached = append(uncached, key)
+	}
+
+	if len(uncached) == 0 {
+		return res, nil
+	}
+
+	rows, err := i.repov1.EventSchemas().ListEventSchemasByKeys(ctx, tenantId, uncached)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list event schemas: %w", err)
+	}
+
+	found := make(map[string]*eventSchema, len(rows))
+
+	for _, row := range rows {
+		compiled, err := schema.Compile(row.Schema)
+
+		if err != nil {
+			// schemas are validated when they are written, so this should not happen
+			return nil, fmt.Errorf("could not compile schema for event key %s: %w", row.EventKey, err)
+		}
+
+		found[row.EventKey] = &eventSchema{
+			schema: compiled,
+			policy: row.Policy,
+		}
+	}
+
+	for _, key := range uncached {
+		s := found[key]
+
+		i.eventSchemaCache.Set(eventSchemaCacheKey(tenantId, key), s, EVENT_SCHEMA_CACHE_TTL)
+
+		if s != nil {
+			res[key] = s
+		}
+	}
+
+	return res, nil
+}
+
+// validateEvents validates the payloads of events against the schemas for their keys. If an event which
+// does not match uses the REJECT policy, an InvalidArgument error is returned. Otherwise, the events which
+// should be quarantined are returned by their index.
+func (i *IngestorImpl) validateEvents(ctx context.Context, tenantId string, events []*repository.CreateEventOpts) (map[int]string, error) {
+	keys := make([]string, 0, len(events))
+
+	for _, event := range events {
+		keys = append(keys, event.Key)
+	}
+
+	schemas, err := i.getEventSchemas(ctx, tenantId, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quarantined := make(map[int]string)
+
+	if len(schemas) == 0 {
+		return quarantined, nil
+	}
+
+	for idx, event := range events {
+		s, ok := schemas[event.Key]
+
+		if !ok {
+			continue
+		}
+
+		err := schema.Validate(s.schema, event.Data)
+
+		if err == nil {
+			continue
+		}
+
+		switch s.policy {
+		case sqlcv1.V1EventSchemaPolicyQUARANTINE:
+			quarantined[idx] = err.Error()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: payload for event %s does not match schema: %s", event.Key, err.Error())
+		}
+	}
+
+	return quarantined, nil
+}
+
+func (i *IngestorImpl) quarantineEvents(ctx context.Context, tenantId string, opts []v1.QuarantineEventOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	for idx := range opts {
+		// payloads which are not valid JSON cannot be stored, and the reason is recorded on the error message
+		if !json.Valid(opts[idx].Data) {
+			opts[idx].Data = nil
+		}
+
+		if !json.Valid(opts[idx].AdditionalMetadata) {
+			opts[idx].AdditionalMetadata = nil
+		}
+	}
+
+	return i.repov1.EventSchemas().QuarantineEvents(ctx, tenantId, opts)
+}
@@ -5,13 +5,16 @@ import (
 	"fmt"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/cache"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	msgqueuev1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
@@ -38,6 +41,7 @@ type IngestorOpts struct {
 	mq                     msgqueue.MessageQueue
 	mqv1                   msgqueuev1.MessageQueue
 	repov1                 v1.Repository
+	l                      *zerolog.Logger
 }
 
 func WithEventRepository(r repository.EventEngineRepository) IngestorOptFunc {
@@ -88,8 +92,18 @@ func WithRepositoryV1(r v1.Repository) IngestorOptFunc {
 	}
 }
 
+func WithLogger(l *zerolog.Logger) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.l = l
+	}
+}
+
 func defaultIngestorOpts() *IngestorOpts {
-	return &IngestorOpts{}
+	logger := logger.NewDefaultLogger("ingestor")
+
+	return &IngestorOpts{
+		l: &logger,
+	}
 }
 
 type IngestorImpl struct {
@@ -101,11 +115,13 @@ type IngestorImpl struct {
 	entitlementsRepository   repository.EntitlementsRepository
 	stepRunRepository        repository.StepRunEngineRepository
 	steprunTenantLookupCache *lru.Cache[string, string]
+	eventSchemaCache         *cache.TTLCache[string, *eventSchema]
 
 	mq     msgqueue.MessageQueue
 	mqv1   msgqueuev1.MessageQueue
 	v      validator.Validator
 	repov1 v1.Repository
+	l      *zerolog.Logger
 }
 
 func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
@@ -155,11 +171,13 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 		entitlementsRepository:   opts.entitlementsRepository,
 		stepRunRepository:        opts.stepRunRepository,
 		steprunTenantLookupCache: stepRunCache,
+		eventSchemaCache:         cache.NewTTL[string, *eventSchema](),
 		logRepository:            opts.logRepository,
 		mq:                       opts.mq,
 		mqv1:                     opts.mqv1,
 		v:                        validator.NewDefaultValidator(),
 		repov1:                   opts.repov1,
+		l:                        opts.l,
 	}, nil
 }
 
@@ -174,6 +192,23 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenant *dbsqlc.Tenant, k
 	}
 }
 
+// ingestEventOpts ingests a single event with options which are not supported by IngestEvent, such as a
+// deduplication key.
+func (i *IngestorImpl) ingestEventOpts(ctx context.Context, tenant *dbsqlc.Tenant, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	switch tenant.Version {
+	case dbsqlc.TenantMajorEngineVersionV0:
+		if opts.DeduplicationKey != nil {
+			return nil, errDeduplicationNotSupported
+		}
+
+		return i.ingestEventV0(ctx, tenant, opts.Key, opts.Data, opts.AdditionalMetadata)
+	case dbsqlc.TenantMajorEngineVersionV1:
+		return i.ingestEventOptsV1(ctx, tenant, opts)
+	default:
+		return nil, fmt.Errorf("unsupported tenant version: %s", tenant.Version)
+	}
+}
+
 func (i *IngestorImpl) ingestEventV0(ctx context.Context, tenant *dbsqlc.Tenant, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
 	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
 	defer span.End()
@@ -213,6 +248,12 @@ func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenant *dbsqlc.Tenan
 
 	switch tenant.Version {
 	case dbsqlc.TenantMajorEngineVersionV0:
+		for _, event := range eventOpts {
+			if event.DeduplicationKey != nil {
+				return nil, errDeduplicationNotSupported
+			}
+		}
+
 		return i.bulkIngestEventV0(ctx, tenant, eventOpts)
 	case dbsqlc.TenantMajorEngineVersionV1:
 		return i.bulkIngestEventV1(ctx, tenant, eventOpts)
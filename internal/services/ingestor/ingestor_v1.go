@@ -12,6 +12,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
 )
 
 type EventResult struct {
@@ -23,17 +24,143 @@ type EventResult struct {
 }
 
 func (i *IngestorImpl) ingestEventV1(ctx context.Context, tenant *dbsqlc.Tenant, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	return i.ingestEventOptsV1(ctx, tenant, &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                key,
+		Data:               data,
+		AdditionalMetadata: metadata,
+	})
+}
+
+func (i *IngestorImpl) ingestEventOptsV1(ctx context.Context, tenant *dbsqlc.Tenant, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
 	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
 	defer span.End()
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	return i.ingestSingleton(tenantId, key, data, metadata)
+	events, err := i.validateAndIngestV1(ctx, tenantId, []*repository.CreateEventOpts{opts})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return events[0], nil
 }
 
-func (i *IngestorImpl) ingestSingleton(tenantId, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
-	eventId := uuid.New().String()
+// validateAndIngestV1 validates the events against the event schemas for the tenant, deduplicates the events
+// which have a deduplication key, quarantines the events which do not match a schema with the QUARANTINE
+// policy, and ingests the rest. If an event does not match a schema with the REJECT policy, no events are
+// ingested.
+func (i *IngestorImpl) validateAndIngestV1(ctx context.Context, tenantId string, eventOpts []*repository.CreateEventOpts) ([]*dbsqlc.Event, error) {
+	quarantined, err := i.validateEvents(ctx, tenantId, eventOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eventIds := make([]string, len(eventOpts))
+
+	for idx := range eventOpts {
+		eventIds[idx] = uuid.New().String()
+	}
+
+	// duplicate events are set to the original event, and are not quarantined or ingested again
+	results, claimed, err := i.deduplicateEvents(ctx, tenantId, eventOpts, eventIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// tracks which events have been quarantined or published, so their deduplication keys are kept on failure
+	ingested := make([]bool, len(eventOpts))
+
+	// if the events cannot be ingested, the deduplication keys of the events which were not ingested are
+	// released so that the events can be retried
+	releaseClaimed := func() {
+		isClaimed := make(map[string]bool, len(claimed))
+
+		for _, eventId := range claimed {
+			isClaimed[eventId] = true
+		}
+
+		toRelease := make([]string, 0, len(claimed))
+
+		for idx, eventId := range eventIds {
+			if isClaimed[eventId] && !ingested[idx] {
+				toRelease = append(toRelease, eventId)
+			}
+		}
+
+		if len(toRelease) == 0 {
+			return
+		}
+
+		if err := i.repov1.Events().ReleaseDeduplicationKeys(context.Background(), tenantId, toRelease); err != nil {
+			i.l.Error().Err(err).Msg("could not release deduplication keys")
+		}
+	}
+
+	quarantinedIdxs := make([]int, 0, len(quarantined))
+
+	toQuarantine := make([]v1.QuarantineEventOpts, 0, len(quarantined))
+
+	for idx, event := range eventOpts {
+		errorMessage, ok := quarantined[idx]
+
+		if !ok || results[idx] != nil {
+			continue
+		}
 
+		toQuarantine = append(toQuarantine, v1.QuarantineEventOpts{
+			EventId:            eventIds[idx],
+			Key:                event.Key,
+			Data:               event.Data,
+			AdditionalMetadata: event.AdditionalMetadata,
+			ErrorMessage:       errorMessage,
+		})
+
+		quarantinedIdxs = append(quarantinedIdxs, idx)
+
+		results[idx] = &dbsqlc.Event{
+			ID:                 sqlchelpers.UUIDFromStr(eventIds[idx]),
+			TenantId:           sqlchelpers.UUIDFromStr(tenantId),
+			Key:                event.Key,
+			Data:               event.Data,
+			AdditionalMetadata: event.AdditionalMetadata,
+		}
+	}
+
+	if err := i.quarantineEvents(ctx, tenantId, toQuarantine); err != nil {
+		releaseClaimed()
+		return nil, fmt.Errorf("could not quarantine events: %w", err)
+	}
+
+	for _, idx := range quarantinedIdxs {
+		ingested[idx] = true
+	}
+
+	for idx, event := range eventOpts {
+		if results[idx] != nil {
+			continue
+		}
+
+		res, err := i.ingestSingleton(tenantId, eventIds[idx], event.Key, event.Data, event.AdditionalMetadata)
+
+		if err != nil {
+			releaseClaimed()
+			return nil, fmt.Errorf("could not ingest event: %w", err)
+		}
+
+		results[idx] = res
+		ingested[idx] = true
+	}
+
+	return results, nil
+}
+
+func (i *IngestorImpl) ingestSingleton(tenantId, eventId, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
 	msg, err := eventToTaskV1(
 		tenantId,
 		eventId,
@@ -67,19 +194,7 @@ func (i *IngestorImpl) bulkIngestEventV1(ctx context.Context, tenant *dbsqlc.Ten
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	results := make([]*dbsqlc.Event, 0, len(eventOpts))
-
-	for _, event := range eventOpts {
-		res, err := i.ingestSingleton(tenantId, event.Key, event.Data, event.AdditionalMetadata)
-
-		if err != nil {
-			return nil, fmt.Errorf("could not ingest event: %w", err)
-		}
-
-		results = append(results, res)
-	}
-
-	return results, nil
+	return i.validateAndIngestV1(ctx, tenantId, eventOpts)
 }
 
 func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *dbsqlc.Tenant, replayedEvent *dbsqlc.Event) (*dbsqlc.Event, error) {
@@ -88,7 +203,7 @@ func (i *IngestorImpl) ingestReplayedEventV1(ctx context.Context, tenant *dbsqlc
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	return i.ingestSingleton(tenantId, replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata)
+	return i.ingestSingleton(tenantId, uuid.New().String(), replayedEvent.Key, replayedEvent.Data, replayedEvent.AdditionalMetadata)
 }
 
 func eventToTaskV1(tenantId, eventId, key string, data, additionalMeta []byte) (*msgqueue.Message, error) {
@@ -2,6 +2,7 @@ package ingestor
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -22,12 +23,13 @@ import (
 func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest) (*contracts.Event, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 
-	var additionalMeta []byte
+	opts := toCreateEventOpts(sqlchelpers.UUIDToStr(tenant.ID), req)
 
-	if req.AdditionalMetadata != nil {
-		additionalMeta = []byte(*req.AdditionalMetadata)
+	if err := i.v.Validate(opts); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", err)
 	}
-	event, err := i.IngestEvent(ctx, tenant, req.Key, []byte(req.Payload), additionalMeta)
+
+	event, err := i.ingestEventOpts(ctx, tenant, opts)
 
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
@@ -63,16 +65,7 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 	events := make([]*repository.CreateEventOpts, 0)
 
 	for _, e := range req.Events {
-		var additionalMeta []byte
-		if e.AdditionalMetadata != nil {
-			additionalMeta = []byte(*e.AdditionalMetadata)
-		}
-		events = append(events, &repository.CreateEventOpts{
-			TenantId:           tenantId,
-			Key:                e.Key,
-			Data:               []byte(e.Payload),
-			AdditionalMetadata: additionalMeta,
-		})
+		events = append(events, toCreateEventOpts(tenantId, e))
 	}
 
 	opts := &repository.BulkCreateEventOpts{
@@ -232,6 +225,12 @@ func (i *IngestorImpl) putLogV0(ctx context.Context, tenant *dbsqlc.Tenant, req
 
 	if req.Metadata != "" {
 		metadata = []byte(req.Metadata)
+
+		// v0 log lines accept any metadata, unless they are structured log lines which set a level, whose
+		// metadata are the fields of the log line
+		if req.Level != nil && !isJSONObject(metadata) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: metadata must be a JSON object")
+		}
 	}
 
 	opts := &repository.CreateLogLineOpts{
@@ -271,6 +270,29 @@ func (i *IngestorImpl) putLogV0(ctx context.Context, tenant *dbsqlc.Tenant, req
 	return &contracts.PutLogResponse{}, nil
 }
 
+func toCreateEventOpts(tenantId string, req *contracts.PushEventRequest) *repository.CreateEventOpts {
+	var additionalMeta []byte
+
+	if req.AdditionalMetadata != nil {
+		additionalMeta = []byte(*req.AdditionalMetadata)
+	}
+
+	opts := &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                req.Key,
+		Data:               []byte(req.Payload),
+		AdditionalMetadata: additionalMeta,
+		DeduplicationKey:   req.DeduplicationKey,
+	}
+
+	if req.DeduplicationTtlSeconds != nil {
+		ttl := time.Duration(*req.DeduplicationTtlSeconds) * time.Second
+		opts.DeduplicationTTL = &ttl
+	}
+
+	return opts
+}
+
 func toEvent(e *dbsqlc.Event) (*contracts.Event, error) {
 	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
 	eventId := sqlchelpers.UUIDToStr(e.ID)
@@ -310,3 +332,10 @@ func streamEventToTask(e *dbsqlc.StreamEvent, workflowRunId string, retryCount *
 		Retries:  3,
 	}
 }
+
+// isJSONObject returns whether the metadata of a log line is a JSON object, so that its fields can be filtered.
+func isJSONObject(data []byte) bool {
+	var fields map[string]interface{}
+
+	return json.Unmarshal(data, &fields) == nil && fields != nil
+}
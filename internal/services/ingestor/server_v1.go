@@ -77,6 +77,10 @@ func (i *IngestorImpl) putLogV1(ctx context.Context, tenant *dbsqlc.Tenant, req
 
 	if req.Metadata != "" {
 		metadata = []byte(req.Metadata)
+
+		if !isJSONObject(metadata) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: metadata must be a JSON object")
+		}
 	}
 
 	opts := &v1.CreateLogLineOpts{
@@ -324,6 +324,10 @@ func (s *Scheduler) handleCheckQueue(ctx context.Context, msg *msgqueue.Message)
 			s.pool.NotifyQueues(ctx, msg.TenantID, payload.QueueNames)
 		}
 
+		for _, used := range payload.SlotsUsed {
+			s.pool.UseSlots(ctx, msg.TenantID, used.WorkerId, used.SlotPool, used.Slots)
+		}
+
 		if payload.SlotsReleased {
 			s.pool.Replenish(ctx, msg.TenantID)
 		}
@@ -6,9 +6,20 @@ import (
 )
 
 type CheckTenantQueuesPayload struct {
-	SlotsReleased bool     `json:"slots_released"`
-	QueueNames    []string `json:"queue_name"`
-	StrategyIds   []int64  `json:"strategy_ids"`
+	SlotsReleased bool          `json:"slots_released"`
+	QueueNames    []string      `json:"queue_name"`
+	StrategyIds   []int64       `json:"strategy_ids"`
+	SlotsUsed     []WorkerSlots `json:"slots_used,omitempty"`
+}
+
+// WorkerSlots are slots in the slot pool of a worker which were filled outside of the scheduler
+type WorkerSlots struct {
+	WorkerId string `json:"worker_id"`
+
+	// the name of the slot pool, or an empty string for the default slot pool of the worker
+	SlotPool string `json:"slot_pool"`
+
+	Slots int `json:"slots"`
 }
 
 func NotifyTaskReleased(tenantId string, tasks []*sqlcv1.ReleaseTasksRow) (*msgqueue.Message, error) {
@@ -80,6 +91,33 @@ func NotifyTaskCreated(tenantId string, tasks []*sqlcv1.V1Task) (*msgqueue.Messa
 	)
 }
 
+// NotifySlotsUsed tells the scheduler that slots of a worker were filled outside of the scheduler, so that
+// they are not assigned again before the scheduler loads them from the database.
+func NotifySlotsUsed(tenantId string, slots []WorkerSlots) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"check-tenant-queue",
+		true,
+		false,
+		CheckTenantQueuesPayload{
+			SlotsUsed: slots,
+		},
+	)
+}
+
+// NotifySlotsReleased tells the scheduler that slots of workers were released outside of the scheduler.
+func NotifySlotsReleased(tenantId string) (*msgqueue.Message, error) {
+	return msgqueue.NewTenantMessage(
+		tenantId,
+		"check-tenant-queue",
+		true,
+		false,
+		CheckTenantQueuesPayload{
+			SlotsReleased: true,
+		},
+	)
+}
+
 type TaskAssignedBulkTaskPayload struct {
 	WorkerIdToTaskIds map[string][]int64 `json:"worker_id_to_task_id" validate:"required"`
 }
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"fmt"
+
 	"github.com/jackc/pgx/v5/pgtype"
 
 	msgqueue "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
@@ -184,10 +186,58 @@ type SignalTaskCancelledPayload struct {
 
 type CancelTasksPayload struct {
 	Tasks []v1.TaskIdInsertedAtRetryCount `json:"tasks"`
+
+	// (optional) if set, the next step of the bulk operation is processed
+	BulkOperation *BulkOperationPayload `json:"bulk_operation,omitempty"`
 }
 
 type ReplayTasksPayload struct {
 	Tasks []v1.TaskIdInsertedAtRetryCount `json:"tasks"`
+
+	// (optional) if set, the next step of the bulk operation is processed
+	BulkOperation *BulkOperationPayload `json:"bulk_operation,omitempty"`
+}
+
+type BulkOperationPayload struct {
+	// (required) the bulk operation id
+	BulkOperationId string `json:"bulk_operation_id" validate:"required,uuid"`
+
+	// (optional) the cursor after which to match runs, used while the bulk operation is pending
+	MatchAfter *v1.WorkflowRunCursor `json:"match_after,omitempty"`
+}
+
+// BulkOperationMessage returns the message which processes the next step of a bulk operation. Bulk operations
+// are processed by the same handlers as cancelling or replaying a list of tasks.
+func BulkOperationMessage(tenantId string, kind sqlcv1.V1BulkOperationKind, bulkOperationId string, matchAfter *v1.WorkflowRunCursor) (*msgqueue.Message, error) {
+	payload := &BulkOperationPayload{
+		BulkOperationId: bulkOperationId,
+		MatchAfter:      matchAfter,
+	}
+
+	switch kind {
+	case sqlcv1.V1BulkOperationKindCANCEL:
+		return msgqueue.NewTenantMessage(
+			tenantId,
+			"cancel-tasks",
+			false,
+			true,
+			CancelTasksPayload{
+				BulkOperation: payload,
+			},
+		)
+	case sqlcv1.V1BulkOperationKindREPLAY:
+		return msgqueue.NewTenantMessage(
+			tenantId,
+			"replay-tasks",
+			false,
+			true,
+			ReplayTasksPayload{
+				BulkOperation: payload,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown bulk operation kind: %s", kind)
+	}
 }
 
 type NotifyFinalizedPayload struct {
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestBulkOperationMessage(t *testing.T) {
+	bulkOperationId := "6f5f3c1e-2d0a-4f5e-9a43-6a4c0f1b2c3d"
+
+	after := &v1.WorkflowRunCursor{
+		InsertedAt: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		Id:         1000,
+	}
+
+	msg, err := BulkOperationMessage("tenant", sqlcv1.V1BulkOperationKindCANCEL, bulkOperationId, after)
+	require.NoError(t, err)
+
+	assert.Equal(t, "cancel-tasks", msg.ID)
+	assert.Equal(t, "tenant", msg.TenantID)
+	require.Len(t, msg.Payloads, 1)
+
+	cancel := CancelTasksPayload{}
+	require.NoError(t, json.Unmarshal(msg.Payloads[0], &cancel))
+
+	assert.Empty(t, cancel.Tasks)
+	require.NotNil(t, cancel.BulkOperation)
+	assert.Equal(t, bulkOperationId, cancel.BulkOperation.BulkOperationId)
+	require.NotNil(t, cancel.BulkOperation.MatchAfter)
+	assert.Equal(t, *after, *cancel.BulkOperation.MatchAfter)
+
+	msg, err = BulkOperationMessage("tenant", sqlcv1.V1BulkOperationKindREPLAY, bulkOperationId, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "replay-tasks", msg.ID)
+
+	replay := ReplayTasksPayload{}
+	require.NoError(t, json.Unmarshal(msg.Payloads[0], &replay))
+
+	require.NotNil(t, replay.BulkOperation)
+	assert.Equal(t, bulkOperationId, replay.BulkOperation.BulkOperationId)
+	assert.Nil(t, replay.BulkOperation.MatchAfter)
+
+	_, err = BulkOperationMessage("tenant", sqlcv1.V1BulkOperationKind("DELETE"), bulkOperationId, nil)
+	assert.Error(t, err)
+}
@@ -37,6 +37,11 @@ type WorkflowRun struct {
 
 type AdminClient interface {
 	PutWorkflow(workflow *types.Workflow, opts ...PutOptFunc) error
+
+	// DiffWorkflow validates a workflow without registering it, and returns the changes compared to the
+	// latest registered version
+	DiffWorkflow(workflow *types.Workflow) (*admincontracts.WorkflowVersionDiff, error)
+
 	ScheduleWorkflow(workflowName string, opts ...ScheduleOptFunc) error
 
 	// RunWorkflow triggers a workflow run and returns the run id
@@ -48,6 +53,24 @@ type AdminClient interface {
 	RunChildWorkflows(workflows []*RunChildWorkflowsOpts) ([]string, error)
 
 	PutRateLimit(key string, opts *types.RateLimitOpts) error
+
+	// DeleteRateLimit deletes a static rate limit. Rate limits which are still used by a step or are the parent of another rate limit cannot be deleted.
+	DeleteRateLimit(key string) error
+
+	// ResetRateLimit refills a rate limit to its max value and restarts its window
+	ResetRateLimit(key string) error
+
+	// GetWorkflow returns a workflow by name, including the definition of its latest version
+	GetWorkflow(workflowName string) (*admincontracts.Workflow, error)
+
+	// ListWorkflows lists the workflows for the tenant
+	ListWorkflows(opts ...ListWorkflowsOptFunc) (*admincontracts.ListWorkflowsResponse, error)
+
+	// DeleteWorkflow deletes a workflow and all of its versions by name
+	DeleteWorkflow(workflowName string) error
+
+	// ListWorkflowVersions lists the versions of a workflow with their definitions, newest first
+	ListWorkflowVersions(workflowName string, opts ...ListWorkflowVersionsOptFunc) (*admincontracts.ListWorkflowVersionsResponse, error)
 }
 
 type DedupeViolationErr struct {
@@ -120,6 +143,25 @@ func (a *adminClientImpl) PutWorkflow(workflow *types.Workflow, fs ...PutOptFunc
 	return nil
 }
 
+func (a *adminClientImpl) DiffWorkflow(workflow *types.Workflow) (*admincontracts.WorkflowVersionDiff, error) {
+	req, err := a.getPutRequest(workflow)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get put opts: %w", err)
+	}
+
+	dryRun := true
+	req.DryRun = &dryRun
+
+	resp, err := a.client.PutWorkflow(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not validate workflow %s: %w", workflow.Name, err)
+	}
+
+	return resp.Diff, nil
+}
+
 type scheduleOpts struct {
 	schedules []time.Time
 	input     any
@@ -196,6 +238,22 @@ func WithRunMetadata(metadata interface{}) RunOptFunc {
 	}
 }
 
+// WithRunIdempotencyKey sets a key which makes the trigger idempotent. If a workflow run was triggered with the
+// same key within the TTL, the existing workflow run is returned instead of creating a new one. A TTL of 0 uses
+// the default of 24 hours, which is also the maximum.
+func WithRunIdempotencyKey(key string, ttl time.Duration) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		r.IdempotencyKey = &key
+
+		if ttl > 0 {
+			ttlSeconds := int32(ttl.Seconds()) // nolint: gosec
+			r.IdempotencyKeyTtlSeconds = &ttlSeconds
+		}
+
+		return nil
+	}
+}
+
 func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, options ...RunOptFunc) (*Workflow, error) {
 	inputBytes, err := json.Marshal(input)
 
@@ -397,8 +455,9 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 	}
 
 	putParams := &admincontracts.PutRateLimitRequest{
-		Key:   key,
-		Limit: int32(opts.Max), // nolint: gosec
+		Key:       key,
+		Limit:     int32(opts.Max), // nolint: gosec
+		ParentKey: opts.ParentKey,
 	}
 
 	switch opts.Duration {
@@ -412,6 +471,20 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 		putParams.Duration = admincontracts.RateLimitDuration_SECOND
 	}
 
+	switch opts.Algorithm {
+	case types.TokenBucket:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_TOKEN_BUCKET.Enum()
+	case types.SlidingWindow:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_SLIDING_WINDOW.Enum()
+	case types.FixedWindow:
+		putParams.Algorithm = admincontracts.RateLimitAlgorithm_FIXED_WINDOW.Enum()
+	}
+
+	if opts.Burst != nil {
+		burst := int32(*opts.Burst) // nolint: gosec
+		putParams.Burst = &burst
+	}
+
 	_, err := a.client.PutRateLimit(a.ctx.newContext(context.Background()), putParams)
 
 	if err != nil {
@@ -421,13 +494,138 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 	return nil
 }
 
+func (a *adminClientImpl) DeleteRateLimit(key string) error {
+	_, err := a.client.DeleteRateLimit(a.ctx.newContext(context.Background()), &admincontracts.DeleteRateLimitRequest{
+		Key: key,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not delete rate limit: %w", err)
+	}
+
+	return nil
+}
+
+func (a *adminClientImpl) ResetRateLimit(key string) error {
+	_, err := a.client.ResetRateLimit(a.ctx.newContext(context.Background()), &admincontracts.ResetRateLimitRequest{
+		Key: key,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not reset rate limit: %w", err)
+	}
+
+	return nil
+}
+
+func (a *adminClientImpl) GetWorkflow(workflowName string) (*admincontracts.Workflow, error) {
+	res, err := a.client.GetWorkflow(a.ctx.newContext(context.Background()), &admincontracts.GetWorkflowRequest{
+		Name: a.namespaced(workflowName),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	return res, nil
+}
+
+type ListWorkflowsOptFunc func(*admincontracts.ListWorkflowsRequest)
+
+func WithListWorkflowsLimit(limit int32) ListWorkflowsOptFunc {
+	return func(r *admincontracts.ListWorkflowsRequest) {
+		r.Limit = &limit
+	}
+}
+
+func WithListWorkflowsOffset(offset int32) ListWorkflowsOptFunc {
+	return func(r *admincontracts.ListWorkflowsRequest) {
+		r.Offset = &offset
+	}
+}
+
+func WithListWorkflowsSearch(search string) ListWorkflowsOptFunc {
+	return func(r *admincontracts.ListWorkflowsRequest) {
+		r.Search = &search
+	}
+}
+
+func (a *adminClientImpl) ListWorkflows(opts ...ListWorkflowsOptFunc) (*admincontracts.ListWorkflowsResponse, error) {
+	req := &admincontracts.ListWorkflowsRequest{}
+
+	for _, f := range opts {
+		f(req)
+	}
+
+	res, err := a.client.ListWorkflows(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflows: %w", err)
+	}
+
+	return res, nil
+}
+
+func (a *adminClientImpl) DeleteWorkflow(workflowName string) error {
+	_, err := a.client.DeleteWorkflow(a.ctx.newContext(context.Background()), &admincontracts.DeleteWorkflowRequest{
+		Name: a.namespaced(workflowName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not delete workflow: %w", err)
+	}
+
+	return nil
+}
+
+type ListWorkflowVersionsOptFunc func(*admincontracts.ListWorkflowVersionsRequest)
+
+func WithListWorkflowVersionsLimit(limit int32) ListWorkflowVersionsOptFunc {
+	return func(r *admincontracts.ListWorkflowVersionsRequest) {
+		r.Limit = &limit
+	}
+}
+
+func WithListWorkflowVersionsOffset(offset int32) ListWorkflowVersionsOptFunc {
+	return func(r *admincontracts.ListWorkflowVersionsRequest) {
+		r.Offset = &offset
+	}
+}
+
+func (a *adminClientImpl) ListWorkflowVersions(workflowName string, opts ...ListWorkflowVersionsOptFunc) (*admincontracts.ListWorkflowVersionsResponse, error) {
+	req := &admincontracts.ListWorkflowVersionsRequest{
+		Name: a.namespaced(workflowName),
+	}
+
+	for _, f := range opts {
+		f(req)
+	}
+
+	res, err := a.client.ListWorkflowVersions(a.ctx.newContext(context.Background()), req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow versions: %w", err)
+	}
+
+	return res, nil
+}
+
+func (a *adminClientImpl) namespaced(workflowName string) string {
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		return fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	return workflowName
+}
+
 func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontracts.PutWorkflowRequest, error) {
 	opts := &admincontracts.CreateWorkflowVersionOpts{
-		Name:          workflow.Name,
-		Version:       workflow.Version,
-		Description:   workflow.Description,
-		EventTriggers: workflow.Triggers.Events,
-		CronTriggers:  workflow.Triggers.Cron,
+		Name:                workflow.Name,
+		Version:             workflow.Version,
+		Description:         workflow.Description,
+		EventTriggers:       workflow.Triggers.Events,
+		EventTriggerFilters: workflow.Triggers.EventFilters,
+		CronTriggers:        workflow.Triggers.Cron,
 	}
 
 	if workflow.StickyStrategy != nil {
@@ -437,23 +635,12 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 
 	if workflow.Concurrency != nil {
 		opts.Concurrency = &admincontracts.WorkflowConcurrencyOpts{
-			Action:     workflow.Concurrency.ActionID,
-			Expression: workflow.Concurrency.Expression,
-		}
-
-		var limitStrat admincontracts.ConcurrencyLimitStrategy
-
-		switch workflow.Concurrency.LimitStrategy {
-		case types.CancelInProgress:
-			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
-		case types.GroupRoundRobin:
-			limitStrat = admincontracts.ConcurrencyLimitStrategy_GROUP_ROUND_ROBIN
-		case types.CancelNewest:
-			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_NEWEST
-		default:
-			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
+			Action:           workflow.Concurrency.ActionID,
+			Expression:       workflow.Concurrency.Expression,
+			WeightExpression: workflow.Concurrency.WeightExpression,
 		}
 
+		limitStrat := toLimitStrategy(workflow.Concurrency.LimitStrategy)
 		opts.Concurrency.LimitStrategy = &limitStrat
 
 		// TODO: should be a pointer because users might want to set maxRuns temporarily for disabling
@@ -504,6 +691,21 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 	}, nil
 }
 
+func toLimitStrategy(strategy types.WorkflowConcurrencyLimitStrategy) admincontracts.ConcurrencyLimitStrategy {
+	switch strategy {
+	case types.CancelInProgress:
+		return admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
+	case types.GroupRoundRobin:
+		return admincontracts.ConcurrencyLimitStrategy_GROUP_ROUND_ROBIN
+	case types.CancelNewest:
+		return admincontracts.ConcurrencyLimitStrategy_CANCEL_NEWEST
+	case types.WeightedRoundRobin:
+		return admincontracts.ConcurrencyLimitStrategy_WEIGHTED_ROUND_ROBIN
+	default:
+		return admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
+	}
+}
+
 func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*admincontracts.CreateWorkflowJobOpts, error) {
 	jobOpt := &admincontracts.CreateWorkflowJobOpts{
 		Name:        jobName,
@@ -535,6 +737,33 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 			Retries:           int32(step.Retries), // nolint: gosec
 			BackoffFactor:     step.RetryBackoffFactor,
 			BackoffMaxSeconds: step.RetryMaxBackoffSeconds,
+			SkipIf:            step.SkipIf,
+			Slots:             step.Slots,
+			SlotPool:          step.SlotPool,
+		}
+
+		if step.Map != nil {
+			stepOpt.Map = &admincontracts.StepMap{
+				Expression:     step.Map.Expression,
+				MaxParallelism: step.Map.MaxParallelism,
+			}
+		}
+
+		for _, concurrency := range step.Concurrency {
+			limitStrat := toLimitStrategy(concurrency.LimitStrategy)
+
+			opt := &admincontracts.StepConcurrencyOpts{
+				Expression:       concurrency.Expression,
+				LimitStrategy:    &limitStrat,
+				WeightExpression: concurrency.WeightExpression,
+			}
+
+			if concurrency.MaxRuns != 0 {
+				maxRuns := concurrency.MaxRuns
+				opt.MaxRuns = &maxRuns
+			}
+
+			stepOpt.Concurrency = append(stepOpt.Concurrency, opt)
 		}
 
 		for _, rateLimit := range step.RateLimits {
@@ -543,6 +772,7 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 				KeyExpr:         rateLimit.KeyExpr,
 				UnitsExpr:       rateLimit.UnitsExpr,
 				LimitValuesExpr: rateLimit.LimitValueExpr,
+				ParentKey:       rateLimit.ParentKey,
 			}
 
 			if rateLimit.Units != nil {
@@ -553,6 +783,28 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 			stepOpt.RateLimits = append(stepOpt.RateLimits, opt)
 		}
 
+		for _, condition := range step.Conditions {
+			opt := &admincontracts.StepMatchCondition{
+				OrGroupId:       condition.OrGroupId,
+				ReadableDataKey: condition.ReadableDataKey,
+				SleepFor:        condition.SleepFor,
+				UserEventKey:    condition.EventKey,
+				Expression:      condition.Expression,
+			}
+
+			if condition.Action != "" {
+				action, ok := admincontracts.StepMatchConditionAction_value[string(condition.Action)]
+
+				if !ok {
+					return nil, fmt.Errorf("invalid action %s for condition %s on step %s", condition.Action, condition.ReadableDataKey, step.ID)
+				}
+
+				opt.Action = admincontracts.StepMatchConditionAction(action)
+			}
+
+			stepOpt.Conditions = append(stepOpt.Conditions, opt)
+		}
+
 		if step.DesiredLabels != nil {
 			stepOpt.WorkerLabels = make(map[string]*admincontracts.DesiredWorkerLabels, len(step.DesiredLabels))
 			for key, desiredLabel := range step.DesiredLabels {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"runtime"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -30,13 +32,53 @@ type DispatcherClient interface {
 	RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error
 
 	UpsertWorkerLabels(ctx context.Context, workerId string, labels map[string]interface{}) error
+
+	// RegisterDurableEvent registers a set of wait conditions for a step run and releases the step run's slot
+	// until one of the conditions is satisfied.
+	RegisterDurableEvent(ctx context.Context, req *RegisterDurableEventRequest) error
+
+	// ListenForDurableEvent blocks until the wait conditions registered with RegisterDurableEvent are satisfied,
+	// and returns the data of the satisfied conditions.
+	ListenForDurableEvent(ctx context.Context, stepRunId, signalKey, workerId string) ([]byte, error)
 }
 
 const (
 	DefaultActionListenerRetryInterval = 5 * time.Second
 	DefaultActionListenerRetryCount    = 5
+
+	DefaultDurableEventListenerRetryInterval = 5 * time.Second
 )
 
+type DurableSleepCondition struct {
+	// the key which the sleep data is stored under
+	ReadableDataKey string
+
+	// the duration to sleep for
+	SleepFor time.Duration
+}
+
+type DurableUserEventCondition struct {
+	// the key which the event data is stored under
+	ReadableDataKey string
+
+	// the key of the user event to wait for
+	EventKey string
+
+	// (optional) a CEL expression which the event payload must satisfy
+	Expression string
+}
+
+type RegisterDurableEventRequest struct {
+	StepRunId string
+
+	// a key which identifies the wait within the step run
+	SignalKey string
+
+	SleepConditions []DurableSleepCondition
+
+	UserEventConditions []DurableUserEventCondition
+}
+
 // TODO: add validator to client side
 type GetActionListenerRequest struct {
 	WorkerName string
@@ -45,6 +87,9 @@ type GetActionListenerRequest struct {
 	MaxRuns    *int
 	Labels     map[string]interface{}
 	WebhookId  *string
+
+	// SlotPools are the named slot pools of the worker, mapped to the max number of runs of each pool
+	SlotPools map[string]int
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -118,6 +163,17 @@ type WorkerActionListener interface {
 	Actions(ctx context.Context) (<-chan *Action, <-chan error, error)
 
 	Unregister() error
+
+	// Cordon stops the engine from assigning new actions to the worker. Actions which were already assigned
+	// are still sent to the worker.
+	Cordon(ctx context.Context) error
+
+	// Cordoned returns a channel which is closed once the engine reports that the worker is cordoned
+	Cordoned() <-chan struct{}
+
+	// Drained returns a channel which is closed once the engine reports that the worker is cordoned and no
+	// actions are assigned to it anymore
+	Drained() <-chan struct{}
 }
 
 type ActionEventType string
@@ -198,6 +254,12 @@ type actionListenerImpl struct {
 	ctx *contextLoader
 
 	listenerStrategy ListenerStrategy
+
+	cordoned     chan struct{}
+	cordonedOnce sync.Once
+
+	drained     chan struct{}
+	drainedOnce sync.Once
 }
 
 func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetActionListenerRequest) (*actionListenerImpl, *string, error) {
@@ -259,6 +321,14 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		registerReq.MaxRuns = &mr
 	}
 
+	if len(req.SlotPools) > 0 {
+		registerReq.SlotPools = make(map[string]int32, len(req.SlotPools))
+
+		for name, maxRuns := range req.SlotPools {
+			registerReq.SlotPools[name] = int32(maxRuns) // nolint: gosec
+		}
+	}
+
 	// register the worker
 	resp, err := d.client.Register(d.ctx.newContext(ctx), registerReq)
 
@@ -286,6 +356,8 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		tenantId:         d.tenantId,
 		ctx:              d.ctx,
 		listenerStrategy: ListenerStrategyV2,
+		cordoned:         make(chan struct{}),
+		drained:          make(chan struct{}),
 	}, &resp.WorkerId, nil
 }
 
@@ -311,7 +383,7 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 				if now := time.Now().UTC(); lastHeartbeat.Add(4 * time.Second).Before(now) {
 					a.l.Debug().Msgf("updating worker %s heartbeat", a.workerId)
 
-					_, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
+					resp, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
 						WorkerId:    a.workerId,
 						HeartbeatAt: timestamppb.New(now),
 					})
@@ -323,6 +395,14 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, <-cha
 						if status.Code(err) == codes.Unimplemented {
 							return
 						}
+					} else {
+						if resp.IsCordoned {
+							a.setCordoned()
+						}
+
+						if resp.IsDrained {
+							a.setDrained()
+						}
 					}
 
 					lastHeartbeat = time.Now().UTC()
@@ -499,6 +579,41 @@ func (a *actionListenerImpl) Unregister() error {
 	return nil
 }
 
+func (a *actionListenerImpl) Cordon(ctx context.Context) error {
+	_, err := a.client.Cordon(a.ctx.newContext(ctx), &dispatchercontracts.WorkerCordonRequest{
+		WorkerId:   a.workerId,
+		IsCordoned: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	a.setCordoned()
+
+	return nil
+}
+
+func (a *actionListenerImpl) Cordoned() <-chan struct{} {
+	return a.cordoned
+}
+
+func (a *actionListenerImpl) setCordoned() {
+	a.cordonedOnce.Do(func() {
+		close(a.cordoned)
+	})
+}
+
+func (a *actionListenerImpl) Drained() <-chan struct{} {
+	return a.drained
+}
+
+func (a *actionListenerImpl) setDrained() {
+	a.drainedOnce.Do(func() {
+		close(a.drained)
+	})
+}
+
 func (d *dispatcherClientImpl) GetActionListener(ctx context.Context, req *GetActionListenerRequest) (WorkerActionListener, *string, error) {
 	return d.newActionListener(ctx, req)
 }
@@ -621,6 +736,96 @@ func (a *dispatcherClientImpl) RefreshTimeout(ctx context.Context, stepRunId str
 	return nil
 }
 
+func (a *dispatcherClientImpl) RegisterDurableEvent(ctx context.Context, req *RegisterDurableEventRequest) error {
+	sleepConditions := make([]*dispatchercontracts.SleepMatchCondition, 0, len(req.SleepConditions))
+
+	for _, condition := range req.SleepConditions {
+		sleepConditions = append(sleepConditions, &dispatchercontracts.SleepMatchCondition{
+			ReadableDataKey: condition.ReadableDataKey,
+			SleepFor:        DurationToString(condition.SleepFor),
+		})
+	}
+
+	userEventConditions := make([]*dispatchercontracts.UserEventMatchCondition, 0, len(req.UserEventConditions))
+
+	for _, condition := range req.UserEventConditions {
+		c := &dispatchercontracts.UserEventMatchCondition{
+			ReadableDataKey: condition.ReadableDataKey,
+			UserEventKey:    condition.EventKey,
+		}
+
+		if condition.Expression != "" {
+			expression := condition.Expression
+			c.Expression = &expression
+		}
+
+		userEventConditions = append(userEventConditions, c)
+	}
+
+	_, err := a.client.RegisterDurableEvent(a.ctx.newContext(ctx), &dispatchercontracts.RegisterDurableEventRequest{
+		TaskId:              req.StepRunId,
+		SignalKey:           req.SignalKey,
+		SleepConditions:     sleepConditions,
+		UserEventConditions: userEventConditions,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *dispatcherClientImpl) ListenForDurableEvent(ctx context.Context, stepRunId, signalKey, workerId string) ([]byte, error) {
+	req := &dispatchercontracts.ListenForDurableEventRequest{
+		TaskId:    stepRunId,
+		SignalKey: signalKey,
+		WorkerId:  workerId,
+	}
+
+	// durable waits can last for a long time, so we keep reconnecting until the event is received
+	for {
+		stream, err := a.client.ListenForDurableEvent(a.ctx.newContext(ctx), req)
+
+		if err == nil {
+			var event *dispatchercontracts.DurableEvent
+
+			event, err = stream.Recv()
+
+			if err == nil {
+				return event.Data, nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument, codes.Unimplemented, codes.Unauthenticated, codes.PermissionDenied:
+			return nil, err
+		}
+
+		a.l.Warn().Err(err).Msgf("durable event listener for step run %s disconnected, retrying in %s", stepRunId, DefaultDurableEventListenerRetryInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(DefaultDurableEventListenerRetryInterval):
+		}
+	}
+}
+
+// DurationToString converts a duration to a duration string which the engine understands. The engine only
+// accepts a single unit, so durations are rounded up to the nearest second.
+func DurationToString(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+
+	return fmt.Sprintf("%ds", int64(math.Ceil(d.Seconds())))
+}
+
 func (a *dispatcherClientImpl) UpsertWorkerLabels(ctx context.Context, workerId string, req map[string]interface{}) error {
 	labels := mapLabels(req)
 
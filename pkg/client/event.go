@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
@@ -15,18 +16,31 @@ import (
 
 type pushOpt struct {
 	additionalMetadata map[string]string
+	deduplicationKey   *string
+	deduplicationTTL   *time.Duration
 }
 
 type PushOpFunc func(*pushOpt) error
 
 type BulkPushOpFunc func(*eventcontracts.BulkPushEventRequest) error
 
+type PutLogOpFunc func(*eventcontracts.PutLogRequest) error
+
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "DEBUG"
+	LogLevelInfo  LogLevel = "INFO"
+	LogLevelWarn  LogLevel = "WARN"
+	LogLevelError LogLevel = "ERROR"
+)
+
 type EventClient interface {
 	Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error
 
 	BulkPush(ctx context.Context, payloads []EventWithAdditionalMetadata, options ...BulkPushOpFunc) error
 
-	PutLog(ctx context.Context, stepRunId, msg string) error
+	PutLog(ctx context.Context, stepRunId, msg string, options ...PutLogOpFunc) error
 
 	PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error
 }
@@ -35,6 +49,12 @@ type EventWithAdditionalMetadata struct {
 	Event              interface{}       `json:"event"`
 	AdditionalMetadata map[string]string `json:"metadata"`
 	Key                string            `json:"key"`
+
+	// (optional) a key which deduplicates the event, see WithEventDeduplicationKey
+	DeduplicationKey string `json:"deduplicationKey,omitempty"`
+
+	// (optional) how long the deduplication key is valid for
+	DeduplicationTTL time.Duration `json:"deduplicationTtl,omitempty"`
 }
 
 type eventClientImpl struct {
@@ -73,6 +93,21 @@ func WithEventMetadata(metadata map[string]string) PushOpFunc {
 	}
 }
 
+// WithEventDeduplicationKey sets a key which deduplicates the event. If an event with the same key was pushed
+// within the TTL, the original event is returned and no workflows are triggered. A TTL of 0 uses the default
+// of 10 minutes, and the TTL cannot be longer than 24 hours.
+func WithEventDeduplicationKey(key string, ttl time.Duration) PushOpFunc {
+	return func(r *pushOpt) error {
+		r.deduplicationKey = &key
+
+		if ttl > 0 {
+			r.deduplicationTTL = &ttl
+		}
+
+		return nil
+	}
+}
+
 func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error {
 
 	request := eventcontracts.PushEventRequest{
@@ -107,6 +142,9 @@ func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload int
 
 	request.AdditionalMetadata = &additionalMetaString
 
+	request.DeduplicationKey = opts.deduplicationKey
+	request.DeduplicationTtlSeconds = toDeduplicationTtlSeconds(opts.deduplicationTTL)
+
 	_, err = a.client.Push(a.ctx.newContext(ctx), &request)
 
 	if err != nil {
@@ -134,12 +172,23 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 		}
 		eMetadataString := string(eMetadata)
 
-		events = append(events, &eventcontracts.PushEventRequest{
+		event := &eventcontracts.PushEventRequest{
 			Key:                a.namespace + p.Key,
 			EventTimestamp:     timestamppb.Now(),
 			Payload:            string(ePayload),
 			AdditionalMetadata: &eMetadataString,
-		})
+		}
+
+		if p.DeduplicationKey != "" {
+			deduplicationKey := p.DeduplicationKey
+			event.DeduplicationKey = &deduplicationKey
+
+			if p.DeduplicationTTL > 0 {
+				event.DeduplicationTtlSeconds = toDeduplicationTtlSeconds(&p.DeduplicationTTL)
+			}
+		}
+
+		events = append(events, event)
 	}
 
 	request.Events = events
@@ -153,12 +202,57 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 	return nil
 }
 
-func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string) error {
-	_, err := a.client.PutLog(a.ctx.newContext(ctx), &eventcontracts.PutLogRequest{
+func toDeduplicationTtlSeconds(ttl *time.Duration) *int32 {
+	if ttl == nil {
+		return nil
+	}
+
+	seconds := int32(ttl.Seconds()) // nolint: gosec
+
+	return &seconds
+}
+
+// WithLogLineLevel sets the level of the log line. Log lines are INFO by default.
+func WithLogLineLevel(level LogLevel) PutLogOpFunc {
+	return func(r *eventcontracts.PutLogRequest) error {
+		levelStr := string(level)
+		r.Level = &levelStr
+		return nil
+	}
+}
+
+// WithLogLineFields sets the structured fields of the log line, which can be used to filter log lines.
+func WithLogLineFields(fields map[string]interface{}) PutLogOpFunc {
+	return func(r *eventcontracts.PutLogRequest) error {
+		if len(fields) == 0 {
+			return nil
+		}
+
+		fieldsBytes, err := json.Marshal(fields)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal log fields: %w", err)
+		}
+
+		r.Metadata = string(fieldsBytes)
+		return nil
+	}
+}
+
+func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string, options ...PutLogOpFunc) error {
+	request := &eventcontracts.PutLogRequest{
 		CreatedAt: timestamppb.Now(),
 		StepRunId: stepRunId,
 		Message:   msg,
-	})
+	}
+
+	for _, optionFunc := range options {
+		if err := optionFunc(request); err != nil {
+			return err
+		}
+	}
+
+	_, err := a.client.PutLog(a.ctx.newContext(ctx), request)
 
 	return err
 }
@@ -25,10 +25,12 @@ const (
 
 // Defines values for ConcurrencyLimitStrategy.
 const (
-	CANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	DROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	GROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	QUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	CANCELINPROGRESS   ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	CANCELNEWEST       ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	DROPNEWEST         ConcurrencyLimitStrategy = "DROP_NEWEST"
+	GROUPROUNDROBIN    ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	QUEUENEWEST        ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	WEIGHTEDROUNDROBIN ConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 // Defines values for CronWorkflowsMethod.
@@ -54,6 +56,12 @@ const (
 	EventOrderByFieldCreatedAt EventOrderByField = "createdAt"
 )
 
+// Defines values for EventSchemaPolicy.
+const (
+	QUARANTINE EventSchemaPolicy = "QUARANTINE"
+	REJECT     EventSchemaPolicy = "REJECT"
+)
+
 // Defines values for JobRunStatus.
 const (
 	JobRunStatusBACKOFF   JobRunStatus = "BACKOFF"
@@ -83,6 +91,13 @@ const (
 	LogLineOrderByFieldCreatedAt LogLineOrderByField = "createdAt"
 )
 
+// Defines values for RateLimitAlgorithm.
+const (
+	FIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	SLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+	TOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+)
+
 // Defines values for RateLimitOrderByDirection.
 const (
 	Asc  RateLimitOrderByDirection = "asc"
@@ -182,6 +197,28 @@ const (
 	V1 TenantVersion = "V1"
 )
 
+// Defines values for V1BulkOperationKind.
+const (
+	CANCEL V1BulkOperationKind = "CANCEL"
+	REPLAY V1BulkOperationKind = "REPLAY"
+)
+
+// Defines values for V1BulkOperationStatus.
+const (
+	V1BulkOperationStatusCOMPLETED V1BulkOperationStatus = "COMPLETED"
+	V1BulkOperationStatusFAILED    V1BulkOperationStatus = "FAILED"
+	V1BulkOperationStatusPENDING   V1BulkOperationStatus = "PENDING"
+	V1BulkOperationStatusRUNNING   V1BulkOperationStatus = "RUNNING"
+)
+
+// Defines values for V1LogLineLevel.
+const (
+	DEBUG V1LogLineLevel = "DEBUG"
+	ERROR V1LogLineLevel = "ERROR"
+	INFO  V1LogLineLevel = "INFO"
+	WARN  V1LogLineLevel = "WARN"
+)
+
 // Defines values for V1TaskEventType.
 const (
 	V1TaskEventTypeACKNOWLEDGED       V1TaskEventType = "ACKNOWLEDGED"
@@ -224,6 +261,7 @@ const (
 // Defines values for WorkerStatus.
 const (
 	ACTIVE   WorkerStatus = "ACTIVE"
+	CORDONED WorkerStatus = "CORDONED"
 	INACTIVE WorkerStatus = "INACTIVE"
 	PAUSED   WorkerStatus = "PAUSED"
 )
@@ -265,13 +303,13 @@ const (
 
 // Defines values for WorkflowRunStatus.
 const (
-	BACKOFF   WorkflowRunStatus = "BACKOFF"
-	CANCELLED WorkflowRunStatus = "CANCELLED"
-	FAILED    WorkflowRunStatus = "FAILED"
-	PENDING   WorkflowRunStatus = "PENDING"
-	QUEUED    WorkflowRunStatus = "QUEUED"
-	RUNNING   WorkflowRunStatus = "RUNNING"
-	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
+	WorkflowRunStatusBACKOFF   WorkflowRunStatus = "BACKOFF"
+	WorkflowRunStatusCANCELLED WorkflowRunStatus = "CANCELLED"
+	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
+	WorkflowRunStatusPENDING   WorkflowRunStatus = "PENDING"
+	WorkflowRunStatusQUEUED    WorkflowRunStatus = "QUEUED"
+	WorkflowRunStatusRUNNING   WorkflowRunStatus = "RUNNING"
+	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
 // APIError defines model for APIError.
@@ -510,9 +548,62 @@ type EventOrderByDirection string
 // EventOrderByField defines model for EventOrderByField.
 type EventOrderByField string
 
+// EventSchema defines model for EventSchema.
+type EventSchema struct {
+	// CreatedAt When the schema was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Key The event key which the schema applies to.
+	Key string `json:"key"`
+
+	// Policy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+	Policy EventSchemaPolicy `json:"policy"`
+
+	// Schema The JSON schema which event payloads must match.
+	Schema map[string]interface{} `json:"schema"`
+
+	// UpdatedAt When the schema was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// EventSchemaList defines model for EventSchemaList.
+type EventSchemaList struct {
+	Rows []EventSchema `json:"rows"`
+}
+
+// EventSchemaPolicy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+type EventSchemaPolicy string
+
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventTriggerSkip defines model for EventTriggerSkip.
+type EventTriggerSkip struct {
+	// ErrorMessage The reason the filter could not be evaluated, if it could not be evaluated.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// EventKey The key of the event.
+	EventKey string `json:"eventKey"`
+
+	// Expression The CEL filter on the event trigger which the event did not match.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// WorkflowId The id of the workflow which was not triggered.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow which was not triggered.
+	WorkflowName string `json:"workflowName"`
+
+	// WorkflowVersionId The id of the workflow version which was not triggered.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// EventTriggerSkipList defines model for EventTriggerSkipList.
+type EventTriggerSkipList struct {
+	Rows []EventTriggerSkip `json:"rows"`
+}
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Cancelled The number of cancelled runs.
@@ -602,7 +693,8 @@ type ListSlackWebhooks struct {
 // LogLine defines model for LogLine.
 type LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Level     *LogLineLevel `json:"level,omitempty"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -644,6 +736,42 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PutEventSchemaRequest defines model for PutEventSchemaRequest.
+type PutEventSchemaRequest struct {
+	// Policy What happens to events which do not match the schema. REJECT returns an error to the client, while QUARANTINE stores the event without triggering any workflows.
+	Policy EventSchemaPolicy `json:"policy"`
+
+	// Schema The JSON schema which event payloads must match. References to other documents are not supported.
+	Schema map[string]interface{} `json:"schema"`
+}
+
+// QuarantinedEvent defines model for QuarantinedEvent.
+type QuarantinedEvent struct {
+	// AdditionalMetadata Additional metadata for the event.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
+
+	// CreatedAt When the event was quarantined.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Data The payload of the event, if it is valid JSON.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// ErrorMessage The reason the event did not match the schema.
+	ErrorMessage string `json:"errorMessage"`
+
+	// EventId The id of the event.
+	EventId openapi_types.UUID `json:"eventId"`
+
+	// Key The key of the event.
+	Key string `json:"key"`
+}
+
+// QuarantinedEventList defines model for QuarantinedEventList.
+type QuarantinedEventList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       []QuarantinedEvent  `json:"rows"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -658,6 +786,12 @@ type QueueMetrics struct {
 
 // RateLimit defines model for RateLimit.
 type RateLimit struct {
+	// Algorithm The algorithm used to refill the rate limit.
+	Algorithm RateLimitAlgorithm `json:"algorithm"`
+
+	// Burst The maximum number of units a token bucket rate limit can hold. Defaults to the limitValue.
+	Burst *int `json:"burst,omitempty"`
+
 	// Key The key for the rate limit.
 	Key string `json:"key"`
 
@@ -667,6 +801,9 @@ type RateLimit struct {
 	// LimitValue The maximum number of requests allowed within the window.
 	LimitValue int `json:"limitValue"`
 
+	// ParentKey The key of the parent rate limit. Consuming units of this rate limit also consumes units of the parent.
+	ParentKey *string `json:"parentKey,omitempty"`
+
 	// TenantId The ID of the tenant associated with this rate limit.
 	TenantId string `json:"tenantId"`
 
@@ -677,12 +814,32 @@ type RateLimit struct {
 	Window string `json:"window"`
 }
 
+// RateLimitAlgorithm The algorithm used to refill the rate limit.
+type RateLimitAlgorithm string
+
 // RateLimitList defines model for RateLimitList.
 type RateLimitList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]RateLimit        `json:"rows,omitempty"`
 }
 
+// RateLimitMetric defines model for RateLimitMetric.
+type RateLimitMetric struct {
+	// LimitValue The maximum number of units allowed within the window during the time bucket.
+	LimitValue int `json:"limitValue"`
+
+	// Time The start of the time bucket.
+	Time time.Time `json:"time"`
+
+	// Used The maximum number of units used within the window during the time bucket.
+	Used int `json:"used"`
+}
+
+// RateLimitMetrics defines model for RateLimitMetrics.
+type RateLimitMetrics struct {
+	Results *[]RateLimitMetric `json:"results,omitempty"`
+}
+
 // RateLimitOrderByDirection defines model for RateLimitOrderByDirection.
 type RateLimitOrderByDirection string
 
@@ -783,6 +940,9 @@ type SemaphoreSlots struct {
 	// ActionId The action id.
 	ActionId string `json:"actionId"`
 
+	// Slots The number of worker slots used by the step run.
+	Slots *int `json:"slots,omitempty"`
+
 	// StartedAt The time this slot was started.
 	StartedAt *time.Time    `json:"startedAt,omitempty"`
 	Status    StepRunStatus `json:"status"`
@@ -818,11 +978,14 @@ type SlackWebhook struct {
 
 // Step defines model for Step.
 type Step struct {
-	Action   string          `json:"action"`
-	Children *[]string       `json:"children,omitempty"`
-	JobId    string          `json:"jobId"`
-	Metadata APIResourceMeta `json:"metadata"`
-	Parents  *[]string       `json:"parents,omitempty"`
+	Action   string    `json:"action"`
+	Children *[]string `json:"children,omitempty"`
+
+	// Concurrency The concurrency limits of the step.
+	Concurrency *[]StepConcurrency `json:"concurrency,omitempty"`
+	JobId       string             `json:"jobId"`
+	Metadata    APIResourceMeta    `json:"metadata"`
+	Parents     *[]string          `json:"parents,omitempty"`
 
 	// ReadableId The readable id of the step.
 	ReadableId string `json:"readableId"`
@@ -832,6 +995,19 @@ type Step struct {
 	Timeout *string `json:"timeout,omitempty"`
 }
 
+// StepConcurrency defines model for StepConcurrency.
+type StepConcurrency struct {
+	// Expression The CEL expression which returns the concurrency key.
+	Expression    string                   `json:"expression"`
+	LimitStrategy ConcurrencyLimitStrategy `json:"limitStrategy"`
+
+	// MaxRuns The maximum number of concurrent step runs per concurrency key.
+	MaxRuns int32 `json:"maxRuns"`
+
+	// WeightExpression The CEL expression which returns the weight of a concurrency key, for weighted round-robin limits.
+	WeightExpression *string `json:"weightExpression,omitempty"`
+}
+
 // StepRun defines model for StepRun.
 type StepRun struct {
 	CancelledAt         *time.Time              `json:"cancelledAt,omitempty"`
@@ -1049,6 +1225,9 @@ type TenantResourcePolicy struct {
 // TenantStepRunQueueMetrics defines model for TenantStepRunQueueMetrics.
 type TenantStepRunQueueMetrics struct {
 	Queues *map[string]int `json:"queues,omitempty"`
+
+	// UnavailableSlotPools The number of queued step runs for each slot pool which is not registered by any active worker. These step runs can't be assigned until a worker registers the slot pool.
+	UnavailableSlotPools *map[string]int `json:"unavailableSlotPools,omitempty"`
 }
 
 // TenantVersion defines model for TenantVersion.
@@ -1098,6 +1277,9 @@ type UpdateTenantRequest struct {
 
 // UpdateWorkerRequest defines model for UpdateWorkerRequest.
 type UpdateWorkerRequest struct {
+	// IsCordoned Whether the worker is cordoned. A cordoned worker is not assigned new runs, and shuts down once its running runs have finished.
+	IsCordoned *bool `json:"isCordoned,omitempty"`
+
 	// IsPaused Whether the worker is paused and cannot accept new runs.
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
@@ -1166,6 +1348,33 @@ type UserTenantPublic struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// V1BulkOperation defines model for V1BulkOperation.
+type V1BulkOperation struct {
+	// ErrorMessage The reason the bulk operation failed, if it failed
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// FailedCount The number of workflow runs which could not be processed
+	FailedCount int64 `json:"failedCount"`
+
+	// FinishedAt The time the bulk operation finished
+	FinishedAt *time.Time          `json:"finishedAt,omitempty"`
+	Kind       V1BulkOperationKind `json:"kind"`
+
+	// MatchedCount The number of workflow runs which matched the filter
+	MatchedCount int64           `json:"matchedCount"`
+	Metadata     APIResourceMeta `json:"metadata"`
+
+	// ProcessedCount The number of workflow runs which were successfully processed
+	ProcessedCount int64                 `json:"processedCount"`
+	Status         V1BulkOperationStatus `json:"status"`
+}
+
+// V1BulkOperationKind defines model for V1BulkOperationKind.
+type V1BulkOperationKind string
+
+// V1BulkOperationStatus defines model for V1BulkOperationStatus.
+type V1BulkOperationStatus string
+
 // V1CancelTaskRequest defines model for V1CancelTaskRequest.
 type V1CancelTaskRequest struct {
 	// ExternalIds A list of external IDs, which can refer to either task or workflow run external IDs
@@ -1182,7 +1391,8 @@ type V1DagChildren struct {
 // V1LogLine defines model for V1LogLine.
 type V1LogLine struct {
 	// CreatedAt The creation date of the log line.
-	CreatedAt time.Time `json:"createdAt"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Level     *V1LogLineLevel `json:"level,omitempty"`
 
 	// Message The log message.
 	Message string `json:"message"`
@@ -1191,6 +1401,9 @@ type V1LogLine struct {
 	Metadata map[string]interface{} `json:"metadata"`
 }
 
+// V1LogLineLevel defines model for V1LogLineLevel.
+type V1LogLineLevel string
+
 // V1LogLineList defines model for V1LogLineList.
 type V1LogLineList struct {
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
@@ -1204,6 +1417,12 @@ type V1ReplayTaskRequest struct {
 	Filter      *V1TaskFilter         `json:"filter,omitempty"`
 }
 
+// V1TaskBulkActionResponse defines model for V1TaskBulkActionResponse.
+type V1TaskBulkActionResponse struct {
+	// BulkOperationId The id of the bulk operation which processes the tasks matching the filter, if a filter was provided
+	BulkOperationId *openapi_types.UUID `json:"bulkOperationId,omitempty"`
+}
+
 // V1TaskEvent defines model for V1TaskEvent.
 type V1TaskEvent struct {
 	ErrorMessage    *string             `json:"errorMessage,omitempty"`
@@ -1309,10 +1528,13 @@ type V1TaskSummary struct {
 	TaskInsertedAt time.Time `json:"taskInsertedAt"`
 
 	// TenantId The ID of the tenant.
-	TenantId     openapi_types.UUID `json:"tenantId"`
-	Type         V1WorkflowType     `json:"type"`
-	WorkflowId   openapi_types.UUID `json:"workflowId"`
-	WorkflowName *string            `json:"workflowName,omitempty"`
+	TenantId   openapi_types.UUID `json:"tenantId"`
+	Type       V1WorkflowType     `json:"type"`
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowIsPaused Whether the workflow is paused. Queued tasks for a paused workflow are not assigned to workers until the workflow is resumed.
+	WorkflowIsPaused *bool   `json:"workflowIsPaused,omitempty"`
+	WorkflowName     *string `json:"workflowName,omitempty"`
 
 	// WorkflowRunExternalId The external ID of the workflow run
 	WorkflowRunExternalId *openapi_types.UUID `json:"workflowRunExternalId,omitempty"`
@@ -1332,7 +1554,13 @@ type V1TaskSummaryList struct {
 // V1TriggerWorkflowRunRequest defines model for V1TriggerWorkflowRunRequest.
 type V1TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Input              map[string]interface{}  `json:"input"`
+
+	// IdempotencyKey A key which makes the trigger idempotent. If a workflow run was triggered with the same key within the idempotency window, that workflow run is returned instead of creating a new one.
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+
+	// IdempotencyKeyTtlSeconds How long the idempotency key is valid for, in seconds. Defaults to 24 hours, which is also the maximum.
+	IdempotencyKeyTtlSeconds *int                   `json:"idempotencyKeyTtlSeconds,omitempty"`
+	Input                    map[string]interface{} `json:"input"`
 
 	// WorkflowName The name of the workflow.
 	WorkflowName string `json:"workflowName"`
@@ -1471,7 +1699,7 @@ type Worker struct {
 	// Actions The actions this worker can perform.
 	Actions *[]string `json:"actions,omitempty"`
 
-	// AvailableRuns The number of runs this worker can execute concurrently.
+	// AvailableRuns The number of slots of this worker which are not used by running steps.
 	AvailableRuns *int `json:"availableRuns,omitempty"`
 
 	// DispatcherId the id of the assigned dispatcher, in UUID format
@@ -1486,7 +1714,7 @@ type Worker struct {
 	// LastListenerEstablished The time this worker last sent a heartbeat.
 	LastListenerEstablished *time.Time `json:"lastListenerEstablished,omitempty"`
 
-	// MaxRuns The maximum number of runs this worker can execute concurrently.
+	// MaxRuns The number of slots of this worker. A run uses as many slots as its step declares.
 	MaxRuns  *int            `json:"maxRuns,omitempty"`
 	Metadata APIResourceMeta `json:"metadata"`
 
@@ -1497,6 +1725,9 @@ type Worker struct {
 	RecentStepRuns *[]RecentStepRuns  `json:"recentStepRuns,omitempty"`
 	RuntimeInfo    *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
 
+	// SlotPools The named slot pools of the worker. Steps which declare a slot pool only use slots from that pool, other steps use the slots in maxRuns.
+	SlotPools *[]WorkerSlotPool `json:"slotPools,omitempty"`
+
 	// Slots The semaphore slot state for the worker.
 	Slots *[]SemaphoreSlots `json:"slots,omitempty"`
 
@@ -1542,6 +1773,18 @@ type WorkerRuntimeInfo struct {
 // WorkerRuntimeSDKs defines model for WorkerRuntimeSDKs.
 type WorkerRuntimeSDKs string
 
+// WorkerSlotPool defines model for WorkerSlotPool.
+type WorkerSlotPool struct {
+	// AvailableRuns The number of slots in the slot pool which are not used by running steps.
+	AvailableRuns int `json:"availableRuns"`
+
+	// MaxRuns The number of slots in the slot pool.
+	MaxRuns int `json:"maxRuns"`
+
+	// Name The name of the slot pool.
+	Name string `json:"name"`
+}
+
 // WorkerType defines model for WorkerType.
 type WorkerType string
 
@@ -1717,7 +1960,10 @@ type WorkflowTriggerCronRef struct {
 // WorkflowTriggerEventRef defines model for WorkflowTriggerEventRef.
 type WorkflowTriggerEventRef struct {
 	EventKey *string `json:"event_key,omitempty"`
-	ParentId *string `json:"parent_id,omitempty"`
+
+	// Expression The CEL filter which events must match to trigger the workflow.
+	Expression *string `json:"expression,omitempty"`
+	ParentId   *string `json:"parent_id,omitempty"`
 }
 
 // WorkflowTriggers defines model for WorkflowTriggers.
@@ -1783,6 +2029,15 @@ type V1DagListTasksParams struct {
 	Tenant openapi_types.UUID `form:"tenant" json:"tenant"`
 }
 
+// V1LogLineListParams defines parameters for V1LogLineList.
+type V1LogLineListParams struct {
+	// Levels A list of levels to filter by
+	Levels *[]V1LogLineLevel `form:"levels,omitempty" json:"levels,omitempty"`
+
+	// Fields Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
 // V1TaskEventListParams defines parameters for V1TaskEventList.
 type V1TaskEventListParams struct {
 	// Offset The number to skip
@@ -1890,6 +2145,9 @@ type LogLineListParams struct {
 	// Levels A list of levels to filter by
 	Levels *LogLineLevelField `form:"levels,omitempty" json:"levels,omitempty"`
 
+	// Fields Structured fields to filter by, as key:value pairs. Values are parsed as JSON scalars, and are matched as strings if they are not valid JSON scalars
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+
 	// Search The search query to filter for
 	Search *LogLineSearch `form:"search,omitempty" json:"search,omitempty"`
 
@@ -1933,6 +2191,18 @@ type EventListParams struct {
 	EventIds *[]openapi_types.UUID `form:"eventIds,omitempty" json:"eventIds,omitempty"`
 }
 
+// EventListQuarantinedParams defines parameters for EventListQuarantined.
+type EventListQuarantinedParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Keys A list of keys to filter by
+	Keys *[]EventKey `form:"keys,omitempty" json:"keys,omitempty"`
+}
+
 // TenantGetQueueMetricsParams defines parameters for TenantGetQueueMetrics.
 type TenantGetQueueMetricsParams struct {
 	// Workflows A list of workflow IDs to filter by
@@ -1960,6 +2230,15 @@ type RateLimitListParams struct {
 	OrderByDirection *RateLimitOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// RateLimitGetMetricsParams defines parameters for RateLimitGetMetrics.
+type RateLimitGetMetricsParams struct {
+	// Since The time after which to get metrics, defaults to 24 hours ago
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until The time before which to get metrics, defaults to now
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // WorkflowRunListStepRunEventsParams defines parameters for WorkflowRunListStepRunEvents.
 type WorkflowRunListStepRunEventsParams struct {
 	// LastId Last ID of the last event
@@ -2158,6 +2437,9 @@ type EventUpdateCancelJSONRequestBody = CancelEventRequest
 // EventUpdateReplayJSONRequestBody defines body for EventUpdateReplay for application/json ContentType.
 type EventUpdateReplayJSONRequestBody = ReplayEventRequest
 
+// EventSchemaPutJSONRequestBody defines body for EventSchemaPut for application/json ContentType.
+type EventSchemaPutJSONRequestBody = PutEventSchemaRequest
+
 // TenantInviteCreateJSONRequestBody defines body for TenantInviteCreate for application/json ContentType.
 type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 
@@ -2333,11 +2615,14 @@ type ClientInterface interface {
 	V1TaskGet(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// V1LogLineList request
-	V1LogLineList(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	V1LogLineList(ctx context.Context, task openapi_types.UUID, params *V1LogLineListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// V1TaskEventList request
 	V1TaskEventList(ctx context.Context, task openapi_types.UUID, params *V1TaskEventListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V1BulkOperationGet request
+	V1BulkOperationGet(ctx context.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V1TaskListStatusMetrics request
 	V1TaskListStatusMetrics(ctx context.Context, tenant openapi_types.UUID, params *V1TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2430,11 +2715,31 @@ type ClientInterface interface {
 	// EventKeyList request
 	EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventListQuarantined request
+	EventListQuarantined(ctx context.Context, tenant openapi_types.UUID, params *EventListQuarantinedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventUpdateReplayWithBody request with any body
 	EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventSchemaList request
+	EventSchemaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSchemaDelete request
+	EventSchemaDelete(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSchemaGet request
+	EventSchemaGet(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSchemaPutWithBody request with any body
+	EventSchemaPutWithBody(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventSchemaPut(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, body EventSchemaPutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventListTriggerSkips request
+	EventListTriggerSkips(ctx context.Context, tenant openapi_types.UUID, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantInviteList request
 	TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2463,6 +2768,15 @@ type ClientInterface interface {
 	// RateLimitList request
 	RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RateLimitDelete request
+	RateLimitDelete(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RateLimitGetMetrics request
+	RateLimitGetMetrics(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, params *RateLimitGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RateLimitReset request
+	RateLimitReset(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantResourcePolicyGet request
 	TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2866,8 +3180,8 @@ func (c *Client) V1TaskGet(ctx context.Context, task openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) V1LogLineList(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewV1LogLineListRequest(c.Server, task)
+func (c *Client) V1LogLineList(ctx context.Context, task openapi_types.UUID, params *V1LogLineListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1LogLineListRequest(c.Server, task, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2890,6 +3204,18 @@ func (c *Client) V1TaskEventList(ctx context.Context, task openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
+func (c *Client) V1BulkOperationGet(ctx context.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1BulkOperationGetRequest(c.Server, tenant, bulkOperation)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) V1TaskListStatusMetrics(ctx context.Context, tenant openapi_types.UUID, params *V1TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewV1TaskListStatusMetricsRequest(c.Server, tenant, params)
 	if err != nil {
@@ -3298,6 +3624,18 @@ func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
+func (c *Client) EventListQuarantined(ctx context.Context, tenant openapi_types.UUID, params *EventListQuarantinedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventListQuarantinedRequest(c.Server, tenant, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEventUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
@@ -3322,6 +3660,78 @@ func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
+func (c *Client) EventSchemaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSchemaListRequest(c.Server, tenant)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventSchemaDelete(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSchemaDeleteRequest(c.Server, tenant, eventKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventSchemaGet(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSchemaGetRequest(c.Server, tenant, eventKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventSchemaPutWithBody(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSchemaPutRequestWithBody(c.Server, tenant, eventKey, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventSchemaPut(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, body EventSchemaPutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSchemaPutRequest(c.Server, tenant, eventKey, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventListTriggerSkips(ctx context.Context, tenant openapi_types.UUID, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventListTriggerSkipsRequest(c.Server, tenant, event)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewTenantInviteListRequest(c.Server, tenant)
 	if err != nil {
@@ -3442,6 +3852,42 @@ func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
+func (c *Client) RateLimitDelete(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitDeleteRequest(c.Server, tenant, rateLimitKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RateLimitGetMetrics(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, params *RateLimitGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitGetMetricsRequest(c.Server, tenant, rateLimitKey, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RateLimitReset(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitResetRequest(c.Server, tenant, rateLimitKey)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
 	if err != nil {
@@ -4847,7 +5293,7 @@ func NewV1TaskGetRequest(server string, task openapi_types.UUID) (*http.Request,
 }
 
 // NewV1LogLineListRequest generates requests for V1LogLineList
-func NewV1LogLineListRequest(server string, task openapi_types.UUID) (*http.Request, error) {
+func NewV1LogLineListRequest(server string, task openapi_types.UUID, params *V1LogLineListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4872,13 +5318,51 @@ func NewV1LogLineListRequest(server string, task openapi_types.UUID) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Levels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
 
 // NewV1TaskEventListRequest generates requests for V1TaskEventList
 func NewV1TaskEventListRequest(server string, task openapi_types.UUID, params *V1TaskEventListParams) (*http.Request, error) {
@@ -4952,6 +5436,47 @@ func NewV1TaskEventListRequest(server string, task openapi_types.UUID, params *V
 	return req, nil
 }
 
+// NewV1BulkOperationGetRequest generates requests for V1BulkOperationGet
+func NewV1BulkOperationGetRequest(server string, tenant openapi_types.UUID, bulkOperation openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bulk-operation", runtime.ParamLocationPath, bulkOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/stable/tenants/%s/bulk-operations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewV1TaskListStatusMetricsRequest generates requests for V1TaskListStatusMetrics
 func NewV1TaskListStatusMetricsRequest(server string, tenant openapi_types.UUID, params *V1TaskListStatusMetricsParams) (*http.Request, error) {
 	var err error
@@ -5820,6 +6345,22 @@ func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *Lo
 
 		}
 
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		if params.Search != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
@@ -6537,6 +7078,94 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
+// NewEventListQuarantinedRequest generates requests for EventListQuarantined
+func NewEventListQuarantinedRequest(server string, tenant openapi_types.UUID, params *EventListQuarantinedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/quarantined", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keys != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
 func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -6584,8 +7213,8 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewTenantInviteListRequest generates requests for TenantInviteList
-func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventSchemaListRequest generates requests for EventSchemaList
+func NewEventSchemaListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6600,7 +7229,7 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/schemas", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6618,19 +7247,8 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
-func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
-func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventSchemaDeleteRequest generates requests for EventSchemaDelete
+func NewEventSchemaDeleteRequest(server string, tenant openapi_types.UUID, eventKey EventKey) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6640,12 +7258,19 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event-key", runtime.ParamLocationPath, eventKey)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/schemas/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6655,18 +7280,16 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
-func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
+// NewEventSchemaGetRequest generates requests for EventSchemaGet
+func NewEventSchemaGetRequest(server string, tenant openapi_types.UUID, eventKey EventKey) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6678,7 +7301,7 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event-key", runtime.ParamLocationPath, eventKey)
 	if err != nil {
 		return nil, err
 	}
@@ -6688,7 +7311,7 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/schemas/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6698,7 +7321,7 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6706,19 +7329,19 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 	return req, nil
 }
 
-// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
-func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
+// NewEventSchemaPutRequest calls the generic EventSchemaPut builder with application/json body
+func NewEventSchemaPutRequest(server string, tenant openapi_types.UUID, eventKey EventKey, body EventSchemaPutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
+	return NewEventSchemaPutRequestWithBody(server, tenant, eventKey, "application/json", bodyReader)
 }
 
-// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
-func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventSchemaPutRequestWithBody generates requests for EventSchemaPut with any type of body
+func NewEventSchemaPutRequestWithBody(server string, tenant openapi_types.UUID, eventKey EventKey, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6730,7 +7353,7 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event-key", runtime.ParamLocationPath, eventKey)
 	if err != nil {
 		return nil, err
 	}
@@ -6740,7 +7363,7 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/schemas/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6750,7 +7373,7 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -6760,8 +7383,8 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 	return req, nil
 }
 
-// NewTenantMemberListRequest generates requests for TenantMemberList
-func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventListTriggerSkipsRequest generates requests for EventListTriggerSkips
+func NewEventListTriggerSkipsRequest(server string, tenant openapi_types.UUID, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6771,12 +7394,19 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/%s/trigger-skips", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6794,8 +7424,8 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
-func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteListRequest generates requests for TenantInviteList
+func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6805,19 +7435,12 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6827,7 +7450,7 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6835,8 +7458,225 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 	return req, nil
 }
 
-// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
-func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
+// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
+func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
+func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
+func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
+func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
+}
+
+// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
+func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantMemberListRequest generates requests for TenantMemberList
+func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
+func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
+func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7027,6 +7867,167 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 	return req, nil
 }
 
+// NewRateLimitDeleteRequest generates requests for RateLimitDelete
+func NewRateLimitDeleteRequest(server string, tenant openapi_types.UUID, rateLimitKey string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, rateLimitKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRateLimitGetMetricsRequest generates requests for RateLimitGetMetrics
+func NewRateLimitGetMetricsRequest(server string, tenant openapi_types.UUID, rateLimitKey string, params *RateLimitGetMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, rateLimitKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits/%s/metrics", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRateLimitResetRequest generates requests for RateLimitReset
+func NewRateLimitResetRequest(server string, tenant openapi_types.UUID, rateLimitKey string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rate-limit-key", runtime.ParamLocationPath, rateLimitKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits/%s/reset", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
 func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
@@ -9994,11 +10995,14 @@ type ClientWithResponsesInterface interface {
 	V1TaskGetWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1TaskGetResponse, error)
 
 	// V1LogLineListWithResponse request
-	V1LogLineListWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1LogLineListResponse, error)
+	V1LogLineListWithResponse(ctx context.Context, task openapi_types.UUID, params *V1LogLineListParams, reqEditors ...RequestEditorFn) (*V1LogLineListResponse, error)
 
 	// V1TaskEventListWithResponse request
 	V1TaskEventListWithResponse(ctx context.Context, task openapi_types.UUID, params *V1TaskEventListParams, reqEditors ...RequestEditorFn) (*V1TaskEventListResponse, error)
 
+	// V1BulkOperationGetWithResponse request
+	V1BulkOperationGetWithResponse(ctx context.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1BulkOperationGetResponse, error)
+
 	// V1TaskListStatusMetricsWithResponse request
 	V1TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V1TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V1TaskListStatusMetricsResponse, error)
 
@@ -10091,11 +11095,31 @@ type ClientWithResponsesInterface interface {
 	// EventKeyListWithResponse request
 	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
 
+	// EventListQuarantinedWithResponse request
+	EventListQuarantinedWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListQuarantinedParams, reqEditors ...RequestEditorFn) (*EventListQuarantinedResponse, error)
+
 	// EventUpdateReplayWithBodyWithResponse request with any body
 	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
 
 	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
 
+	// EventSchemaListWithResponse request
+	EventSchemaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSchemaListResponse, error)
+
+	// EventSchemaDeleteWithResponse request
+	EventSchemaDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*EventSchemaDeleteResponse, error)
+
+	// EventSchemaGetWithResponse request
+	EventSchemaGetWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*EventSchemaGetResponse, error)
+
+	// EventSchemaPutWithBodyWithResponse request with any body
+	EventSchemaPutWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventSchemaPutResponse, error)
+
+	EventSchemaPutWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, body EventSchemaPutJSONRequestBody, reqEditors ...RequestEditorFn) (*EventSchemaPutResponse, error)
+
+	// EventListTriggerSkipsWithResponse request
+	EventListTriggerSkipsWithResponse(ctx context.Context, tenant openapi_types.UUID, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventListTriggerSkipsResponse, error)
+
 	// TenantInviteListWithResponse request
 	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
 
@@ -10124,6 +11148,15 @@ type ClientWithResponsesInterface interface {
 	// RateLimitListWithResponse request
 	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
 
+	// RateLimitDeleteWithResponse request
+	RateLimitDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*RateLimitDeleteResponse, error)
+
+	// RateLimitGetMetricsWithResponse request
+	RateLimitGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, params *RateLimitGetMetricsParams, reqEditors ...RequestEditorFn) (*RateLimitGetMetricsResponse, error)
+
+	// RateLimitResetWithResponse request
+	RateLimitResetWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*RateLimitResetResponse, error)
+
 	// TenantResourcePolicyGetWithResponse request
 	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
 
@@ -10700,13 +11733,39 @@ func (r V1TaskGetResponse) StatusCode() int {
 type V1LogLineListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *V1LogLineList
+	JSON200      *V1LogLineList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r V1LogLineListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1LogLineListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1TaskEventListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1TaskEventList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON501      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r V1LogLineListResponse) Status() string {
+func (r V1TaskEventListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10714,17 +11773,17 @@ func (r V1LogLineListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1LogLineListResponse) StatusCode() int {
+func (r V1TaskEventListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type V1TaskEventListResponse struct {
+type V1BulkOperationGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *V1TaskEventList
+	JSON200      *V1BulkOperation
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
@@ -10732,7 +11791,7 @@ type V1TaskEventListResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r V1TaskEventListResponse) Status() string {
+func (r V1BulkOperationGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10740,7 +11799,7 @@ func (r V1TaskEventListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1TaskEventListResponse) StatusCode() int {
+func (r V1BulkOperationGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -10800,6 +11859,7 @@ func (r V1TaskGetPointMetricsResponse) StatusCode() int {
 type V1TaskCancelResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V1TaskBulkActionResponse
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
@@ -10825,6 +11885,7 @@ func (r V1TaskCancelResponse) StatusCode() int {
 type V1TaskReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *V1TaskBulkActionResponse
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
@@ -11338,6 +12399,30 @@ func (r EventKeyListResponse) StatusCode() int {
 	return 0
 }
 
+type EventListQuarantinedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuarantinedEventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventListQuarantinedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventListQuarantinedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type EventUpdateReplayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -11363,6 +12448,127 @@ func (r EventUpdateReplayResponse) StatusCode() int {
 	return 0
 }
 
+type EventSchemaListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSchemaList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSchemaListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSchemaListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSchemaDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSchemaDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSchemaDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSchemaGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSchema
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSchemaGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSchemaGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSchemaPutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSchema
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSchemaPutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSchemaPutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventListTriggerSkipsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventTriggerSkipList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventListTriggerSkipsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventListTriggerSkipsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type TenantInviteListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -11555,6 +12761,79 @@ func (r RateLimitListResponse) StatusCode() int {
 	return 0
 }
 
+type RateLimitDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateLimitGetMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateLimitMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitGetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitGetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateLimitResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateLimit
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type TenantResourcePolicyGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -13060,8 +14339,8 @@ func (c *ClientWithResponses) V1TaskGetWithResponse(ctx context.Context, task op
 }
 
 // V1LogLineListWithResponse request returning *V1LogLineListResponse
-func (c *ClientWithResponses) V1LogLineListWithResponse(ctx context.Context, task openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1LogLineListResponse, error) {
-	rsp, err := c.V1LogLineList(ctx, task, reqEditors...)
+func (c *ClientWithResponses) V1LogLineListWithResponse(ctx context.Context, task openapi_types.UUID, params *V1LogLineListParams, reqEditors ...RequestEditorFn) (*V1LogLineListResponse, error) {
+	rsp, err := c.V1LogLineList(ctx, task, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -13077,6 +14356,15 @@ func (c *ClientWithResponses) V1TaskEventListWithResponse(ctx context.Context, t
 	return ParseV1TaskEventListResponse(rsp)
 }
 
+// V1BulkOperationGetWithResponse request returning *V1BulkOperationGetResponse
+func (c *ClientWithResponses) V1BulkOperationGetWithResponse(ctx context.Context, tenant openapi_types.UUID, bulkOperation openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1BulkOperationGetResponse, error) {
+	rsp, err := c.V1BulkOperationGet(ctx, tenant, bulkOperation, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1BulkOperationGetResponse(rsp)
+}
+
 // V1TaskListStatusMetricsWithResponse request returning *V1TaskListStatusMetricsResponse
 func (c *ClientWithResponses) V1TaskListStatusMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *V1TaskListStatusMetricsParams, reqEditors ...RequestEditorFn) (*V1TaskListStatusMetricsResponse, error) {
 	rsp, err := c.V1TaskListStatusMetrics(ctx, tenant, params, reqEditors...)
@@ -13344,50 +14632,112 @@ func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, t
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
+	return ParseEventCreateBulkResponse(rsp)
+}
+
+// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
+func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateCancelResponse(rsp)
+}
+
+func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateCancelResponse(rsp)
+}
+
+// EventKeyListWithResponse request returning *EventKeyListResponse
+func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
+	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventKeyListResponse(rsp)
+}
+
+// EventListQuarantinedWithResponse request returning *EventListQuarantinedResponse
+func (c *ClientWithResponses) EventListQuarantinedWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListQuarantinedParams, reqEditors ...RequestEditorFn) (*EventListQuarantinedResponse, error) {
+	rsp, err := c.EventListQuarantined(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventListQuarantinedResponse(rsp)
+}
+
+// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
+func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateReplayResponse(rsp)
+}
+
+func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateReplayResponse(rsp)
+}
+
+// EventSchemaListWithResponse request returning *EventSchemaListResponse
+func (c *ClientWithResponses) EventSchemaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSchemaListResponse, error) {
+	rsp, err := c.EventSchemaList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventSchemaListResponse(rsp)
 }
 
-// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
-func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+// EventSchemaDeleteWithResponse request returning *EventSchemaDeleteResponse
+func (c *ClientWithResponses) EventSchemaDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*EventSchemaDeleteResponse, error) {
+	rsp, err := c.EventSchemaDelete(ctx, tenant, eventKey, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseEventSchemaDeleteResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+// EventSchemaGetWithResponse request returning *EventSchemaGetResponse
+func (c *ClientWithResponses) EventSchemaGetWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, reqEditors ...RequestEditorFn) (*EventSchemaGetResponse, error) {
+	rsp, err := c.EventSchemaGet(ctx, tenant, eventKey, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+	return ParseEventSchemaGetResponse(rsp)
 }
 
-// EventKeyListWithResponse request returning *EventKeyListResponse
-func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
-	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+// EventSchemaPutWithBodyWithResponse request with arbitrary body returning *EventSchemaPutResponse
+func (c *ClientWithResponses) EventSchemaPutWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventSchemaPutResponse, error) {
+	rsp, err := c.EventSchemaPutWithBody(ctx, tenant, eventKey, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventKeyListResponse(rsp)
+	return ParseEventSchemaPutResponse(rsp)
 }
 
-// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
-func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+func (c *ClientWithResponses) EventSchemaPutWithResponse(ctx context.Context, tenant openapi_types.UUID, eventKey EventKey, body EventSchemaPutJSONRequestBody, reqEditors ...RequestEditorFn) (*EventSchemaPutResponse, error) {
+	rsp, err := c.EventSchemaPut(ctx, tenant, eventKey, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseEventSchemaPutResponse(rsp)
 }
 
-func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+// EventListTriggerSkipsWithResponse request returning *EventListTriggerSkipsResponse
+func (c *ClientWithResponses) EventListTriggerSkipsWithResponse(ctx context.Context, tenant openapi_types.UUID, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventListTriggerSkipsResponse, error) {
+	rsp, err := c.EventListTriggerSkips(ctx, tenant, event, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+	return ParseEventListTriggerSkipsResponse(rsp)
 }
 
 // TenantInviteListWithResponse request returning *TenantInviteListResponse
@@ -13478,6 +14828,33 @@ func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, ten
 	return ParseRateLimitListResponse(rsp)
 }
 
+// RateLimitDeleteWithResponse request returning *RateLimitDeleteResponse
+func (c *ClientWithResponses) RateLimitDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*RateLimitDeleteResponse, error) {
+	rsp, err := c.RateLimitDelete(ctx, tenant, rateLimitKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateLimitDeleteResponse(rsp)
+}
+
+// RateLimitGetMetricsWithResponse request returning *RateLimitGetMetricsResponse
+func (c *ClientWithResponses) RateLimitGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, params *RateLimitGetMetricsParams, reqEditors ...RequestEditorFn) (*RateLimitGetMetricsResponse, error) {
+	rsp, err := c.RateLimitGetMetrics(ctx, tenant, rateLimitKey, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateLimitGetMetricsResponse(rsp)
+}
+
+// RateLimitResetWithResponse request returning *RateLimitResetResponse
+func (c *ClientWithResponses) RateLimitResetWithResponse(ctx context.Context, tenant openapi_types.UUID, rateLimitKey string, reqEditors ...RequestEditorFn) (*RateLimitResetResponse, error) {
+	rsp, err := c.RateLimitReset(ctx, tenant, rateLimitKey, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateLimitResetResponse(rsp)
+}
+
 // TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
 func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
 	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
@@ -14126,63 +15503,282 @@ func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error
 		return nil, err
 	}
 
-	response := &ReadinessGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	response := &ReadinessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
+func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertEmailGroupDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
+func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertEmailGroupUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
+func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiTokenUpdateRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
+func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloudMetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
+func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EventGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
+func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EventDataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
-func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
+func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupDeleteResponse{
+	response := &MetadataGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIMeta
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
-func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
+func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupUpdateResponse{
+	response := &MetadataListIntegrationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroup
+		var dest ListAPIMetaIntegration
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14195,8 +15791,27 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 		}
 		response.JSON400 = &dest
 
+	}
+
+	return response, nil
+}
+
+// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
+func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MonitoringPostRunProbeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14207,15 +15822,15 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 	return response, nil
 }
 
-// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
-func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
+func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenUpdateRevokeResponse{
+	response := &SlackWebhookDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -14228,107 +15843,121 @@ func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevok
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
-func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
+func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloudMetadataGetResponse{
+	response := &SnsDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
-func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
+func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventGetResponse{
+	response := &SnsUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
-func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+// ParseV1DagListTasksResponse parses an HTTP response from a V1DagListTasksWithResponse call
+func ParseV1DagListTasksResponse(rsp *http.Response) (*V1DagListTasksResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventDataGetResponse{
+	response := &V1DagListTasksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventData
+		var dest []V1DagChildren
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14348,27 +15977,34 @@ func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
-func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+// ParseV1TaskGetResponse parses an HTTP response from a V1TaskGetWithResponse call
+func ParseV1TaskGetResponse(rsp *http.Response) (*V1TaskGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataGetResponse{
+	response := &V1TaskGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIMeta
+		var dest V1TaskSummary
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14381,27 +16017,48 @@ func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error)
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
-func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+// ParseV1LogLineListResponse parses an HTTP response from a V1LogLineListWithResponse call
+func ParseV1LogLineListResponse(rsp *http.Response) (*V1LogLineListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataListIntegrationsResponse{
+	response := &V1LogLineListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPIMetaIntegration
+		var dest V1LogLineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14414,25 +16071,46 @@ func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListInt
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseMonitoringPostRunProbeResponse parses an HTTP response from a MonitoringPostRunProbeWithResponse call
-func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRunProbeResponse, error) {
+// ParseV1TaskEventListResponse parses an HTTP response from a V1TaskEventListWithResponse call
+func ParseV1TaskEventListResponse(rsp *http.Response) (*V1TaskEventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MonitoringPostRunProbeResponse{
+	response := &V1TaskEventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1TaskEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14440,25 +16118,46 @@ func ParseMonitoringPostRunProbeResponse(rsp *http.Response) (*MonitoringPostRun
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
-func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+// ParseV1BulkOperationGetResponse parses an HTTP response from a V1BulkOperationGetWithResponse call
+func ParseV1BulkOperationGetResponse(rsp *http.Response) (*V1BulkOperationGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookDeleteResponse{
+	response := &V1BulkOperationGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1BulkOperation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14466,39 +16165,53 @@ func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteRes
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
-func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+// ParseV1TaskListStatusMetricsResponse parses an HTTP response from a V1TaskListStatusMetricsWithResponse call
+func ParseV1TaskListStatusMetricsResponse(rsp *http.Response) (*V1TaskListStatusMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsDeleteResponse{
+	response := &V1TaskListStatusMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1TaskRunMetrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14506,39 +16219,46 @@ func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
-func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+// ParseV1TaskGetPointMetricsResponse parses an HTTP response from a V1TaskGetPointMetricsWithResponse call
+func ParseV1TaskGetPointMetricsResponse(rsp *http.Response) (*V1TaskGetPointMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsUpdateResponse{
+	response := &V1TaskGetPointMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1TaskPointMetrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14546,41 +16266,41 @@ func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON501 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseV1DagListTasksResponse parses an HTTP response from a V1DagListTasksWithResponse call
-func ParseV1DagListTasksResponse(rsp *http.Response) (*V1DagListTasksResponse, error) {
+// ParseV1TaskCancelResponse parses an HTTP response from a V1TaskCancelWithResponse call
+func ParseV1TaskCancelResponse(rsp *http.Response) (*V1TaskCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1DagListTasksResponse{
+	response := &V1TaskCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []V1DagChildren
+		var dest V1TaskBulkActionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14600,6 +16320,13 @@ func ParseV1DagListTasksResponse(rsp *http.Response) (*V1DagListTasksResponse, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14612,22 +16339,22 @@ func ParseV1DagListTasksResponse(rsp *http.Response) (*V1DagListTasksResponse, e
 	return response, nil
 }
 
-// ParseV1TaskGetResponse parses an HTTP response from a V1TaskGetWithResponse call
-func ParseV1TaskGetResponse(rsp *http.Response) (*V1TaskGetResponse, error) {
+// ParseV1TaskReplayResponse parses an HTTP response from a V1TaskReplayWithResponse call
+func ParseV1TaskReplayResponse(rsp *http.Response) (*V1TaskReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskGetResponse{
+	response := &V1TaskReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskSummary
+		var dest V1TaskBulkActionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14666,22 +16393,22 @@ func ParseV1TaskGetResponse(rsp *http.Response) (*V1TaskGetResponse, error) {
 	return response, nil
 }
 
-// ParseV1LogLineListResponse parses an HTTP response from a V1LogLineListWithResponse call
-func ParseV1LogLineListResponse(rsp *http.Response) (*V1LogLineListResponse, error) {
+// ParseV1WorkflowRunListResponse parses an HTTP response from a V1WorkflowRunListWithResponse call
+func ParseV1WorkflowRunListResponse(rsp *http.Response) (*V1WorkflowRunListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1LogLineListResponse{
+	response := &V1WorkflowRunListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1LogLineList
+		var dest V1TaskSummaryList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14701,27 +16428,34 @@ func ParseV1LogLineListResponse(rsp *http.Response) (*V1LogLineListResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseV1TaskEventListResponse parses an HTTP response from a V1TaskEventListWithResponse call
-func ParseV1TaskEventListResponse(rsp *http.Response) (*V1TaskEventListResponse, error) {
+// ParseV1WorkflowRunDisplayNamesListResponse parses an HTTP response from a V1WorkflowRunDisplayNamesListWithResponse call
+func ParseV1WorkflowRunDisplayNamesListResponse(rsp *http.Response) (*V1WorkflowRunDisplayNamesListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskEventListResponse{
+	response := &V1WorkflowRunDisplayNamesListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskEventList
+		var dest V1WorkflowRunDisplayNameList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14741,13 +16475,6 @@ func ParseV1TaskEventListResponse(rsp *http.Response) (*V1TaskEventListResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14760,22 +16487,22 @@ func ParseV1TaskEventListResponse(rsp *http.Response) (*V1TaskEventListResponse,
 	return response, nil
 }
 
-// ParseV1TaskListStatusMetricsResponse parses an HTTP response from a V1TaskListStatusMetricsWithResponse call
-func ParseV1TaskListStatusMetricsResponse(rsp *http.Response) (*V1TaskListStatusMetricsResponse, error) {
+// ParseV1WorkflowRunCreateResponse parses an HTTP response from a V1WorkflowRunCreateWithResponse call
+func ParseV1WorkflowRunCreateResponse(rsp *http.Response) (*V1WorkflowRunCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskListStatusMetricsResponse{
+	response := &V1WorkflowRunCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskRunMetrics
+		var dest V1WorkflowRunDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14795,34 +16522,27 @@ func ParseV1TaskListStatusMetricsResponse(rsp *http.Response) (*V1TaskListStatus
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV1TaskGetPointMetricsResponse parses an HTTP response from a V1TaskGetPointMetricsWithResponse call
-func ParseV1TaskGetPointMetricsResponse(rsp *http.Response) (*V1TaskGetPointMetricsResponse, error) {
+// ParseV1WorkflowRunGetResponse parses an HTTP response from a V1WorkflowRunGetWithResponse call
+func ParseV1WorkflowRunGetResponse(rsp *http.Response) (*V1WorkflowRunGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskGetPointMetricsResponse{
+	response := &V1WorkflowRunGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskPointMetrics
+		var dest V1WorkflowRunDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14854,40 +16574,40 @@ func ParseV1TaskGetPointMetricsResponse(rsp *http.Response) (*V1TaskGetPointMetr
 	return response, nil
 }
 
-// ParseV1TaskCancelResponse parses an HTTP response from a V1TaskCancelWithResponse call
-func ParseV1TaskCancelResponse(rsp *http.Response) (*V1TaskCancelResponse, error) {
+// ParseV1WorkflowRunTaskEventsListResponse parses an HTTP response from a V1WorkflowRunTaskEventsListWithResponse call
+func ParseV1WorkflowRunTaskEventsListResponse(rsp *http.Response) (*V1WorkflowRunTaskEventsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskCancelResponse{
+	response := &V1WorkflowRunTaskEventsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1TaskEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
 		var dest APIErrors
@@ -14901,20 +16621,27 @@ func ParseV1TaskCancelResponse(rsp *http.Response) (*V1TaskCancelResponse, error
 	return response, nil
 }
 
-// ParseV1TaskReplayResponse parses an HTTP response from a V1TaskReplayWithResponse call
-func ParseV1TaskReplayResponse(rsp *http.Response) (*V1TaskReplayResponse, error) {
+// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
+func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1TaskReplayResponse{
+	response := &StepRunListArchivesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunArchiveList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14936,34 +16663,27 @@ func ParseV1TaskReplayResponse(rsp *http.Response) (*V1TaskReplayResponse, error
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV1WorkflowRunListResponse parses an HTTP response from a V1WorkflowRunListWithResponse call
-func ParseV1WorkflowRunListResponse(rsp *http.Response) (*V1WorkflowRunListResponse, error) {
+// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
+func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1WorkflowRunListResponse{
+	response := &StepRunListEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskSummaryList
+		var dest StepRunEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14983,34 +16703,34 @@ func ParseV1WorkflowRunListResponse(rsp *http.Response) (*V1WorkflowRunListRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON501 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseV1WorkflowRunDisplayNamesListResponse parses an HTTP response from a V1WorkflowRunDisplayNamesListWithResponse call
-func ParseV1WorkflowRunDisplayNamesListResponse(rsp *http.Response) (*V1WorkflowRunDisplayNamesListResponse, error) {
+// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
+func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1WorkflowRunDisplayNamesListResponse{
+	response := &LogLineListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1WorkflowRunDisplayNameList
+		var dest LogLineList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15030,34 +16750,27 @@ func ParseV1WorkflowRunDisplayNamesListResponse(rsp *http.Response) (*V1Workflow
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV1WorkflowRunCreateResponse parses an HTTP response from a V1WorkflowRunCreateWithResponse call
-func ParseV1WorkflowRunCreateResponse(rsp *http.Response) (*V1WorkflowRunCreateResponse, error) {
+// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
+func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1WorkflowRunCreateResponse{
+	response := &TenantCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1WorkflowRunDetails
+		var dest Tenant
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15071,7 +16784,7 @@ func ParseV1WorkflowRunCreateResponse(rsp *http.Response) (*V1WorkflowRunCreateR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15082,22 +16795,22 @@ func ParseV1WorkflowRunCreateResponse(rsp *http.Response) (*V1WorkflowRunCreateR
 	return response, nil
 }
 
-// ParseV1WorkflowRunGetResponse parses an HTTP response from a V1WorkflowRunGetWithResponse call
-func ParseV1WorkflowRunGetResponse(rsp *http.Response) (*V1WorkflowRunGetResponse, error) {
+// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
+func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1WorkflowRunGetResponse{
+	response := &TenantUpdateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1WorkflowRunDetails
+		var dest Tenant
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15111,40 +16824,33 @@ func ParseV1WorkflowRunGetResponse(rsp *http.Response) (*V1WorkflowRunGetRespons
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseV1WorkflowRunTaskEventsListResponse parses an HTTP response from a V1WorkflowRunTaskEventsListWithResponse call
-func ParseV1WorkflowRunTaskEventsListResponse(rsp *http.Response) (*V1WorkflowRunTaskEventsListResponse, error) {
+// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
+func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &V1WorkflowRunTaskEventsListResponse{
+	response := &AlertEmailGroupListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest V1TaskEventList
+		var dest TenantAlertEmailGroupList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15158,44 +16864,37 @@ func ParseV1WorkflowRunTaskEventsListResponse(rsp *http.Response) (*V1WorkflowRu
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON501 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
-func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
+func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListArchivesResponse{
+	response := &AlertEmailGroupCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunArchiveList
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantAlertEmailGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -15205,40 +16904,33 @@ func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
-func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
+func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListEventsResponse{
+	response := &TenantAlertingSettingsGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
+		var dest TenantAlertingSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15252,40 +16944,33 @@ func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsRespo
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIErrors
+		var dest APIError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
-func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
+func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LogLineListResponse{
+	response := &ApiTokenListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogLineList
+		var dest ListAPITokensResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15310,22 +16995,22 @@ func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error)
 	return response, nil
 }
 
-// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
-func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
+func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantCreateResponse{
+	response := &ApiTokenCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest CreateAPITokenResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15339,7 +17024,7 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15350,22 +17035,22 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 	return response, nil
 }
 
-// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
-func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
+func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantUpdateResponse{
+	response := &EventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest EventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15379,7 +17064,7 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15390,22 +17075,22 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 	return response, nil
 }
 
-// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
-func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
+func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupListResponse{
+	response := &EventCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroupList
+		var dest Event
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15419,37 +17104,44 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
-func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
+func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupCreateResponse{
+	response := &EventCreateBulkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantAlertEmailGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Events
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -15459,33 +17151,42 @@ func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCre
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
-func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
+func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantAlertingSettingsGetResponse{
+	response := &EventUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertingSettings
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15499,33 +17200,40 @@ func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlerting
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
-func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
+// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
+func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenListResponse{
+	response := &EventKeyListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPITokensResponse
+		var dest EventKeyList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15550,22 +17258,22 @@ func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error
 	return response, nil
 }
 
-// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
-func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
+// ParseEventListQuarantinedResponse parses an HTTP response from a EventListQuarantinedWithResponse call
+func ParseEventListQuarantinedResponse(rsp *http.Response) (*EventListQuarantinedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenCreateResponse{
+	response := &EventListQuarantinedResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateAPITokenResponse
+		var dest QuarantinedEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15590,15 +17298,15 @@ func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, e
 	return response, nil
 }
 
-// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
-func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
+// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
+func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventListResponse{
+	response := &EventUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -15625,27 +17333,34 @@ func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
-func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
+// ParseEventSchemaListResponse parses an HTTP response from a EventSchemaListWithResponse call
+func ParseEventSchemaListResponse(rsp *http.Response) (*EventSchemaListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateResponse{
+	response := &EventSchemaListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest EventSchemaList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15665,39 +17380,25 @@ func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
-func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
+// ParseEventSchemaDeleteResponse parses an HTTP response from a EventSchemaDeleteWithResponse call
+func ParseEventSchemaDeleteResponse(rsp *http.Response) (*EventSchemaDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateBulkResponse{
+	response := &EventSchemaDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Events
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -15712,36 +17413,34 @@ func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
-func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
+// ParseEventSchemaGetResponse parses an HTTP response from a EventSchemaGetWithResponse call
+func ParseEventSchemaGetResponse(rsp *http.Response) (*EventSchemaGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateCancelResponse{
+	response := &EventSchemaGetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
+		var dest EventSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15761,34 +17460,34 @@ func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
-func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
+// ParseEventSchemaPutResponse parses an HTTP response from a EventSchemaPutWithResponse call
+func ParseEventSchemaPutResponse(rsp *http.Response) (*EventSchemaPutResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventKeyListResponse{
+	response := &EventSchemaPutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventKeyList
+		var dest EventSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15813,22 +17512,22 @@ func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error
 	return response, nil
 }
 
-// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
-func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
+// ParseEventListTriggerSkipsResponse parses an HTTP response from a EventListTriggerSkipsWithResponse call
+func ParseEventListTriggerSkipsResponse(rsp *http.Response) (*EventListTriggerSkipsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateReplayResponse{
+	response := &EventListTriggerSkipsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest EventTriggerSkipList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15848,13 +17547,6 @@ func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
@@ -16180,6 +17872,133 @@ func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, err
 	return response, nil
 }
 
+// ParseRateLimitDeleteResponse parses an HTTP response from a RateLimitDeleteWithResponse call
+func ParseRateLimitDeleteResponse(rsp *http.Response) (*RateLimitDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RateLimitDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRateLimitGetMetricsResponse parses an HTTP response from a RateLimitGetMetricsWithResponse call
+func ParseRateLimitGetMetricsResponse(rsp *http.Response) (*RateLimitGetMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RateLimitGetMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateLimitMetrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRateLimitResetResponse parses an HTTP response from a RateLimitResetWithResponse call
+func ParseRateLimitResetResponse(rsp *http.Response) (*RateLimitResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RateLimitResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RateLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseTenantResourcePolicyGetResponse parses an HTTP response from a TenantResourcePolicyGetWithResponse call
 func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePolicyGetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
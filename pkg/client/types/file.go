@@ -46,6 +46,10 @@ const (
 	CancelInProgress WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
 	CancelNewest     WorkflowConcurrencyLimitStrategy = "CANCEL_NEWEST"
 	GroupRoundRobin  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+
+	// WeightedRoundRobin works like GroupRoundRobin, but a concurrency key with a weight of N can run N times
+	// as many runs as a key with a weight of 1. Weights are set with a weight expression or per tenant.
+	WeightedRoundRobin WorkflowConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 type WorkflowConcurrency struct {
@@ -56,12 +60,17 @@ type WorkflowConcurrency struct {
 	MaxRuns int32 `yaml:"maxRuns,omitempty"`
 
 	LimitStrategy WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
+
+	WeightExpression *string `yaml:"weightExpression,omitempty"`
 }
 
 type WorkflowTriggers struct {
 	Events    []string    `yaml:"events,omitempty"`
 	Cron      []string    `yaml:"crons,omitempty"`
 	Schedules []time.Time `yaml:"schedules,omitempty"`
+
+	// EventFilters are CEL filters for event triggers, keyed by event key
+	EventFilters map[string]string `yaml:"eventFilters,omitempty"`
 }
 
 type RandomScheduleOpt string
@@ -124,6 +133,48 @@ type WorkflowStep struct {
 	DesiredLabels          map[string]*DesiredWorkerLabel `yaml:"desiredLabels,omitempty"`
 	RetryBackoffFactor     *float32                       `yaml:"retryBackoffFactor,omitempty"`
 	RetryMaxBackoffSeconds *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
+	Conditions             []StepCondition                `yaml:"conditions,omitempty"`
+	SkipIf                 *string                        `yaml:"skipIf,omitempty"`
+	Map                    *StepMap                       `yaml:"map,omitempty"`
+	Concurrency            []StepConcurrency              `yaml:"concurrency,omitempty"`
+	Slots                  *int32                         `yaml:"slots,omitempty"`
+	SlotPool               *string                        `yaml:"slotPool,omitempty"`
+}
+
+// StepConcurrency limits the number of concurrent runs of a step which share the concurrency key returned by
+// the CEL expression.
+type StepConcurrency struct {
+	Expression       string                           `yaml:"expression"`
+	MaxRuns          int32                            `yaml:"maxRuns,omitempty"`
+	LimitStrategy    WorkflowConcurrencyLimitStrategy `yaml:"limitStrategy,omitempty"`
+	WeightExpression *string                          `yaml:"weightExpression,omitempty"`
+}
+
+// StepMap turns a step into a map step, which runs the step once for each item of the list returned by the
+// CEL expression. MaxParallelism limits the number of items which run at the same time.
+type StepMap struct {
+	Expression     string `yaml:"expression"`
+	MaxParallelism *int32 `yaml:"maxParallelism,omitempty"`
+}
+
+type StepConditionAction string
+
+const (
+	StepConditionActionQueue  StepConditionAction = "QUEUE"
+	StepConditionActionSkip   StepConditionAction = "SKIP"
+	StepConditionActionCancel StepConditionAction = "CANCEL"
+)
+
+// StepCondition is a condition which queues, skips or cancels a step. Conditions with the same OrGroupId are
+// OR'd together, while groups with the same action are AND'd together. Exactly one of SleepFor and EventKey
+// must be set.
+type StepCondition struct {
+	OrGroupId       string              `yaml:"orGroupId"`
+	Action          StepConditionAction `yaml:"action,omitempty"`
+	ReadableDataKey string              `yaml:"readableDataKey"`
+	SleepFor        *string             `yaml:"sleepFor,omitempty"`
+	EventKey        *string             `yaml:"eventKey,omitempty"`
+	Expression      *string             `yaml:"expression,omitempty"`
 }
 
 type RateLimit struct {
@@ -132,6 +183,7 @@ type RateLimit struct {
 	Units          *int    `yaml:"units,omitempty"`
 	UnitsExpr      *string `yaml:"unitsExpr,omitempty"`
 	LimitValueExpr *string `yaml:"limitValueExpr,omitempty"`
+	ParentKey      *string `yaml:"parentKey,omitempty"`
 }
 
 func ParseYAML(ctx context.Context, yamlBytes []byte) (Workflow, error) {
@@ -12,7 +12,32 @@ const (
 	Year   RateLimitDuration = "year"
 )
 
+type RateLimitAlgorithm string
+
+const (
+	// FixedWindow refills the rate limit to its max at the end of each window. This can allow bursts of
+	// up to twice the max around the end of a window.
+	FixedWindow RateLimitAlgorithm = "fixed_window"
+
+	// TokenBucket refills the rate limit continuously at max units per window, up to the burst size.
+	TokenBucket RateLimitAlgorithm = "token_bucket"
+
+	// SlidingWindow counts the units consumed in the previous window towards the max, weighted by how
+	// much of the previous window overlaps with a window ending now.
+	SlidingWindow RateLimitAlgorithm = "sliding_window"
+)
+
 type RateLimitOpts struct {
 	Max      int
 	Duration RateLimitDuration
+
+	// (optional) the key of the parent rate limit. Consuming units of this rate limit also consumes
+	// units of the parent, so the parent limits the total usage of all of its children.
+	ParentKey *string
+
+	// (optional) the algorithm used to refill the rate limit, defaults to FixedWindow
+	Algorithm RateLimitAlgorithm `validate:"omitempty,oneof=fixed_window token_bucket sliding_window"`
+
+	// (optional) the max number of units a TokenBucket rate limit can hold, defaults to Max
+	Burst *int `validate:"omitnil,gt=0"`
 }
@@ -50,6 +50,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 
 	msgqueuev1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1"
+	natsmqv1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1/nats"
 	pgmqv1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1/postgres"
 	rabbitmqv1 "github.com/hatchet-dev/hatchet/internal/msgqueue/v1/rabbitmq"
 	clientv1 "github.com/hatchet-dev/hatchet/pkg/client/v1"
@@ -349,6 +350,30 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 
 				return cleanupv1()
 			}
+		case "nats":
+			l.Warn().Msg("Using a NATS-backed message queue. This feature is still in beta.")
+
+			// the v0 engine does not support NATS, so v0 messages are sent over the Postgres-backed message queue
+			mq = postgres.NewPostgresMQ(
+				dc.EngineRepository.MessageQueue(),
+				postgres.WithLogger(&l),
+				postgres.WithQos(cf.MessageQueue.Postgres.Qos),
+			)
+
+			cleanupv1, natsMq := natsmqv1.New(
+				natsmqv1.WithURL(cf.MessageQueue.NATS.URL),
+				natsmqv1.WithLogger(&l),
+				natsmqv1.WithQos(cf.MessageQueue.NATS.Qos),
+				natsmqv1.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
+				natsmqv1.WithDeadLetterBackoff(cf.MessageQueue.NATS.DeadLetterBackoff),
+			)
+
+			if natsMq == nil {
+				return nil, nil, fmt.Errorf("could not connect to nats at %s", cf.MessageQueue.NATS.URL)
+			}
+
+			mqv1 = natsMq
+			cleanup1 = cleanupv1
 		}
 
 		ing, err = ingestor.NewIngestor(
@@ -360,6 +385,7 @@ func createControllerLayer(dc *database.Layer, cf *server.ServerConfigFile, vers
 			ingestor.WithEntitlementsRepository(dc.EntitlementRepository),
 			ingestor.WithStepRunRepository(dc.EngineRepository.StepRun()),
 			ingestor.WithRepositoryV1(dc.V1),
+			ingestor.WithLogger(&l),
 		)
 
 		if err != nil {
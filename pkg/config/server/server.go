@@ -376,6 +376,8 @@ type MessageQueueConfigFile struct {
 	Postgres PostgresMQConfigFile `mapstructure:"postgres" json:"postgres,omitempty"`
 
 	RabbitMQ RabbitMQConfigFile `mapstructure:"rabbitmq" json:"rabbitmq,omitempty" validate:"required"`
+
+	NATS NATSMQConfigFile `mapstructure:"nats" json:"nats,omitempty"`
 }
 
 type PostgresMQConfigFile struct {
@@ -387,6 +389,15 @@ type RabbitMQConfigFile struct {
 	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
 }
 
+type NATSMQConfigFile struct {
+	URL string `mapstructure:"url" json:"url,omitempty" default:"nats://localhost:4222"`
+	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+
+	// DeadLetterBackoff is how long a message which failed to be processed waits on the dead letter queue
+	// before it is retried.
+	DeadLetterBackoff time.Duration `mapstructure:"deadLetterBackoff" json:"deadLetterBackoff,omitempty" default:"5s"`
+}
+
 type ConfigFileEmail struct {
 	Postmark PostmarkConfigFile `mapstructure:"postmark" json:"postmark,omitempty"`
 }
@@ -640,9 +651,12 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("msgQueue.kind", "SERVER_MSGQUEUE_KIND")
 	_ = v.BindEnv("msgQueue.rabbitmq.url", "SERVER_MSGQUEUE_RABBITMQ_URL")
+	_ = v.BindEnv("msgQueue.nats.url", "SERVER_MSGQUEUE_NATS_URL")
+	_ = v.BindEnv("msgQueue.nats.deadLetterBackoff", "SERVER_MSGQUEUE_NATS_DEAD_LETTER_BACKOFF")
 
 	// throughput options
 	_ = v.BindEnv("msgQueue.rabbitmq.qos", "SERVER_MSGQUEUE_RABBITMQ_QOS")
+	_ = v.BindEnv("msgQueue.nats.qos", "SERVER_MSGQUEUE_NATS_QOS")
 	_ = v.BindEnv("runtime.requeueLimit", "SERVER_REQUEUE_LIMIT")
 	_ = v.BindEnv("runtime.singleQueueLimit", "SERVER_SINGLE_QUEUE_LIMIT")
 	_ = v.BindEnv("runtime.updateHashFactor", "SERVER_UPDATE_HASH_FACTOR")
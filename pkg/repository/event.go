@@ -27,6 +27,12 @@ type CreateEventOpts struct {
 
 	// (optional) the event metadata
 	AdditionalMetadata []byte
+
+	// (optional) a key which deduplicates the event within the deduplication TTL
+	DeduplicationKey *string `validate:"omitempty,min=1,max=255"`
+
+	// (optional) how long the deduplication key is valid for
+	DeduplicationTTL *time.Duration `validate:"omitempty,min=1s,max=24h"`
 }
 
 type ListEventOpts struct {
@@ -23,7 +23,7 @@ type CreateLogLineOpts struct {
 	// (optional) The level of the log line.
 	Level *string `validate:"omitnil,oneof=INFO ERROR WARN DEBUG"`
 
-	// (optional) The metadata of the log line.
+	// (optional) The metadata of the log line, a JSON object of structured fields.
 	Metadata []byte
 }
 
@@ -43,6 +43,9 @@ type ListLogsOpts struct {
 	// (optional) a search query
 	Search *string
 
+	// (optional) fields which the metadata of the log lines must contain
+	Fields map[string]interface{}
+
 	// (optional) the order by field
 	OrderBy *string `validate:"omitempty,oneof=createdAt"`
 
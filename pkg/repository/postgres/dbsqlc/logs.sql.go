@@ -18,7 +18,8 @@ WHERE
   "tenantId" = $1::uuid AND
   ($2::uuid IS NULL OR "stepRunId" = $2::uuid) AND
   ($3::text IS NULL OR "message" LIKE concat('%', $3::text, '%')) AND
-  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[]))
+  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[])) AND
+  ($5::jsonb IS NULL OR "metadata" @> $5::jsonb)
 `
 
 type CountLogLinesParams struct {
@@ -26,6 +27,7 @@ type CountLogLinesParams struct {
 	StepRunId pgtype.UUID    `json:"stepRunId"`
 	Search    pgtype.Text    `json:"search"`
 	Levels    []LogLineLevel `json:"levels"`
+	Fields    []byte         `json:"fields"`
 }
 
 func (q *Queries) CountLogLines(ctx context.Context, db DBTX, arg CountLogLinesParams) (int64, error) {
@@ -34,6 +36,7 @@ func (q *Queries) CountLogLines(ctx context.Context, db DBTX, arg CountLogLinesP
 		arg.StepRunId,
 		arg.Search,
 		arg.Levels,
+		arg.Fields,
 	)
 	var total int64
 	err := row.Scan(&total)
@@ -99,15 +102,16 @@ WHERE
   "tenantId" = $1::uuid AND
   ($2::uuid IS NULL OR "stepRunId" = $2::uuid) AND
   ($3::text IS NULL OR "message" LIKE concat('%', $3::text, '%')) AND
-  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[]))
+  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[])) AND
+  ($5::jsonb IS NULL OR "metadata" @> $5::jsonb)
 ORDER BY
-  CASE WHEN $5::text = 'createdAt ASC' THEN "createdAt" END ASC,
-  CASE WHEN $5::text = 'createdAt DESC' THEN "createdAt" END DESC,
+  CASE WHEN $6::text = 'createdAt ASC' THEN "createdAt" END ASC,
+  CASE WHEN $6::text = 'createdAt DESC' THEN "createdAt" END DESC,
   -- add order by id to make sure the order is deterministic
-  CASE WHEN $5::text = 'createdAt ASC' THEN "id" END ASC,
-  CASE WHEN $5::text = 'createdAt DESC' THEN "id" END DESC
-LIMIT COALESCE($7, 50)
-OFFSET COALESCE($6, 0)
+  CASE WHEN $6::text = 'createdAt ASC' THEN "id" END ASC,
+  CASE WHEN $6::text = 'createdAt DESC' THEN "id" END DESC
+LIMIT COALESCE($8, 50)
+OFFSET COALESCE($7, 0)
 `
 
 type ListLogLinesParams struct {
@@ -115,6 +119,7 @@ type ListLogLinesParams struct {
 	StepRunId pgtype.UUID    `json:"stepRunId"`
 	Search    pgtype.Text    `json:"search"`
 	Levels    []LogLineLevel `json:"levels"`
+	Fields    []byte         `json:"fields"`
 	OrderBy   pgtype.Text    `json:"orderBy"`
 	Offset    interface{}    `json:"offset"`
 	Limit     interface{}    `json:"limit"`
@@ -126,6 +131,7 @@ func (q *Queries) ListLogLines(ctx context.Context, db DBTX, arg ListLogLinesPar
 		arg.StepRunId,
 		arg.Search,
 		arg.Levels,
+		arg.Fields,
 		arg.OrderBy,
 		arg.Offset,
 		arg.Limit,
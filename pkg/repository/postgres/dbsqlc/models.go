@@ -14,11 +14,12 @@ import (
 type ConcurrencyLimitStrategy string
 
 const (
-	ConcurrencyLimitStrategyCANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
-	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	ConcurrencyLimitStrategyCANCELNEWEST     ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyCANCELINPROGRESS   ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	ConcurrencyLimitStrategyDROPNEWEST         ConcurrencyLimitStrategy = "DROP_NEWEST"
+	ConcurrencyLimitStrategyQUEUENEWEST        ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	ConcurrencyLimitStrategyGROUPROUNDROBIN    ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyCANCELNEWEST       ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyWEIGHTEDROUNDROBIN ConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 func (e *ConcurrencyLimitStrategy) Scan(src interface{}) error {
@@ -406,6 +407,49 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -413,6 +457,7 @@ const (
 	StepExpressionKindDYNAMICRATELIMITVALUE  StepExpressionKind = "DYNAMIC_RATE_LIMIT_VALUE"
 	StepExpressionKindDYNAMICRATELIMITUNITS  StepExpressionKind = "DYNAMIC_RATE_LIMIT_UNITS"
 	StepExpressionKindDYNAMICRATELIMITWINDOW StepExpressionKind = "DYNAMIC_RATE_LIMIT_WINDOW"
+	StepExpressionKindDYNAMICRATELIMITPARENT StepExpressionKind = "DYNAMIC_RATE_LIMIT_PARENT"
 )
 
 func (e *StepExpressionKind) Scan(src interface{}) error {
@@ -450,6 +495,91 @@ func (ns NullStepExpressionKind) Value() (driver.Value, error) {
 	return string(ns.StepExpressionKind), nil
 }
 
+type StepMatchConditionAction string
+
+const (
+	StepMatchConditionActionQUEUE  StepMatchConditionAction = "QUEUE"
+	StepMatchConditionActionSKIP   StepMatchConditionAction = "SKIP"
+	StepMatchConditionActionCANCEL StepMatchConditionAction = "CANCEL"
+)
+
+func (e *StepMatchConditionAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepMatchConditionAction(s)
+	case string:
+		*e = StepMatchConditionAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepMatchConditionAction: %T", src)
+	}
+	return nil
+}
+
+type NullStepMatchConditionAction struct {
+	StepMatchConditionAction StepMatchConditionAction `json:"StepMatchConditionAction"`
+	Valid                    bool                     `json:"valid"` // Valid is true if StepMatchConditionAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepMatchConditionAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepMatchConditionAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepMatchConditionAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepMatchConditionAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepMatchConditionAction), nil
+}
+
+type StepMatchConditionKind string
+
+const (
+	StepMatchConditionKindSLEEP     StepMatchConditionKind = "SLEEP"
+	StepMatchConditionKindUSEREVENT StepMatchConditionKind = "USER_EVENT"
+)
+
+func (e *StepMatchConditionKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepMatchConditionKind(s)
+	case string:
+		*e = StepMatchConditionKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepMatchConditionKind: %T", src)
+	}
+	return nil
+}
+
+type NullStepMatchConditionKind struct {
+	StepMatchConditionKind StepMatchConditionKind `json:"StepMatchConditionKind"`
+	Valid                  bool                   `json:"valid"` // Valid is true if StepMatchConditionKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepMatchConditionKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepMatchConditionKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepMatchConditionKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepMatchConditionKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepMatchConditionKind), nil
+}
+
 type StepRateLimitKind string
 
 const (
@@ -1398,12 +1528,16 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId     pgtype.UUID        `json:"tenantId"`
+	Key          string             `json:"key"`
+	LimitValue   int32              `json:"limitValue"`
+	Value        int32              `json:"value"`
+	Window       string             `json:"window"`
+	LastRefill   pgtype.Timestamp   `json:"lastRefill"`
+	ParentKey    pgtype.Text        `json:"parentKey"`
+	Algorithm    RateLimitAlgorithm `json:"algorithm"`
+	Burst        pgtype.Int4        `json:"burst"`
+	PreviousUsed pgtype.Int4        `json:"previousUsed"`
 }
 
 type RetryQueueItem struct {
@@ -1483,6 +1617,21 @@ type Step struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	SkipIf             pgtype.Text      `json:"skipIf"`
+	MapExpression      pgtype.Text      `json:"mapExpression"`
+	MapMaxParallelism  pgtype.Int4      `json:"mapMaxParallelism"`
+	Slots              int32            `json:"slots"`
+	SlotPool           pgtype.Text      `json:"slotPool"`
+}
+
+type StepConcurrency struct {
+	ID               int64                    `json:"id"`
+	TenantId         pgtype.UUID              `json:"tenantId"`
+	StepId           pgtype.UUID              `json:"stepId"`
+	Expression       string                   `json:"expression"`
+	MaxRuns          int32                    `json:"maxRuns"`
+	LimitStrategy    ConcurrencyLimitStrategy `json:"limitStrategy"`
+	WeightExpression pgtype.Text              `json:"weightExpression"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -1505,6 +1654,19 @@ type StepExpression struct {
 	Kind       StepExpressionKind `json:"kind"`
 }
 
+type StepMatchCondition struct {
+	ID              int64                    `json:"id"`
+	TenantId        pgtype.UUID              `json:"tenantId"`
+	StepId          pgtype.UUID              `json:"stepId"`
+	OrGroupId       string                   `json:"orGroupId"`
+	Action          StepMatchConditionAction `json:"action"`
+	Kind            StepMatchConditionKind   `json:"kind"`
+	ReadableDataKey string                   `json:"readableDataKey"`
+	SleepDuration   pgtype.Text              `json:"sleepDuration"`
+	EventKey        pgtype.Text              `json:"eventKey"`
+	Expression      pgtype.Text              `json:"expression"`
+}
+
 type StepOrder struct {
 	A pgtype.UUID `json:"A"`
 	B pgtype.UUID `json:"B"`
@@ -1810,6 +1972,7 @@ type Worker struct {
 	Os                      pgtype.Text      `json:"os"`
 	RuntimeExtra            pgtype.Text      `json:"runtimeExtra"`
 	SdkVersion              pgtype.Text      `json:"sdkVersion"`
+	IsCordoned              bool             `json:"isCordoned"`
 }
 
 type WorkerAssignEvent struct {
@@ -1828,6 +1991,12 @@ type WorkerLabel struct {
 	IntValue  pgtype.Int4      `json:"intValue"`
 }
 
+type WorkerSlotPool struct {
+	WorkerId pgtype.UUID `json:"workerId"`
+	Name     string      `json:"name"`
+	MaxRuns  int32       `json:"maxRuns"`
+}
+
 type Workflow struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -1848,6 +2017,7 @@ type WorkflowConcurrency struct {
 	MaxRuns                    int32                    `json:"maxRuns"`
 	LimitStrategy              ConcurrencyLimitStrategy `json:"limitStrategy"`
 	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
+	WeightExpression           pgtype.Text              `json:"weightExpression"`
 }
 
 type WorkflowRun struct {
@@ -1938,8 +2108,9 @@ type WorkflowTriggerCronRef struct {
 }
 
 type WorkflowTriggerEventRef struct {
-	ParentId pgtype.UUID `json:"parentId"`
-	EventKey string      `json:"eventKey"`
+	ParentId   pgtype.UUID `json:"parentId"`
+	EventKey   string      `json:"eventKey"`
+	Expression pgtype.Text `json:"expression"`
 }
 
 type WorkflowTriggerScheduledRef struct {
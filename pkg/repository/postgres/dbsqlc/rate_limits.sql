@@ -4,17 +4,26 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "parentKey",
+    "algorithm",
+    "burst"
 ) VALUES (
     @tenantId::uuid,
     @key::text,
     sqlc.arg('limit')::int,
-    sqlc.arg('limit')::int,
-    COALESCE(sqlc.narg('window')::text, '1 minute')
+    COALESCE(sqlc.narg('burst')::int, sqlc.arg('limit')::int),
+    COALESCE(sqlc.narg('window')::text, '1 minute'),
+    sqlc.narg('parentKey')::text,
+    COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    sqlc.narg('burst')::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = sqlc.arg('limit')::int,
     "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "value" = LEAST("RateLimit"."value", COALESCE(sqlc.narg('burst')::int, sqlc.arg('limit')::int)),
+    "parentKey" = sqlc.narg('parentKey')::text,
+    "algorithm" = COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    "burst" = sqlc.narg('burst')::int
 RETURNING *;
 
 -- name: UpsertRateLimitsBulk :exec
@@ -79,28 +88,16 @@ FROM
     rate_limits;
 
 -- name: ListRateLimitsForTenantNoMutate :many
--- Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
+-- Returns the stored state of the rate limits along with the database time, so the caller can refill
+-- the rate limits according to their algorithm without updating them
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    sqlc.embed(rl),
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
     "RateLimit" rl
 WHERE
-    "tenantId" = @tenantId::uuid
+    rl."tenantId" = @tenantId::uuid
     AND (
         sqlc.narg('search')::text IS NULL OR
         rl."key" like concat('%', sqlc.narg('search')::text, '%')
@@ -118,48 +115,81 @@ OFFSET
 LIMIT
     COALESCE(sqlc.narg('limit'), 50);
 
--- name: ListRateLimitsForTenantWithMutate :many
-WITH rls_to_update AS (
+-- name: ListRateLimitStatesForTenant :many
+-- Returns all rate limits for the tenant along with the database time, so the caller can refill
+-- the rate limits according to their algorithm.
+SELECT
+    sqlc.embed(rl),
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid;
+
+-- name: ListRateLimitStatesForUpdate :many
+-- Locks the given rate limits and returns them along with the database time, so the caller can
+-- refill and consume the rate limits according to their algorithm.
+SELECT
+    sqlc.embed(rl),
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = ANY(@keys::text[])
+ORDER BY
+    rl."tenantId" ASC, rl."key" ASC
+FOR UPDATE;
+
+-- name: LockRateLimitParents :exec
+-- Serializes changes to the parents of the tenant's rate limits, so that concurrent upserts can't form a cycle
+SELECT pg_advisory_xact_lock(hashtextextended('rate-limit-parents:' || @tenantId::text, 0));
+
+-- name: ListRateLimitAncestorKeys :many
+-- Returns the key of the rate limit followed by the keys of its ancestors, or no rows if the rate limit
+-- does not exist in the tenant
+WITH RECURSIVE ancestors AS (
     SELECT
-        rl.*
+        rl."key",
+        rl."parentKey",
+        1 AS depth
     FROM
         "RateLimit" rl
     WHERE
         rl."tenantId" = @tenantId::uuid
-        AND NOW() - rl."lastRefill" >= rl."window"::INTERVAL
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
-), refill AS (
-    UPDATE
-        "RateLimit" rl
-    SET
-        "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
+        AND rl."key" = @key::text
+    UNION ALL
+    SELECT
+        rl."key",
+        rl."parentKey",
+        a.depth + 1
     FROM
-        rls_to_update
+        "RateLimit" rl
+    JOIN
+        ancestors a ON rl."key" = a."parentKey"
     WHERE
-        rl."tenantId" = rls_to_update."tenantId"
-        AND rl."key" = rls_to_update."key"
-    RETURNING rl.*
+        rl."tenantId" = @tenantId::uuid
+        -- guards against cycles which were created before parents were validated
+        AND a.depth < 100
 )
 SELECT
-    rl.*,
-    (rl."lastRefill" + rl."window"::INTERVAL)::timestamp AS "nextRefillAt"
+    "key"
 FROM
-    "RateLimit" rl
-WHERE
-    rl."tenantId" = @tenantId::uuid
-    AND rl."key" NOT IN (SELECT "key" FROM refill)
-
-UNION ALL
+    ancestors
+ORDER BY
+    depth ASC;
 
+-- name: ListExistingRateLimitKeys :many
+-- Returns the given rate limit keys which exist in the tenant
 SELECT
-    refill.*,
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
+    "key"
 FROM
-    refill;
+    "RateLimit"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "key" = ANY(@keys::text[]);
 
 -- name: ListRateLimitsForSteps :many
 SELECT
@@ -170,41 +200,27 @@ WHERE
     srl."stepId" = ANY(@stepIds::uuid[])
     AND srl."tenantId" = @tenantId::uuid;
 
--- name: BulkUpdateRateLimits :many
+-- name: BulkUpdateRateLimitStates :exec
 WITH input AS (
     SELECT
-        "key", "units"
+        "key", "value", "lastRefill", "previousUsed"
     FROM
         (
             SELECT
                 unnest(@keys::text[]) AS "key",
-                unnest(@units::int[]) AS "units"
+                unnest(@values::int[]) AS "value",
+                unnest(@lastRefills::timestamp[]) AS "lastRefill",
+                unnest(@previousUseds::int[]) AS "previousUsed"
         ) AS subquery
-), rls_to_update AS (
-    SELECT
-        rl.*
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = @tenantId::uuid
-        AND rl."key" = ANY(SELECT "key" FROM input)
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
 )
 UPDATE
     "RateLimit" rl
 SET
-    "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "value" = input."value",
+    "lastRefill" = input."lastRefill",
+    "previousUsed" = input."previousUsed"
 FROM
-    rls_to_update rl2
+    input
 WHERE
-    rl2."tenantId" = rl."tenantId"
-    AND rl2."key" = rl."key"
-RETURNING rl.*;
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = input."key";
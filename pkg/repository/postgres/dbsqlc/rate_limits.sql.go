@@ -11,77 +11,49 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const bulkUpdateRateLimits = `-- name: BulkUpdateRateLimits :many
+const bulkUpdateRateLimitStates = `-- name: BulkUpdateRateLimitStates :exec
 WITH input AS (
     SELECT
-        "key", "units"
+        "key", "value", "lastRefill", "previousUsed"
     FROM
         (
             SELECT
-                unnest($1::text[]) AS "key",
-                unnest($2::int[]) AS "units"
+                unnest($2::text[]) AS "key",
+                unnest($3::int[]) AS "value",
+                unnest($4::timestamp[]) AS "lastRefill",
+                unnest($5::int[]) AS "previousUsed"
         ) AS subquery
-), rls_to_update AS (
-    SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = $3::uuid
-        AND rl."key" = ANY(SELECT "key" FROM input)
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
 )
 UPDATE
     "RateLimit" rl
 SET
-    "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "value" = input."value",
+    "lastRefill" = input."lastRefill",
+    "previousUsed" = input."previousUsed"
 FROM
-    rls_to_update rl2
+    input
 WHERE
-    rl2."tenantId" = rl."tenantId"
-    AND rl2."key" = rl."key"
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+    rl."tenantId" = $1::uuid
+    AND rl."key" = input."key"
 `
 
-type BulkUpdateRateLimitsParams struct {
-	Keys     []string    `json:"keys"`
-	Units    []int32     `json:"units"`
-	Tenantid pgtype.UUID `json:"tenantid"`
+type BulkUpdateRateLimitStatesParams struct {
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+	Keys          []string           `json:"keys"`
+	Values        []int32            `json:"values"`
+	Lastrefills   []pgtype.Timestamp `json:"lastrefills"`
+	Previoususeds []int32            `json:"previoususeds"`
 }
 
-func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpdateRateLimitsParams) ([]*RateLimit, error) {
-	rows, err := db.Query(ctx, bulkUpdateRateLimits, arg.Keys, arg.Units, arg.Tenantid)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*RateLimit
-	for rows.Next() {
-		var i RateLimit
-		if err := rows.Scan(
-			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) BulkUpdateRateLimitStates(ctx context.Context, db DBTX, arg BulkUpdateRateLimitStatesParams) error {
+	_, err := db.Exec(ctx, bulkUpdateRateLimitStates,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Values,
+		arg.Lastrefills,
+		arg.Previoususeds,
+	)
+	return err
 }
 
 const countRateLimits = `-- name: CountRateLimits :one
@@ -125,6 +97,215 @@ func (q *Queries) CountRateLimits(ctx context.Context, db DBTX, arg CountRateLim
 	return total, err
 }
 
+const listExistingRateLimitKeys = `-- name: ListExistingRateLimitKeys :many
+SELECT
+    "key"
+FROM
+    "RateLimit"
+WHERE
+    "tenantId" = $1::uuid
+    AND "key" = ANY($2::text[])
+`
+
+type ListExistingRateLimitKeysParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Keys     []string    `json:"keys"`
+}
+
+// Returns the given rate limit keys which exist in the tenant
+func (q *Queries) ListExistingRateLimitKeys(ctx context.Context, db DBTX, arg ListExistingRateLimitKeysParams) ([]string, error) {
+	rows, err := db.Query(ctx, listExistingRateLimitKeys, arg.Tenantid, arg.Keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateLimitAncestorKeys = `-- name: ListRateLimitAncestorKeys :many
+WITH RECURSIVE ancestors AS (
+    SELECT
+        rl."key",
+        rl."parentKey",
+        1 AS depth
+    FROM
+        "RateLimit" rl
+    WHERE
+        rl."tenantId" = $1::uuid
+        AND rl."key" = $2::text
+    UNION ALL
+    SELECT
+        rl."key",
+        rl."parentKey",
+        a.depth + 1
+    FROM
+        "RateLimit" rl
+    JOIN
+        ancestors a ON rl."key" = a."parentKey"
+    WHERE
+        rl."tenantId" = $1::uuid
+        -- guards against cycles which were created before parents were validated
+        AND a.depth < 100
+)
+SELECT
+    "key"
+FROM
+    ancestors
+ORDER BY
+    depth ASC
+`
+
+type ListRateLimitAncestorKeysParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+// Returns the key of the rate limit followed by the keys of its ancestors, or no rows if the rate limit
+// does not exist in the tenant
+func (q *Queries) ListRateLimitAncestorKeys(ctx context.Context, db DBTX, arg ListRateLimitAncestorKeysParams) ([]string, error) {
+	rows, err := db.Query(ctx, listRateLimitAncestorKeys, arg.Tenantid, arg.Key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateLimitStatesForTenant = `-- name: ListRateLimitStatesForTenant :many
+SELECT
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl."parentKey", rl.algorithm, rl.burst, rl."previousUsed",
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+`
+
+type ListRateLimitStatesForTenantRow struct {
+	RateLimit      RateLimit        `json:"rate_limit"`
+	WindowInterval pgtype.Interval  `json:"windowInterval"`
+	Now            pgtype.Timestamp `json:"now"`
+}
+
+// Returns all rate limits for the tenant along with the database time, so the caller can refill
+// the rate limits according to their algorithm.
+func (q *Queries) ListRateLimitStatesForTenant(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitStatesForTenantRow, error) {
+	rows, err := db.Query(ctx, listRateLimitStatesForTenant, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListRateLimitStatesForTenantRow
+	for rows.Next() {
+		var i ListRateLimitStatesForTenantRow
+		if err := rows.Scan(
+			&i.RateLimit.TenantId,
+			&i.RateLimit.Key,
+			&i.RateLimit.LimitValue,
+			&i.RateLimit.Value,
+			&i.RateLimit.Window,
+			&i.RateLimit.LastRefill,
+			&i.RateLimit.ParentKey,
+			&i.RateLimit.Algorithm,
+			&i.RateLimit.Burst,
+			&i.RateLimit.PreviousUsed,
+			&i.WindowInterval,
+			&i.Now,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRateLimitStatesForUpdate = `-- name: ListRateLimitStatesForUpdate :many
+SELECT
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl."parentKey", rl.algorithm, rl.burst, rl."previousUsed",
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
+FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."key" = ANY($2::text[])
+ORDER BY
+    rl."tenantId" ASC, rl."key" ASC
+FOR UPDATE
+`
+
+type ListRateLimitStatesForUpdateParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Keys     []string    `json:"keys"`
+}
+
+type ListRateLimitStatesForUpdateRow struct {
+	RateLimit      RateLimit        `json:"rate_limit"`
+	WindowInterval pgtype.Interval  `json:"windowInterval"`
+	Now            pgtype.Timestamp `json:"now"`
+}
+
+// Locks the given rate limits and returns them along with the database time, so the caller can
+// refill and consume the rate limits according to their algorithm.
+func (q *Queries) ListRateLimitStatesForUpdate(ctx context.Context, db DBTX, arg ListRateLimitStatesForUpdateParams) ([]*ListRateLimitStatesForUpdateRow, error) {
+	rows, err := db.Query(ctx, listRateLimitStatesForUpdate, arg.Tenantid, arg.Keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListRateLimitStatesForUpdateRow
+	for rows.Next() {
+		var i ListRateLimitStatesForUpdateRow
+		if err := rows.Scan(
+			&i.RateLimit.TenantId,
+			&i.RateLimit.Key,
+			&i.RateLimit.LimitValue,
+			&i.RateLimit.Value,
+			&i.RateLimit.Window,
+			&i.RateLimit.LastRefill,
+			&i.RateLimit.ParentKey,
+			&i.RateLimit.Algorithm,
+			&i.RateLimit.Burst,
+			&i.RateLimit.PreviousUsed,
+			&i.WindowInterval,
+			&i.Now,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRateLimitsForSteps = `-- name: ListRateLimitsForSteps :many
 SELECT
     units, "stepId", "rateLimitKey", "tenantId", kind
@@ -168,26 +349,13 @@ func (q *Queries) ListRateLimitsForSteps(ctx context.Context, db DBTX, arg ListR
 
 const listRateLimitsForTenantNoMutate = `-- name: ListRateLimitsForTenantNoMutate :many
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl."parentKey", rl.algorithm, rl.burst, rl."previousUsed",
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
     "RateLimit" rl
 WHERE
-    "tenantId" = $1::uuid
+    rl."tenantId" = $1::uuid
     AND (
         $2::text IS NULL OR
         rl."key" like concat('%', $2::text, '%')
@@ -215,15 +383,13 @@ type ListRateLimitsForTenantNoMutateParams struct {
 }
 
 type ListRateLimitsForTenantNoMutateRow struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	RateLimit      RateLimit        `json:"rate_limit"`
+	WindowInterval pgtype.Interval  `json:"windowInterval"`
+	Now            pgtype.Timestamp `json:"now"`
 }
 
-// Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
+// Returns the stored state of the rate limits along with the database time, so the caller can refill
+// the rate limits according to their algorithm without updating them
 func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX, arg ListRateLimitsForTenantNoMutateParams) ([]*ListRateLimitsForTenantNoMutateRow, error) {
 	rows, err := db.Query(ctx, listRateLimitsForTenantNoMutate,
 		arg.Tenantid,
@@ -240,12 +406,18 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 	for rows.Next() {
 		var i ListRateLimitsForTenantNoMutateRow
 		if err := rows.Scan(
-			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
+			&i.RateLimit.TenantId,
+			&i.RateLimit.Key,
+			&i.RateLimit.LimitValue,
+			&i.RateLimit.Value,
+			&i.RateLimit.Window,
+			&i.RateLimit.LastRefill,
+			&i.RateLimit.ParentKey,
+			&i.RateLimit.Algorithm,
+			&i.RateLimit.Burst,
+			&i.RateLimit.PreviousUsed,
+			&i.WindowInterval,
+			&i.Now,
 		); err != nil {
 			return nil, err
 		}
@@ -257,86 +429,14 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 	return items, nil
 }
 
-const listRateLimitsForTenantWithMutate = `-- name: ListRateLimitsForTenantWithMutate :many
-WITH rls_to_update AS (
-    SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = $1::uuid
-        AND NOW() - rl."lastRefill" >= rl."window"::INTERVAL
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
-), refill AS (
-    UPDATE
-        "RateLimit" rl
-    SET
-        "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
-    FROM
-        rls_to_update
-    WHERE
-        rl."tenantId" = rls_to_update."tenantId"
-        AND rl."key" = rls_to_update."key"
-    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-)
-SELECT
-    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill",
-    (rl."lastRefill" + rl."window"::INTERVAL)::timestamp AS "nextRefillAt"
-FROM
-    "RateLimit" rl
-WHERE
-    rl."tenantId" = $1::uuid
-    AND rl."key" NOT IN (SELECT "key" FROM refill)
-
-UNION ALL
-
-SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
-FROM
-    refill
+const lockRateLimitParents = `-- name: LockRateLimitParents :exec
+SELECT pg_advisory_xact_lock(hashtextextended('rate-limit-parents:' || $1::text, 0))
 `
 
-type ListRateLimitsForTenantWithMutateRow struct {
-	TenantId     pgtype.UUID      `json:"tenantId"`
-	Key          string           `json:"key"`
-	LimitValue   int32            `json:"limitValue"`
-	Value        int32            `json:"value"`
-	Window       string           `json:"window"`
-	LastRefill   pgtype.Timestamp `json:"lastRefill"`
-	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
-}
-
-func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitsForTenantWithMutateRow, error) {
-	rows, err := db.Query(ctx, listRateLimitsForTenantWithMutate, tenantid)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*ListRateLimitsForTenantWithMutateRow
-	for rows.Next() {
-		var i ListRateLimitsForTenantWithMutateRow
-		if err := rows.Scan(
-			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
-			&i.NextRefillAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+// Serializes changes to the parents of the tenant's rate limits, so that concurrent upserts can't form a cycle
+func (q *Queries) LockRateLimitParents(ctx context.Context, db DBTX, tenantid pgtype.UUID) error {
+	_, err := db.Exec(ctx, lockRateLimitParents, tenantid)
+	return err
 }
 
 const upsertRateLimit = `-- name: UpsertRateLimit :one
@@ -345,25 +445,37 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "parentKey",
+    "algorithm",
+    "burst"
 ) VALUES (
     $1::uuid,
     $2::text,
     $3::int,
-    $3::int,
-    COALESCE($4::text, '1 minute')
+    COALESCE($4::int, $3::int),
+    COALESCE($5::text, '1 minute'),
+    $6::text,
+    COALESCE($7::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    $4::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = $3::int,
-    "window" = COALESCE($4::text, '1 minute'),
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
-RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    "window" = COALESCE($5::text, '1 minute'),
+    "value" = LEAST("RateLimit"."value", COALESCE($4::int, $3::int)),
+    "parentKey" = $6::text,
+    "algorithm" = COALESCE($7::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    "burst" = $4::int
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", "parentKey", algorithm, burst, "previousUsed"
 `
 
 type UpsertRateLimitParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Key      string      `json:"key"`
-	Limit    int32       `json:"limit"`
-	Window   pgtype.Text `json:"window"`
+	Tenantid  pgtype.UUID            `json:"tenantid"`
+	Key       string                 `json:"key"`
+	Limit     int32                  `json:"limit"`
+	Burst     pgtype.Int4            `json:"burst"`
+	Window    pgtype.Text            `json:"window"`
+	ParentKey pgtype.Text            `json:"parentKey"`
+	Algorithm NullRateLimitAlgorithm `json:"algorithm"`
 }
 
 func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLimitParams) (*RateLimit, error) {
@@ -371,7 +483,10 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		arg.Tenantid,
 		arg.Key,
 		arg.Limit,
+		arg.Burst,
 		arg.Window,
+		arg.ParentKey,
+		arg.Algorithm,
 	)
 	var i RateLimit
 	err := row.Scan(
@@ -381,6 +496,10 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		&i.Value,
 		&i.Window,
 		&i.LastRefill,
+		&i.ParentKey,
+		&i.Algorithm,
+		&i.Burst,
+		&i.PreviousUsed,
 	)
 	return &i, err
 }
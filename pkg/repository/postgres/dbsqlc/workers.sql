@@ -112,7 +112,8 @@ SELECT
     w."dispatcherId" AS "dispatcherId",
     d."lastHeartbeatAt" AS "dispatcherLastHeartbeatAt",
     w."isActive" AS "isActive",
-    w."lastListenerEstablished" AS "lastListenerEstablished"
+    w."lastListenerEstablished" AS "lastListenerEstablished",
+    w."isCordoned" AS "isCordoned"
 FROM
     "Worker" w
 LEFT JOIN
@@ -182,7 +183,8 @@ SET
     "maxRuns" = coalesce(sqlc.narg('maxRuns')::int, "maxRuns"),
     "lastHeartbeatAt" = coalesce(sqlc.narg('lastHeartbeatAt')::timestamp, "lastHeartbeatAt"),
     "isActive" = coalesce(sqlc.narg('isActive')::boolean, "isActive"),
-    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused")
+    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused"),
+    "isCordoned" = coalesce(sqlc.narg('isCordoned')::boolean, "isCordoned")
 WHERE
     "id" = @id::uuid
 RETURNING *;
@@ -290,6 +292,32 @@ SET
     "strValue" = sqlc.narg('strValue')::text
 RETURNING *;
 
+-- name: UpsertWorkerSlotPools :exec
+WITH input AS (
+    SELECT
+        unnest(@names::text[]) AS "name",
+        unnest(@maxRuns::integer[]) AS "maxRuns"
+), deleted_pools AS (
+    DELETE FROM "WorkerSlotPool"
+    WHERE
+        "workerId" = @workerId::uuid
+        AND NOT ("name" = ANY(@names::text[]))
+)
+INSERT INTO "WorkerSlotPool" (
+    "workerId",
+    "name",
+    "maxRuns"
+)
+SELECT
+    @workerId::uuid,
+    input."name",
+    input."maxRuns"
+FROM
+    input
+ON CONFLICT ("workerId", "name") DO UPDATE
+SET
+    "maxRuns" = EXCLUDED."maxRuns";
+
 -- name: DeleteOldWorkers :one
 WITH for_delete AS (
     SELECT
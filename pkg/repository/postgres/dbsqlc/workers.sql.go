@@ -42,7 +42,7 @@ INSERT INTO "Worker" (
     $9::text,
     $10::text,
     $11::text
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 type CreateWorkerParams struct {
@@ -94,6 +94,7 @@ func (q *Queries) CreateWorker(ctx context.Context, db DBTX, arg CreateWorkerPar
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -186,7 +187,7 @@ DELETE FROM
   "Worker"
 WHERE
   "id" = $1::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, id pgtype.UUID) (*Worker, error) {
@@ -212,6 +213,7 @@ func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, id pgtype.UUID) (*W
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -254,7 +256,7 @@ func (q *Queries) GetWorkerActionsByWorkerId(ctx context.Context, db DBTX, arg G
 
 const getWorkerById = `-- name: GetWorkerById :one
 SELECT
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion", w."isCordoned",
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
         SELECT COUNT(*)
@@ -300,6 +302,7 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.Worker.Os,
 		&i.Worker.RuntimeExtra,
 		&i.Worker.SdkVersion,
+		&i.Worker.IsCordoned,
 		&i.WebhookUrl,
 		&i.RemainingSlots,
 	)
@@ -308,7 +311,7 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 
 const getWorkerByWebhookId = `-- name: GetWorkerByWebhookId :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 FROM
     "Worker"
 WHERE
@@ -344,6 +347,7 @@ func (q *Queries) GetWorkerByWebhookId(ctx context.Context, db DBTX, arg GetWork
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -355,7 +359,8 @@ SELECT
     w."dispatcherId" AS "dispatcherId",
     d."lastHeartbeatAt" AS "dispatcherLastHeartbeatAt",
     w."isActive" AS "isActive",
-    w."lastListenerEstablished" AS "lastListenerEstablished"
+    w."lastListenerEstablished" AS "lastListenerEstablished",
+    w."isCordoned" AS "isCordoned"
 FROM
     "Worker" w
 LEFT JOIN
@@ -377,6 +382,7 @@ type GetWorkerForEngineRow struct {
 	DispatcherLastHeartbeatAt pgtype.Timestamp `json:"dispatcherLastHeartbeatAt"`
 	IsActive                  bool             `json:"isActive"`
 	LastListenerEstablished   pgtype.Timestamp `json:"lastListenerEstablished"`
+	IsCordoned                bool             `json:"isCordoned"`
 }
 
 func (q *Queries) GetWorkerForEngine(ctx context.Context, db DBTX, arg GetWorkerForEngineParams) (*GetWorkerForEngineRow, error) {
@@ -389,6 +395,7 @@ func (q *Queries) GetWorkerForEngine(ctx context.Context, db DBTX, arg GetWorker
 		&i.DispatcherLastHeartbeatAt,
 		&i.IsActive,
 		&i.LastListenerEstablished,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -687,7 +694,7 @@ func (q *Queries) ListWorkerLabels(ctx context.Context, db DBTX, workerid pgtype
 
 const listWorkersWithSlotCount = `-- name: ListWorkersWithSlotCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion", workers."isCordoned",
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
@@ -777,6 +784,7 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Os,
 			&i.Worker.RuntimeExtra,
 			&i.Worker.SdkVersion,
+			&i.Worker.IsCordoned,
 			&i.WebhookUrl,
 			&i.WebhookId,
 			&i.RemainingSlots,
@@ -800,10 +808,11 @@ SET
     "maxRuns" = coalesce($2::int, "maxRuns"),
     "lastHeartbeatAt" = coalesce($3::timestamp, "lastHeartbeatAt"),
     "isActive" = coalesce($4::boolean, "isActive"),
-    "isPaused" = coalesce($5::boolean, "isPaused")
+    "isPaused" = coalesce($5::boolean, "isPaused"),
+    "isCordoned" = coalesce($6::boolean, "isCordoned")
 WHERE
-    "id" = $6::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+    "id" = $7::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 type UpdateWorkerParams struct {
@@ -812,6 +821,7 @@ type UpdateWorkerParams struct {
 	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
 	IsActive        pgtype.Bool      `json:"isActive"`
 	IsPaused        pgtype.Bool      `json:"isPaused"`
+	IsCordoned      pgtype.Bool      `json:"isCordoned"`
 	ID              pgtype.UUID      `json:"id"`
 }
 
@@ -822,6 +832,7 @@ func (q *Queries) UpdateWorker(ctx context.Context, db DBTX, arg UpdateWorkerPar
 		arg.LastHeartbeatAt,
 		arg.IsActive,
 		arg.IsPaused,
+		arg.IsCordoned,
 		arg.ID,
 	)
 	var i Worker
@@ -845,6 +856,7 @@ func (q *Queries) UpdateWorker(ctx context.Context, db DBTX, arg UpdateWorkerPar
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -860,7 +872,7 @@ WHERE
         "lastListenerEstablished" IS NULL
         OR "lastListenerEstablished" <= $2::timestamp
         )
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 type UpdateWorkerActiveStatusParams struct {
@@ -892,6 +904,7 @@ func (q *Queries) UpdateWorkerActiveStatus(ctx context.Context, db DBTX, arg Upd
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -904,7 +917,7 @@ SET
     "lastHeartbeatAt" = $1::timestamp
 WHERE
     "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 type UpdateWorkerHeartbeatParams struct {
@@ -935,6 +948,7 @@ func (q *Queries) UpdateWorkerHeartbeat(ctx context.Context, db DBTX, arg Update
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsCordoned,
 	)
 	return &i, err
 }
@@ -945,7 +959,7 @@ SET "isActive" = $1::boolean
 WHERE
   "tenantId" = $2::uuid AND
   "webhookId" = $3::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isCordoned"
 `
 
 type UpdateWorkersByWebhookIdParams struct {
@@ -983,6 +997,7 @@ func (q *Queries) UpdateWorkersByWebhookId(ctx context.Context, db DBTX, arg Upd
 			&i.Os,
 			&i.RuntimeExtra,
 			&i.SdkVersion,
+			&i.IsCordoned,
 		); err != nil {
 			return nil, err
 		}
@@ -1086,3 +1101,41 @@ func (q *Queries) UpsertWorkerLabel(ctx context.Context, db DBTX, arg UpsertWork
 	)
 	return &i, err
 }
+
+const upsertWorkerSlotPools = `-- name: UpsertWorkerSlotPools :exec
+WITH input AS (
+    SELECT
+        unnest($2::text[]) AS "name",
+        unnest($3::integer[]) AS "maxRuns"
+), deleted_pools AS (
+    DELETE FROM "WorkerSlotPool"
+    WHERE
+        "workerId" = $1::uuid
+        AND NOT ("name" = ANY($2::text[]))
+)
+INSERT INTO "WorkerSlotPool" (
+    "workerId",
+    "name",
+    "maxRuns"
+)
+SELECT
+    $1::uuid,
+    input."name",
+    input."maxRuns"
+FROM
+    input
+ON CONFLICT ("workerId", "name") DO UPDATE
+SET
+    "maxRuns" = EXCLUDED."maxRuns"
+`
+
+type UpsertWorkerSlotPoolsParams struct {
+	Workerid pgtype.UUID `json:"workerid"`
+	Names    []string    `json:"names"`
+	Maxruns  []int32     `json:"maxruns"`
+}
+
+func (q *Queries) UpsertWorkerSlotPools(ctx context.Context, db DBTX, arg UpsertWorkerSlotPoolsParams) error {
+	_, err := db.Exec(ctx, upsertWorkerSlotPools, arg.Workerid, arg.Names, arg.Maxruns)
+	return err
+}
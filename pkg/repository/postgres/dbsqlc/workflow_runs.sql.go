@@ -1490,7 +1490,7 @@ func (q *Queries) GetStepRunsForJobRunsWithOutput(ctx context.Context, db DBTX,
 const getStepsForJobs = `-- name: GetStepsForJobs :many
 SELECT
 	j."id" as "jobId",
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."skipIf", s."mapExpression", s."mapMaxParallelism", s.slots, s."slotPool",
     (
         SELECT array_agg(so."A")::uuid[]  -- Casting the array_agg result to uuid[]
         FROM "_StepOrder" so
@@ -1540,6 +1540,11 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 			&i.Step.RetryBackoffFactor,
 			&i.Step.RetryMaxBackoff,
 			&i.Step.ScheduleTimeout,
+			&i.Step.SkipIf,
+			&i.Step.MapExpression,
+			&i.Step.MapMaxParallelism,
+			&i.Step.Slots,
+			&i.Step.SlotPool,
 			&i.Parents,
 		); err != nil {
 			return nil, err
@@ -1555,7 +1560,7 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 const getStepsForWorkflowVersion = `-- name: GetStepsForWorkflowVersion :many
 
 SELECT
-    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout"  from "Step"
+    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout", "Step"."skipIf", "Step"."mapExpression", "Step"."mapMaxParallelism", "Step".slots, "Step"."slotPool"  from "Step"
 JOIN "Job" j ON "Step"."jobId" = j."id"
 WHERE
     j."workflowVersionId" = ANY($1::uuid[])
@@ -1585,6 +1590,11 @@ func (q *Queries) GetStepsForWorkflowVersion(ctx context.Context, db DBTX, workf
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SkipIf,
+			&i.MapExpression,
+			&i.MapMaxParallelism,
+			&i.Slots,
+			&i.SlotPool,
 		); err != nil {
 			return nil, err
 		}
@@ -173,7 +173,8 @@ INSERT INTO "WorkflowConcurrency" (
     "getConcurrencyGroupId",
     "maxRuns",
     "limitStrategy",
-    "concurrencyGroupExpression"
+    "concurrencyGroupExpression",
+    "weightExpression"
 ) VALUES (
     gen_random_uuid(),
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -182,7 +183,8 @@ INSERT INTO "WorkflowConcurrency" (
     sqlc.narg('getConcurrencyGroupId')::uuid,
     coalesce(sqlc.narg('maxRuns')::integer, 1),
     coalesce(sqlc.narg('limitStrategy')::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
-    sqlc.narg('concurrencyGroupExpression')::text
+    sqlc.narg('concurrencyGroupExpression')::text,
+    sqlc.narg('weightExpression')::text
 ) RETURNING *;
 
 -- name: CreateJob :one
@@ -231,7 +233,12 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "skipIf",
+    "mapExpression",
+    "mapMaxParallelism",
+    "slots",
+    "slotPool"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -246,7 +253,12 @@ INSERT INTO "Step" (
     coalesce(sqlc.narg('retries')::integer, 0),
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('retryBackoffFactor'),
-    sqlc.narg('retryMaxBackoff')
+    sqlc.narg('retryMaxBackoff'),
+    sqlc.narg('skipIf')::text,
+    sqlc.narg('mapExpression')::text,
+    sqlc.narg('mapMaxParallelism')::integer,
+    coalesce(sqlc.narg('slots')::integer, 1),
+    sqlc.narg('slotPool')::text
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -289,6 +301,46 @@ INSERT INTO "StepExpression" (
 SET
     "expression" = EXCLUDED."expression";
 
+-- name: CreateStepMatchConditions :exec
+INSERT INTO "StepMatchCondition" (
+    "tenantId",
+    "stepId",
+    "orGroupId",
+    "action",
+    "kind",
+    "readableDataKey",
+    "sleepDuration",
+    "eventKey",
+    "expression"
+)
+SELECT
+    @tenantId::uuid,
+    @stepId::uuid,
+    unnest(@orGroupIds::text[]),
+    unnest(cast(@actions::text[] as "StepMatchConditionAction"[])),
+    unnest(cast(@kinds::text[] as "StepMatchConditionKind"[])),
+    unnest(@readableDataKeys::text[]),
+    NULLIF(unnest(@sleepDurations::text[]), ''),
+    NULLIF(unnest(@eventKeys::text[]), ''),
+    NULLIF(unnest(@expressions::text[]), '');
+
+-- name: CreateStepConcurrencies :exec
+INSERT INTO "StepConcurrency" (
+    "tenantId",
+    "stepId",
+    "expression",
+    "maxRuns",
+    "limitStrategy",
+    "weightExpression"
+)
+SELECT
+    @tenantId::uuid,
+    @stepId::uuid,
+    unnest(@expressions::text[]),
+    unnest(@maxRuns::integer[]),
+    unnest(cast(@limitStrategies::text[] as "ConcurrencyLimitStrategy"[])),
+    NULLIF(unnest(@weightExpressions::text[]), '');
+
 -- name: UpsertAction :one
 INSERT INTO "Action" (
     "id",
@@ -351,10 +403,12 @@ INSERT INTO "WorkflowTriggers" (
 -- name: CreateWorkflowTriggerEventRef :one
 INSERT INTO "WorkflowTriggerEventRef" (
     "parentId",
-    "eventKey"
+    "eventKey",
+    "expression"
 ) VALUES (
     @workflowTriggersId::uuid,
-    @eventTrigger::text
+    @eventTrigger::text,
+    sqlc.narg('expression')::text
 ) RETURNING *;
 
 -- name: CreateWorkflowTriggerCronRef :one
@@ -485,7 +539,8 @@ SELECT
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
     wc."getConcurrencyGroupId" as "concurrencyGroupId",
-    wc."concurrencyGroupExpression" as "concurrencyGroupExpression"
+    wc."concurrencyGroupExpression" as "concurrencyGroupExpression",
+    wc."weightExpression" as "concurrencyWeightExpression"
 FROM
     "WorkflowVersion" as workflowVersions
 JOIN
@@ -576,6 +631,105 @@ ORDER BY
     workflowVersions."order" DESC
 LIMIT 1;
 
+-- name: ListWorkflowVersionIds :many
+SELECT
+    workflowVersions."id"
+FROM
+    "WorkflowVersion" as workflowVersions
+JOIN
+    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+WHERE
+    workflow."tenantId" = @tenantId::uuid AND
+    workflowVersions."workflowId" = @workflowId::uuid AND
+    workflowVersions."deletedAt" IS NULL
+ORDER BY
+    workflowVersions."order" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountWorkflowVersions :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "WorkflowVersion" as workflowVersions
+JOIN
+    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+WHERE
+    workflow."tenantId" = @tenantId::uuid AND
+    workflowVersions."workflowId" = @workflowId::uuid AND
+    workflowVersions."deletedAt" IS NULL;
+
+-- name: ListJobsForWorkflowVersions :many
+SELECT
+    *
+FROM
+    "Job" as jobs
+WHERE
+    jobs."workflowVersionId" = ANY(@workflowVersionIds::uuid[]) AND
+    jobs."tenantId" = @tenantId::uuid AND
+    jobs."deletedAt" IS NULL
+ORDER BY
+    jobs."name" ASC;
+
+-- name: ListWorkflowConcurrencyActions :many
+SELECT
+    wc."workflowVersionId",
+    a."actionId"
+FROM
+    "WorkflowConcurrency" as wc
+JOIN
+    "Action" as a ON a."id" = wc."getConcurrencyGroupId"
+WHERE
+    wc."workflowVersionId" = ANY(@workflowVersionIds::uuid[]);
+
+-- name: ListWorkflowDescriptions :many
+SELECT
+    "id",
+    "description"
+FROM
+    "Workflow"
+WHERE
+    "id" = ANY(@ids::uuid[]) AND
+    "tenantId" = @tenantId::uuid;
+
+-- name: ListStepExpressionsForSteps :many
+SELECT
+    *
+FROM
+    "StepExpression"
+WHERE
+    "stepId" = ANY(@stepIds::uuid[]);
+
+-- name: ListStepMatchConditionsForSteps :many
+SELECT
+    *
+FROM
+    "StepMatchCondition"
+WHERE
+    "stepId" = ANY(@stepIds::uuid[])
+ORDER BY
+    "id" ASC;
+
+-- name: ListStepConcurrenciesForSteps :many
+SELECT
+    *
+FROM
+    "StepConcurrency"
+WHERE
+    "stepId" = ANY(@stepIds::uuid[])
+ORDER BY
+    "id" ASC;
+
+-- name: ListDesiredWorkerLabelsForSteps :many
+SELECT
+    *
+FROM
+    "StepDesiredWorkerLabel"
+WHERE
+    "stepId" = ANY(@stepIds::uuid[]);
+
 -- name: CountWorkflowRunsRoundRobin :one
 SELECT COUNT(*) AS total
 FROM
@@ -733,6 +887,40 @@ JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
 WHERE
     wt."workflowVersionId" = @workflowVersionId::uuid;
 
+-- name: ListWorkflowVersionCronTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."cron",
+    wtc."input"
+FROM
+    "WorkflowTriggerCronRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY(@workflowVersionIds::uuid[]) AND
+    wtc."method" = 'DEFAULT';
+
+-- name: ListWorkflowVersionEventTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."eventKey",
+    wtc."expression"
+FROM
+    "WorkflowTriggerEventRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY(@workflowVersionIds::uuid[]);
+
+-- name: ListWorkflowVersionScheduleTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."triggerAt"
+FROM
+    "WorkflowTriggerScheduledRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY(@workflowVersionIds::uuid[]) AND
+    wtc."method" = 'DEFAULT';
+
 -- name: GetWorkflowVersionById :one
 SELECT
     sqlc.embed(wv),
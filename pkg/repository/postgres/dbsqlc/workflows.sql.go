@@ -174,6 +174,31 @@ func (q *Queries) CountWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg
 	return total, err
 }
 
+const countWorkflowVersions = `-- name: CountWorkflowVersions :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "WorkflowVersion" as workflowVersions
+JOIN
+    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+WHERE
+    workflow."tenantId" = $1::uuid AND
+    workflowVersions."workflowId" = $2::uuid AND
+    workflowVersions."deletedAt" IS NULL
+`
+
+type CountWorkflowVersionsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+}
+
+func (q *Queries) CountWorkflowVersions(ctx context.Context, db DBTX, arg CountWorkflowVersionsParams) (int64, error) {
+	row := db.QueryRow(ctx, countWorkflowVersions, arg.Tenantid, arg.Workflowid)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countWorkflows = `-- name: CountWorkflows :one
 SELECT
     count(workflows) OVER() AS total
@@ -368,7 +393,12 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "skipIf",
+    "mapExpression",
+    "mapMaxParallelism",
+    "slots",
+    "slotPool"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -383,8 +413,13 @@ INSERT INTO "Step" (
     coalesce($11::integer, 0),
     coalesce($12::text, '5m'),
     $13,
-    $14
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout"
+    $14,
+    $15::text,
+    $16::text,
+    $17::integer,
+    coalesce($18::integer, 1),
+    $19::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout", "skipIf", "mapExpression", "mapMaxParallelism", slots, "slotPool"
 `
 
 type CreateStepParams struct {
@@ -402,6 +437,11 @@ type CreateStepParams struct {
 	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
+	SkipIf             pgtype.Text      `json:"skipIf"`
+	MapExpression      pgtype.Text      `json:"mapExpression"`
+	MapMaxParallelism  pgtype.Int4      `json:"mapMaxParallelism"`
+	Slots              pgtype.Int4      `json:"slots"`
+	SlotPool           pgtype.Text      `json:"slotPool"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -420,6 +460,11 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.ScheduleTimeout,
 		arg.RetryBackoffFactor,
 		arg.RetryMaxBackoff,
+		arg.SkipIf,
+		arg.MapExpression,
+		arg.MapMaxParallelism,
+		arg.Slots,
+		arg.SlotPool,
 	)
 	var i Step
 	err := row.Scan(
@@ -437,10 +482,54 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
 		&i.ScheduleTimeout,
+		&i.SkipIf,
+		&i.MapExpression,
+		&i.MapMaxParallelism,
+		&i.Slots,
+		&i.SlotPool,
 	)
 	return &i, err
 }
 
+const createStepConcurrencies = `-- name: CreateStepConcurrencies :exec
+INSERT INTO "StepConcurrency" (
+    "tenantId",
+    "stepId",
+    "expression",
+    "maxRuns",
+    "limitStrategy",
+    "weightExpression"
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    unnest($3::text[]),
+    unnest($4::integer[]),
+    unnest(cast($5::text[] as "ConcurrencyLimitStrategy"[])),
+    NULLIF(unnest($6::text[]), '')
+`
+
+type CreateStepConcurrenciesParams struct {
+	Tenantid          pgtype.UUID `json:"tenantid"`
+	Stepid            pgtype.UUID `json:"stepid"`
+	Expressions       []string    `json:"expressions"`
+	Maxruns           []int32     `json:"maxruns"`
+	Limitstrategies   []string    `json:"limitstrategies"`
+	Weightexpressions []string    `json:"weightexpressions"`
+}
+
+func (q *Queries) CreateStepConcurrencies(ctx context.Context, db DBTX, arg CreateStepConcurrenciesParams) error {
+	_, err := db.Exec(ctx, createStepConcurrencies,
+		arg.Tenantid,
+		arg.Stepid,
+		arg.Expressions,
+		arg.Maxruns,
+		arg.Limitstrategies,
+		arg.Weightexpressions,
+	)
+	return err
+}
+
 const createStepExpressions = `-- name: CreateStepExpressions :exec
 INSERT INTO "StepExpression" (
     "key",
@@ -474,6 +563,57 @@ func (q *Queries) CreateStepExpressions(ctx context.Context, db DBTX, arg Create
 	return err
 }
 
+const createStepMatchConditions = `-- name: CreateStepMatchConditions :exec
+INSERT INTO "StepMatchCondition" (
+    "tenantId",
+    "stepId",
+    "orGroupId",
+    "action",
+    "kind",
+    "readableDataKey",
+    "sleepDuration",
+    "eventKey",
+    "expression"
+)
+SELECT
+    $1::uuid,
+    $2::uuid,
+    unnest($3::text[]),
+    unnest(cast($4::text[] as "StepMatchConditionAction"[])),
+    unnest(cast($5::text[] as "StepMatchConditionKind"[])),
+    unnest($6::text[]),
+    NULLIF(unnest($7::text[]), ''),
+    NULLIF(unnest($8::text[]), ''),
+    NULLIF(unnest($9::text[]), '')
+`
+
+type CreateStepMatchConditionsParams struct {
+	Tenantid         pgtype.UUID `json:"tenantid"`
+	Stepid           pgtype.UUID `json:"stepid"`
+	Orgroupids       []string    `json:"orgroupids"`
+	Actions          []string    `json:"actions"`
+	Kinds            []string    `json:"kinds"`
+	Readabledatakeys []string    `json:"readabledatakeys"`
+	Sleepdurations   []string    `json:"sleepdurations"`
+	Eventkeys        []string    `json:"eventkeys"`
+	Expressions      []string    `json:"expressions"`
+}
+
+func (q *Queries) CreateStepMatchConditions(ctx context.Context, db DBTX, arg CreateStepMatchConditionsParams) error {
+	_, err := db.Exec(ctx, createStepMatchConditions,
+		arg.Tenantid,
+		arg.Stepid,
+		arg.Orgroupids,
+		arg.Actions,
+		arg.Kinds,
+		arg.Readabledatakeys,
+		arg.Sleepdurations,
+		arg.Eventkeys,
+		arg.Expressions,
+	)
+	return err
+}
+
 const createStepRateLimit = `-- name: CreateStepRateLimit :one
 INSERT INTO "StepRateLimit" (
     "units",
@@ -580,7 +720,8 @@ INSERT INTO "WorkflowConcurrency" (
     "getConcurrencyGroupId",
     "maxRuns",
     "limitStrategy",
-    "concurrencyGroupExpression"
+    "concurrencyGroupExpression",
+    "weightExpression"
 ) VALUES (
     gen_random_uuid(),
     coalesce($1::timestamp, CURRENT_TIMESTAMP),
@@ -589,8 +730,9 @@ INSERT INTO "WorkflowConcurrency" (
     $4::uuid,
     coalesce($5::integer, 1),
     coalesce($6::"ConcurrencyLimitStrategy", 'CANCEL_IN_PROGRESS'),
-    $7::text
-) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "concurrencyGroupExpression"
+    $7::text,
+    $8::text
+) RETURNING id, "createdAt", "updatedAt", "workflowVersionId", "getConcurrencyGroupId", "maxRuns", "limitStrategy", "concurrencyGroupExpression", "weightExpression"
 `
 
 type CreateWorkflowConcurrencyParams struct {
@@ -601,6 +743,7 @@ type CreateWorkflowConcurrencyParams struct {
 	MaxRuns                    pgtype.Int4                  `json:"maxRuns"`
 	LimitStrategy              NullConcurrencyLimitStrategy `json:"limitStrategy"`
 	ConcurrencyGroupExpression pgtype.Text                  `json:"concurrencyGroupExpression"`
+	WeightExpression           pgtype.Text                  `json:"weightExpression"`
 }
 
 func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg CreateWorkflowConcurrencyParams) (*WorkflowConcurrency, error) {
@@ -612,6 +755,7 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		arg.MaxRuns,
 		arg.LimitStrategy,
 		arg.ConcurrencyGroupExpression,
+		arg.WeightExpression,
 	)
 	var i WorkflowConcurrency
 	err := row.Scan(
@@ -623,6 +767,7 @@ func (q *Queries) CreateWorkflowConcurrency(ctx context.Context, db DBTX, arg Cr
 		&i.MaxRuns,
 		&i.LimitStrategy,
 		&i.ConcurrencyGroupExpression,
+		&i.WeightExpression,
 	)
 	return &i, err
 }
@@ -754,22 +899,25 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 const createWorkflowTriggerEventRef = `-- name: CreateWorkflowTriggerEventRef :one
 INSERT INTO "WorkflowTriggerEventRef" (
     "parentId",
-    "eventKey"
+    "eventKey",
+    "expression"
 ) VALUES (
     $1::uuid,
-    $2::text
-) RETURNING "parentId", "eventKey"
+    $2::text,
+    $3::text
+) RETURNING "parentId", "eventKey", expression
 `
 
 type CreateWorkflowTriggerEventRefParams struct {
 	Workflowtriggersid pgtype.UUID `json:"workflowtriggersid"`
 	Eventtrigger       string      `json:"eventtrigger"`
+	Expression         pgtype.Text `json:"expression"`
 }
 
 func (q *Queries) CreateWorkflowTriggerEventRef(ctx context.Context, db DBTX, arg CreateWorkflowTriggerEventRefParams) (*WorkflowTriggerEventRef, error) {
-	row := db.QueryRow(ctx, createWorkflowTriggerEventRef, arg.Workflowtriggersid, arg.Eventtrigger)
+	row := db.QueryRow(ctx, createWorkflowTriggerEventRef, arg.Workflowtriggersid, arg.Eventtrigger, arg.Expression)
 	var i WorkflowTriggerEventRef
-	err := row.Scan(&i.ParentId, &i.EventKey)
+	err := row.Scan(&i.ParentId, &i.EventKey, &i.Expression)
 	return &i, err
 }
 
@@ -1262,7 +1410,7 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 
 const getWorkflowVersionEventTriggerRefs = `-- name: GetWorkflowVersionEventTriggerRefs :many
 SELECT
-    wtc."parentId", wtc."eventKey"
+    wtc."parentId", wtc."eventKey", wtc.expression
 FROM
     "WorkflowTriggerEventRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1279,7 +1427,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 	var items []*WorkflowTriggerEventRef
 	for rows.Next() {
 		var i WorkflowTriggerEventRef
-		if err := rows.Scan(&i.ParentId, &i.EventKey); err != nil {
+		if err := rows.Scan(&i.ParentId, &i.EventKey, &i.Expression); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1297,7 +1445,8 @@ SELECT
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
     wc."getConcurrencyGroupId" as "concurrencyGroupId",
-    wc."concurrencyGroupExpression" as "concurrencyGroupExpression"
+    wc."concurrencyGroupExpression" as "concurrencyGroupExpression",
+    wc."weightExpression" as "concurrencyWeightExpression"
 FROM
     "WorkflowVersion" as workflowVersions
 JOIN
@@ -1317,12 +1466,13 @@ type GetWorkflowVersionForEngineParams struct {
 }
 
 type GetWorkflowVersionForEngineRow struct {
-	WorkflowVersion            WorkflowVersion              `json:"workflow_version"`
-	WorkflowName               string                       `json:"workflowName"`
-	ConcurrencyLimitStrategy   NullConcurrencyLimitStrategy `json:"concurrencyLimitStrategy"`
-	ConcurrencyMaxRuns         pgtype.Int4                  `json:"concurrencyMaxRuns"`
-	ConcurrencyGroupId         pgtype.UUID                  `json:"concurrencyGroupId"`
-	ConcurrencyGroupExpression pgtype.Text                  `json:"concurrencyGroupExpression"`
+	WorkflowVersion             WorkflowVersion              `json:"workflow_version"`
+	WorkflowName                string                       `json:"workflowName"`
+	ConcurrencyLimitStrategy    NullConcurrencyLimitStrategy `json:"concurrencyLimitStrategy"`
+	ConcurrencyMaxRuns          pgtype.Int4                  `json:"concurrencyMaxRuns"`
+	ConcurrencyGroupId          pgtype.UUID                  `json:"concurrencyGroupId"`
+	ConcurrencyGroupExpression  pgtype.Text                  `json:"concurrencyGroupExpression"`
+	ConcurrencyWeightExpression pgtype.Text                  `json:"concurrencyWeightExpression"`
 }
 
 func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg GetWorkflowVersionForEngineParams) ([]*GetWorkflowVersionForEngineRow, error) {
@@ -1353,6 +1503,7 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.ConcurrencyMaxRuns,
 			&i.ConcurrencyGroupId,
 			&i.ConcurrencyGroupExpression,
+			&i.ConcurrencyWeightExpression,
 		); err != nil {
 			return nil, err
 		}
@@ -1716,6 +1867,95 @@ func (q *Queries) ListCronWorkflows(ctx context.Context, db DBTX, arg ListCronWo
 	return items, nil
 }
 
+const listDesiredWorkerLabelsForSteps = `-- name: ListDesiredWorkerLabelsForSteps :many
+SELECT
+    id, "createdAt", "updatedAt", "stepId", key, "strValue", "intValue", required, comparator, weight
+FROM
+    "StepDesiredWorkerLabel"
+WHERE
+    "stepId" = ANY($1::uuid[])
+`
+
+func (q *Queries) ListDesiredWorkerLabelsForSteps(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*StepDesiredWorkerLabel, error) {
+	rows, err := db.Query(ctx, listDesiredWorkerLabelsForSteps, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepDesiredWorkerLabel
+	for rows.Next() {
+		var i StepDesiredWorkerLabel
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.StepId,
+			&i.Key,
+			&i.StrValue,
+			&i.IntValue,
+			&i.Required,
+			&i.Comparator,
+			&i.Weight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobsForWorkflowVersions = `-- name: ListJobsForWorkflowVersions :many
+SELECT
+    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", name, description, timeout, kind
+FROM
+    "Job" as jobs
+WHERE
+    jobs."workflowVersionId" = ANY($1::uuid[]) AND
+    jobs."tenantId" = $2::uuid AND
+    jobs."deletedAt" IS NULL
+ORDER BY
+    jobs."name" ASC
+`
+
+type ListJobsForWorkflowVersionsParams struct {
+	Workflowversionids []pgtype.UUID `json:"workflowversionids"`
+	Tenantid           pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) ListJobsForWorkflowVersions(ctx context.Context, db DBTX, arg ListJobsForWorkflowVersionsParams) ([]*Job, error) {
+	rows, err := db.Query(ctx, listJobsForWorkflowVersions, arg.Workflowversionids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.TenantId,
+			&i.WorkflowVersionId,
+			&i.Name,
+			&i.Description,
+			&i.Timeout,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPausedWorkflows = `-- name: ListPausedWorkflows :many
 SELECT
     "id"
@@ -1747,6 +1987,364 @@ func (q *Queries) ListPausedWorkflows(ctx context.Context, db DBTX, tenantid pgt
 	return items, nil
 }
 
+const listStepConcurrenciesForSteps = `-- name: ListStepConcurrenciesForSteps :many
+SELECT
+    id, "tenantId", "stepId", expression, "maxRuns", "limitStrategy", "weightExpression"
+FROM
+    "StepConcurrency"
+WHERE
+    "stepId" = ANY($1::uuid[])
+ORDER BY
+    "id" ASC
+`
+
+func (q *Queries) ListStepConcurrenciesForSteps(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*StepConcurrency, error) {
+	rows, err := db.Query(ctx, listStepConcurrenciesForSteps, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepConcurrency
+	for rows.Next() {
+		var i StepConcurrency
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.StepId,
+			&i.Expression,
+			&i.MaxRuns,
+			&i.LimitStrategy,
+			&i.WeightExpression,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepExpressionsForSteps = `-- name: ListStepExpressionsForSteps :many
+SELECT
+    key, "stepId", expression, kind
+FROM
+    "StepExpression"
+WHERE
+    "stepId" = ANY($1::uuid[])
+`
+
+func (q *Queries) ListStepExpressionsForSteps(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*StepExpression, error) {
+	rows, err := db.Query(ctx, listStepExpressionsForSteps, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepExpression
+	for rows.Next() {
+		var i StepExpression
+		if err := rows.Scan(
+			&i.Key,
+			&i.StepId,
+			&i.Expression,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepMatchConditionsForSteps = `-- name: ListStepMatchConditionsForSteps :many
+SELECT
+    id, "tenantId", "stepId", "orGroupId", action, kind, "readableDataKey", "sleepDuration", "eventKey", expression
+FROM
+    "StepMatchCondition"
+WHERE
+    "stepId" = ANY($1::uuid[])
+ORDER BY
+    "id" ASC
+`
+
+func (q *Queries) ListStepMatchConditionsForSteps(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*StepMatchCondition, error) {
+	rows, err := db.Query(ctx, listStepMatchConditionsForSteps, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepMatchCondition
+	for rows.Next() {
+		var i StepMatchCondition
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.StepId,
+			&i.OrGroupId,
+			&i.Action,
+			&i.Kind,
+			&i.ReadableDataKey,
+			&i.SleepDuration,
+			&i.EventKey,
+			&i.Expression,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowConcurrencyActions = `-- name: ListWorkflowConcurrencyActions :many
+SELECT
+    wc."workflowVersionId",
+    a."actionId"
+FROM
+    "WorkflowConcurrency" as wc
+JOIN
+    "Action" as a ON a."id" = wc."getConcurrencyGroupId"
+WHERE
+    wc."workflowVersionId" = ANY($1::uuid[])
+`
+
+type ListWorkflowConcurrencyActionsRow struct {
+	WorkflowVersionId pgtype.UUID `json:"workflowVersionId"`
+	ActionId          string      `json:"actionId"`
+}
+
+func (q *Queries) ListWorkflowConcurrencyActions(ctx context.Context, db DBTX, workflowversionids []pgtype.UUID) ([]*ListWorkflowConcurrencyActionsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowConcurrencyActions, workflowversionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowConcurrencyActionsRow
+	for rows.Next() {
+		var i ListWorkflowConcurrencyActionsRow
+		if err := rows.Scan(&i.WorkflowVersionId, &i.ActionId); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowDescriptions = `-- name: ListWorkflowDescriptions :many
+SELECT
+    "id",
+    "description"
+FROM
+    "Workflow"
+WHERE
+    "id" = ANY($1::uuid[]) AND
+    "tenantId" = $2::uuid
+`
+
+type ListWorkflowDescriptionsParams struct {
+	Ids      []pgtype.UUID `json:"ids"`
+	Tenantid pgtype.UUID   `json:"tenantid"`
+}
+
+type ListWorkflowDescriptionsRow struct {
+	ID          pgtype.UUID `json:"id"`
+	Description pgtype.Text `json:"description"`
+}
+
+func (q *Queries) ListWorkflowDescriptions(ctx context.Context, db DBTX, arg ListWorkflowDescriptionsParams) ([]*ListWorkflowDescriptionsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowDescriptions, arg.Ids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowDescriptionsRow
+	for rows.Next() {
+		var i ListWorkflowDescriptionsRow
+		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionCronTriggerRefs = `-- name: ListWorkflowVersionCronTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."cron",
+    wtc."input"
+FROM
+    "WorkflowTriggerCronRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY($1::uuid[]) AND
+    wtc."method" = 'DEFAULT'
+`
+
+type ListWorkflowVersionCronTriggerRefsRow struct {
+	WorkflowVersionId pgtype.UUID `json:"workflowVersionId"`
+	Cron              string      `json:"cron"`
+	Input             []byte      `json:"input"`
+}
+
+func (q *Queries) ListWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX, workflowversionids []pgtype.UUID) ([]*ListWorkflowVersionCronTriggerRefsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionCronTriggerRefs, workflowversionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowVersionCronTriggerRefsRow
+	for rows.Next() {
+		var i ListWorkflowVersionCronTriggerRefsRow
+		if err := rows.Scan(&i.WorkflowVersionId, &i.Cron, &i.Input); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionEventTriggerRefs = `-- name: ListWorkflowVersionEventTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."eventKey",
+    wtc."expression"
+FROM
+    "WorkflowTriggerEventRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY($1::uuid[])
+`
+
+type ListWorkflowVersionEventTriggerRefsRow struct {
+	WorkflowVersionId pgtype.UUID `json:"workflowVersionId"`
+	EventKey          string      `json:"eventKey"`
+	Expression        pgtype.Text `json:"expression"`
+}
+
+func (q *Queries) ListWorkflowVersionEventTriggerRefs(ctx context.Context, db DBTX, workflowversionids []pgtype.UUID) ([]*ListWorkflowVersionEventTriggerRefsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionEventTriggerRefs, workflowversionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowVersionEventTriggerRefsRow
+	for rows.Next() {
+		var i ListWorkflowVersionEventTriggerRefsRow
+		if err := rows.Scan(&i.WorkflowVersionId, &i.EventKey, &i.Expression); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionIds = `-- name: ListWorkflowVersionIds :many
+SELECT
+    workflowVersions."id"
+FROM
+    "WorkflowVersion" as workflowVersions
+JOIN
+    "Workflow" as workflow ON workflow."id" = workflowVersions."workflowId"
+WHERE
+    workflow."tenantId" = $1::uuid AND
+    workflowVersions."workflowId" = $2::uuid AND
+    workflowVersions."deletedAt" IS NULL
+ORDER BY
+    workflowVersions."order" DESC
+OFFSET
+    COALESCE($3, 0)
+LIMIT
+    COALESCE($4, 50)
+`
+
+type ListWorkflowVersionIdsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Offset     interface{} `json:"offset"`
+	Limit      interface{} `json:"limit"`
+}
+
+func (q *Queries) ListWorkflowVersionIds(ctx context.Context, db DBTX, arg ListWorkflowVersionIdsParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionIds,
+		arg.Tenantid,
+		arg.Workflowid,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkflowVersionScheduleTriggerRefs = `-- name: ListWorkflowVersionScheduleTriggerRefs :many
+SELECT
+    wt."workflowVersionId",
+    wtc."triggerAt"
+FROM
+    "WorkflowTriggerScheduledRef" as wtc
+JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
+WHERE
+    wt."workflowVersionId" = ANY($1::uuid[]) AND
+    wtc."method" = 'DEFAULT'
+`
+
+type ListWorkflowVersionScheduleTriggerRefsRow struct {
+	WorkflowVersionId pgtype.UUID      `json:"workflowVersionId"`
+	TriggerAt         pgtype.Timestamp `json:"triggerAt"`
+}
+
+func (q *Queries) ListWorkflowVersionScheduleTriggerRefs(ctx context.Context, db DBTX, workflowversionids []pgtype.UUID) ([]*ListWorkflowVersionScheduleTriggerRefsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowVersionScheduleTriggerRefs, workflowversionids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowVersionScheduleTriggerRefsRow
+	for rows.Next() {
+		var i ListWorkflowVersionScheduleTriggerRefsRow
+		if err := rows.Scan(&i.WorkflowVersionId, &i.TriggerAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT
     workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused"
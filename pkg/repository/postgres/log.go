@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -79,6 +80,17 @@ func (r *logAPIRepository) ListLogLines(tenantId string, opts *repository.ListLo
 		countParams.Levels = levels
 	}
 
+	if len(opts.Fields) > 0 {
+		fields, err := json.Marshal(opts.Fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal fields: %w", err)
+		}
+
+		queryParams.Fields = fields
+		countParams.Fields = fields
+	}
+
 	orderByField := "createdAt"
 	orderByDirection := "DESC"
 
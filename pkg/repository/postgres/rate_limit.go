@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
@@ -111,12 +114,34 @@ func (r *rateLimitEngineRepository) ListRateLimits(ctx context.Context, tenantId
 		return nil, fmt.Errorf("could not commit transaction: %w", err)
 	}
 
+	// the stored values are only updated when the rate limits are consumed, so they are refilled here with
+	// the algorithm of each rate limit
+	for _, rl := range rls {
+		state := newRateLimitState(&rl.RateLimit, rl.WindowInterval).Refill(rl.Now.Time)
+
+		rl.RateLimit.Value = int32(state.Available(rl.Now.Time)) // nolint: gosec
+		rl.RateLimit.LastRefill = sqlchelpers.TimestampFromTime(state.LastRefill)
+	}
+
 	res.Rows = rls
 	res.Count = int(count)
 
 	return res, nil
 }
 
+func (r *rateLimitEngineRepository) ListExistingRateLimitKeys(ctx context.Context, tenantId string, keys []string) ([]string, error) {
+	existing, err := r.queries.ListExistingRateLimitKeys(ctx, r.pool, dbsqlc.ListExistingRateLimitKeysParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list rate limit keys: %w", err)
+	}
+
+	return existing, nil
+}
+
 func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantId string, key string, opts *repository.UpsertRateLimitOpts) (*dbsqlc.RateLimit, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
@@ -132,15 +157,88 @@ func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantI
 		upsertParams.Window = sqlchelpers.TextFromStr(getWindowParamFromDurString(*opts.Duration))
 	}
 
-	rateLimit, err := r.queries.UpsertRateLimit(ctx, r.pool, upsertParams)
+	if opts.ParentKey != nil {
+		upsertParams.ParentKey = sqlchelpers.TextFromStr(*opts.ParentKey)
+	}
+
+	if opts.Algorithm != nil {
+		upsertParams.Algorithm = dbsqlc.NullRateLimitAlgorithm{
+			RateLimitAlgorithm: dbsqlc.RateLimitAlgorithm(*opts.Algorithm),
+			Valid:              true,
+		}
+	}
+
+	if opts.Burst != nil {
+		if opts.Algorithm == nil || *opts.Algorithm != string(dbsqlc.RateLimitAlgorithmTOKENBUCKET) {
+			return nil, fmt.Errorf("burst can only be set for %s rate limits", dbsqlc.RateLimitAlgorithmTOKENBUCKET)
+		}
+
+		upsertParams.Burst = pgtype.Int4{
+			Int32: int32(*opts.Burst), // nolint: gosec
+			Valid: true,
+		}
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	if opts.ParentKey != nil {
+		if err := r.validateParentKey(ctx, tx, upsertParams.Tenantid, key, *opts.ParentKey); err != nil {
+			return nil, err
+		}
+	}
+
+	rateLimit, err := r.queries.UpsertRateLimit(ctx, tx, upsertParams)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not upsert rate limit: %w", err)
 	}
 
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return rateLimit, nil
 }
 
+// validateParentKey checks that the parent exists in the tenant, and that the rate limit is not one of the
+// parent's ancestors, as consuming a rate limit in a cycle would never terminate.
+func (r *rateLimitEngineRepository) validateParentKey(ctx context.Context, tx dbsqlc.DBTX, tenantId pgtype.UUID, key, parentKey string) error {
+	if parentKey == key {
+		return fmt.Errorf("%w: rate limit %s cannot be its own parent", repository.ErrRateLimitParentCycle, key)
+	}
+
+	err := r.queries.LockRateLimitParents(ctx, tx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not lock rate limit parents: %w", err)
+	}
+
+	ancestors, err := r.queries.ListRateLimitAncestorKeys(ctx, tx, dbsqlc.ListRateLimitAncestorKeysParams{
+		Tenantid: tenantId,
+		Key:      parentKey,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list rate limit ancestors: %w", err)
+	}
+
+	if len(ancestors) == 0 {
+		return fmt.Errorf("%w: %s", repository.ErrRateLimitParentNotFound, parentKey)
+	}
+
+	if i := slices.Index(ancestors, key); i >= 0 {
+		return fmt.Errorf("%w: %s", repository.ErrRateLimitParentCycle, strings.Join(append([]string{key}, ancestors[:i+1]...), " -> "))
+	}
+
+	return nil
+}
+
 var durationStrings = []string{
 	"SECOND",
 	"MINUTE",
@@ -7,6 +7,8 @@ import (
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
 type rateLimitRepository struct {
@@ -32,7 +34,7 @@ func (d *rateLimitRepository) ListCandidateRateLimits(ctx context.Context, tenan
 	ids := make([]string, len(rls))
 
 	for i, rl := range rls {
-		ids[i] = rl.Key
+		ids[i] = rl.RateLimit.Key
 	}
 
 	return ids, nil
@@ -47,38 +49,90 @@ func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgt
 
 	defer rollback()
 
-	params := dbsqlc.BulkUpdateRateLimitsParams{
-		Tenantid: tenantId,
-		Keys:     make([]string, 0, len(updates)),
-		Units:    make([]int32, 0, len(updates)),
-	}
+	rls, err := d.queries.ListRateLimitStatesForTenant(ctx, tx, tenantId)
 
-	for k, v := range updates {
-		params.Keys = append(params.Keys, k)
-		params.Units = append(params.Units, int32(v)) // nolint: gosec
+	if err != nil {
+		return nil, err
 	}
 
-	_, err = d.queries.BulkUpdateRateLimits(ctx, tx, params)
+	res := make(map[string]int, len(rls))
 
-	if err != nil {
-		return nil, err
+	// only the rate limits which are consumed or due for a refill are locked and written
+	keysToLock := make([]string, 0, len(updates))
+
+	for _, rl := range rls {
+		dbNow := rl.Now.Time
+		curr := newRateLimitState(&rl.RateLimit, rl.WindowInterval)
+
+		if _, ok := updates[rl.RateLimit.Key]; ok || curr.Refill(dbNow).ChangedFrom(curr) {
+			keysToLock = append(keysToLock, rl.RateLimit.Key)
+			continue
+		}
+
+		res[rl.RateLimit.Key] = curr.Available(dbNow)
 	}
 
-	newRls, err := d.queries.ListRateLimitsForTenantWithMutate(ctx, tx, tenantId)
+	if len(keysToLock) > 0 {
+		lockedRls, err := d.queries.ListRateLimitStatesForUpdate(ctx, tx, dbsqlc.ListRateLimitStatesForUpdateParams{
+			Tenantid: tenantId,
+			Keys:     keysToLock,
+		})
 
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+
+		params := dbsqlc.BulkUpdateRateLimitStatesParams{
+			Tenantid: tenantId,
+		}
+
+		for _, rl := range lockedRls {
+			dbNow := rl.Now.Time
+			curr := newRateLimitState(&rl.RateLimit, rl.WindowInterval)
+
+			next := curr.Refill(dbNow)
+
+			if units, ok := updates[rl.RateLimit.Key]; ok {
+				next = next.Consume(dbNow, units)
+			}
+
+			if next.ChangedFrom(curr) {
+				params.Keys = append(params.Keys, rl.RateLimit.Key)
+				params.Values = append(params.Values, int32(next.Value)) // nolint: gosec
+				params.Lastrefills = append(params.Lastrefills, sqlchelpers.TimestampFromTime(next.LastRefill))
+				params.Previoususeds = append(params.Previoususeds, int32(next.PreviousUsed)) // nolint: gosec
+			}
+
+			res[rl.RateLimit.Key] = next.Available(dbNow)
+		}
+
+		if len(params.Keys) > 0 {
+			err = d.queries.BulkUpdateRateLimitStates(ctx, tx, params)
+
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if err := commit(ctx); err != nil {
 		return nil, err
 	}
 
-	res := make(map[string]int, len(newRls))
+	return res, nil
+}
 
-	for _, rl := range newRls {
-		res[rl.Key] = int(rl.Value)
+// newRateLimitState returns the state of a rate limit, which is refilled and consumed with the same
+// algorithms as the v1 scheduler.
+func newRateLimitState(rl *dbsqlc.RateLimit, window pgtype.Interval) v1.RateLimitState {
+	return v1.RateLimitState{
+		Value:        int(rl.Value),
+		ParentKey:    rl.ParentKey.String,
+		Algorithm:    sqlcv1.RateLimitAlgorithm(rl.Algorithm),
+		LimitValue:   int(rl.LimitValue),
+		Burst:        int(rl.Burst.Int32),
+		Window:       sqlchelpers.PgIntervalToDuration(window),
+		LastRefill:   rl.LastRefill.Time,
+		PreviousUsed: int(rl.PreviousUsed.Int32),
 	}
-
-	return res, err
 }
@@ -15,3 +15,11 @@ func DurationToPgInterval(d time.Duration) pgtype.Interval {
 		Valid:        true,
 	}
 }
+
+// PgIntervalToDuration converts an interval to a duration, treating a month as 30 days like Postgres
+// does when comparing intervals.
+func PgIntervalToDuration(i pgtype.Interval) time.Duration {
+	return time.Duration(i.Microseconds)*time.Microsecond +
+		time.Duration(i.Days)*24*time.Hour +
+		time.Duration(i.Months)*30*24*time.Hour
+}
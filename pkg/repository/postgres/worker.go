@@ -173,6 +173,13 @@ func (w *workerAPIRepository) UpdateWorker(tenantId, workerId string, opts repos
 		}
 	}
 
+	if opts.IsCordoned != nil {
+		updateParams.IsCordoned = pgtype.Bool{
+			Bool:  *opts.IsCordoned,
+			Valid: true,
+		}
+	}
+
 	worker, err := w.queries.UpdateWorker(context.Background(), w.pool, updateParams)
 
 	if err != nil {
@@ -369,6 +376,26 @@ func (w *workerEngineRepository) CreateNewWorker(ctx context.Context, tenantId s
 			return nil, nil, fmt.Errorf("could not link actions to worker: %w", err)
 		}
 
+		if len(opts.SlotPools) > 0 {
+			names := make([]string, 0, len(opts.SlotPools))
+			maxRuns := make([]int32, 0, len(opts.SlotPools))
+
+			for name, poolMaxRuns := range opts.SlotPools {
+				names = append(names, name)
+				maxRuns = append(maxRuns, int32(poolMaxRuns)) // nolint: gosec
+			}
+
+			err = w.queries.UpsertWorkerSlotPools(ctx, tx, dbsqlc.UpsertWorkerSlotPoolsParams{
+				Names:    names,
+				Maxruns:  maxRuns,
+				Workerid: worker.ID,
+			})
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not upsert worker slot pools: %w", err)
+			}
+		}
+
 		err = tx.Commit(ctx)
 
 		if err != nil {
@@ -417,6 +444,13 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 		}
 	}
 
+	if opts.IsCordoned != nil {
+		updateParams.IsCordoned = pgtype.Bool{
+			Bool:  *opts.IsCordoned,
+			Valid: true,
+		}
+	}
+
 	worker, err := w.queries.UpdateWorker(ctx, tx, updateParams)
 
 	if err != nil {
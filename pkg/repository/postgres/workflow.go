@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,6 +35,10 @@ func NewWorkflowRepository(shared *sharedRepository) repository.WorkflowAPIRepos
 }
 
 func (r *workflowAPIRepository) ListWorkflows(tenantId string, opts *repository.ListWorkflowsOpts) (*repository.ListWorkflowsResult, error) {
+	return listWorkflows(context.Background(), r.sharedRepository, tenantId, opts)
+}
+
+func listWorkflows(ctx context.Context, r *sharedRepository, tenantId string, opts *repository.ListWorkflowsOpts) (*repository.ListWorkflowsResult, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
 	}
@@ -70,27 +76,27 @@ func (r *workflowAPIRepository) ListWorkflows(tenantId string, opts *repository.
 
 	queryParams.Orderby = orderByField + " " + orderByDirection
 
-	tx, err := r.pool.Begin(context.Background())
+	tx, err := r.pool.Begin(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 
-	defer sqlchelpers.DeferRollback(context.Background(), r.l, tx.Rollback)
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
 
-	workflows, err := r.queries.ListWorkflows(context.Background(), tx, queryParams)
+	workflows, err := r.queries.ListWorkflows(ctx, tx, queryParams)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch workflows: %w", err)
 	}
 
-	count, err := r.queries.CountWorkflows(context.Background(), tx, countParams)
+	count, err := r.queries.CountWorkflows(ctx, tx, countParams)
 
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, err
 	}
 
-	err = tx.Commit(context.Background())
+	err = tx.Commit(ctx)
 
 	if err != nil {
 		return nil, err
@@ -877,6 +883,546 @@ func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, te
 	return *cachedArr, nil
 }
 
+func (r *workflowEngineRepository) ListWorkflows(ctx context.Context, tenantId string, opts *repository.ListWorkflowsOpts) (*repository.ListWorkflowsResult, error) {
+	return listWorkflows(ctx, r.sharedRepository, tenantId, opts)
+}
+
+func (r *workflowEngineRepository) DeleteWorkflow(ctx context.Context, tenantId, workflowId string) (*dbsqlc.Workflow, error) {
+	return r.queries.SoftDeleteWorkflow(ctx, r.pool, sqlchelpers.UUIDFromStr(workflowId))
+}
+
+func (r *workflowEngineRepository) ListWorkflowVersions(ctx context.Context, tenantId, workflowId string, opts *repository.ListWorkflowVersionsOpts) (*repository.ListWorkflowVersionsResult, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkflowId := sqlchelpers.UUIDFromStr(workflowId)
+
+	queryParams := dbsqlc.ListWorkflowVersionIdsParams{
+		Tenantid:   pgTenantId,
+		Workflowid: pgWorkflowId,
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	versionIds, err := r.queries.ListWorkflowVersionIds(ctx, r.pool, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow versions: %w", err)
+	}
+
+	count, err := r.queries.CountWorkflowVersions(ctx, r.pool, dbsqlc.CountWorkflowVersionsParams{
+		Tenantid:   pgTenantId,
+		Workflowid: pgWorkflowId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to count workflow versions: %w", err)
+	}
+
+	res := &repository.ListWorkflowVersionsResult{
+		Rows:  []*dbsqlc.GetWorkflowVersionForEngineRow{},
+		Count: int(count),
+	}
+
+	if len(versionIds) == 0 {
+		return res, nil
+	}
+
+	versions, err := r.queries.GetWorkflowVersionForEngine(ctx, r.pool, dbsqlc.GetWorkflowVersionForEngineParams{
+		Tenantid: pgTenantId,
+		Ids:      versionIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow versions: %w", err)
+	}
+
+	// GetWorkflowVersionForEngine doesn't preserve ordering, so we sort by the version order
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].WorkflowVersion.Order > versions[j].WorkflowVersion.Order
+	})
+
+	res.Rows = versions
+
+	return res, nil
+}
+
+func (r *workflowEngineRepository) ListWorkflowVersionOpts(ctx context.Context, tenantId string, workflowVersions []*dbsqlc.GetWorkflowVersionForEngineRow) (map[string]*repository.CreateWorkflowVersionOpts, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	res := make(map[string]*repository.CreateWorkflowVersionOpts, len(workflowVersions))
+
+	if len(workflowVersions) == 0 {
+		return res, nil
+	}
+
+	versionIds := make([]pgtype.UUID, 0, len(workflowVersions))
+	workflowIds := make([]pgtype.UUID, 0, len(workflowVersions))
+
+	for _, workflowVersion := range workflowVersions {
+		versionIds = append(versionIds, workflowVersion.WorkflowVersion.ID)
+		workflowIds = append(workflowIds, workflowVersion.WorkflowVersion.WorkflowId)
+	}
+
+	descriptions, err := r.queries.ListWorkflowDescriptions(ctx, r.pool, dbsqlc.ListWorkflowDescriptionsParams{
+		Ids:      workflowIds,
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflows: %w", err)
+	}
+
+	workflowIdsToDescriptions := make(map[string]pgtype.Text, len(descriptions))
+
+	for _, description := range descriptions {
+		workflowIdsToDescriptions[sqlchelpers.UUIDToStr(description.ID)] = description.Description
+	}
+
+	actions, err := r.queries.ListWorkflowConcurrencyActions(ctx, r.pool, versionIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch concurrency actions: %w", err)
+	}
+
+	versionIdsToActions := make(map[string]string, len(actions))
+
+	for _, action := range actions {
+		versionIdsToActions[sqlchelpers.UUIDToStr(action.WorkflowVersionId)] = action.ActionId
+	}
+
+	for _, workflowVersion := range workflowVersions {
+		wv := workflowVersion.WorkflowVersion
+		versionId := sqlchelpers.UUIDToStr(wv.ID)
+
+		opts := &repository.CreateWorkflowVersionOpts{
+			Name: workflowVersion.WorkflowName,
+			Kind: repository.StringPtr(string(wv.Kind)),
+		}
+
+		if description := workflowIdsToDescriptions[sqlchelpers.UUIDToStr(wv.WorkflowId)]; description.Valid {
+			opts.Description = &description.String
+		}
+
+		if wv.Version.Valid {
+			opts.Version = &wv.Version.String
+		}
+
+		if wv.ScheduleTimeout != "" {
+			opts.ScheduleTimeout = &wv.ScheduleTimeout
+		}
+
+		if wv.Sticky.Valid {
+			opts.Sticky = repository.StringPtr(string(wv.Sticky.StickyStrategy))
+		}
+
+		if wv.DefaultPriority.Valid {
+			opts.DefaultPriority = &wv.DefaultPriority.Int32
+		}
+
+		if workflowVersion.ConcurrencyLimitStrategy.Valid {
+			opts.Concurrency = &repository.CreateWorkflowConcurrencyOpts{
+				LimitStrategy: repository.StringPtr(string(workflowVersion.ConcurrencyLimitStrategy.ConcurrencyLimitStrategy)),
+			}
+
+			if workflowVersion.ConcurrencyMaxRuns.Valid {
+				opts.Concurrency.MaxRuns = &workflowVersion.ConcurrencyMaxRuns.Int32
+			}
+
+			if workflowVersion.ConcurrencyGroupExpression.Valid {
+				opts.Concurrency.Expression = &workflowVersion.ConcurrencyGroupExpression.String
+			}
+
+			if workflowVersion.ConcurrencyWeightExpression.Valid {
+				opts.Concurrency.WeightExpression = &workflowVersion.ConcurrencyWeightExpression.String
+			}
+
+			if action, ok := versionIdsToActions[versionId]; ok && workflowVersion.ConcurrencyGroupId.Valid {
+				opts.Concurrency.Action = &action
+			}
+		}
+
+		res[versionId] = opts
+	}
+
+	events, err := r.queries.ListWorkflowVersionEventTriggerRefs(ctx, r.pool, versionIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch event triggers: %w", err)
+	}
+
+	for _, event := range events {
+		opts, ok := res[sqlchelpers.UUIDToStr(event.WorkflowVersionId)]
+
+		if !ok {
+			continue
+		}
+
+		opts.EventTriggers = append(opts.EventTriggers, event.EventKey)
+
+		if event.Expression.Valid {
+			if opts.EventTriggerFilters == nil {
+				opts.EventTriggerFilters = make(map[string]string)
+			}
+
+			opts.EventTriggerFilters[event.EventKey] = event.Expression.String
+		}
+	}
+
+	// crons and schedules which were created via the API are not part of the workflow definition,
+	// so only the DEFAULT triggers are returned here
+	crons, err := r.queries.ListWorkflowVersionCronTriggerRefs(ctx, r.pool, versionIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cron triggers: %w", err)
+	}
+
+	for _, cron := range crons {
+		opts, ok := res[sqlchelpers.UUIDToStr(cron.WorkflowVersionId)]
+
+		if !ok {
+			continue
+		}
+
+		opts.CronTriggers = append(opts.CronTriggers, cron.Cron)
+		opts.CronInput = cron.Input
+	}
+
+	scheduled, err := r.queries.ListWorkflowVersionScheduleTriggerRefs(ctx, r.pool, versionIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch scheduled triggers: %w", err)
+	}
+
+	for _, schedule := range scheduled {
+		opts, ok := res[sqlchelpers.UUIDToStr(schedule.WorkflowVersionId)]
+
+		if !ok {
+			continue
+		}
+
+		opts.ScheduledTriggers = append(opts.ScheduledTriggers, schedule.TriggerAt.Time)
+	}
+
+	jobs, err := r.queries.ListJobsForWorkflowVersions(ctx, r.pool, dbsqlc.ListJobsForWorkflowVersionsParams{
+		Workflowversionids: versionIds,
+		Tenantid:           pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jobs: %w", err)
+	}
+
+	jobIds := make([]pgtype.UUID, len(jobs))
+
+	for i, job := range jobs {
+		jobIds[i] = job.ID
+	}
+
+	steps, err := r.queries.GetStepsForJobs(ctx, r.pool, dbsqlc.GetStepsForJobsParams{
+		Jobids:   jobIds,
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch steps: %w", err)
+	}
+
+	stepIds := make([]pgtype.UUID, len(steps))
+	stepIdsToReadableIds := make(map[string]string, len(steps))
+
+	for i, step := range steps {
+		stepIds[i] = step.Step.ID
+		stepIdsToReadableIds[sqlchelpers.UUIDToStr(step.Step.ID)] = step.Step.ReadableId.String
+	}
+
+	labels, err := r.queries.ListDesiredWorkerLabelsForSteps(ctx, r.pool, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch desired worker labels: %w", err)
+	}
+
+	stepIdsToLabels := make(map[string]map[string]repository.DesiredWorkerLabelOpts)
+
+	for _, label := range labels {
+		stepId := sqlchelpers.UUIDToStr(label.StepId)
+
+		if _, ok := stepIdsToLabels[stepId]; !ok {
+			stepIdsToLabels[stepId] = make(map[string]repository.DesiredWorkerLabelOpts)
+		}
+
+		labelOpts := repository.DesiredWorkerLabelOpts{
+			Key:        label.Key,
+			Required:   repository.BoolPtr(label.Required),
+			Weight:     &label.Weight,
+			Comparator: repository.StringPtr(string(label.Comparator)),
+		}
+
+		if label.StrValue.Valid {
+			labelOpts.StrValue = &label.StrValue.String
+		}
+
+		if label.IntValue.Valid {
+			labelOpts.IntValue = &label.IntValue.Int32
+		}
+
+		stepIdsToLabels[stepId][label.Key] = labelOpts
+	}
+
+	staticRateLimits, err := r.queries.ListRateLimitsForSteps(ctx, r.pool, dbsqlc.ListRateLimitsForStepsParams{
+		Stepids:  stepIds,
+		Tenantid: pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch step rate limits: %w", err)
+	}
+
+	expressions, err := r.queries.ListStepExpressionsForSteps(ctx, r.pool, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch step expressions: %w", err)
+	}
+
+	stepIdsToRateLimits := stepRateLimitsToOpts(staticRateLimits, expressions)
+
+	conditions, err := r.queries.ListStepMatchConditionsForSteps(ctx, r.pool, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch step match conditions: %w", err)
+	}
+
+	stepIdsToConditions := make(map[string][]repository.CreateStepMatchConditionOpts)
+
+	for _, condition := range conditions {
+		stepId := sqlchelpers.UUIDToStr(condition.StepId)
+
+		conditionOpts := repository.CreateStepMatchConditionOpts{
+			OrGroupId:       condition.OrGroupId,
+			Action:          string(condition.Action),
+			ReadableDataKey: condition.ReadableDataKey,
+		}
+
+		if condition.SleepDuration.Valid {
+			conditionOpts.SleepFor = &condition.SleepDuration.String
+		}
+
+		if condition.EventKey.Valid {
+			conditionOpts.EventKey = &condition.EventKey.String
+		}
+
+		if condition.Expression.Valid {
+			conditionOpts.Expression = &condition.Expression.String
+		}
+
+		stepIdsToConditions[stepId] = append(stepIdsToConditions[stepId], conditionOpts)
+	}
+
+	concurrencies, err := r.queries.ListStepConcurrenciesForSteps(ctx, r.pool, stepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch step concurrency limits: %w", err)
+	}
+
+	stepIdsToConcurrency := make(map[string][]repository.CreateStepConcurrencyOpts)
+
+	for _, concurrency := range concurrencies {
+		stepId := sqlchelpers.UUIDToStr(concurrency.StepId)
+		maxRuns := concurrency.MaxRuns
+		limitStrategy := string(concurrency.LimitStrategy)
+
+		concurrencyOpts := repository.CreateStepConcurrencyOpts{
+			Expression:    concurrency.Expression,
+			MaxRuns:       &maxRuns,
+			LimitStrategy: &limitStrategy,
+		}
+
+		if concurrency.WeightExpression.Valid {
+			concurrencyOpts.WeightExpression = &concurrency.WeightExpression.String
+		}
+
+		stepIdsToConcurrency[stepId] = append(stepIdsToConcurrency[stepId], concurrencyOpts)
+	}
+
+	jobIdsToSteps := make(map[string][]repository.CreateWorkflowStepOpts, len(jobs))
+
+	for _, step := range steps {
+		stepId := sqlchelpers.UUIDToStr(step.Step.ID)
+		jobId := sqlchelpers.UUIDToStr(step.JobId)
+		retries := int(step.Step.Retries)
+
+		stepOpts := repository.CreateWorkflowStepOpts{
+			ReadableId:          step.Step.ReadableId.String,
+			Action:              step.Step.ActionId,
+			Retries:             &retries,
+			RateLimits:          stepIdsToRateLimits[stepId],
+			DesiredWorkerLabels: stepIdsToLabels[stepId],
+			Conditions:          stepIdsToConditions[stepId],
+			Concurrency:         stepIdsToConcurrency[stepId],
+		}
+
+		if step.Step.Timeout.Valid {
+			stepOpts.Timeout = &step.Step.Timeout.String
+		}
+
+		if len(step.Step.CustomUserData) > 0 {
+			userData := string(step.Step.CustomUserData)
+			stepOpts.UserData = &userData
+		}
+
+		if step.Step.RetryBackoffFactor.Valid {
+			stepOpts.RetryBackoffFactor = &step.Step.RetryBackoffFactor.Float64
+		}
+
+		if step.Step.RetryMaxBackoff.Valid {
+			maxBackoff := int(step.Step.RetryMaxBackoff.Int32)
+			stepOpts.RetryBackoffMaxSeconds = &maxBackoff
+		}
+
+		if step.Step.SkipIf.Valid {
+			stepOpts.SkipIf = &step.Step.SkipIf.String
+		}
+
+		if step.Step.MapExpression.Valid {
+			stepOpts.Map = &repository.CreateStepMapOpts{
+				Expression: step.Step.MapExpression.String,
+			}
+
+			if step.Step.MapMaxParallelism.Valid {
+				maxParallelism := int(step.Step.MapMaxParallelism.Int32)
+				stepOpts.Map.MaxParallelism = &maxParallelism
+			}
+		}
+
+		if step.Step.Slots != 1 {
+			slots := int(step.Step.Slots)
+			stepOpts.Slots = &slots
+		}
+
+		if step.Step.SlotPool.Valid {
+			stepOpts.SlotPool = &step.Step.SlotPool.String
+		}
+
+		for _, parent := range step.Parents {
+			stepOpts.Parents = append(stepOpts.Parents, stepIdsToReadableIds[sqlchelpers.UUIDToStr(parent)])
+		}
+
+		sort.Strings(stepOpts.Parents)
+
+		jobIdsToSteps[jobId] = append(jobIdsToSteps[jobId], stepOpts)
+	}
+
+	for _, job := range jobs {
+		opts, ok := res[sqlchelpers.UUIDToStr(job.WorkflowVersionId)]
+
+		if !ok {
+			continue
+		}
+
+		jobId := sqlchelpers.UUIDToStr(job.ID)
+		jobSteps := jobIdsToSteps[jobId]
+
+		sort.SliceStable(jobSteps, func(i, j int) bool {
+			return jobSteps[i].ReadableId < jobSteps[j].ReadableId
+		})
+
+		jobOpts := repository.CreateWorkflowJobOpts{
+			Name:  job.Name,
+			Steps: jobSteps,
+			Kind:  string(job.Kind),
+		}
+
+		if job.Description.Valid {
+			jobOpts.Description = &job.Description.String
+		}
+
+		if job.Kind == dbsqlc.JobKindONFAILURE {
+			opts.OnFailureJob = &jobOpts
+			continue
+		}
+
+		opts.Jobs = append(opts.Jobs, jobOpts)
+	}
+
+	return res, nil
+}
+
+// stepRateLimitsToOpts is the inverse of the rate limit handling in createJobTx: static rate limits are stored as
+// step rate limits, while rate limits with any expressions are stored as a set of step expressions.
+func stepRateLimitsToOpts(staticRateLimits []*dbsqlc.StepRateLimit, expressions []*dbsqlc.StepExpression) map[string][]repository.CreateWorkflowStepRateLimitOpts {
+	res := make(map[string][]repository.CreateWorkflowStepRateLimitOpts)
+
+	for _, rateLimit := range staticRateLimits {
+		if rateLimit.Kind != dbsqlc.StepRateLimitKindSTATIC {
+			continue
+		}
+
+		stepId := sqlchelpers.UUIDToStr(rateLimit.StepId)
+		units := int(rateLimit.Units)
+
+		res[stepId] = append(res[stepId], repository.CreateWorkflowStepRateLimitOpts{
+			Key:   rateLimit.RateLimitKey,
+			Units: &units,
+		})
+	}
+
+	type dynamicKey struct {
+		stepId string
+		key    string
+	}
+
+	dynamic := make(map[dynamicKey]*repository.CreateWorkflowStepRateLimitOpts)
+	dynamicKeys := make([]dynamicKey, 0)
+
+	for _, expr := range expressions {
+		k := dynamicKey{
+			stepId: sqlchelpers.UUIDToStr(expr.StepId),
+			key:    expr.Key,
+		}
+
+		if _, ok := dynamic[k]; !ok {
+			dynamic[k] = &repository.CreateWorkflowStepRateLimitOpts{
+				Key: expr.Key,
+			}
+
+			dynamicKeys = append(dynamicKeys, k)
+		}
+
+		rateLimit := dynamic[k]
+		expression := expr.Expression
+
+		switch expr.Kind {
+		case dbsqlc.StepExpressionKindDYNAMICRATELIMITKEY:
+			if expression != cel.Str(expr.Key) {
+				rateLimit.KeyExpr = &expression
+			}
+		case dbsqlc.StepExpressionKindDYNAMICRATELIMITVALUE:
+			rateLimit.LimitExpr = &expression
+		case dbsqlc.StepExpressionKindDYNAMICRATELIMITUNITS:
+			if units, err := strconv.Atoi(expression); err == nil {
+				rateLimit.Units = &units
+			} else {
+				rateLimit.UnitsExpr = &expression
+			}
+		case dbsqlc.StepExpressionKindDYNAMICRATELIMITWINDOW:
+			duration := strings.Trim(expression, `"`)
+			rateLimit.Duration = &duration
+		case dbsqlc.StepExpressionKindDYNAMICRATELIMITPARENT:
+			parentKey := strings.Trim(expression, `"`)
+			rateLimit.ParentKey = &parentKey
+		}
+	}
+
+	for _, k := range dynamicKeys {
+		res[k.stepId] = append(res[k.stepId], *dynamic[k])
+	}
+
+	return res
+}
+
 func (r *workflowAPIRepository) GetWorkflowWorkerCount(tenantId, workflowId string) (int, int, error) {
 	params := dbsqlc.GetWorkflowWorkerCountParams{
 		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
@@ -1014,6 +1560,10 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 			params.ConcurrencyGroupExpression = sqlchelpers.TextFromStr(*opts.Concurrency.Expression)
 		}
 
+		if opts.Concurrency.WeightExpression != nil && *opts.Concurrency.WeightExpression != "" {
+			params.WeightExpression = sqlchelpers.TextFromStr(*opts.Concurrency.WeightExpression)
+		}
+
 		if opts.Concurrency.MaxRuns != nil {
 			params.MaxRuns = sqlchelpers.ToInt(*opts.Concurrency.MaxRuns)
 		}
@@ -1060,13 +1610,19 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 	}
 
 	for _, eventTrigger := range opts.EventTriggers {
+		params := dbsqlc.CreateWorkflowTriggerEventRefParams{
+			Workflowtriggersid: sqlcWorkflowTriggers.ID,
+			Eventtrigger:       eventTrigger,
+		}
+
+		if expr, ok := opts.EventTriggerFilters[eventTrigger]; ok && expr != "" {
+			params.Expression = sqlchelpers.TextFromStr(expr)
+		}
+
 		_, err := r.queries.CreateWorkflowTriggerEventRef(
 			ctx,
 			tx,
-			dbsqlc.CreateWorkflowTriggerEventRefParams{
-				Workflowtriggersid: sqlcWorkflowTriggers.ID,
-				Eventtrigger:       eventTrigger,
-			},
+			params,
 		)
 
 		if err != nil {
@@ -1235,6 +1791,32 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			}
 		}
 
+		if stepOpts.SkipIf != nil {
+			createStepParams.SkipIf = sqlchelpers.TextFromStr(*stepOpts.SkipIf)
+		}
+
+		if stepOpts.Map != nil {
+			createStepParams.MapExpression = sqlchelpers.TextFromStr(stepOpts.Map.Expression)
+
+			if stepOpts.Map.MaxParallelism != nil {
+				createStepParams.MapMaxParallelism = pgtype.Int4{
+					Int32: int32(*stepOpts.Map.MaxParallelism), // nolint: gosec
+					Valid: true,
+				}
+			}
+		}
+
+		if stepOpts.Slots != nil {
+			createStepParams.Slots = pgtype.Int4{
+				Int32: int32(*stepOpts.Slots), // nolint: gosec
+				Valid: true,
+			}
+		}
+
+		if stepOpts.SlotPool != nil {
+			createStepParams.SlotPool = sqlchelpers.TextFromStr(*stepOpts.SlotPool)
+		}
+
 		_, err = r.queries.CreateStep(
 			ctx,
 			tx,
@@ -1362,6 +1944,13 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 					createStepExprParams.Kinds = append(createStepExprParams.Kinds, string(dbsqlc.StepExpressionKindDYNAMICRATELIMITWINDOW))
 					createStepExprParams.Keys = append(createStepExprParams.Keys, rateLimit.Key)
 					createStepExprParams.Expressions = append(createStepExprParams.Expressions, windowExpr)
+
+					// create the parent expression, if it's set
+					if rateLimit.ParentKey != nil {
+						createStepExprParams.Kinds = append(createStepExprParams.Kinds, string(dbsqlc.StepExpressionKindDYNAMICRATELIMITPARENT))
+						createStepExprParams.Keys = append(createStepExprParams.Keys, rateLimit.Key)
+						createStepExprParams.Expressions = append(createStepExprParams.Expressions, cel.Str(*rateLimit.ParentKey))
+					}
 				} else {
 					_, err := r.queries.CreateStepRateLimit(
 						ctx,
@@ -1393,6 +1982,92 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 				}
 			}
 		}
+
+		if len(stepOpts.Conditions) > 0 {
+			createConditionsParams := dbsqlc.CreateStepMatchConditionsParams{
+				Tenantid: tenantId,
+				Stepid:   sqlchelpers.UUIDFromStr(stepId),
+			}
+
+			for _, condition := range stepOpts.Conditions {
+				var sleepDuration, eventKey, expression string
+
+				kind := dbsqlc.StepMatchConditionKindUSEREVENT
+
+				if condition.SleepFor != nil {
+					kind = dbsqlc.StepMatchConditionKindSLEEP
+					sleepDuration = *condition.SleepFor
+				}
+
+				if condition.EventKey != nil {
+					eventKey = *condition.EventKey
+				}
+
+				if condition.Expression != nil {
+					expression = *condition.Expression
+				}
+
+				createConditionsParams.Orgroupids = append(createConditionsParams.Orgroupids, condition.OrGroupId)
+				createConditionsParams.Actions = append(createConditionsParams.Actions, condition.Action)
+				createConditionsParams.Kinds = append(createConditionsParams.Kinds, string(kind))
+				createConditionsParams.Readabledatakeys = append(createConditionsParams.Readabledatakeys, condition.ReadableDataKey)
+				createConditionsParams.Sleepdurations = append(createConditionsParams.Sleepdurations, sleepDuration)
+				createConditionsParams.Eventkeys = append(createConditionsParams.Eventkeys, eventKey)
+				createConditionsParams.Expressions = append(createConditionsParams.Expressions, expression)
+			}
+
+			err := r.queries.CreateStepMatchConditions(
+				ctx,
+				tx,
+				createConditionsParams,
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not create step match conditions: %w", err)
+			}
+		}
+
+		if len(stepOpts.Concurrency) > 0 {
+			createConcurrencyParams := dbsqlc.CreateStepConcurrenciesParams{
+				Tenantid: tenantId,
+				Stepid:   sqlchelpers.UUIDFromStr(stepId),
+			}
+
+			for _, concurrency := range stepOpts.Concurrency {
+				maxRuns := int32(1)
+
+				if concurrency.MaxRuns != nil {
+					maxRuns = *concurrency.MaxRuns
+				}
+
+				limitStrategy := string(dbsqlc.ConcurrencyLimitStrategyCANCELINPROGRESS)
+
+				if concurrency.LimitStrategy != nil && *concurrency.LimitStrategy != "" {
+					limitStrategy = *concurrency.LimitStrategy
+				}
+
+				var weightExpression string
+
+				if concurrency.WeightExpression != nil {
+					weightExpression = *concurrency.WeightExpression
+				}
+
+				createConcurrencyParams.Expressions = append(createConcurrencyParams.Expressions, concurrency.Expression)
+				createConcurrencyParams.Maxruns = append(createConcurrencyParams.Maxruns, maxRuns)
+				createConcurrencyParams.Limitstrategies = append(createConcurrencyParams.Limitstrategies, limitStrategy)
+				createConcurrencyParams.Weightexpressions = append(createConcurrencyParams.Weightexpressions, weightExpression)
+			}
+
+			err := r.queries.CreateStepConcurrencies(
+				ctx,
+				tx,
+				createConcurrencyParams,
+			)
+
+			if err != nil {
+				return "", fmt.Errorf("could not create step concurrency limits: %w", err)
+			}
+		}
 	}
 
 	return jobId, nil
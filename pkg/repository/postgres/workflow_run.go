@@ -559,6 +559,16 @@ func (w *workflowRunAPIRepository) GetStepsForJobs(ctx context.Context, tenantId
 	})
 }
 
+func (w *workflowRunAPIRepository) GetStepConcurrenciesForSteps(ctx context.Context, stepIds []string) ([]*dbsqlc.StepConcurrency, error) {
+	stepIdsPg := make([]pgtype.UUID, len(stepIds))
+
+	for i := range stepIds {
+		stepIdsPg[i] = sqlchelpers.UUIDFromStr(stepIds[i])
+	}
+
+	return w.queries.ListStepConcurrenciesForSteps(ctx, w.pool, stepIdsPg)
+}
+
 func (w *workflowRunAPIRepository) GetStepRunsForJobRuns(ctx context.Context, tenantId string, jobRunIds []string) ([]*repository.StepRunForJobRun, error) {
 	jobRunIdsPg := make([]pgtype.UUID, len(jobRunIds))
 
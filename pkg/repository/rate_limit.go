@@ -2,10 +2,18 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/dbsqlc"
 )
 
+// ErrRateLimitParentNotFound is returned when the parent of a rate limit does not exist in the tenant.
+var ErrRateLimitParentNotFound = errors.New("parent rate limit not found")
+
+// ErrRateLimitParentCycle is returned when the parent of a rate limit is the rate limit itself or one of
+// its descendants.
+var ErrRateLimitParentCycle = errors.New("parent rate limit would create a cycle")
+
 type ListRateLimitOpts struct {
 	// (optional) a search query for the key
 	Search *string
@@ -34,11 +42,23 @@ type UpsertRateLimitOpts struct {
 
 	// The rate limit duration
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the key of the parent rate limit
+	ParentKey *string
+
+	// (optional) the algorithm used to refill the rate limit, defaults to FIXED_WINDOW
+	Algorithm *string `validate:"omitnil,oneof=FIXED_WINDOW TOKEN_BUCKET SLIDING_WINDOW"`
+
+	// (optional) the capacity of a TOKEN_BUCKET rate limit, defaults to the limit
+	Burst *int `validate:"omitnil,gt=0"`
 }
 
 type RateLimitEngineRepository interface {
 	ListRateLimits(ctx context.Context, tenantId string, opts *ListRateLimitOpts) (*ListRateLimitsResult, error)
 
+	// ListExistingRateLimitKeys returns the given rate limit keys which exist in the tenant
+	ListExistingRateLimitKeys(ctx context.Context, tenantId string, keys []string) ([]string, error)
+
 	// CreateRateLimit creates a new rate limit record
 	UpsertRateLimit(ctx context.Context, tenantId string, key string, opts *UpsertRateLimitOpts) (*dbsqlc.RateLimit, error)
 }
@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// BulkOperationFilter is the filter which determines the runs that a bulk operation applies to. It is
+// stored on the bulk operation, and is evaluated against the OLAP tables.
+type BulkOperationFilter struct {
+	Statuses []sqlcv1.V1ReadableStatusOlap `json:"statuses,omitempty"`
+
+	Since time.Time `json:"since"`
+
+	Until *time.Time `json:"until,omitempty"`
+
+	WorkflowIds []uuid.UUID `json:"workflow_ids,omitempty"`
+
+	AdditionalMetadata map[string]interface{} `json:"additional_metadata,omitempty"`
+}
+
+func (f BulkOperationFilter) ToListWorkflowRunOpts(limit int64, after *WorkflowRunCursor) ListWorkflowRunOpts {
+	statuses := f.Statuses
+
+	if len(statuses) == 0 {
+		statuses = []sqlcv1.V1ReadableStatusOlap{
+			sqlcv1.V1ReadableStatusOlapQUEUED,
+			sqlcv1.V1ReadableStatusOlapRUNNING,
+			sqlcv1.V1ReadableStatusOlapFAILED,
+			sqlcv1.V1ReadableStatusOlapCOMPLETED,
+			sqlcv1.V1ReadableStatusOlapCANCELLED,
+		}
+	}
+
+	workflowIds := f.WorkflowIds
+
+	if workflowIds == nil {
+		workflowIds = []uuid.UUID{}
+	}
+
+	return ListWorkflowRunOpts{
+		CreatedAfter:       f.Since,
+		FinishedBefore:     f.Until,
+		Statuses:           statuses,
+		WorkflowIds:        workflowIds,
+		AdditionalMetadata: f.AdditionalMetadata,
+		Limit:              limit,
+		After:              after,
+	}
+}
+
+type CreateBulkOperationOpts struct {
+	Kind sqlcv1.V1BulkOperationKind `validate:"required,oneof=CANCEL REPLAY"`
+
+	Filter BulkOperationFilter
+}
+
+type CompleteBulkOperationBatchOpts struct {
+	// the id of the last item of the bulk operation before the batch
+	PreviousLastItemId int64
+
+	// the id of the last item of the batch
+	LastItemId int64
+
+	// the number of items in the batch which were processed
+	ProcessedCount int64
+
+	// the number of items in the batch which could not be processed
+	FailedCount int64
+}
+
+type BulkOperationRepository interface {
+	CreateBulkOperation(ctx context.Context, tenantId string, opts CreateBulkOperationOpts) (*sqlcv1.V1BulkOperation, error)
+
+	GetBulkOperation(ctx context.Context, tenantId, bulkOperationId string) (*sqlcv1.V1BulkOperation, error)
+
+	// AddMatchedItems adds a page of runs which match the filter of a pending bulk operation. If isLastPage is
+	// set, the bulk operation moves to RUNNING.
+	AddMatchedItems(ctx context.Context, tenantId, bulkOperationId string, externalIds []pgtype.UUID, isLastPage bool) (*sqlcv1.V1BulkOperation, error)
+
+	// ListNextItems lists the next batch of items to process for a running bulk operation.
+	ListNextItems(ctx context.Context, bulkOperationId string, lastItemId int64, limit int) ([]*sqlcv1.V1BulkOperationItem, error)
+
+	// CompleteBatch moves the last item of a running bulk operation past a processed batch of items and records
+	// the result of the batch in the same statement, so that the batch is only counted once. It returns
+	// pgx.ErrNoRows if the batch was already completed.
+	CompleteBatch(ctx context.Context, tenantId, bulkOperationId string, opts CompleteBulkOperationBatchOpts) (*sqlcv1.V1BulkOperation, error)
+
+	// FinishBulkOperation marks a bulk operation as completed, or as failed if errorMessage is set, and removes
+	// its items.
+	FinishBulkOperation(ctx context.Context, tenantId, bulkOperationId string, errorMessage *string) (*sqlcv1.V1BulkOperation, error)
+}
+
+type bulkOperationRepository struct {
+	*sharedRepository
+}
+
+func newBulkOperationRepository(shared *sharedRepository) BulkOperationRepository {
+	return &bulkOperationRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *bulkOperationRepository) CreateBulkOperation(ctx context.Context, tenantId string, opts CreateBulkOperationOpts) (*sqlcv1.V1BulkOperation, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	filterBytes, err := json.Marshal(opts.Filter)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal filter: %w", err)
+	}
+
+	return r.queries.CreateBulkOperation(ctx, r.pool, sqlcv1.CreateBulkOperationParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Kind:     opts.Kind,
+		Filter:   filterBytes,
+	})
+}
+
+func (r *bulkOperationRepository) GetBulkOperation(ctx context.Context, tenantId, bulkOperationId string) (*sqlcv1.V1BulkOperation, error) {
+	return r.queries.GetBulkOperation(ctx, r.pool, sqlcv1.GetBulkOperationParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		ID:       sqlchelpers.UUIDFromStr(bulkOperationId),
+	})
+}
+
+func (r *bulkOperationRepository) AddMatchedItems(ctx context.Context, tenantId, bulkOperationId string, externalIds []pgtype.UUID, isLastPage bool) (*sqlcv1.V1BulkOperation, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	inserted, err := r.queries.InsertBulkOperationItems(ctx, tx, sqlcv1.InsertBulkOperationItemsParams{
+		Bulkoperationid: sqlchelpers.UUIDFromStr(bulkOperationId),
+		Externalids:     externalIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not insert bulk operation items: %w", err)
+	}
+
+	op, err := r.queries.UpdateBulkOperationMatched(ctx, tx, sqlcv1.UpdateBulkOperationMatchedParams{
+		Matchedcount: inserted,
+		Islastpage:   isLastPage,
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		ID:           sqlchelpers.UUIDFromStr(bulkOperationId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update bulk operation: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func (r *bulkOperationRepository) ListNextItems(ctx context.Context, bulkOperationId string, lastItemId int64, limit int) ([]*sqlcv1.V1BulkOperationItem, error) {
+	return r.queries.ListBulkOperationItems(ctx, r.pool, sqlcv1.ListBulkOperationItemsParams{
+		Bulkoperationid: sqlchelpers.UUIDFromStr(bulkOperationId),
+		Lastitemid:      lastItemId,
+		Batchsize:       int32(limit), // nolint: gosec
+	})
+}
+
+func (r *bulkOperationRepository) CompleteBatch(ctx context.Context, tenantId, bulkOperationId string, opts CompleteBulkOperationBatchOpts) (*sqlcv1.V1BulkOperation, error) {
+	return r.queries.CompleteBulkOperationBatch(ctx, r.pool, sqlcv1.CompleteBulkOperationBatchParams{
+		Lastitemid:         opts.LastItemId,
+		Processedcount:     opts.ProcessedCount,
+		Failedcount:        opts.FailedCount,
+		Tenantid:           sqlchelpers.UUIDFromStr(tenantId),
+		ID:                 sqlchelpers.UUIDFromStr(bulkOperationId),
+		Previouslastitemid: opts.PreviousLastItemId,
+	})
+}
+
+func (r *bulkOperationRepository) FinishBulkOperation(ctx context.Context, tenantId, bulkOperationId string, errorMessage *string) (*sqlcv1.V1BulkOperation, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	params := sqlcv1.FinishBulkOperationParams{
+		Status:   sqlcv1.V1BulkOperationStatusCOMPLETED,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		ID:       sqlchelpers.UUIDFromStr(bulkOperationId),
+	}
+
+	if errorMessage != nil {
+		params.Status = sqlcv1.V1BulkOperationStatusFAILED
+		params.ErrorMessage = sqlchelpers.TextFromStr(*errorMessage)
+	}
+
+	op, err := r.queries.FinishBulkOperation(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not finish bulk operation: %w", err)
+	}
+
+	err = r.queries.DeleteBulkOperationItems(ctx, tx, op.ID)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete bulk operation items: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
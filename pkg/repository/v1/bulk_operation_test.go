@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestBulkOperationFilter_ToListWorkflowRunOpts(t *testing.T) {
+	since := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	after := &WorkflowRunCursor{
+		InsertedAt: since.Add(time.Hour),
+		Id:         200,
+	}
+
+	opts := BulkOperationFilter{
+		Since: since,
+	}.ToListWorkflowRunOpts(100, after)
+
+	// an empty filter matches runs in every status and workflow
+	assert.Len(t, opts.Statuses, 5)
+	assert.NotNil(t, opts.WorkflowIds)
+	assert.Empty(t, opts.WorkflowIds)
+	assert.Equal(t, since, opts.CreatedAfter)
+	assert.Nil(t, opts.FinishedBefore)
+	assert.Equal(t, int64(100), opts.Limit)
+	assert.Equal(t, after, opts.After)
+}
+
+func TestBulkOperationFilter_RoundTrip(t *testing.T) {
+	until := time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	// the filter is stored as JSON on the bulk operation and read back by the tasks controller
+	filter := BulkOperationFilter{
+		Statuses:           []sqlcv1.V1ReadableStatusOlap{sqlcv1.V1ReadableStatusOlapFAILED},
+		Since:              until.Add(-24 * time.Hour),
+		Until:              &until,
+		WorkflowIds:        []uuid.UUID{uuid.New()},
+		AdditionalMetadata: map[string]interface{}{"customer": "acme"},
+	}
+
+	filterBytes, err := json.Marshal(filter)
+	require.NoError(t, err)
+
+	res := BulkOperationFilter{}
+	require.NoError(t, json.Unmarshal(filterBytes, &res))
+
+	assert.Equal(t, filter.ToListWorkflowRunOpts(10, nil), res.ToListWorkflowRunOpts(10, nil))
+}
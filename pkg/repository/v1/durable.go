@@ -0,0 +1,576 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// durableSleepEventKey is the key of the internal event which is sent when a durable sleep expires. The
+// resource hint of the event is the id of the v1_durable_sleep row.
+const durableSleepEventKey = "DURABLE_SLEEP_COMPLETED"
+
+// durableEventMaxWait is how far the timeout of a durable task is pushed back when it only waits on user
+// events, which don't have a natural deadline.
+const durableEventMaxWait = 30 * 24 * time.Hour
+
+type DurableSleepCondition struct {
+	// (required) the key which the sleep data is stored under
+	ReadableDataKey string `validate:"required"`
+
+	// (required) the duration to sleep for
+	SleepFor string `validate:"required,duration"`
+}
+
+type DurableUserEventCondition struct {
+	// (required) the key which the event data is stored under
+	ReadableDataKey string `validate:"required"`
+
+	// (required) the key of the user event
+	EventKey string `validate:"required"`
+
+	// (optional) a CEL expression which the event data must satisfy
+	Expression string `validate:"omitempty,celevent"`
+}
+
+type RegisterDurableEventOpts struct {
+	// (required) the external id of the task which is waiting
+	TaskExternalId string `validate:"required,uuid"`
+
+	// (required) a key which identifies the wait within the task
+	SignalKey string `validate:"required"`
+
+	SleepConditions []DurableSleepCondition `validate:"dive"`
+
+	UserEventConditions []DurableUserEventCondition `validate:"dive"`
+}
+
+type RegisterDurableEventResult struct {
+	TaskId int64
+
+	RetryCount int32
+
+	// the worker which held the task's slot before it was released, if any
+	ReleasedWorkerId *string
+
+	// the time the task will time out if none of the conditions are satisfied
+	TimeoutAt time.Time
+}
+
+type ResumeDurableEventOpts struct {
+	// (required) the external id of the task which is waiting
+	TaskExternalId string `validate:"required,uuid"`
+
+	// (required) the key which was used to register the wait
+	SignalKey string `validate:"required"`
+
+	// (required) the worker which the task is assigned back to
+	WorkerId string `validate:"required,uuid"`
+}
+
+type DurableEventResult struct {
+	TaskExternalId string
+
+	SignalKey string
+
+	TaskId int64
+
+	TaskInsertedAt pgtype.Timestamptz
+
+	RetryCount int32
+
+	// the worker which the task was assigned back to
+	WorkerId string
+
+	// the number of slots the task fills in the slot pool of the worker
+	Slots int32
+
+	// the slot pool of the worker which the task fills, or an empty string for the default slot pool
+	SlotPool string
+
+	// the aggregated data of the satisfied conditions, keyed by action and readable data key
+	Data []byte
+}
+
+// ResumeDurableEventsResult is the result of ResumeDurableEvents. Waits which are satisfied but whose worker
+// does not belong to the tenant or does not have a free slot for the task are in neither list, and are
+// resumed on a later call once the worker has capacity.
+type ResumeDurableEventsResult struct {
+	// the waits which were satisfied, whose tasks were assigned back to their workers
+	Resumed []*DurableEventResult
+
+	// the waits whose tasks are no longer running, so they can't be resumed
+	NotRunning []ResumeDurableEventOpts
+}
+
+type durableEventSignalCreatedData struct {
+	// the deadline of the wait, before the task's step timeout is added
+	WaitUntil time.Time `json:"wait_until"`
+}
+
+func getDurableSignalEventKey(taskExternalId, signalKey string) string {
+	return fmt.Sprintf("%s.durable.%s", taskExternalId, signalKey)
+}
+
+func (m *MatchRepositoryImpl) RegisterDurableEvent(ctx context.Context, tenantId string, opts RegisterDurableEventOpts) (*RegisterDurableEventResult, error) {
+	if err := m.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	if len(opts.SleepConditions) == 0 && len(opts.UserEventConditions) == 0 {
+		return nil, fmt.Errorf("at least one sleep or user event condition is required")
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	tasks, err := m.lookupExternalIds(ctx, tx, tenantId, []string{opts.TaskExternalId})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup task: %w", err)
+	}
+
+	if len(tasks) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	task := tasks[0]
+	eventKey := getDurableSignalEventKey(opts.TaskExternalId, opts.SignalKey)
+
+	// if the wait was registered by a previous attempt of the task, we don't register it again
+	existingEvents, err := m.queries.LockSignalCreatedEvents(ctx, tx, sqlcv1.LockSignalCreatedEventsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskids:         []int64{task.ID},
+		Taskinsertedats: []pgtype.Timestamptz{task.InsertedAt},
+		Eventkeys:       []string{eventKey},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock signal created events: %w", err)
+	}
+
+	var waitUntil time.Time
+
+	if len(existingEvents) > 0 {
+		data := &durableEventSignalCreatedData{}
+
+		if err := json.Unmarshal(existingEvents[0].Data, data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal signal created data: %w", err)
+		}
+
+		waitUntil = data.WaitUntil
+	} else {
+		waitUntil, err = m.createDurableEventMatches(ctx, tx, tenantId, task, eventKey, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(&durableEventSignalCreatedData{
+			WaitUntil: waitUntil,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = m.createTaskEvents(
+			ctx,
+			tx,
+			tenantId,
+			[]TaskIdInsertedAtRetryCount{
+				{
+					Id:         task.ID,
+					InsertedAt: task.InsertedAt,
+					RetryCount: -1,
+				},
+			},
+			[]string{opts.TaskExternalId},
+			[][]byte{data},
+			[]sqlcv1.V1TaskEventType{sqlcv1.V1TaskEventTypeSIGNALCREATED},
+			[]string{eventKey},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signal created event: %w", err)
+		}
+	}
+
+	released, err := m.queries.ReleaseDurableTaskSlot(ctx, tx, sqlcv1.ReleaseDurableTaskSlotParams{
+		WaitUntil:  sqlchelpers.TimestamptzFromTime(waitUntil),
+		Externalid: sqlchelpers.UUIDFromStr(opts.TaskExternalId),
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to release slot for durable task: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	res := &RegisterDurableEventResult{
+		TaskId:     released.TaskID,
+		RetryCount: released.RetryCount,
+		TimeoutAt:  released.TimeoutAt.Time,
+	}
+
+	if released.ReleasedWorkerID.Valid {
+		workerId := sqlchelpers.UUIDToStr(released.ReleasedWorkerID)
+		res.ReleasedWorkerId = &workerId
+	}
+
+	return res, nil
+}
+
+// createDurableEventMatches creates a signal match for the wait conditions, and returns the deadline of the wait.
+func (m *MatchRepositoryImpl) createDurableEventMatches(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	task *sqlcv1.FlattenExternalIdsRow,
+	eventKey string,
+	opts RegisterDurableEventOpts,
+) (time.Time, error) {
+	// any of the conditions satisfies the wait, so they are all placed in the same group
+	groupId := uuid.NewString()
+	conditions := make([]GroupMatchCondition, 0, len(opts.SleepConditions)+len(opts.UserEventConditions))
+	waitUntil := time.Now().Add(durableEventMaxWait)
+
+	if len(opts.SleepConditions) > 0 {
+		sleepDurations := make([]string, 0, len(opts.SleepConditions))
+
+		for _, sleep := range opts.SleepConditions {
+			sleepDurations = append(sleepDurations, sleep.SleepFor)
+		}
+
+		sleeps, err := m.queries.CreateDurableSleeps(ctx, tx, sqlcv1.CreateDurableSleepsParams{
+			Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+			Sleepdurations: sleepDurations,
+		})
+
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to create durable sleeps: %w", err)
+		}
+
+		if len(sleeps) != len(opts.SleepConditions) {
+			return time.Time{}, fmt.Errorf("expected %d durable sleeps to be created, but only %d were created", len(opts.SleepConditions), len(sleeps))
+		}
+
+		for i, sleep := range sleeps {
+			sleepId := strconv.FormatInt(sleep.ID, 10)
+
+			conditions = append(conditions, GroupMatchCondition{
+				GroupId:           groupId,
+				EventType:         sqlcv1.V1EventTypeINTERNAL,
+				EventKey:          durableSleepEventKey,
+				EventResourceHint: &sleepId,
+				ReadableDataKey:   opts.SleepConditions[i].ReadableDataKey,
+				Expression:        "true",
+				Action:            sqlcv1.V1MatchConditionActionCREATE,
+			})
+
+			if sleep.SleepUntil.Time.Before(waitUntil) {
+				waitUntil = sleep.SleepUntil.Time
+			}
+		}
+	}
+
+	for _, event := range opts.UserEventConditions {
+		expression := event.Expression
+
+		if expression == "" {
+			expression = "true"
+		}
+
+		conditions = append(conditions, GroupMatchCondition{
+			GroupId:         groupId,
+			EventType:       sqlcv1.V1EventTypeUSER,
+			EventKey:        event.EventKey,
+			ReadableDataKey: event.ReadableDataKey,
+			Expression:      expression,
+			Action:          sqlcv1.V1MatchConditionActionCREATE,
+		})
+	}
+
+	taskExternalId := sqlchelpers.UUIDToStr(task.ExternalID)
+
+	err := m.createEventMatches(ctx, tx, tenantId, []CreateMatchOpts{
+		{
+			Kind:                 sqlcv1.V1MatchKindSIGNAL,
+			Conditions:           conditions,
+			SignalTaskId:         &task.ID,
+			SignalTaskInsertedAt: task.InsertedAt,
+			SignalExternalId:     &taskExternalId,
+			SignalKey:            &eventKey,
+		},
+	})
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to create durable event matches: %w", err)
+	}
+
+	return waitUntil, nil
+}
+
+func (m *MatchRepositoryImpl) ResumeDurableEvents(ctx context.Context, tenantId string, opts []ResumeDurableEventOpts) (*ResumeDurableEventsResult, error) {
+	for _, opt := range opts {
+		if err := m.v.Validate(opt); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &ResumeDurableEventsResult{}
+
+	if len(opts) == 0 {
+		return res, nil
+	}
+
+	externalIds := make([]string, 0, len(opts))
+
+	for _, opt := range opts {
+		externalIds = append(externalIds, opt.TaskExternalId)
+	}
+
+	tasks, err := m.lookupExternalIds(ctx, m.pool, tenantId, externalIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup tasks: %w", err)
+	}
+
+	externalIdsToTask := make(map[string]*sqlcv1.FlattenExternalIdsRow, len(tasks))
+
+	for _, task := range tasks {
+		externalIdsToTask[sqlchelpers.UUIDToStr(task.ExternalID)] = task
+	}
+
+	taskIds := make([]int64, 0, len(opts))
+	taskInsertedAts := make([]pgtype.Timestamptz, 0, len(opts))
+	eventKeys := make([]string, 0, len(opts))
+	eventKeysToOpts := make(map[string]ResumeDurableEventOpts, len(opts))
+
+	for _, opt := range opts {
+		task, ok := externalIdsToTask[opt.TaskExternalId]
+
+		if !ok {
+			res.NotRunning = append(res.NotRunning, opt)
+			continue
+		}
+
+		eventKey := getDurableSignalEventKey(opt.TaskExternalId, opt.SignalKey)
+
+		taskIds = append(taskIds, task.ID)
+		taskInsertedAts = append(taskInsertedAts, task.InsertedAt)
+		eventKeys = append(eventKeys, eventKey)
+		eventKeysToOpts[eventKey] = opt
+	}
+
+	if len(eventKeys) == 0 {
+		return res, nil
+	}
+
+	// check for completed signals before starting a transaction, as this is called in a polling loop
+	events, err := m.queries.ListMatchingSignalEvents(ctx, m.pool, sqlcv1.ListMatchingSignalEventsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Eventtype:       sqlcv1.V1TaskEventTypeSIGNALCOMPLETED,
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+		Eventkeys:       eventKeys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signal completed events: %w", err)
+	}
+
+	if len(events) == 0 {
+		return res, nil
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	workerIds := make([]pgtype.UUID, 0, len(events))
+	seenWorkerIds := make(map[string]bool, len(events))
+
+	for _, event := range events {
+		opt, ok := eventKeysToOpts[event.EventKey.String]
+
+		if !ok || seenWorkerIds[opt.WorkerId] {
+			continue
+		}
+
+		seenWorkerIds[opt.WorkerId] = true
+		workerIds = append(workerIds, sqlchelpers.UUIDFromStr(opt.WorkerId))
+	}
+
+	// the workers are locked so that the free slots of a worker are checked and filled by one resume at a time
+	lockedWorkerIds, err := m.queries.LockWorkersForDurableResume(ctx, tx, sqlcv1.LockWorkersForDurableResumeParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Workerids: workerIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock workers: %w", err)
+	}
+
+	tenantWorkerIds := make(map[string]bool, len(lockedWorkerIds))
+
+	for _, workerId := range lockedWorkerIds {
+		tenantWorkerIds[sqlchelpers.UUIDToStr(workerId)] = true
+	}
+
+	for _, event := range events {
+		opt, ok := eventKeysToOpts[event.EventKey.String]
+
+		if !ok {
+			continue
+		}
+
+		// the wait is only resumed once, even if the signal was completed more than once
+		delete(eventKeysToOpts, event.EventKey.String)
+
+		if !tenantWorkerIds[opt.WorkerId] {
+			m.l.Warn().Msgf("not resuming durable task %s: worker %s does not belong to the tenant", opt.TaskExternalId, opt.WorkerId)
+			continue
+		}
+
+		runtime, err := m.queries.ResumeDurableTask(ctx, tx, sqlcv1.ResumeDurableTaskParams{
+			Workerid:   sqlchelpers.UUIDFromStr(opt.WorkerId),
+			Externalid: sqlchelpers.UUIDFromStr(opt.TaskExternalId),
+			Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		})
+
+		if err != nil {
+			// the task may have been cancelled or timed out while it was waiting
+			if errors.Is(err, pgx.ErrNoRows) {
+				res.NotRunning = append(res.NotRunning, opt)
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to resume durable task: %w", err)
+		}
+
+		// the worker doesn't have a free slot for the task, so the wait is resumed on a later call
+		if !runtime.Resumed {
+			continue
+		}
+
+		res.Resumed = append(res.Resumed, &DurableEventResult{
+			TaskExternalId: opt.TaskExternalId,
+			SignalKey:      opt.SignalKey,
+			TaskId:         runtime.TaskID,
+			TaskInsertedAt: runtime.TaskInsertedAt,
+			RetryCount:     runtime.RetryCount,
+			WorkerId:       opt.WorkerId,
+			Slots:          runtime.Slots,
+			SlotPool:       runtime.SlotPool.String,
+			Data:           event.Data,
+		})
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (m *MatchRepositoryImpl) UnassignResumedDurableTask(ctx context.Context, tenantId string, resumed *DurableEventResult) (bool, error) {
+	count, err := m.queries.UnassignResumedDurableTask(ctx, m.pool, sqlcv1.UnassignResumedDurableTaskParams{
+		Taskid:         resumed.TaskId,
+		Taskinsertedat: resumed.TaskInsertedAt,
+		Retrycount:     resumed.RetryCount,
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Workerid:       sqlchelpers.UUIDFromStr(resumed.WorkerId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("failed to unassign resumed durable task: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func (m *MatchRepositoryImpl) ProcessDurableSleeps(ctx context.Context, tenantId string) (*InternalEventMatchResults, bool, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer rollback()
+
+	// TODO: make limit configurable
+	limit := 1000
+
+	sleeps, err := m.queries.PopDurableSleeps(ctx, tx, sqlcv1.PopDurableSleepsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit: pgtype.Int4{
+			Int32: int32(limit),
+			Valid: true,
+		},
+	})
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to pop durable sleeps: %w", err)
+	}
+
+	if len(sleeps) == 0 {
+		return &InternalEventMatchResults{}, false, nil
+	}
+
+	candidateMatches := make([]CandidateEventMatch, 0, len(sleeps))
+
+	for _, sleep := range sleeps {
+		sleepId := strconv.FormatInt(sleep.ID, 10)
+
+		data, err := json.Marshal(map[string]interface{}{
+			"sleep_duration": sleep.SleepDuration,
+		})
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		candidateMatches = append(candidateMatches, CandidateEventMatch{
+			ID:             uuid.NewString(),
+			EventTimestamp: sleep.SleepUntil.Time,
+			Key:            durableSleepEventKey,
+			ResourceHint:   &sleepId,
+			Data:           data,
+		})
+	}
+
+	res, err := m.processInternalEventMatches(ctx, tx, tenantId, candidateMatches)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, false, err
+	}
+
+	return res, len(sleeps) == limit, nil
+}
@@ -0,0 +1,214 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// setupRunningTask creates a tenant with a single-task workflow, triggers the workflow and assigns the
+// task to a worker, returning the tenant id and the task.
+func setupRunningTask(t *testing.T, conf *database.Layer, workerId string) (string, *sqlcv1.V1Task) {
+	t.Helper()
+
+	ctx := context.Background()
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	_, err = conf.APIRepository.Tenant().CreateTenant(ctx, &repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+	require.NoError(t, err)
+
+	_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "durable-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{ReadableId: "durable", Action: "durable:durable"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: "durable-workflow",
+				Data:         []byte(`{}`),
+			},
+			ExternalId: uuid.New().String(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	task := tasks[0]
+
+	// assign the task to the worker, as the scheduler would
+	_, err = conf.Pool.Exec(
+		ctx,
+		`INSERT INTO v1_task_runtime (task_id, task_inserted_at, retry_count, worker_id, tenant_id, timeout_at)
+		VALUES ($1, $2, $3, $4::uuid, $5::uuid, CURRENT_TIMESTAMP + INTERVAL '1 minute')`,
+		task.ID,
+		task.InsertedAt,
+		task.RetryCount,
+		workerId,
+		tenantId,
+	)
+	require.NoError(t, err)
+
+	return tenantId, task
+}
+
+func getRuntimeWorkerId(t *testing.T, conf *database.Layer, task *sqlcv1.V1Task) pgtype.UUID {
+	t.Helper()
+
+	var workerId pgtype.UUID
+
+	err := conf.Pool.QueryRow(
+		context.Background(),
+		`SELECT worker_id FROM v1_task_runtime WHERE task_id = $1 AND task_inserted_at = $2 AND retry_count = $3`,
+		task.ID,
+		task.InsertedAt,
+		task.RetryCount,
+	).Scan(&workerId)
+	require.NoError(t, err)
+
+	return workerId
+}
+
+func TestRegisterDurableEvent_Idempotent(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		workerId := uuid.New().String()
+		tenantId, task := setupRunningTask(t, conf, workerId)
+
+		opts := v1.RegisterDurableEventOpts{
+			TaskExternalId: sqlchelpers.UUIDToStr(task.ExternalID),
+			SignalKey:      "wait-1",
+			SleepConditions: []v1.DurableSleepCondition{
+				{ReadableDataKey: "sleep", SleepFor: "1h"},
+			},
+			UserEventConditions: []v1.DurableUserEventCondition{
+				{ReadableDataKey: "event", EventKey: "user:create"},
+			},
+		}
+
+		first, err := conf.V1.Matches().RegisterDurableEvent(ctx, tenantId, opts)
+		require.NoError(t, err)
+
+		// the slot is released while the task waits, and the timeout is pushed back past the sleep
+		require.NotNil(t, first.ReleasedWorkerId)
+		assert.Equal(t, workerId, *first.ReleasedWorkerId)
+		assert.True(t, first.TimeoutAt.After(time.Now().Add(59*time.Minute)))
+		assert.False(t, getRuntimeWorkerId(t, conf, task).Valid)
+
+		// registering the same wait again, for example after the worker reconnects, does not create new
+		// matches or move the deadline of the wait
+		second, err := conf.V1.Matches().RegisterDurableEvent(ctx, tenantId, opts)
+		require.NoError(t, err)
+
+		assert.Nil(t, second.ReleasedWorkerId)
+		assert.Equal(t, first.TimeoutAt, second.TimeoutAt)
+
+		var matchCount, sleepCount int
+
+		err = conf.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM v1_match WHERE tenant_id = $1::uuid AND signal_task_id = $2`, tenantId, task.ID).Scan(&matchCount)
+		require.NoError(t, err)
+
+		err = conf.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM v1_durable_sleep WHERE tenant_id = $1::uuid`, tenantId).Scan(&sleepCount)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, matchCount)
+		assert.Equal(t, 1, sleepCount)
+
+		return nil
+	})
+}
+
+func TestResumeDurableEvents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		workerId := uuid.New().String()
+		tenantId, task := setupRunningTask(t, conf, workerId)
+		taskExternalId := sqlchelpers.UUIDToStr(task.ExternalID)
+
+		_, err := conf.V1.Matches().RegisterDurableEvent(ctx, tenantId, v1.RegisterDurableEventOpts{
+			TaskExternalId: taskExternalId,
+			SignalKey:      "wait-1",
+			UserEventConditions: []v1.DurableUserEventCondition{
+				{ReadableDataKey: "event", EventKey: "user:create", Expression: "input.user_id == '1'"},
+			},
+		})
+		require.NoError(t, err)
+
+		resumeOpts := []v1.ResumeDurableEventOpts{
+			{TaskExternalId: taskExternalId, SignalKey: "wait-1", WorkerId: workerId},
+			// waits for tasks which don't exist are reported as not running
+			{TaskExternalId: uuid.New().String(), SignalKey: "wait-1", WorkerId: workerId},
+		}
+
+		res, err := conf.V1.Matches().ResumeDurableEvents(ctx, tenantId, resumeOpts)
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Resumed)
+		require.Len(t, res.NotRunning, 1)
+		assert.Equal(t, resumeOpts[1], res.NotRunning[0])
+
+		// an event which doesn't satisfy the expression does not complete the wait
+		_, err = conf.V1.Matches().ProcessUserEventMatches(ctx, tenantId, []v1.CandidateEventMatch{
+			{ID: uuid.New().String(), EventTimestamp: time.Now(), Key: "user:create", Data: []byte(`{"user_id":"2"}`)},
+		})
+		require.NoError(t, err)
+
+		res, err = conf.V1.Matches().ResumeDurableEvents(ctx, tenantId, resumeOpts[:1])
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Resumed)
+		assert.False(t, getRuntimeWorkerId(t, conf, task).Valid, "the slot stays released until the wait is satisfied")
+
+		matchRes, err := conf.V1.Matches().ProcessUserEventMatches(ctx, tenantId, []v1.CandidateEventMatch{
+			{ID: uuid.New().String(), EventTimestamp: time.Now(), Key: "user:create", Data: []byte(`{"user_id":"1"}`)},
+		})
+		require.NoError(t, err)
+		require.Len(t, matchRes.SignaledTasks, 1)
+
+		res, err = conf.V1.Matches().ResumeDurableEvents(ctx, tenantId, resumeOpts[:1])
+		require.NoError(t, err)
+
+		require.Len(t, res.Resumed, 1)
+		assert.Equal(t, taskExternalId, res.Resumed[0].TaskExternalId)
+		assert.Equal(t, "wait-1", res.Resumed[0].SignalKey)
+		assert.Equal(t, task.ID, res.Resumed[0].TaskId)
+		assert.Contains(t, string(res.Resumed[0].Data), `"user_id":"1"`)
+
+		// the task is assigned back to the worker which is listening for the event
+		assert.Equal(t, workerId, sqlchelpers.UUIDToStr(getRuntimeWorkerId(t, conf, task)))
+
+		return nil
+	})
+}
@@ -0,0 +1,235 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// the longest time an event deduplication key can be valid for. Partitions of the deduplication table are
+// dropped once all of their keys have expired, so this cannot be changed without changing the retention.
+const MAX_EVENT_DEDUPLICATION_TTL = 24 * time.Hour
+
+type DeduplicateEventOpts struct {
+	// (required) the deduplication key of the event
+	DeduplicationKey string `validate:"required,min=1,max=255"`
+
+	// (required) how long the deduplication key is valid for
+	TTL time.Duration `validate:"required,min=1s,max=24h"`
+
+	// (required) the id of the event, which is returned for later events with the same key
+	EventId string `validate:"required,uuid"`
+
+	// (required) the event key
+	Key string `validate:"required"`
+
+	Data []byte
+
+	AdditionalMetadata []byte
+}
+
+type EventRepository interface {
+	// DeduplicateEvents claims the deduplication keys of the given events. For each event, it returns the
+	// original event if the key was already claimed by an event which has not expired, or nil if the key was
+	// claimed by this event. Events with the same key in opts are deduplicated against the first of them.
+	DeduplicateEvents(ctx context.Context, tenantId string, opts []DeduplicateEventOpts) ([]*sqlcv1.V1EventDeduplication, error)
+
+	// ReleaseDeduplicationKeys releases the deduplication keys claimed by the given events, for example if
+	// the events could not be ingested.
+	ReleaseDeduplicationKeys(ctx context.Context, tenantId string, eventIds []string) error
+
+	UpdateTablePartitions(ctx context.Context) error
+}
+
+type eventRepository struct {
+	*sharedRepository
+}
+
+func newEventRepository(shared *sharedRepository) EventRepository {
+	return &eventRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *eventRepository) DeduplicateEvents(ctx context.Context, tenantId string, opts []DeduplicateEventOpts) ([]*sqlcv1.V1EventDeduplication, error) {
+	if len(opts) == 0 {
+		return []*sqlcv1.V1EventDeduplication{}, nil
+	}
+
+	keys := make([]string, 0, len(opts))
+
+	for _, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return nil, fmt.Errorf("invalid deduplication options: %w", err)
+		}
+
+		keys = append(keys, opt.DeduplicationKey)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	// the keys are locked for the rest of the transaction, so concurrent events with the same key wait
+	// for this transaction to commit and then see the claimed key
+	err = r.queries.LockEventDeduplicationKeys(ctx, tx, sqlcv1.LockEventDeduplicationKeysParams{
+		Tenantid:          tenantId,
+		Deduplicationkeys: keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not lock deduplication keys: %w", err)
+	}
+
+	existing, err := r.queries.ListActiveEventDeduplications(ctx, tx, sqlcv1.ListActiveEventDeduplicationsParams{
+		Tenantid:          pgTenantId,
+		Deduplicationkeys: keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list deduplication keys: %w", err)
+	}
+
+	keysToOriginal := make(map[string]*sqlcv1.V1EventDeduplication, len(existing))
+
+	for _, row := range existing {
+		keysToOriginal[row.DeduplicationKey] = row
+	}
+
+	res := make([]*sqlcv1.V1EventDeduplication, len(opts))
+	params := sqlcv1.CreateEventDeduplicationsParams{
+		Tenantid: pgTenantId,
+	}
+
+	for i, opt := range opts {
+		if original, ok := keysToOriginal[opt.DeduplicationKey]; ok {
+			res[i] = original
+			continue
+		}
+
+		var data []byte
+
+		if len(opt.Data) > 0 {
+			data = opt.Data
+		}
+
+		var additionalMetadata []byte
+
+		if len(opt.AdditionalMetadata) > 0 {
+			additionalMetadata = opt.AdditionalMetadata
+		}
+
+		params.Deduplicationkeys = append(params.Deduplicationkeys, opt.DeduplicationKey)
+		params.Eventids = append(params.Eventids, sqlchelpers.UUIDFromStr(opt.EventId))
+		params.Eventkeys = append(params.Eventkeys, opt.Key)
+		params.Datas = append(params.Datas, data)
+		params.Additionalmetadatas = append(params.Additionalmetadatas, additionalMetadata)
+		params.Ttlseconds = append(params.Ttlseconds, int64(opt.TTL.Seconds()))
+
+		// later events in opts with the same key are deduplicated against this event
+		keysToOriginal[opt.DeduplicationKey] = &sqlcv1.V1EventDeduplication{
+			TenantID:           pgTenantId,
+			DeduplicationKey:   opt.DeduplicationKey,
+			EventID:            sqlchelpers.UUIDFromStr(opt.EventId),
+			EventKey:           opt.Key,
+			Data:               data,
+			AdditionalMetadata: additionalMetadata,
+			InsertedAt:         sqlchelpers.TimestamptzFromTime(time.Now()),
+			ExpiresAt:          sqlchelpers.TimestamptzFromTime(time.Now().Add(opt.TTL)),
+		}
+	}
+
+	if len(params.Deduplicationkeys) > 0 {
+		err = r.queries.CreateEventDeduplications(ctx, tx, params)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create deduplication keys: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *eventRepository) ReleaseDeduplicationKeys(ctx context.Context, tenantId string, eventIds []string) error {
+	if len(eventIds) == 0 {
+		return nil
+	}
+
+	pgEventIds := make([]pgtype.UUID, 0, len(eventIds))
+
+	for _, eventId := range eventIds {
+		pgEventIds = append(pgEventIds, sqlchelpers.UUIDFromStr(eventId))
+	}
+
+	return r.queries.DeleteEventDeduplications(ctx, r.pool, sqlcv1.DeleteEventDeduplicationsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventids: pgEventIds,
+	})
+}
+
+func (r *eventRepository) UpdateTablePartitions(ctx context.Context) error {
+	today := time.Now().UTC()
+	tomorrow := today.AddDate(0, 0, 1)
+
+	// every key in a partition has expired once the partition is older than the maximum TTL
+	removeBefore := today.Add(-1 * MAX_EVENT_DEDUPLICATION_TTL)
+
+	for _, date := range []time.Time{today, tomorrow} {
+		err := r.queries.CreateEventPartitions(ctx, r.pool, pgtype.Date{
+			Time:  date,
+			Valid: true,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	partitions, err := r.queries.ListEventPartitionsBeforeDate(ctx, r.pool, pgtype.Date{
+		Time:  removeBefore,
+		Valid: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		r.l.Debug().Msgf("detaching partition %s", partition.PartitionName)
+
+		_, err := r.pool.Exec(
+			ctx,
+			fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s CONCURRENTLY", partition.ParentTable, partition.PartitionName),
+		)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = r.pool.Exec(
+			ctx,
+			fmt.Sprintf("DROP TABLE %s", partition.PartitionName),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+type PutEventSchemaOpts struct {
+	// (required) the event key which the schema applies to
+	Key string `validate:"required,min=1,max=255"`
+
+	// (required) the JSON schema which event payloads must match
+	Schema []byte `validate:"required"`
+
+	// (required) what happens to events which do not match the schema
+	Policy sqlcv1.V1EventSchemaPolicy `validate:"required,oneof=REJECT QUARANTINE"`
+}
+
+type QuarantineEventOpts struct {
+	EventId string `validate:"required,uuid"`
+
+	Key string `validate:"required"`
+
+	// the payload of the event, which is only set if it is valid JSON
+	Data []byte
+
+	AdditionalMetadata []byte
+
+	// (required) the reason the event was quarantined
+	ErrorMessage string `validate:"required"`
+}
+
+type ListQuarantinedEventsOpts struct {
+	Keys []string
+
+	Offset *int64
+
+	Limit *int64
+}
+
+type EventSchemaRepository interface {
+	// PutEventSchema creates or replaces the schema for an event key.
+	PutEventSchema(ctx context.Context, tenantId string, opts PutEventSchemaOpts) (*sqlcv1.V1EventSchema, error)
+
+	GetEventSchema(ctx context.Context, tenantId, key string) (*sqlcv1.V1EventSchema, error)
+
+	ListEventSchemas(ctx context.Context, tenantId string) ([]*sqlcv1.V1EventSchema, error)
+
+	// ListEventSchemasByKeys lists the schemas for the given event keys. Keys without a schema are omitted.
+	ListEventSchemasByKeys(ctx context.Context, tenantId string, keys []string) ([]*sqlcv1.V1EventSchema, error)
+
+	DeleteEventSchema(ctx context.Context, tenantId, key string) (*sqlcv1.V1EventSchema, error)
+
+	QuarantineEvents(ctx context.Context, tenantId string, opts []QuarantineEventOpts) error
+
+	ListQuarantinedEvents(ctx context.Context, tenantId string, opts ListQuarantinedEventsOpts) ([]*sqlcv1.V1QuarantinedEvent, int64, error)
+}
+
+type eventSchemaRepository struct {
+	*sharedRepository
+}
+
+func newEventSchemaRepository(shared *sharedRepository) EventSchemaRepository {
+	return &eventSchemaRepository{
+		sharedRepository: shared,
+	}
+}
+
+func (r *eventSchemaRepository) PutEventSchema(ctx context.Context, tenantId string, opts PutEventSchemaOpts) (*sqlcv1.V1EventSchema, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertEventSchema(ctx, r.pool, sqlcv1.UpsertEventSchemaParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey: opts.Key,
+		Schema:   opts.Schema,
+		Policy:   opts.Policy,
+	})
+}
+
+func (r *eventSchemaRepository) GetEventSchema(ctx context.Context, tenantId, key string) (*sqlcv1.V1EventSchema, error) {
+	return r.queries.GetEventSchema(ctx, r.pool, sqlcv1.GetEventSchemaParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey: key,
+	})
+}
+
+func (r *eventSchemaRepository) ListEventSchemas(ctx context.Context, tenantId string) ([]*sqlcv1.V1EventSchema, error) {
+	return r.queries.ListEventSchemas(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *eventSchemaRepository) ListEventSchemasByKeys(ctx context.Context, tenantId string, keys []string) ([]*sqlcv1.V1EventSchema, error) {
+	if len(keys) == 0 {
+		return []*sqlcv1.V1EventSchema{}, nil
+	}
+
+	return r.queries.ListEventSchemasByKeys(ctx, r.pool, sqlcv1.ListEventSchemasByKeysParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Eventkeys: keys,
+	})
+}
+
+func (r *eventSchemaRepository) DeleteEventSchema(ctx context.Context, tenantId, key string) (*sqlcv1.V1EventSchema, error) {
+	return r.queries.DeleteEventSchema(ctx, r.pool, sqlcv1.DeleteEventSchemaParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey: key,
+	})
+}
+
+func (r *eventSchemaRepository) QuarantineEvents(ctx context.Context, tenantId string, opts []QuarantineEventOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	eventIds := make([]pgtype.UUID, 0, len(opts))
+	eventKeys := make([]string, 0, len(opts))
+	datas := make([][]byte, 0, len(opts))
+	additionalMetadatas := make([][]byte, 0, len(opts))
+	errorMessages := make([]string, 0, len(opts))
+
+	for _, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return fmt.Errorf("invalid quarantined event: %w", err)
+		}
+
+		var additionalMetadata []byte
+
+		if len(opt.AdditionalMetadata) > 0 {
+			additionalMetadata = opt.AdditionalMetadata
+		}
+
+		var data []byte
+
+		if len(opt.Data) > 0 {
+			data = opt.Data
+		}
+
+		eventIds = append(eventIds, sqlchelpers.UUIDFromStr(opt.EventId))
+		eventKeys = append(eventKeys, opt.Key)
+		datas = append(datas, data)
+		additionalMetadatas = append(additionalMetadatas, additionalMetadata)
+		errorMessages = append(errorMessages, opt.ErrorMessage)
+	}
+
+	return r.queries.CreateQuarantinedEvents(ctx, r.pool, sqlcv1.CreateQuarantinedEventsParams{
+		Tenantid:            sqlchelpers.UUIDFromStr(tenantId),
+		Eventids:            eventIds,
+		Eventkeys:           eventKeys,
+		Datas:               datas,
+		Additionalmetadatas: additionalMetadatas,
+		Errormessages:       errorMessages,
+	})
+}
+
+func (r *eventSchemaRepository) ListQuarantinedEvents(ctx context.Context, tenantId string, opts ListQuarantinedEventsOpts) ([]*sqlcv1.V1QuarantinedEvent, int64, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	listParams := sqlcv1.ListQuarantinedEventsParams{
+		Tenantid: pgTenantId,
+		Keys:     opts.Keys,
+	}
+
+	countParams := sqlcv1.CountQuarantinedEventsParams{
+		Tenantid: pgTenantId,
+		Keys:     opts.Keys,
+	}
+
+	if opts.Offset != nil {
+		listParams.Offset = pgtype.Int8{Int64: *opts.Offset, Valid: true}
+	}
+
+	if opts.Limit != nil {
+		listParams.Limit = pgtype.Int8{Int64: *opts.Limit, Valid: true}
+	}
+
+	events, err := r.queries.ListQuarantinedEvents(ctx, tx, listParams)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not list quarantined events: %w", err)
+	}
+
+	count, err := r.queries.CountQuarantinedEvents(ctx, tx, countParams)
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not count quarantined events: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return events, count, nil
+}
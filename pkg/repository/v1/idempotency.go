@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// the longest time an idempotency key can be valid for. Partitions of the idempotency key table are dropped
+// once all of their keys have expired, so this cannot be changed without changing the retention.
+const MAX_IDEMPOTENCY_KEY_TTL = 24 * time.Hour
+
+// the TTL of an idempotency key, if one is not set when the workflow is triggered
+const DEFAULT_IDEMPOTENCY_KEY_TTL = MAX_IDEMPOTENCY_KEY_TTL
+
+func idempotencyKeyTTLSeconds(ttl *time.Duration) int64 {
+	if ttl == nil {
+		return int64(DEFAULT_IDEMPOTENCY_KEY_TTL.Seconds())
+	}
+
+	return int64(ttl.Seconds())
+}
+
+// generateExternalIdsForIdempotentWorkflows claims the idempotency keys of the given opts. Opts whose key was
+// already claimed by a workflow run which has not expired are given the external id of that workflow run and
+// are marked to be skipped. Opts with the same key are deduplicated against the first of them.
+func (s *sharedRepository) generateExternalIdsForIdempotentWorkflows(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error {
+	keys := make([]string, 0, len(opts))
+
+	for _, opt := range opts {
+		if err := s.v.Validate(opt.TriggerTaskData); err != nil {
+			return fmt.Errorf("invalid trigger options: %w", err)
+		}
+
+		keys = append(keys, *opt.IdempotencyKey)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	// the keys are locked for the rest of the transaction, so concurrent triggers with the same key wait
+	// for this transaction to commit and then see the claimed key
+	err = s.queries.LockIdempotencyKeys(ctx, tx, sqlcv1.LockIdempotencyKeysParams{
+		Tenantid: tenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not lock idempotency keys: %w", err)
+	}
+
+	existing, err := s.queries.ListActiveIdempotencyKeys(ctx, tx, sqlcv1.ListActiveIdempotencyKeysParams{
+		Tenantid: pgTenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not list idempotency keys: %w", err)
+	}
+
+	keysToExternalIds := make(map[string]string, len(existing))
+
+	for _, row := range existing {
+		keysToExternalIds[row.Key] = sqlchelpers.UUIDToStr(row.ExternalID)
+	}
+
+	params := sqlcv1.CreateIdempotencyKeysParams{
+		Tenantid: pgTenantId,
+	}
+
+	for i := range opts {
+		opt := opts[i] // we don't want a copy here, we want the actual pointer as we modify in-place
+		key := *opt.IdempotencyKey
+
+		if externalId, ok := keysToExternalIds[key]; ok {
+			opt.ExternalId = externalId
+			opt.ShouldSkip = true
+			continue
+		}
+
+		opt.ExternalId = uuid.NewString()
+		keysToExternalIds[key] = opt.ExternalId
+
+		params.Keys = append(params.Keys, key)
+		params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(opt.ExternalId))
+		params.Ttlseconds = append(params.Ttlseconds, idempotencyKeyTTLSeconds(opt.IdempotencyKeyTTL))
+	}
+
+	if len(params.Keys) > 0 {
+		err = s.queries.CreateIdempotencyKeys(ctx, tx, params)
+
+		if err != nil {
+			return fmt.Errorf("could not create idempotency keys: %w", err)
+		}
+	}
+
+	return commit(ctx)
+}
+
+// registerIdempotencyKeys checks the idempotency keys of the tuples within the trigger transaction, which
+// guards against triggers that bypassed or raced with the initial claim of the key, or which were delivered
+// more than once. It returns the external ids of the tuples which should not be created, either because the
+// key belongs to a different workflow run or because the workflow run already exists.
+func (r *TriggerRepositoryImpl) registerIdempotencyKeys(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	tuples []triggerTuple,
+) (map[string]struct{}, error) {
+	keys := make([]string, 0)
+
+	for _, tuple := range tuples {
+		if tuple.idempotencyKey != nil {
+			keys = append(keys, *tuple.idempotencyKey)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	err := r.queries.LockIdempotencyKeys(ctx, tx, sqlcv1.LockIdempotencyKeysParams{
+		Tenantid: tenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not lock idempotency keys: %w", err)
+	}
+
+	existing, err := r.queries.ListActiveIdempotencyKeys(ctx, tx, sqlcv1.ListActiveIdempotencyKeysParams{
+		Tenantid: pgTenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list idempotency keys: %w", err)
+	}
+
+	keysToExternalIds := make(map[string]string, len(existing))
+
+	for _, row := range existing {
+		keysToExternalIds[row.Key] = sqlchelpers.UUIDToStr(row.ExternalID)
+	}
+
+	tuplesToSkip := make(map[string]struct{})
+	ownedExternalIds := make([]pgtype.UUID, 0)
+	params := sqlcv1.CreateIdempotencyKeysParams{
+		Tenantid: pgTenantId,
+	}
+
+	for _, tuple := range tuples {
+		if tuple.idempotencyKey == nil {
+			continue
+		}
+
+		key := *tuple.idempotencyKey
+		externalId, ok := keysToExternalIds[key]
+
+		switch {
+		case !ok:
+			keysToExternalIds[key] = tuple.externalId
+
+			params.Keys = append(params.Keys, key)
+			params.Externalids = append(params.Externalids, sqlchelpers.UUIDFromStr(tuple.externalId))
+			params.Ttlseconds = append(params.Ttlseconds, idempotencyKeyTTLSeconds(tuple.idempotencyKeyTTL))
+		case externalId != tuple.externalId:
+			tuplesToSkip[tuple.externalId] = struct{}{}
+		default:
+			ownedExternalIds = append(ownedExternalIds, sqlchelpers.UUIDFromStr(tuple.externalId))
+		}
+	}
+
+	if len(ownedExternalIds) > 0 {
+		created, err := r.queries.LookupExternalIds(ctx, tx, sqlcv1.LookupExternalIdsParams{
+			Tenantid:    pgTenantId,
+			Externalids: ownedExternalIds,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not look up idempotent workflow runs: %w", err)
+		}
+
+		for _, row := range created {
+			tuplesToSkip[sqlchelpers.UUIDToStr(row.ExternalID)] = struct{}{}
+		}
+	}
+
+	if len(params.Keys) > 0 {
+		err = r.queries.CreateIdempotencyKeys(ctx, tx, params)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create idempotency keys: %w", err)
+		}
+	}
+
+	return tuplesToSkip, nil
+}
+
+func (r *TriggerRepositoryImpl) ReleaseIdempotencyKeys(ctx context.Context, tenantId string, externalIds []string) error {
+	if len(externalIds) == 0 {
+		return nil
+	}
+
+	pgExternalIds := make([]pgtype.UUID, 0, len(externalIds))
+
+	for _, externalId := range externalIds {
+		pgExternalIds = append(pgExternalIds, sqlchelpers.UUIDFromStr(externalId))
+	}
+
+	return r.queries.DeleteIdempotencyKeys(ctx, r.pool, sqlcv1.DeleteIdempotencyKeysParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Externalids: pgExternalIds,
+	})
+}
+
+func (r *TriggerRepositoryImpl) UpdateTablePartitions(ctx context.Context) error {
+	today := time.Now().UTC()
+	tomorrow := today.AddDate(0, 0, 1)
+
+	// every key in a partition has expired once the partition is older than the maximum TTL
+	removeBefore := today.Add(-1 * MAX_IDEMPOTENCY_KEY_TTL)
+
+	for _, date := range []time.Time{today, tomorrow} {
+		err := r.queries.CreateIdempotencyKeyPartitions(ctx, r.pool, pgtype.Date{
+			Time:  date,
+			Valid: true,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	partitions, err := r.queries.ListIdempotencyKeyPartitionsBeforeDate(ctx, r.pool, pgtype.Date{
+		Time:  removeBefore,
+		Valid: true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		r.l.Debug().Msgf("detaching partition %s", partition.PartitionName)
+
+		_, err := r.pool.Exec(
+			ctx,
+			fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s CONCURRENTLY", partition.ParentTable, partition.PartitionName),
+		)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = r.pool.Exec(
+			ctx,
+			fmt.Sprintf("DROP TABLE %s", partition.PartitionName),
+		)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -80,17 +80,22 @@ func (c *ChildWorkflowSignalCreatedData) Bytes() []byte {
 }
 
 // GenerateExternalIdsForWorkflow generates external ids and additional looks up child workflows and whether they
-// already exist.
+// already exist. Workflows with an idempotency key are given the external id of the workflow run which claimed
+// the key, if there is one.
 func (s *sharedRepository) PopulateExternalIdsForWorkflow(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error {
 	// get child workflow data first
 	optsWithParents := make([]*WorkflowNameTriggerOpts, 0, len(opts))
+	optsWithIdempotencyKeys := make([]*WorkflowNameTriggerOpts, 0)
 
 	for i := range opts {
 		opt := opts[i] // we don't want a copy here, we want the actual pointer as we modify in-place
 
-		if opt.ParentExternalId != nil && opt.ChildIndex != nil {
+		switch {
+		case opt.ParentExternalId != nil && opt.ChildIndex != nil:
 			optsWithParents = append(optsWithParents, opt)
-		} else {
+		case opt.IdempotencyKey != nil:
+			optsWithIdempotencyKeys = append(optsWithIdempotencyKeys, opt)
+		default:
 			opt.ExternalId = uuid.NewString()
 		}
 	}
@@ -103,6 +108,14 @@ func (s *sharedRepository) PopulateExternalIdsForWorkflow(ctx context.Context, t
 		}
 	}
 
+	if len(optsWithIdempotencyKeys) > 0 {
+		err := s.generateExternalIdsForIdempotentWorkflows(ctx, tenantId, optsWithIdempotencyKeys)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
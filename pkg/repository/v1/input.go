@@ -2,6 +2,8 @@ package v1
 
 import (
 	"encoding/json"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
 )
 
 type TaskInput struct {
@@ -15,7 +17,10 @@ func (s *sharedRepository) DesiredWorkerId(t *TaskInput) *string {
 		for _, stepReadableId := range t.TriggerData.DataKeys() {
 			data := t.TriggerData.DataValueAsTaskOutputEvent(stepReadableId)
 
-			return data.WorkerId
+			// data from sleep and user event conditions does not have a worker id
+			if data != nil && data.WorkerId != nil {
+				return data.WorkerId
+			}
 		}
 	}
 
@@ -78,6 +83,11 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 			data := t.TriggerData.DataValueAsTaskOutputEvent(stepReadableId)
 
 			switch {
+			case data == nil || (!data.IsCompleted() && !data.IsFailed()):
+				// data from sleep and user event conditions is passed to the task as-is
+				if dataMap, ok := t.TriggerData.DataValue(stepReadableId).(map[string]interface{}); ok {
+					parents[stepReadableId] = dataMap
+				}
 			case data.IsCompleted():
 				dataMap := make(map[string]interface{})
 
@@ -102,6 +112,26 @@ func (s *sharedRepository) ToV1StepRunData(t *TaskInput) *V1StepRunData {
 	}
 }
 
+// newCELInput returns the input for CEL expressions which are evaluated against a task, such as concurrency
+// keys and rate limit expressions. Parents are keyed by step readable id and contain the outputs of completed
+// parent tasks.
+func (s *sharedRepository) newCELInput(t *TaskInput, additionalMeta map[string]interface{}, workflowRunId string, eventKey *string) cel.Input {
+	opts := []cel.InputOpts{
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithWorkflowRunID(workflowRunId),
+	}
+
+	if stepRunData := s.ToV1StepRunData(t); stepRunData != nil {
+		opts = append(opts, cel.WithInput(stepRunData.Input), cel.WithParents(stepRunData.Parents))
+	}
+
+	if eventKey != nil {
+		opts = append(opts, cel.WithEventKey(*eventKey))
+	}
+
+	return cel.NewInput(opts...)
+}
+
 type V1StepRunData struct {
 	Input       map[string]interface{}            `json:"input"`
 	TriggeredBy string                            `json:"triggered_by"`
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -22,6 +24,9 @@ type ListLogsOpts struct {
 
 	// (optional) a search query
 	Search *string
+
+	// (optional) fields which the metadata of the log lines must contain
+	Fields map[string]interface{}
 }
 
 type CreateLogLineOpts struct {
@@ -38,7 +43,7 @@ type CreateLogLineOpts struct {
 	// (optional) The level of the log line.
 	Level *string `validate:"omitnil,oneof=INFO ERROR WARN DEBUG"`
 
-	// (optional) The metadata of the log line.
+	// (optional) The metadata of the log line, a JSON object of structured fields.
 	Metadata []byte
 }
 
@@ -75,6 +80,28 @@ func (r *logLineRepositoryImpl) ListLogLines(ctx context.Context, tenantId strin
 		queryParams.Search = sqlchelpers.TextFromStr(*opts.Search)
 	}
 
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	for _, level := range opts.Levels {
+		queryParams.Levels = append(queryParams.Levels, sqlcv1.V1LogLineLevel(level))
+	}
+
+	if len(opts.Fields) > 0 {
+		fields, err := json.Marshal(opts.Fields)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal fields: %w", err)
+		}
+
+		queryParams.Fields = fields
+	}
+
 	return r.queries.ListLogLines(ctx, r.pool, queryParams)
 }
 
@@ -83,6 +110,12 @@ func (r *logLineRepositoryImpl) PutLog(ctx context.Context, tenantId string, opt
 		return err
 	}
 
+	level := sqlcv1.V1LogLineLevelINFO
+
+	if opts.Level != nil {
+		level = sqlcv1.V1LogLineLevel(*opts.Level)
+	}
+
 	_, err := r.queries.InsertLogLine(
 		ctx,
 		r.pool,
@@ -92,6 +125,8 @@ func (r *logLineRepositoryImpl) PutLog(ctx context.Context, tenantId string, opt
 				TaskID:         opts.TaskId,
 				TaskInsertedAt: opts.TaskInsertedAt,
 				Message:        opts.Message,
+				Level:          level,
+				Metadata:       opts.Metadata,
 			},
 		},
 	)
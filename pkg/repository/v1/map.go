@@ -0,0 +1,863 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+type ExpandMapTasksResult struct {
+	// The list of map items which were created
+	CreatedTasks []*sqlcv1.V1Task
+
+	// The list of map tasks which finished, either because all of their items completed or because an item
+	// failed
+	FinishedTasks []*TaskOutputEvent
+
+	// The internal events for the finished map tasks
+	InternalEvents []InternalTaskEvent
+}
+
+// MapTaskOutput is the output of a completed map task, which contains the outputs of its items in the order
+// of the list returned by the map expression.
+type MapTaskOutput struct {
+	Outputs []json.RawMessage `json:"outputs"`
+}
+
+// isMapItem returns whether a task is an item of a map task, rather than the map task itself. Map tasks always
+// belong to a DAG, while their items are created as standalone tasks.
+func isMapItem(mapExpression pgtype.Text, dagId *int64) bool {
+	return mapExpression.Valid && dagId == nil
+}
+
+// getMapItemSignalKey returns the key of the signal events which are written on a map task for an item. The
+// retry count of the map task is used as the step index of the key, so that the items of a replayed map task
+// don't collide with the items of its previous attempt.
+func getMapItemSignalKey(mapTask *sqlcv1.V1Task, index int) string {
+	return getChildSignalEventKey(sqlchelpers.UUIDToStr(mapTask.ExternalID), int64(mapTask.RetryCount), int64(index), nil)
+}
+
+// parseMapItemSignalKey returns the retry count of the map task and the index of the item from the key of a map
+// item signal. Keys which can't have been written for a map item are not ok.
+func parseMapItemSignalKey(key string) (retryCount int32, index int, ok bool) {
+	parts := strings.Split(key, ".")
+
+	if len(parts) != 3 {
+		return 0, 0, false
+	}
+
+	rc, err := strconv.ParseInt(parts[1], 10, 32)
+
+	if err != nil {
+		return 0, 0, false
+	}
+
+	index, err = strconv.Atoi(parts[2])
+
+	if err != nil || index < 0 {
+		return 0, 0, false
+	}
+
+	return int32(rc), index, true
+}
+
+// newMapItemInput returns the input of a map item, which is the workflow input of the map task along with the
+// item and its index.
+func newMapItemInput(workflowInput map[string]interface{}, item interface{}, index int) *TaskInput {
+	input := make(map[string]interface{}, len(workflowInput)+2)
+
+	for k, v := range workflowInput {
+		input[k] = v
+	}
+
+	input["item"] = item
+	input["index"] = index
+
+	return &TaskInput{
+		Input: input,
+	}
+}
+
+// getMapItemOutput returns the output of a map item from the data of its signal completed event.
+func getMapItemOutput(data []byte) (*TaskOutputEvent, error) {
+	matchData, err := NewMatchData(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range matchData.DataKeys() {
+		if output := matchData.DataValueAsTaskOutputEvent(key); output != nil {
+			return output, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signal data does not contain a task output")
+}
+
+// getMapItemError returns the error which fails the map task if the item did not succeed, or an empty string.
+func getMapItemError(index int, output *TaskOutputEvent) string {
+	switch {
+	case output.IsFailed():
+		return fmt.Sprintf("map item %d failed: %s", index, output.ErrorMessage)
+	case output.IsCancelled():
+		return fmt.Sprintf("map item %d was cancelled", index)
+	default:
+		return ""
+	}
+}
+
+// mapTaskState is the progress of a map task for its current retry count.
+type mapTaskState struct {
+	itemCount      int
+	createdCount   int
+	completedCount int
+	errorMessage   string
+}
+
+func (s *mapTaskState) isFailed() bool {
+	return s.errorMessage != ""
+}
+
+func (s *mapTaskState) isCompleted() bool {
+	return !s.isFailed() && s.completedCount >= s.itemCount
+}
+
+// nextItems returns the range [from, to) of the items which should be created next. Items are created in order,
+// and at most maxParallelism items are in flight at the same time, unless maxParallelism is 0.
+func (s *mapTaskState) nextItems(maxParallelism int) (from, to int) {
+	from, to = s.createdCount, s.itemCount
+
+	if maxParallelism > 0 {
+		inFlight := s.createdCount - s.completedCount
+		to = min(to, from+max(maxParallelism-inFlight, 0))
+	}
+
+	return from, max(from, to)
+}
+
+// newMapTaskOutput returns the output of a completed map task from the outputs of its items, which are keyed by
+// the index of the item.
+func newMapTaskOutput(itemCount int, outputs map[int]*TaskOutputEvent) ([]byte, error) {
+	res := &MapTaskOutput{
+		Outputs: make([]json.RawMessage, 0, itemCount),
+	}
+
+	for i := 0; i < itemCount; i++ {
+		output, ok := outputs[i]
+
+		if !ok {
+			return nil, fmt.Errorf("missing output for map item %d", i)
+		}
+
+		if len(output.Output) == 0 {
+			res.Outputs = append(res.Outputs, json.RawMessage("null"))
+		} else {
+			res.Outputs = append(res.Outputs, output.Output)
+		}
+	}
+
+	return json.Marshal(res)
+}
+
+// recordMapItemSignals updates the progress of map tasks with the signal completed events of their items. This
+// is called in the same transaction which writes the signal completed events, so that each item is counted
+// exactly once. Signals which weren't written for the current retry of a map task don't match any progress and
+// are ignored.
+func (s *sharedRepository) recordMapItemSignals(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	tasks []TaskIdInsertedAtRetryCount,
+	eventKeys []string,
+	datas [][]byte,
+) error {
+	type mapTaskKey struct {
+		id         int64
+		insertedAt time.Time
+		retryCount int32
+	}
+
+	keys := make([]mapTaskKey, 0)
+	keysToTasks := make(map[mapTaskKey]TaskIdInsertedAtRetryCount)
+	keysToCompletedCounts := make(map[mapTaskKey]int32)
+	keysToErrors := make(map[mapTaskKey]string)
+
+	for i, task := range tasks {
+		retryCount, index, ok := parseMapItemSignalKey(eventKeys[i])
+
+		if !ok {
+			continue
+		}
+
+		k := mapTaskKey{
+			id:         task.Id,
+			insertedAt: task.InsertedAt.Time,
+			retryCount: retryCount,
+		}
+
+		if _, ok := keysToTasks[k]; !ok {
+			keys = append(keys, k)
+			keysToTasks[k] = task
+		}
+
+		keysToCompletedCounts[k]++
+
+		output, err := getMapItemOutput(datas[i])
+
+		if err != nil {
+			s.l.Error().Err(err).Msg("failed to parse signal completed event data")
+			continue
+		}
+
+		if errMsg := getMapItemError(index, output); errMsg != "" && keysToErrors[k] == "" {
+			keysToErrors[k] = errMsg
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	params := sqlcv1.IncrementMapTaskCompletedCountsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskids:         make([]int64, 0, len(keys)),
+		Taskinsertedats: make([]pgtype.Timestamptz, 0, len(keys)),
+		Retrycounts:     make([]int32, 0, len(keys)),
+		Completedcounts: make([]int32, 0, len(keys)),
+		Errormessages:   make([]string, 0, len(keys)),
+	}
+
+	for _, k := range keys {
+		params.Taskids = append(params.Taskids, k.id)
+		params.Taskinsertedats = append(params.Taskinsertedats, keysToTasks[k].InsertedAt)
+		params.Retrycounts = append(params.Retrycounts, k.retryCount)
+		params.Completedcounts = append(params.Completedcounts, keysToCompletedCounts[k])
+		params.Errormessages = append(params.Errormessages, keysToErrors[k])
+	}
+
+	return s.queries.IncrementMapTaskCompletedCounts(ctx, tx, params)
+}
+
+func parseMapTaskInput(mapTask *sqlcv1.V1Task) (*V1StepRunData, error) {
+	var stepRunData V1StepRunData
+
+	if err := json.Unmarshal(mapTask.Input, &stepRunData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task input: %w", err)
+	}
+
+	return &stepRunData, nil
+}
+
+// evalMapExpression evaluates the map expression of a step against the input of a map task, and returns the
+// list of items.
+func (r *TriggerRepositoryImpl) evalMapExpression(expr string, mapTask *sqlcv1.V1Task, stepRunData *V1StepRunData) ([]interface{}, error) {
+	var additionalMeta map[string]interface{}
+
+	if len(mapTask.AdditionalMetadata) > 0 {
+		if err := json.Unmarshal(mapTask.AdditionalMetadata, &additionalMeta); err != nil {
+			return nil, fmt.Errorf("failed to process additional metadata: not a json object")
+		}
+	}
+
+	res, err := r.celParser.ParseAndEvalStepRun(expr, cel.NewInput(
+		cel.WithInput(stepRunData.Input),
+		cel.WithParents(stepRunData.Parents),
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithWorkflowRunID(sqlchelpers.UUIDToStr(mapTask.WorkflowRunID)),
+	))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse map expression (%s): %w", expr, err)
+	}
+
+	if res.Type != cel.StepRunOutTypeList {
+		return nil, fmt.Errorf("failed to parse map expression (%s): expected list output, got %s", expr, res.Type)
+	}
+
+	return res.List, nil
+}
+
+// listMapItemOutputs lists the signal completed events of the first itemCounts items of each map task, and
+// returns the outputs of the completed items, keyed by the id of the map task and the index of the item.
+func (r *TriggerRepositoryImpl) listMapItemOutputs(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId string,
+	mapTasks []*sqlcv1.V1Task,
+	itemCounts map[int64]int,
+) (map[int64]map[int]*TaskOutputEvent, error) {
+	outputs := make(map[int64]map[int]*TaskOutputEvent)
+
+	signalTaskIds := make([]int64, 0)
+	signalTaskInsertedAts := make([]pgtype.Timestamptz, 0)
+	signalKeys := make([]string, 0)
+
+	for _, mapTask := range mapTasks {
+		outputs[mapTask.ID] = make(map[int]*TaskOutputEvent)
+
+		for i := 0; i < itemCounts[mapTask.ID]; i++ {
+			signalTaskIds = append(signalTaskIds, mapTask.ID)
+			signalTaskInsertedAts = append(signalTaskInsertedAts, mapTask.InsertedAt)
+			signalKeys = append(signalKeys, getMapItemSignalKey(mapTask, i))
+		}
+	}
+
+	if len(signalKeys) == 0 {
+		return outputs, nil
+	}
+
+	events, err := r.queries.ListMatchingSignalEvents(ctx, tx, sqlcv1.ListMatchingSignalEventsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Eventtype:       sqlcv1.V1TaskEventTypeSIGNALCOMPLETED,
+		Taskids:         signalTaskIds,
+		Taskinsertedats: signalTaskInsertedAts,
+		Eventkeys:       signalKeys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signal completed events: %w", err)
+	}
+
+	for _, event := range events {
+		_, index, ok := parseMapItemSignalKey(event.EventKey.String)
+
+		if !ok {
+			continue
+		}
+
+		output, err := getMapItemOutput(event.Data)
+
+		if err != nil {
+			r.l.Error().Err(err).Msg("failed to parse signal completed event data")
+			output = &TaskOutputEvent{}
+		}
+
+		outputs[event.TaskID][index] = output
+	}
+
+	return outputs, nil
+}
+
+// ExpandMapTasks creates the next items of the given map tasks, up to the max parallelism of the map step. Once
+// all items of a map task have completed, the map task completes with the ordered outputs of its items, and if
+// any item fails or is cancelled, the map task fails.
+//
+// The map expression is evaluated once per retry of a map task, and the progress of the map task is stored in
+// v1_map_task, so expanding a map task only reads the items which are created next.
+func (r *TriggerRepositoryImpl) ExpandMapTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*ExpandMapTasksResult, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	taskIds := make([]int64, len(tasks))
+	taskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
+
+	for i, task := range tasks {
+		taskIds[i] = task.Id
+		taskInsertedAts[i] = task.InsertedAt
+	}
+
+	// lock the map tasks, so that concurrent signals for the same map task don't create duplicate items
+	mapTasks, err := r.queries.LockMapTasks(ctx, tx, sqlcv1.LockMapTasksParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskids:         taskIds,
+		Taskinsertedats: taskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock map tasks: %w", err)
+	}
+
+	res := &ExpandMapTasksResult{}
+
+	if len(mapTasks) == 0 {
+		return res, nil
+	}
+
+	// skip map tasks which have already finished
+	externalIds := make([]pgtype.UUID, len(mapTasks))
+	eventTypes := make([][]string, len(mapTasks))
+	uniqueStepIds := make(map[string]struct{})
+	stepIds := make([]pgtype.UUID, 0)
+
+	for i, mapTask := range mapTasks {
+		externalIds[i] = mapTask.ExternalID
+		eventTypes[i] = []string{
+			string(sqlcv1.V1TaskEventTypeCOMPLETED),
+			string(sqlcv1.V1TaskEventTypeFAILED),
+			string(sqlcv1.V1TaskEventTypeCANCELLED),
+		}
+
+		stepId := sqlchelpers.UUIDToStr(mapTask.StepID)
+
+		if _, ok := uniqueStepIds[stepId]; !ok {
+			uniqueStepIds[stepId] = struct{}{}
+			stepIds = append(stepIds, mapTask.StepID)
+		}
+	}
+
+	finalizedEvents, err := r.queries.ListMatchingTaskEvents(ctx, tx, sqlcv1.ListMatchingTaskEventsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskexternalids: externalIds,
+		Eventtypes:      eventTypes,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list finalized map tasks: %w", err)
+	}
+
+	finalizedExternalIds := make(map[string]struct{})
+
+	for _, event := range finalizedEvents {
+		finalizedExternalIds[sqlchelpers.UUIDToStr(event.ExternalID)] = struct{}{}
+	}
+
+	steps, err := r.queries.ListStepsByIds(ctx, tx, sqlcv1.ListStepsByIdsParams{
+		Ids:      stepIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list steps: %w", err)
+	}
+
+	stepIdsToConfig := make(map[string]*sqlcv1.ListStepsByIdsRow)
+
+	for _, step := range steps {
+		stepIdsToConfig[sqlchelpers.UUIDToStr(step.ID)] = step
+	}
+
+	activeTasks := make([]*sqlcv1.V1Task, 0, len(mapTasks))
+	activeTaskIds := make([]int64, 0, len(mapTasks))
+	activeTaskInsertedAts := make([]pgtype.Timestamptz, 0, len(mapTasks))
+	activeRetryCounts := make(map[int64]int32, len(mapTasks))
+
+	for _, mapTask := range mapTasks {
+		if _, ok := finalizedExternalIds[sqlchelpers.UUIDToStr(mapTask.ExternalID)]; ok {
+			continue
+		}
+
+		stepConfig, ok := stepIdsToConfig[sqlchelpers.UUIDToStr(mapTask.StepID)]
+
+		if !ok || !stepConfig.MapExpression.Valid {
+			r.l.Error().Msgf("could not find map expression for step %s", sqlchelpers.UUIDToStr(mapTask.StepID))
+			continue
+		}
+
+		activeTasks = append(activeTasks, mapTask)
+		activeTaskIds = append(activeTaskIds, mapTask.ID)
+		activeTaskInsertedAts = append(activeTaskInsertedAts, mapTask.InsertedAt)
+		activeRetryCounts[mapTask.ID] = mapTask.RetryCount
+	}
+
+	if len(activeTasks) == 0 {
+		return res, nil
+	}
+
+	storedStates, err := r.queries.LockMapTaskStates(ctx, tx, sqlcv1.LockMapTaskStatesParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Taskids:         activeTaskIds,
+		Taskinsertedats: activeTaskInsertedAts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock map task states: %w", err)
+	}
+
+	taskStates := make(map[int64]*mapTaskState)
+
+	for _, state := range storedStates {
+		// the progress of a previous retry of the map task is replaced below
+		if state.RetryCount != activeRetryCounts[state.TaskID] {
+			continue
+		}
+
+		taskStates[state.TaskID] = &mapTaskState{
+			itemCount:      int(state.ItemCount),
+			createdCount:   int(state.CreatedCount),
+			completedCount: int(state.CompletedCount),
+			errorMessage:   state.ErrorMessage.String,
+		}
+	}
+
+	// evaluate the map expression of map tasks which are expanded for the first time in their current retry
+	taskInputs := make(map[int64]*V1StepRunData)
+	taskItems := make(map[int64][]interface{})
+	newTasks := make([]*sqlcv1.V1Task, 0)
+
+	for _, mapTask := range activeTasks {
+		if _, ok := taskStates[mapTask.ID]; ok {
+			continue
+		}
+
+		stepConfig := stepIdsToConfig[sqlchelpers.UUIDToStr(mapTask.StepID)]
+
+		stepRunData, err := parseMapTaskInput(mapTask)
+
+		if err != nil {
+			taskStates[mapTask.ID] = &mapTaskState{errorMessage: err.Error()}
+			continue
+		}
+
+		items, err := r.evalMapExpression(stepConfig.MapExpression.String, mapTask, stepRunData)
+
+		if err != nil {
+			taskStates[mapTask.ID] = &mapTaskState{errorMessage: err.Error()}
+			continue
+		}
+
+		taskInputs[mapTask.ID] = stepRunData
+		taskItems[mapTask.ID] = items
+		newTasks = append(newTasks, mapTask)
+	}
+
+	if len(newTasks) > 0 {
+		params := sqlcv1.UpsertMapTaskStatesParams{
+			Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+			Taskids:         make([]int64, 0, len(newTasks)),
+			Taskinsertedats: make([]pgtype.Timestamptz, 0, len(newTasks)),
+			Retrycounts:     make([]int32, 0, len(newTasks)),
+			Items:           make([][]byte, 0, len(newTasks)),
+			Itemcounts:      make([]int32, 0, len(newTasks)),
+			Createdcounts:   make([]int32, 0, len(newTasks)),
+			Completedcounts: make([]int32, 0, len(newTasks)),
+			Errormessages:   make([]string, 0, len(newTasks)),
+		}
+
+		for _, mapTask := range newTasks {
+			items := taskItems[mapTask.ID]
+
+			itemsBytes, err := json.Marshal(items)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal map items: %w", err)
+			}
+
+			state := &mapTaskState{itemCount: len(items)}
+			taskStates[mapTask.ID] = state
+
+			params.Taskids = append(params.Taskids, mapTask.ID)
+			params.Taskinsertedats = append(params.Taskinsertedats, mapTask.InsertedAt)
+			params.Retrycounts = append(params.Retrycounts, mapTask.RetryCount)
+			params.Items = append(params.Items, itemsBytes)
+			params.Itemcounts = append(params.Itemcounts, int32(state.itemCount))                // nolint: gosec
+			params.Createdcounts = append(params.Createdcounts, int32(state.createdCount))       // nolint: gosec
+			params.Completedcounts = append(params.Completedcounts, int32(state.completedCount)) // nolint: gosec
+			params.Errormessages = append(params.Errormessages, state.errorMessage)
+		}
+
+		err = r.queries.UpsertMapTaskStates(ctx, tx, params)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to store map task states: %w", err)
+		}
+	}
+
+	// determine which map tasks have finished, and which items should be created next
+	type mapExpansion struct {
+		mapTask *sqlcv1.V1Task
+		from    int
+		to      int
+	}
+
+	finished := make(map[int64]*TaskOutputEvent)
+	completedTasks := make([]*sqlcv1.V1Task, 0)
+	completedItemCounts := make(map[int64]int)
+	expansions := make([]mapExpansion, 0)
+
+	listItemTaskIds := make([]int64, 0)
+	listItemTaskInsertedAts := make([]pgtype.Timestamptz, 0)
+	listItemOffsets := make([]int32, 0)
+	listItemCounts := make([]int32, 0)
+
+	for _, mapTask := range activeTasks {
+		state := taskStates[mapTask.ID]
+
+		switch {
+		case state.isFailed():
+			finished[mapTask.ID] = NewFailedTaskOutputEventFromTask(mapTask)
+			finished[mapTask.ID].ErrorMessage = state.errorMessage
+		case state.isCompleted():
+			completedTasks = append(completedTasks, mapTask)
+			completedItemCounts[mapTask.ID] = state.itemCount
+		default:
+			stepConfig := stepIdsToConfig[sqlchelpers.UUIDToStr(mapTask.StepID)]
+			maxParallelism := 0
+
+			if stepConfig.MapMaxParallelism.Valid {
+				maxParallelism = int(stepConfig.MapMaxParallelism.Int32)
+			}
+
+			from, to := state.nextItems(maxParallelism)
+
+			if from == to {
+				continue
+			}
+
+			expansions = append(expansions, mapExpansion{
+				mapTask: mapTask,
+				from:    from,
+				to:      to,
+			})
+
+			if _, ok := taskItems[mapTask.ID]; !ok {
+				listItemTaskIds = append(listItemTaskIds, mapTask.ID)
+				listItemTaskInsertedAts = append(listItemTaskInsertedAts, mapTask.InsertedAt)
+				listItemOffsets = append(listItemOffsets, int32(from))  // nolint: gosec
+				listItemCounts = append(listItemCounts, int32(to-from)) // nolint: gosec
+			}
+		}
+	}
+
+	// the outputs of the items are only read once, when the map task completes
+	if len(completedTasks) > 0 {
+		outputs, err := r.listMapItemOutputs(ctx, tx, tenantId, completedTasks, completedItemCounts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mapTask := range completedTasks {
+			outputBytes, err := newMapTaskOutput(completedItemCounts[mapTask.ID], outputs[mapTask.ID])
+
+			if err != nil {
+				finished[mapTask.ID] = NewFailedTaskOutputEventFromTask(mapTask)
+				finished[mapTask.ID].ErrorMessage = err.Error()
+				continue
+			}
+
+			finished[mapTask.ID] = baseFromTasksRow(mapTask)
+			finished[mapTask.ID].EventType = sqlcv1.V1TaskEventTypeCOMPLETED
+			finished[mapTask.ID].Output = outputBytes
+		}
+	}
+
+	// read the items which are created next for map tasks which were not evaluated above
+	expansionItems := make(map[int64][]interface{})
+
+	if len(listItemTaskIds) > 0 {
+		rows, err := r.queries.ListMapTaskItems(ctx, tx, sqlcv1.ListMapTaskItemsParams{
+			Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+			Taskids:         listItemTaskIds,
+			Taskinsertedats: listItemTaskInsertedAts,
+			Offsets:         listItemOffsets,
+			Counts:          listItemCounts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list map items: %w", err)
+		}
+
+		for _, row := range rows {
+			var items []interface{}
+
+			if err := json.Unmarshal(row.Items, &items); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal map items: %w", err)
+			}
+
+			expansionItems[row.TaskID] = items
+		}
+	}
+
+	itemOpts := make([]CreateTaskOpts, 0)
+	itemSignalTaskIds := make([]TaskIdInsertedAtRetryCount, 0)
+	itemSignalExternalIds := make([]string, 0)
+	itemSignalDatas := make([][]byte, 0)
+	itemSignalKeys := make([]string, 0)
+	itemMatches := make([]CreateMatchOpts, 0)
+
+	createdTaskIds := make([]int64, 0, len(expansions))
+	createdTaskInsertedAts := make([]pgtype.Timestamptz, 0, len(expansions))
+	createdCounts := make([]int32, 0, len(expansions))
+
+	for _, expansion := range expansions {
+		mapTask := expansion.mapTask
+		mapTaskExternalId := sqlchelpers.UUIDToStr(mapTask.ExternalID)
+		parentInsertedAt := mapTask.InsertedAt.Time
+
+		items, ok := expansionItems[mapTask.ID]
+
+		if all, evaluated := taskItems[mapTask.ID]; evaluated {
+			items, ok = all[expansion.from:expansion.to], true
+		}
+
+		if !ok || len(items) != expansion.to-expansion.from {
+			return nil, fmt.Errorf("could not read items %d to %d of map task %d", expansion.from, expansion.to, mapTask.ID)
+		}
+
+		stepRunData, ok := taskInputs[mapTask.ID]
+
+		if !ok {
+			stepRunData, err = parseMapTaskInput(mapTask)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for j, item := range items {
+			i := expansion.from + j
+			itemExternalId := uuid.NewString()
+			childIndex := int64(i)
+			key := getMapItemSignalKey(mapTask, i)
+
+			// items belong to the workflow run of the map task, rather than being workflow runs of their own
+			itemOpts = append(itemOpts, CreateTaskOpts{
+				ExternalId:           itemExternalId,
+				WorkflowRunId:        sqlchelpers.UUIDToStr(mapTask.WorkflowRunID),
+				StepId:               sqlchelpers.UUIDToStr(mapTask.StepID),
+				Input:                newMapItemInput(stepRunData.Input, item, i),
+				StepIndex:            int(mapTask.RetryCount),
+				AdditionalMetadata:   mapTask.AdditionalMetadata,
+				InitialState:         sqlcv1.V1TaskInitialStateQUEUED,
+				ParentTaskExternalId: &mapTaskExternalId,
+				ParentTaskId:         &mapTask.ID,
+				ParentTaskInsertedAt: &parentInsertedAt,
+				ChildIndex:           &childIndex,
+			})
+
+			itemSignalTaskIds = append(itemSignalTaskIds, TaskIdInsertedAtRetryCount{
+				Id:         mapTask.ID,
+				InsertedAt: mapTask.InsertedAt,
+				RetryCount: -1,
+			})
+			itemSignalExternalIds = append(itemSignalExternalIds, mapTaskExternalId)
+			itemSignalDatas = append(itemSignalDatas, (&ChildWorkflowSignalCreatedData{
+				ChildExternalId:  itemExternalId,
+				ParentExternalId: mapTaskExternalId,
+				ChildIndex:       childIndex,
+			}).Bytes())
+			itemSignalKeys = append(itemSignalKeys, key)
+
+			itemMatches = append(itemMatches, getChildSignalMatch(
+				mapTaskExternalId,
+				mapTask.ID,
+				mapTask.InsertedAt,
+				itemExternalId,
+				mapTask.StepReadableID,
+				key,
+			))
+		}
+
+		createdTaskIds = append(createdTaskIds, mapTask.ID)
+		createdTaskInsertedAts = append(createdTaskInsertedAts, mapTask.InsertedAt)
+		createdCounts = append(createdCounts, int32(expansion.to)) // nolint: gosec
+	}
+
+	if len(itemOpts) > 0 {
+		res.CreatedTasks, err = r.createTasks(ctx, tx, tenantId, itemOpts)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create map items: %w", err)
+		}
+
+		_, err = r.createTaskEvents(
+			ctx,
+			tx,
+			tenantId,
+			itemSignalTaskIds,
+			itemSignalExternalIds,
+			itemSignalDatas,
+			makeEventTypeArr(sqlcv1.V1TaskEventTypeSIGNALCREATED, len(itemSignalTaskIds)),
+			itemSignalKeys,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signal created events: %w", err)
+		}
+
+		err = r.createEventMatches(ctx, tx, tenantId, itemMatches)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create map item matches: %w", err)
+		}
+
+		err = r.queries.UpdateMapTaskCreatedCounts(ctx, tx, sqlcv1.UpdateMapTaskCreatedCountsParams{
+			Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+			Taskids:         createdTaskIds,
+			Taskinsertedats: createdTaskInsertedAts,
+			Createdcounts:   createdCounts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update map task states: %w", err)
+		}
+	}
+
+	if len(finished) > 0 {
+		finishedTasks := make([]TaskIdInsertedAtRetryCount, 0, len(finished))
+		finishedTaskIds := make([]int64, 0, len(finished))
+		finishedTaskInsertedAts := make([]pgtype.Timestamptz, 0, len(finished))
+		finishedExternalIds := make([]string, 0, len(finished))
+		finishedDatas := make([][]byte, 0, len(finished))
+		finishedEventTypes := make([]sqlcv1.V1TaskEventType, 0, len(finished))
+
+		for _, mapTask := range activeTasks {
+			output, ok := finished[mapTask.ID]
+
+			if !ok {
+				continue
+			}
+
+			finishedTasks = append(finishedTasks, TaskIdInsertedAtRetryCount{
+				Id:         mapTask.ID,
+				InsertedAt: mapTask.InsertedAt,
+				RetryCount: mapTask.RetryCount,
+			})
+			finishedTaskIds = append(finishedTaskIds, mapTask.ID)
+			finishedTaskInsertedAts = append(finishedTaskInsertedAts, mapTask.InsertedAt)
+			finishedExternalIds = append(finishedExternalIds, sqlchelpers.UUIDToStr(mapTask.ExternalID))
+			finishedDatas = append(finishedDatas, output.Bytes())
+			finishedEventTypes = append(finishedEventTypes, output.EventType)
+			res.FinishedTasks = append(res.FinishedTasks, output)
+		}
+
+		res.InternalEvents, err = r.createTaskEvents(
+			ctx,
+			tx,
+			tenantId,
+			finishedTasks,
+			finishedExternalIds,
+			finishedDatas,
+			finishedEventTypes,
+			make([]string, len(finishedTasks)),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create map task events: %w", err)
+		}
+
+		// the progress of finished map tasks is no longer needed, a replay of the map task evaluates the map
+		// expression again
+		err = r.queries.DeleteMapTaskStates(ctx, tx, sqlcv1.DeleteMapTaskStatesParams{
+			Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+			Taskids:         finishedTaskIds,
+			Taskinsertedats: finishedTaskInsertedAts,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete map task states: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,271 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// setupMapWorkflow creates a tenant with a workflow which maps the items returned by the "list" step over the
+// "process" step, and triggers the workflow, returning the tenant id and the "list" task.
+func setupMapWorkflow(t *testing.T, conf *database.Layer, maxParallelism int) (string, *sqlcv1.V1Task) {
+	t.Helper()
+
+	ctx := context.Background()
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	_, err = conf.APIRepository.Tenant().CreateTenant(ctx, &repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+	require.NoError(t, err)
+
+	_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "map-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{ReadableId: "list", Action: "map:list"},
+					{
+						ReadableId: "process",
+						Action:     "map:process",
+						Parents:    []string{"list"},
+						Map: &repository.CreateStepMapOpts{
+							Expression:     `parents["list"].items`,
+							MaxParallelism: &maxParallelism,
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: "map-workflow",
+				Data:         []byte(`{"bucket":"files"}`),
+			},
+			ExternalId: uuid.New().String(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	return tenantId, tasks[0]
+}
+
+// processInternalEvents matches the internal events of finished tasks, as the tasks controller would.
+func processInternalEvents(t *testing.T, conf *database.Layer, tenantId string, events []v1.InternalTaskEvent) *v1.InternalEventMatchResults {
+	t.Helper()
+
+	candidates := make([]v1.CandidateEventMatch, 0, len(events))
+
+	for _, event := range events {
+		candidates = append(candidates, v1.CandidateEventMatch{
+			ID:             uuid.NewString(),
+			EventTimestamp: time.Now(),
+			Key:            string(event.EventType),
+			Data:           event.Data,
+			ResourceHint:   &event.TaskExternalID,
+		})
+	}
+
+	res, err := conf.V1.Matches().ProcessInternalEventMatches(context.Background(), tenantId, candidates)
+	require.NoError(t, err)
+
+	return res
+}
+
+func completeTask(t *testing.T, conf *database.Layer, tenantId string, task *sqlcv1.V1Task, output string) *v1.InternalEventMatchResults {
+	t.Helper()
+
+	res, err := conf.V1.Tasks().CompleteTasks(context.Background(), tenantId, []v1.CompleteTaskOpts{
+		{
+			TaskIdInsertedAtRetryCount: &v1.TaskIdInsertedAtRetryCount{
+				Id:         task.ID,
+				InsertedAt: task.InsertedAt,
+				RetryCount: task.RetryCount,
+			},
+			Output: []byte(output),
+		},
+	})
+	require.NoError(t, err)
+
+	return processInternalEvents(t, conf, tenantId, res.InternalEvents)
+}
+
+func expandMapTasks(t *testing.T, conf *database.Layer, tenantId string, tasks []v1.TaskIdInsertedAtRetryCount) *v1.ExpandMapTasksResult {
+	t.Helper()
+
+	res, err := conf.V1.Triggers().ExpandMapTasks(context.Background(), tenantId, tasks)
+	require.NoError(t, err)
+
+	return res
+}
+
+// startMapTask completes the "list" task with the given items, and expands the map task which is created.
+func startMapTask(t *testing.T, conf *database.Layer, tenantId string, listTask *sqlcv1.V1Task, items string) (*sqlcv1.V1Task, *v1.ExpandMapTasksResult) {
+	t.Helper()
+
+	matchRes := completeTask(t, conf, tenantId, listTask, fmt.Sprintf(`{"items":%s}`, items))
+	require.Len(t, matchRes.CreatedTasks, 1)
+
+	mapTask := matchRes.CreatedTasks[0]
+	require.Equal(t, sqlcv1.V1TaskInitialStateMAPPED, mapTask.InitialState)
+
+	return mapTask, expandMapTasks(t, conf, tenantId, []v1.TaskIdInsertedAtRetryCount{
+		{Id: mapTask.ID, InsertedAt: mapTask.InsertedAt, RetryCount: mapTask.RetryCount},
+	})
+}
+
+func getItemIndex(t *testing.T, item *sqlcv1.V1Task) int {
+	t.Helper()
+
+	var input v1.TaskInput
+
+	require.NoError(t, json.Unmarshal(item.Input, &input))
+
+	return int(input.Input["index"].(float64))
+}
+
+func TestExpandMapTasks(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		tenantId, listTask := setupMapWorkflow(t, conf, 2)
+
+		mapTask, res := startMapTask(t, conf, tenantId, listTask, `["a","b","c"]`)
+
+		// only max parallelism items are created at once
+		require.Len(t, res.CreatedTasks, 2)
+		assert.Empty(t, res.FinishedTasks)
+
+		items := make(map[int]*sqlcv1.V1Task)
+
+		for _, item := range res.CreatedTasks {
+			// items are part of the workflow run of the map task, and receive the workflow input
+			assert.Equal(t, mapTask.WorkflowRunID, item.WorkflowRunID)
+			assert.Equal(t, mapTask.ExternalID, item.ParentTaskExternalID)
+
+			var input v1.TaskInput
+
+			require.NoError(t, json.Unmarshal(item.Input, &input))
+			assert.Equal(t, "files", input.Input["bucket"])
+
+			items[getItemIndex(t, item)] = item
+		}
+
+		require.Contains(t, items, 0)
+		require.Contains(t, items, 1)
+
+		// completing the second item first starts the third item
+		matchRes := completeTask(t, conf, tenantId, items[1], `{"n":1}`)
+		require.Len(t, matchRes.SignaledTasks, 1)
+
+		res = expandMapTasks(t, conf, tenantId, matchRes.SignaledTasks)
+		require.Len(t, res.CreatedTasks, 1)
+		assert.Empty(t, res.FinishedTasks)
+		assert.Equal(t, 2, getItemIndex(t, res.CreatedTasks[0]))
+
+		items[2] = res.CreatedTasks[0]
+
+		// expanding again without any completed items is a no-op
+		res = expandMapTasks(t, conf, tenantId, matchRes.SignaledTasks)
+		assert.Empty(t, res.CreatedTasks)
+		assert.Empty(t, res.FinishedTasks)
+
+		for _, i := range []int{2, 0} {
+			matchRes = completeTask(t, conf, tenantId, items[i], fmt.Sprintf(`{"n":%d}`, i))
+			res = expandMapTasks(t, conf, tenantId, matchRes.SignaledTasks)
+			assert.Empty(t, res.CreatedTasks)
+		}
+
+		// the map task completes with the outputs in the order of the items
+		require.Len(t, res.FinishedTasks, 1)
+		assert.Equal(t, sqlcv1.V1TaskEventTypeCOMPLETED, res.FinishedTasks[0].EventType)
+		assert.JSONEq(t, `{"outputs":[{"n":0},{"n":1},{"n":2}]}`, string(res.FinishedTasks[0].Output))
+		require.Len(t, res.InternalEvents, 1)
+
+		var stateCount int
+
+		err := conf.Pool.QueryRow(context.Background(), `SELECT COUNT(*) FROM v1_map_task WHERE tenant_id = $1::uuid`, tenantId).Scan(&stateCount)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, stateCount, "the progress of a finished map task is removed")
+
+		return nil
+	})
+}
+
+func TestExpandMapTasks_ItemFailure(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId, listTask := setupMapWorkflow(t, conf, 1)
+
+		_, res := startMapTask(t, conf, tenantId, listTask, `["a","b"]`)
+		require.Len(t, res.CreatedTasks, 1)
+
+		item := res.CreatedTasks[0]
+
+		failRes, err := conf.V1.Tasks().FailTasks(ctx, tenantId, []v1.FailTaskOpts{
+			{
+				TaskIdInsertedAtRetryCount: &v1.TaskIdInsertedAtRetryCount{
+					Id:         item.ID,
+					InsertedAt: item.InsertedAt,
+					RetryCount: item.RetryCount,
+				},
+				IsAppError:   true,
+				ErrorMessage: "boom",
+			},
+		})
+		require.NoError(t, err)
+
+		matchRes := processInternalEvents(t, conf, tenantId, failRes.InternalEvents)
+		res = expandMapTasks(t, conf, tenantId, matchRes.SignaledTasks)
+
+		// the map task fails without creating the remaining items
+		assert.Empty(t, res.CreatedTasks)
+		require.Len(t, res.FinishedTasks, 1)
+		assert.True(t, res.FinishedTasks[0].IsFailed())
+		assert.Equal(t, "map item 0 failed: boom", res.FinishedTasks[0].ErrorMessage)
+
+		return nil
+	})
+}
+
+func TestExpandMapTasks_EmptyList(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		tenantId, listTask := setupMapWorkflow(t, conf, 1)
+
+		mapTask, res := startMapTask(t, conf, tenantId, listTask, `[]`)
+
+		assert.Empty(t, res.CreatedTasks)
+		require.Len(t, res.FinishedTasks, 1)
+		assert.Equal(t, sqlchelpers.UUIDToStr(mapTask.ExternalID), res.FinishedTasks[0].TaskExternalId)
+		assert.JSONEq(t, `{"outputs":[]}`, string(res.FinishedTasks[0].Output))
+
+		return nil
+	})
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestParseMapItemSignalKey(t *testing.T) {
+	key := getChildSignalEventKey("c0a4f1b4-5b5e-4f3b-9f4e-7a1f0f3c7e21", 2, 15, nil)
+
+	retryCount, index, ok := parseMapItemSignalKey(key)
+
+	require.True(t, ok)
+	assert.Equal(t, int32(2), retryCount)
+	assert.Equal(t, 15, index)
+
+	for _, key := range []string{"wait-1", "a.b.c", "c0a4f1b4.1.child-key", "c0a4f1b4.1.-1", "a.1.2.3"} {
+		_, _, ok := parseMapItemSignalKey(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestNewMapItemInput(t *testing.T) {
+	workflowInput := map[string]interface{}{
+		"bucket": "files",
+		"item":   "overwritten",
+	}
+
+	input := newMapItemInput(workflowInput, map[string]interface{}{"name": "a.txt"}, 3)
+
+	assert.Equal(t, map[string]interface{}{
+		"bucket": "files",
+		"item":   map[string]interface{}{"name": "a.txt"},
+		"index":  3,
+	}, input.Input)
+
+	// the workflow input is not modified
+	assert.Equal(t, "overwritten", workflowInput["item"])
+}
+
+func TestMapTaskState_NextItems(t *testing.T) {
+	tests := []struct {
+		name           string
+		state          mapTaskState
+		maxParallelism int
+		from, to       int
+	}{
+		{
+			name:  "first expansion without a limit",
+			state: mapTaskState{itemCount: 5},
+			from:  0,
+			to:    5,
+		},
+		{
+			name:           "first expansion with a limit",
+			state:          mapTaskState{itemCount: 5},
+			maxParallelism: 2,
+			from:           0,
+			to:             2,
+		},
+		{
+			name:           "an item completed",
+			state:          mapTaskState{itemCount: 5, createdCount: 2, completedCount: 1},
+			maxParallelism: 2,
+			from:           2,
+			to:             3,
+		},
+		{
+			name:           "at the limit",
+			state:          mapTaskState{itemCount: 5, createdCount: 3, completedCount: 1},
+			maxParallelism: 2,
+			from:           3,
+			to:             3,
+		},
+		{
+			name:           "every item created",
+			state:          mapTaskState{itemCount: 5, createdCount: 5, completedCount: 4},
+			maxParallelism: 2,
+			from:           5,
+			to:             5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.state.nextItems(tt.maxParallelism)
+
+			assert.Equal(t, tt.from, from)
+			assert.Equal(t, tt.to, to)
+		})
+	}
+}
+
+func TestNewMapTaskOutput(t *testing.T) {
+	output, err := newMapTaskOutput(3, map[int]*TaskOutputEvent{
+		2: {EventType: sqlcv1.V1TaskEventTypeCOMPLETED, Output: []byte(`{"n":2}`)},
+		0: {EventType: sqlcv1.V1TaskEventTypeCOMPLETED, Output: []byte(`{"n":0}`)},
+		1: {EventType: sqlcv1.V1TaskEventTypeCOMPLETED},
+	})
+
+	require.NoError(t, err)
+
+	// the outputs are in the order of the items, regardless of the order in which they completed
+	assert.JSONEq(t, `{"outputs":[{"n":0},null,{"n":2}]}`, string(output))
+
+	_, err = newMapTaskOutput(2, map[int]*TaskOutputEvent{
+		0: {EventType: sqlcv1.V1TaskEventTypeCOMPLETED},
+	})
+
+	assert.ErrorContains(t, err, "missing output for map item 1")
+}
+
+func TestGetMapItemOutput(t *testing.T) {
+	output := &TaskOutputEvent{
+		EventType:    sqlcv1.V1TaskEventTypeFAILED,
+		ErrorMessage: "boom",
+	}
+
+	outputBytes, err := json.Marshal(output)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(map[string]map[string][]json.RawMessage{
+		string(sqlcv1.V1MatchConditionActionCREATE): {
+			"process-file": {outputBytes},
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := getMapItemOutput(data)
+
+	require.NoError(t, err)
+	assert.True(t, res.IsFailed())
+	assert.Equal(t, "map item 4 failed: boom", getMapItemError(4, res))
+
+	_, err = getMapItemOutput([]byte(`{"CREATE":{}}`))
+
+	assert.Error(t, err)
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 
-	"github.com/google/cel-go/cel"
+	celgo "github.com/google/cel-go/cel"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
@@ -62,6 +64,10 @@ type CreateMatchOpts struct {
 
 	TriggerChildKey pgtype.Text
 
+	// Whether the conditions of the trigger step are registered in a new match once this match is queued,
+	// instead of being part of this match
+	TriggerDeferredStepConditions bool
+
 	SignalTaskId *int64
 
 	SignalTaskInsertedAt pgtype.Timestamptz
@@ -77,6 +83,9 @@ type InternalEventMatchResults struct {
 
 	// The list of tasks which were replayed from the matches
 	ReplayedTasks []*sqlcv1.V1Task
+
+	// The list of tasks which received a signal from the matches
+	SignaledTasks []TaskIdInsertedAtRetryCount
 }
 
 type GroupMatchCondition struct {
@@ -98,10 +107,34 @@ type GroupMatchCondition struct {
 
 	// (optional) the data which was used to satisfy the condition (relevant for replays)
 	Data []byte
+
+	// (optional) whether the condition is already satisfied, for data which is carried over from another match
+	IsSatisfied bool
 }
 
 type MatchRepository interface {
 	ProcessInternalEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error)
+
+	ProcessUserEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error)
+
+	// ProcessDurableSleeps satisfies the match conditions for any durable sleeps which have expired. It returns
+	// true if there may be more sleeps to process.
+	ProcessDurableSleeps(ctx context.Context, tenantId string) (*InternalEventMatchResults, bool, error)
+
+	// RegisterDurableEvent registers the wait conditions for a durable task and releases the task's slot
+	// until one of the conditions is satisfied.
+	RegisterDurableEvent(ctx context.Context, tenantId string, opts RegisterDurableEventOpts) (*RegisterDurableEventResult, error)
+
+	// ResumeDurableEvents checks whether the wait conditions for a batch of durable tasks have been satisfied.
+	// The tasks whose waits have been satisfied are assigned back to their workers once the workers have a free
+	// slot, and the data of the satisfied conditions is returned. Waits which have not been satisfied yet, or
+	// whose workers don't have a free slot yet, are not part of the result.
+	ResumeDurableEvents(ctx context.Context, tenantId string, opts []ResumeDurableEventOpts) (*ResumeDurableEventsResult, error)
+
+	// UnassignResumedDurableTask unassigns a resumed durable task from its worker if the result of the wait
+	// could not be delivered to the worker, so that the wait is resumed again once the worker listens for it.
+	// It returns false if the task is no longer assigned to the worker.
+	UnassignResumedDurableTask(ctx context.Context, tenantId string, resumed *DurableEventResult) (bool, error)
 }
 
 type MatchRepositoryImpl struct {
@@ -137,7 +170,34 @@ func (m *MatchRepositoryImpl) ProcessInternalEventMatches(ctx context.Context, t
 	return res, nil
 }
 
+// ProcessUserEventMatches processes a list of user events
+func (m *MatchRepositoryImpl) ProcessUserEventMatches(ctx context.Context, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, m.pool, m.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	res, err := m.processEventMatches(ctx, tx, tenantId, events, sqlcv1.V1EventTypeUSER)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx sqlcv1.DBTX, tenantId string, events []CandidateEventMatch) (*InternalEventMatchResults, error) {
+	return m.processEventMatches(ctx, tx, tenantId, events, sqlcv1.V1EventTypeINTERNAL)
+}
+
+func (m *sharedRepository) processEventMatches(ctx context.Context, tx sqlcv1.DBTX, tenantId string, events []CandidateEventMatch, eventType sqlcv1.V1EventType) (*InternalEventMatchResults, error) {
 	start := time.Now()
 
 	res := &InternalEventMatchResults{}
@@ -172,7 +232,7 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 		tx,
 		sqlcv1.ListMatchConditionsForEventParams{
 			Tenantid:           sqlchelpers.UUIDFromStr(tenantId),
-			Eventtype:          sqlcv1.V1EventTypeINTERNAL,
+			Eventtype:          eventType,
 			Eventkeys:          eventKeys,
 			Eventresourcehints: resourceHints,
 		},
@@ -272,23 +332,38 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 
 		dagIdsToInput := make(map[int64][]byte)
 		dagIdsToMetadata := make(map[int64][]byte)
+		dagIdsToEventKey := make(map[int64]*string)
 
 		for _, dagData := range dagInputDatas {
 			dagIdsToInput[dagData.DagID] = dagData.Input
 			dagIdsToMetadata[dagData.DagID] = dagData.AdditionalMetadata
+
+			if dagData.EventKey.Valid {
+				dagIdsToEventKey[dagData.DagID] = &dagData.EventKey.String
+			}
+		}
+
+		stepIdsToConditions, err := m.listDeferredStepConditions(ctx, tx, tenantId, satisfiedMatches)
+
+		if err != nil {
+			return nil, err
 		}
 
 		// determine which tasks to create based on step ids
 		createTaskOpts := make([]CreateTaskOpts, 0, len(satisfiedMatches))
 		replayTaskOpts := make([]ReplayTaskOpts, 0, len(satisfiedMatches))
+		deferredStepMatches := make([]CreateMatchOpts, 0)
+		deferredStepSleeps := make([]pendingStepSleep, 0)
 
 		for _, match := range satisfiedMatches {
 			if match.TriggerStepID.Valid && match.TriggerExternalID.Valid {
 				var input, additionalMetadata []byte
+				var eventKey *string
 
 				if match.TriggerDagID.Valid {
 					input = dagIdsToInput[match.TriggerDagID.Int64]
 					additionalMetadata = dagIdsToMetadata[match.TriggerDagID.Int64]
+					eventKey = dagIdsToEventKey[match.TriggerDagID.Int64]
 				}
 
 				matchData, err := NewMatchData(match.McAggregatedData)
@@ -297,14 +372,36 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 					return nil, err
 				}
 
+				stepConditions := stepIdsToConditions[sqlchelpers.UUIDToStr(match.TriggerStepID)]
+
+				// the parents of the step have completed, so the step's own conditions are registered now
+				if match.TriggerDeferredStepConditions && len(stepConditions) > 0 && matchData.Action() == sqlcv1.V1MatchConditionActionQUEUE {
+					opt, sleeps, err := getDeferredStepMatch(match, matchData, stepConditions)
+
+					if err != nil {
+						return nil, err
+					}
+
+					deferredStepMatches = append(deferredStepMatches, opt)
+					deferredStepSleeps = append(deferredStepSleeps, sleeps...)
+
+					continue
+				}
+
 				if match.TriggerExistingTaskID.Valid {
 					opt := ReplayTaskOpts{
 						TaskId:             match.TriggerExistingTaskID.Int64,
 						InsertedAt:         match.TriggerExistingTaskInsertedAt,
 						ExternalId:         sqlchelpers.UUIDToStr(match.TriggerExternalID),
+						WorkflowRunId:      sqlchelpers.UUIDToStr(match.TriggerWorkflowRunID),
 						StepId:             sqlchelpers.UUIDToStr(match.TriggerStepID),
 						AdditionalMetadata: additionalMetadata,
 						InitialState:       sqlcv1.V1TaskInitialStateQUEUED,
+						EventKey:           eventKey,
+					}
+
+					if match.TriggerDagID.Valid {
+						opt.DagId = &match.TriggerDagID.Int64
 					}
 
 					switch matchData.Action() {
@@ -325,6 +422,7 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 						StepId:             sqlchelpers.UUIDToStr(match.TriggerStepID),
 						StepIndex:          int(match.TriggerStepIndex.Int64),
 						AdditionalMetadata: additionalMetadata,
+						EventKey:           eventKey,
 					}
 
 					switch matchData.Action() {
@@ -369,6 +467,20 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 			}
 		}
 
+		if len(deferredStepMatches) > 0 {
+			err = m.createStepSleeps(ctx, tx, tenantId, deferredStepSleeps)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to create step sleeps: %w", err)
+			}
+
+			err = m.createEventMatches(ctx, tx, tenantId, deferredStepMatches)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to create deferred step matches: %w", err)
+			}
+		}
+
 		// create tasks
 		tasks, err = m.createTasks(ctx, tx, tenantId, createTaskOpts)
 
@@ -423,12 +535,53 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 		if err != nil {
 			return nil, fmt.Errorf("failed to create signal completed events: %w", err)
 		}
+
+		err = m.recordMapItemSignals(ctx, tx, tenantId, taskIds, eventKeys, datas)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to record map item signals: %w", err)
+		}
+
+		res.SignaledTasks = taskIds
 	}
 
 	end := time.Now()
 
 	if end.Sub(start) > 100*time.Millisecond {
-		m.l.Warn().Msgf("processing internal event matches took %s", end.Sub(start))
+		m.l.Warn().Msgf("processing %s event matches took %s", strings.ToLower(string(eventType)), end.Sub(start))
+	}
+
+	return res, nil
+}
+
+// listDeferredStepConditions lists the conditions of the steps whose conditions were deferred until the
+// satisfied matches were queued, keyed by step id.
+func (m *sharedRepository) listDeferredStepConditions(ctx context.Context, tx sqlcv1.DBTX, tenantId string, satisfiedMatches []*sqlcv1.SaveSatisfiedMatchConditionsRow) (map[string][]*sqlcv1.StepMatchCondition, error) {
+	res := make(map[string][]*sqlcv1.StepMatchCondition)
+	stepIds := make([]pgtype.UUID, 0)
+
+	for _, match := range satisfiedMatches {
+		if match.TriggerDeferredStepConditions && match.TriggerStepID.Valid {
+			stepIds = append(stepIds, match.TriggerStepID)
+		}
+	}
+
+	if len(stepIds) == 0 {
+		return res, nil
+	}
+
+	stepConditions, err := m.queries.ListStepMatchConditions(ctx, tx, sqlcv1.ListStepMatchConditionsParams{
+		Stepids:  stepIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list step match conditions: %w", err)
+	}
+
+	for _, condition := range stepConditions {
+		stepId := sqlchelpers.UUIDToStr(condition.StepId)
+		res[stepId] = append(res[stepId], condition)
 	}
 
 	return res, nil
@@ -436,17 +589,11 @@ func (m *sharedRepository) processInternalEventMatches(ctx context.Context, tx s
 
 func (m *sharedRepository) processCELExpressions(ctx context.Context, events []CandidateEventMatch, conditions []*sqlcv1.ListMatchConditionsForEventRow) (map[string][]*sqlcv1.ListMatchConditionsForEventRow, error) {
 	// parse CEL expressions
-	programs := make(map[int64]cel.Program)
+	programs := make(map[int64]celgo.Program)
 	conditionIdsToConditions := make(map[int64]*sqlcv1.ListMatchConditionsForEventRow)
 
 	for _, condition := range conditions {
-		ast, issues := m.env.Compile(condition.Expression.String)
-
-		if issues != nil {
-			return nil, issues.Err()
-		}
-
-		program, err := m.env.Program(ast)
+		program, err := m.celParser.ParseEvent(condition.Expression.String)
 
 		if err != nil {
 			return nil, err
@@ -479,13 +626,14 @@ func (m *sharedRepository) processCELExpressions(ctx context.Context, events []C
 				continue
 			}
 
-			if condition.EventResourceHint.Valid && condition.EventResourceHint.String != *event.ResourceHint {
+			if condition.EventResourceHint.Valid && (event.ResourceHint == nil || condition.EventResourceHint.String != *event.ResourceHint) {
 				continue
 			}
 
-			out, _, err := program.ContextEval(ctx, map[string]interface{}{
-				"input": inputData,
-			})
+			out, _, err := program.ContextEval(ctx, map[string]interface{}(cel.NewInput(
+				cel.WithInput(inputData),
+				cel.WithEventKey(event.Key),
+			)))
 
 			if err != nil {
 				return nil, err
@@ -517,11 +665,13 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 	triggerParentTaskInsertedAts := make([]pgtype.Timestamptz, 0, len(eventMatches))
 	triggerChildIndices := make([]pgtype.Int8, 0, len(eventMatches))
 	triggerChildKeys := make([]pgtype.Text, 0, len(eventMatches))
+	triggerDeferredStepConditions := make([]bool, 0, len(eventMatches))
 
 	signalTenantIds := make([]pgtype.UUID, 0, len(eventMatches))
 	signalKinds := make([]string, 0, len(eventMatches))
 	signalTaskIds := make([]int64, 0, len(eventMatches))
 	signalTaskInsertedAts := make([]pgtype.Timestamptz, 0, len(eventMatches))
+	signalExternalIds := make([]pgtype.UUID, 0, len(eventMatches))
 	signalKeys := make([]string, 0, len(eventMatches))
 
 	for _, match := range eventMatches {
@@ -539,6 +689,7 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 			triggerParentTaskInsertedAts = append(triggerParentTaskInsertedAts, match.TriggerParentTaskInsertedAt)
 			triggerChildIndices = append(triggerChildIndices, match.TriggerChildIndex)
 			triggerChildKeys = append(triggerChildKeys, match.TriggerChildKey)
+			triggerDeferredStepConditions = append(triggerDeferredStepConditions, match.TriggerDeferredStepConditions)
 
 			if match.TriggerExistingTaskId != nil {
 				triggerExistingTaskIds = append(triggerExistingTaskIds, pgtype.Int8{Int64: *match.TriggerExistingTaskId, Valid: true})
@@ -559,6 +710,12 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 			signalTaskIds = append(signalTaskIds, *match.SignalTaskId)
 			signalTaskInsertedAts = append(signalTaskInsertedAts, match.SignalTaskInsertedAt)
 			signalKeys = append(signalKeys, *match.SignalKey)
+
+			if match.SignalExternalId != nil {
+				signalExternalIds = append(signalExternalIds, sqlchelpers.UUIDFromStr(*match.SignalExternalId))
+			} else {
+				signalExternalIds = append(signalExternalIds, pgtype.UUID{})
+			}
 		}
 	}
 
@@ -584,6 +741,7 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 				TriggerParentTaskInsertedAt:   triggerParentTaskInsertedAts,
 				TriggerChildIndex:             triggerChildIndices,
 				TriggerChildKey:               triggerChildKeys,
+				TriggerDeferredStepConditions: triggerDeferredStepConditions,
 			},
 		)
 
@@ -603,6 +761,7 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 				Kinds:                 signalKinds,
 				Signaltaskids:         signalTaskIds,
 				Signaltaskinsertedats: signalTaskInsertedAts,
+				Signalexternalids:     signalExternalIds,
 				Signalkeys:            signalKeys,
 			},
 		)
@@ -634,7 +793,7 @@ func (m *sharedRepository) createEventMatches(ctx context.Context, tx sqlcv1.DBT
 				OrGroupID:       sqlchelpers.UUIDFromStr(condition.GroupId),
 				Expression:      sqlchelpers.TextFromStr(condition.Expression),
 				Action:          condition.Action,
-				IsSatisfied:     false,
+				IsSatisfied:     condition.IsSatisfied,
 				Data:            condition.Data,
 			}
 
@@ -33,6 +33,17 @@ func (m *MatchData) DataKeys() []string {
 	return keys
 }
 
+// DataValue returns the first data value for a readable data key, or nil if there is none
+func (m *MatchData) DataValue(key string) interface{} {
+	values := m.dataKeys[key]
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values[0]
+}
+
 // Helper function for internal events
 func (m *MatchData) DataValueAsTaskOutputEvent(key string) *TaskOutputEvent {
 	values := m.dataKeys[key]
@@ -62,9 +62,20 @@ type ListWorkflowRunOpts struct {
 
 	Offset int64
 
+	// (optional) if set, only runs which come after the cursor in the (inserted_at, id) descending order are listed
+	After *WorkflowRunCursor
+
 	ParentTaskExternalId *pgtype.UUID
 }
 
+// WorkflowRunCursor is the position of a workflow run in the (inserted_at, id) order of the OLAP tables. It is
+// used to page through workflow runs whose statuses may change while they are being listed.
+type WorkflowRunCursor struct {
+	InsertedAt time.Time `json:"inserted_at"`
+
+	Id int64 `json:"id"`
+}
+
 type ReadTaskRunMetricsOpts struct {
 	CreatedAfter time.Time
 
@@ -185,6 +196,9 @@ type OLAPRepository interface {
 
 	ListTasks(ctx context.Context, tenantId string, opts ListTaskRunOpts) ([]*sqlcv1.PopulateTaskRunDataRow, int, error)
 	ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error)
+
+	// ListWorkflowRunIds lists the ids of the workflow runs which match the options, without populating or counting them.
+	ListWorkflowRunIds(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*sqlcv1.FetchWorkflowRunIdsRow, error)
 	ListTaskRunEvents(ctx context.Context, tenantId string, taskId int64, taskInsertedAt pgtype.Timestamptz, limit, offset int64) ([]*sqlcv1.ListTaskEventsRow, error)
 	ListTaskRunEventsByWorkflowRunId(ctx context.Context, tenantId string, workflowRunId pgtype.UUID) ([]*sqlcv1.ListTaskEventsForWorkflowRunRow, error)
 	ListWorkflowRunDisplayNames(ctx context.Context, tenantId pgtype.UUID, externalIds []pgtype.UUID) ([]*sqlcv1.ListWorkflowRunDisplayNamesRow, error)
@@ -193,6 +207,8 @@ type OLAPRepository interface {
 	CreateTaskEvents(ctx context.Context, tenantId string, events []sqlcv1.CreateTaskEventsOLAPParams) error
 	CreateDAGs(ctx context.Context, tenantId string, dags []*DAGWithData) error
 	GetTaskPointMetrics(ctx context.Context, tenantId string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*sqlcv1.GetTaskPointMetricsRow, error)
+	SampleRateLimits(ctx context.Context, tenantId string) error
+	GetRateLimitPointMetrics(ctx context.Context, tenantId string, key string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*sqlcv1.ListRateLimitSamplesRow, error)
 	UpdateTaskStatuses(ctx context.Context, tenantId string) (bool, []UpdateTaskStatusRow, error)
 	UpdateDAGStatuses(ctx context.Context, tenantId string) (bool, []UpdateDAGStatusRow, error)
 	ReadDAG(ctx context.Context, dagExternalId string) (*sqlcv1.V1DagsOlap, error)
@@ -618,15 +634,7 @@ func (r *OLAPRepositoryImpl) ListTasksByIdAndInsertedAt(ctx context.Context, ten
 	return tasksWithData, nil
 }
 
-func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error) {
-	tx, err := r.pool.Begin(ctx)
-
-	if err != nil {
-		return nil, 0, err
-	}
-
-	defer tx.Rollback(ctx)
-
+func toFetchWorkflowRunIdsParams(tenantId string, opts ListWorkflowRunOpts) (sqlcv1.FetchWorkflowRunIdsParams, sqlcv1.CountWorkflowRunsParams) {
 	params := sqlcv1.FetchWorkflowRunIdsParams{
 		Tenantid:               sqlchelpers.UUIDFromStr(tenantId),
 		Since:                  sqlchelpers.TimestamptzFromTime(opts.CreatedAfter),
@@ -688,6 +696,31 @@ func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId stri
 		params.ParentTaskExternalId = *opts.ParentTaskExternalId
 	}
 
+	if opts.After != nil {
+		params.AfterInsertedAt = sqlchelpers.TimestamptzFromTime(opts.After.InsertedAt)
+		params.AfterId = pgtype.Int8{Int64: opts.After.Id, Valid: true}
+	}
+
+	return params, countParams
+}
+
+func (r *OLAPRepositoryImpl) ListWorkflowRunIds(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*sqlcv1.FetchWorkflowRunIdsRow, error) {
+	params, _ := toFetchWorkflowRunIdsParams(tenantId, opts)
+
+	return r.queries.FetchWorkflowRunIds(ctx, r.pool, params)
+}
+
+func (r *OLAPRepositoryImpl) ListWorkflowRuns(ctx context.Context, tenantId string, opts ListWorkflowRunOpts) ([]*WorkflowRunData, int, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	params, countParams := toFetchWorkflowRunIdsParams(tenantId, opts)
+
 	workflowRunIds, err := r.queries.FetchWorkflowRunIds(ctx, tx, params)
 
 	if err != nil {
@@ -1140,6 +1173,7 @@ func (r *OLAPRepositoryImpl) writeTaskBatch(ctx context.Context, tenantId string
 			DagInsertedAt:        task.DagInsertedAt,
 			ParentTaskExternalID: task.ParentTaskExternalID,
 			WorkflowRunID:        task.WorkflowRunID,
+			IdempotencyKey:       task.IdempotencyKey,
 		})
 	}
 
@@ -1168,6 +1202,7 @@ func (r *OLAPRepositoryImpl) writeDAGBatch(ctx context.Context, tenantId string,
 			Input:                dag.Input,
 			AdditionalMetadata:   dag.AdditionalMetadata,
 			ParentTaskExternalID: parentTaskExternalID,
+			IdempotencyKey:       dag.IdempotencyKey,
 		})
 	}
 
@@ -1207,6 +1242,56 @@ func (r *OLAPRepositoryImpl) GetTaskPointMetrics(ctx context.Context, tenantId s
 	return rows, nil
 }
 
+func (r *OLAPRepositoryImpl) SampleRateLimits(ctx context.Context, tenantId string) error {
+	rls, err := r.queries.ListRateLimitStatesForTenant(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return fmt.Errorf("could not list rate limits: %w", err)
+	}
+
+	if len(rls) == 0 {
+		return nil
+	}
+
+	params := sqlcv1.CreateRateLimitSamplesOLAPParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Keys:        make([]string, 0, len(rls)),
+		Values:      make([]int32, 0, len(rls)),
+		Limitvalues: make([]int32, 0, len(rls)),
+	}
+
+	// samples use the same state as the scheduler, so they are correct for every rate limit algorithm
+	for _, rl := range rls {
+		state := newRateLimitState(&rl.RateLimit, rl.WindowInterval)
+
+		params.Keys = append(params.Keys, rl.RateLimit.Key)
+		params.Values = append(params.Values, int32(state.Available(rl.Now.Time))) // nolint: gosec
+		params.Limitvalues = append(params.Limitvalues, int32(state.capacity()))   // nolint: gosec
+	}
+
+	return r.queries.CreateRateLimitSamplesOLAP(ctx, r.pool, params)
+}
+
+func (r *OLAPRepositoryImpl) GetRateLimitPointMetrics(ctx context.Context, tenantId string, key string, startTimestamp *time.Time, endTimestamp *time.Time, bucketInterval time.Duration) ([]*sqlcv1.ListRateLimitSamplesRow, error) {
+	rows, err := r.queries.ListRateLimitSamples(ctx, r.pool, sqlcv1.ListRateLimitSamplesParams{
+		Interval:      durationToPgInterval(bucketInterval),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Key:           key,
+		Sampledafter:  sqlchelpers.TimestamptzFromTime(*startTimestamp),
+		Sampledbefore: sqlchelpers.TimestamptzFromTime(*endTimestamp),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return []*sqlcv1.ListRateLimitSamplesRow{}, nil
+		}
+
+		return nil, err
+	}
+
+	return rows, nil
+}
+
 func (r *OLAPRepositoryImpl) ReadDAG(ctx context.Context, dagExternalId string) (*sqlcv1.V1DagsOlap, error) {
 	return r.queries.ReadDAGByExternalID(ctx, r.pool, sqlchelpers.UUIDFromStr(dagExternalId))
 }
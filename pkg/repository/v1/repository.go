@@ -19,18 +19,24 @@ type Repository interface {
 	Workers() WorkerRepository
 	Workflows() WorkflowRepository
 	Ticker() TickerRepository
+	BulkOperations() BulkOperationRepository
+	EventSchemas() EventSchemaRepository
+	Events() EventRepository
 }
 
 type repositoryImpl struct {
-	triggers  TriggerRepository
-	tasks     TaskRepository
-	scheduler SchedulerRepository
-	matches   MatchRepository
-	olap      OLAPRepository
-	logs      LogLineRepository
-	workers   WorkerRepository
-	workflows WorkflowRepository
-	ticker    TickerRepository
+	triggers     TriggerRepository
+	tasks        TaskRepository
+	scheduler    SchedulerRepository
+	matches      MatchRepository
+	olap         OLAPRepository
+	logs         LogLineRepository
+	workers      WorkerRepository
+	workflows    WorkflowRepository
+	ticker       TickerRepository
+	bulkOps      BulkOperationRepository
+	eventSchemas EventSchemaRepository
+	events       EventRepository
 }
 
 func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger, taskRetentionPeriod, olapRetentionPeriod time.Duration, maxInternalRetryCount int32) (Repository, func() error) {
@@ -45,15 +51,18 @@ func NewRepository(pool *pgxpool.Pool, l *zerolog.Logger, taskRetentionPeriod, o
 	}
 
 	impl := &repositoryImpl{
-		triggers:  newTriggerRepository(shared),
-		tasks:     newTaskRepository(shared, taskRetentionPeriod, maxInternalRetryCount),
-		scheduler: newSchedulerRepository(shared),
-		matches:   matchRepo,
-		olap:      newOLAPRepository(shared, olapRetentionPeriod),
-		logs:      newLogLineRepository(shared),
-		workers:   newWorkerRepository(shared),
-		workflows: newWorkflowRepository(shared),
-		ticker:    newTickerRepository(shared),
+		triggers:     newTriggerRepository(shared),
+		tasks:        newTaskRepository(shared, taskRetentionPeriod, maxInternalRetryCount),
+		scheduler:    newSchedulerRepository(shared),
+		matches:      matchRepo,
+		olap:         newOLAPRepository(shared, olapRetentionPeriod),
+		logs:         newLogLineRepository(shared),
+		workers:      newWorkerRepository(shared),
+		workflows:    newWorkflowRepository(shared),
+		ticker:       newTickerRepository(shared),
+		bulkOps:      newBulkOperationRepository(shared),
+		eventSchemas: newEventSchemaRepository(shared),
+		events:       newEventRepository(shared),
 	}
 
 	return impl, func() error {
@@ -100,3 +109,15 @@ func (r *repositoryImpl) Workflows() WorkflowRepository {
 func (r *repositoryImpl) Ticker() TickerRepository {
 	return r.ticker
 }
+
+func (r *repositoryImpl) BulkOperations() BulkOperationRepository {
+	return r.bulkOps
+}
+
+func (r *repositoryImpl) EventSchemas() EventSchemaRepository {
+	return r.eventSchemas
+}
+
+func (r *repositoryImpl) Events() EventRepository {
+	return r.events
+}
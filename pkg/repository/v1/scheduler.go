@@ -35,12 +35,60 @@ type QueueRepository interface {
 
 	GetTaskRateLimits(ctx context.Context, queueItems []*sqlcv1.V1QueueItem) (map[int64]map[string]int32, error)
 	GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) (map[string][]*sqlcv1.GetDesiredLabelsRow, error)
+
+	// GetStepSlots returns the number of worker slots which a run of each step uses, keyed by step id.
+	GetStepSlots(ctx context.Context, stepIds []pgtype.UUID) (map[string]StepSlots, error)
 	Cleanup()
 }
 
+// StepSlots is the number of worker slots which a run of a step uses, and the slot pool which they are taken
+// from. An empty slot pool is the default slot pool of a worker, which has the worker's max runs.
+type StepSlots struct {
+	Slots    int
+	SlotPool string
+}
+
+type RateLimitState struct {
+	// Value is the number of units which were left at LastRefill. For sliding window rate limits, this
+	// does not include the units consumed in the previous window, use Available to get the units which
+	// can be consumed.
+	Value int
+
+	// ParentKey is the key of the parent rate limit, or an empty string if the rate limit has no parent.
+	// Consuming units of a rate limit also consumes units of its parent.
+	ParentKey string
+
+	// Algorithm is the algorithm used to refill the rate limit. An empty algorithm is treated as a
+	// fixed window.
+	Algorithm sqlcv1.RateLimitAlgorithm
+
+	// LimitValue is the number of units which are refilled in each window
+	LimitValue int
+
+	// Burst is the capacity of a token bucket rate limit. If zero, the capacity is the limit value.
+	Burst int
+
+	// Window is the duration of the rate limit window. Rate limits without a window are never refilled.
+	Window time.Duration
+
+	// LastRefill is the time of the last refill, or the start of the current window for sliding window
+	// rate limits.
+	LastRefill time.Time
+
+	// PreviousUsed is the number of units consumed in the previous window of a sliding window rate limit
+	PreviousUsed int
+}
+
 type RateLimitRepository interface {
 	ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error)
-	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, *time.Time, error)
+	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]RateLimitState, *time.Time, error)
+
+	// DeleteRateLimit deletes a static rate limit. Rate limits which are still used by a step or which are the
+	// parent of another rate limit cannot be deleted.
+	DeleteRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error)
+
+	// ResetRateLimit refills a rate limit to its limit value and restarts its window.
+	ResetRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error)
 }
 
 type AssignmentRepository interface {
@@ -3,6 +3,11 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log"
+	"math"
+
+	celgo "github.com/google/cel-go/cel"
+	lru "github.com/hashicorp/golang-lru/v2"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
@@ -43,15 +48,34 @@ type ConcurrencyRepository interface {
 	UpdateConcurrencyStrategyIsActive(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv1.V1StepConcurrency) error
 
 	RunConcurrencyStrategy(ctx context.Context, tenantId pgtype.UUID, strategy *sqlcv1.V1StepConcurrency) (*RunConcurrencyResult, error)
+
+	// Sets the weight of a concurrency key for WEIGHTED_ROUND_ROBIN strategies in the tenant
+	UpsertConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string, weight int32) error
+
+	// Removes the weight of a concurrency key, so that the key falls back to its weight expression
+	DeleteConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string) error
+
+	ListConcurrencyKeyWeights(ctx context.Context, tenantId pgtype.UUID, keys []string) ([]*sqlcv1.V1ConcurrencyKeyWeight, error)
 }
 
 type ConcurrencyRepositoryImpl struct {
 	*sharedRepository
+
+	// weightExpressionCache caches the compiled weight expressions of weighted round-robin strategies, keyed
+	// by strategy id
+	weightExpressionCache *lru.Cache[int64, celgo.Program]
 }
 
 func newConcurrencyRepository(s *sharedRepository) ConcurrencyRepository {
+	weightExpressionCache, err := lru.New[int64, celgo.Program](1000)
+
+	if err != nil {
+		log.Fatalf("failed to create LRU cache: %v", err)
+	}
+
 	return &ConcurrencyRepositoryImpl{
-		sharedRepository: s,
+		sharedRepository:      s,
+		weightExpressionCache: weightExpressionCache,
 	}
 }
 
@@ -113,11 +137,39 @@ func (c *ConcurrencyRepositoryImpl) RunConcurrencyStrategy(
 		return c.runCancelInProgress(ctx, tenantId, strategy)
 	case sqlcv1.V1ConcurrencyStrategyCANCELNEWEST:
 		return c.runCancelNewest(ctx, tenantId, strategy)
+	case sqlcv1.V1ConcurrencyStrategyWEIGHTEDROUNDROBIN:
+		return c.runWeightedRoundRobin(ctx, tenantId, strategy)
 	}
 
 	return nil, nil
 }
 
+func (c *ConcurrencyRepositoryImpl) UpsertConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string, weight int32) error {
+	if weight < 1 {
+		return fmt.Errorf("concurrency key weight must be at least 1, got %d", weight)
+	}
+
+	return c.queries.UpsertConcurrencyKeyWeight(ctx, c.pool, sqlcv1.UpsertConcurrencyKeyWeightParams{
+		Tenantid: tenantId,
+		Key:      key,
+		Weight:   weight,
+	})
+}
+
+func (c *ConcurrencyRepositoryImpl) DeleteConcurrencyKeyWeight(ctx context.Context, tenantId pgtype.UUID, key string) error {
+	return c.queries.DeleteConcurrencyKeyWeight(ctx, c.pool, sqlcv1.DeleteConcurrencyKeyWeightParams{
+		Tenantid: tenantId,
+		Key:      key,
+	})
+}
+
+func (c *ConcurrencyRepositoryImpl) ListConcurrencyKeyWeights(ctx context.Context, tenantId pgtype.UUID, keys []string) ([]*sqlcv1.V1ConcurrencyKeyWeight, error) {
+	return c.queries.ListConcurrencyKeyWeights(ctx, c.pool, sqlcv1.ListConcurrencyKeyWeightsParams{
+		Tenantid: tenantId,
+		Keys:     keys,
+	})
+}
+
 func (c *ConcurrencyRepositoryImpl) runGroupRoundRobin(
 	ctx context.Context,
 	tenantId pgtype.UUID,
@@ -686,3 +738,273 @@ WHERE tenant_id = $1::uuid AND strategy_id = $2::bigint;`,
 		NextConcurrencyStrategies: nextConcurrencyStrategies,
 	}, nil
 }
+
+// getWeightedMaxRuns returns the maximum number of running tasks for each concurrency key of a weighted
+// round-robin strategy, which is maxRuns multiplied by the weight of the key. Weights from the tenant's
+// weight table take precedence over the weight expression, and weights less than 1 are treated as 1.
+func getWeightedMaxRuns(keys []string, maxRuns int32, tenantWeights map[string]int32, evalWeight func(key string) int) []int32 {
+	res := make([]int32, len(keys))
+
+	for i, key := range keys {
+		weight, ok := tenantWeights[key]
+
+		if !ok && evalWeight != nil {
+			evaluated := evalWeight(key)
+
+			if evaluated > math.MaxInt32 {
+				evaluated = math.MaxInt32
+			}
+
+			weight = int32(evaluated) // nolint: gosec
+		}
+
+		if weight < 1 {
+			weight = 1
+		}
+
+		keyMaxRuns := int64(maxRuns) * int64(weight)
+
+		if keyMaxRuns > math.MaxInt32 {
+			keyMaxRuns = math.MaxInt32
+		}
+
+		res[i] = int32(keyMaxRuns) // nolint: gosec
+	}
+
+	return res
+}
+
+// getWeightExpression returns the compiled weight expression of a weighted round-robin strategy, or nil if the
+// strategy has no weight expression or it can't be compiled. The expression of a strategy never changes, so it
+// is compiled once per strategy.
+func (c *ConcurrencyRepositoryImpl) getWeightExpression(strategy *sqlcv1.V1StepConcurrency) celgo.Program {
+	if !strategy.WeightExpression.Valid || strategy.WeightExpression.String == "" {
+		return nil
+	}
+
+	if prg, ok := c.weightExpressionCache.Get(strategy.ID); ok {
+		return prg
+	}
+
+	prg, err := c.celParser.ParseConcurrencyWeight(strategy.WeightExpression.String)
+
+	if err != nil {
+		c.l.Warn().Err(err).Msgf("could not parse weight expression for concurrency strategy %d, using a weight of 1", strategy.ID)
+		return nil
+	}
+
+	c.weightExpressionCache.Add(strategy.ID, prg)
+
+	return prg
+}
+
+// getKeyLimits returns the per-key limits for a weighted round-robin strategy for all keys which currently
+// have a slot.
+func (c *ConcurrencyRepositoryImpl) getKeyLimits(
+	ctx context.Context,
+	tx sqlcv1.DBTX,
+	tenantId pgtype.UUID,
+	strategy *sqlcv1.V1StepConcurrency,
+	keys []string,
+) ([]int32, error) {
+	if len(keys) == 0 {
+		return []int32{}, nil
+	}
+
+	weights, err := c.queries.ListConcurrencyKeyWeights(ctx, tx, sqlcv1.ListConcurrencyKeyWeightsParams{
+		Tenantid: tenantId,
+		Keys:     keys,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list concurrency key weights: %w", err)
+	}
+
+	tenantWeights := make(map[string]int32, len(weights))
+
+	for _, w := range weights {
+		tenantWeights[w.Key] = w.Weight
+	}
+
+	var evalWeight func(key string) int
+
+	if prg := c.getWeightExpression(strategy); prg != nil {
+		evalWeight = func(key string) int {
+			weight, err := c.celParser.EvalConcurrencyWeight(prg, key)
+
+			if err != nil {
+				c.l.Warn().Err(err).Msgf("could not evaluate weight expression for concurrency strategy %d, using a weight of 1", strategy.ID)
+				return 1
+			}
+
+			return weight
+		}
+	}
+
+	return getWeightedMaxRuns(keys, strategy.MaxConcurrency, tenantWeights, evalWeight), nil
+}
+
+func (c *ConcurrencyRepositoryImpl) runWeightedRoundRobin(
+	ctx context.Context,
+	tenantId pgtype.UUID,
+	strategy *sqlcv1.V1StepConcurrency,
+) (res *RunConcurrencyResult, err error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, c.pool, c.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	err = c.queries.ConcurrencyAdvisoryLock(ctx, tx, strategy.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var queued []TaskWithQueue
+	var cancelled []TaskWithCancelledReason
+	var nextConcurrencyStrategies []int64
+
+	if strategy.ParentStrategyID.Valid {
+		acquired, err := c.queries.TryConcurrencyAdvisoryLock(ctx, tx, PARENT_STRATEGY_LOCK_OFFSET+strategy.ParentStrategyID.Int64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if acquired {
+			keys, err := c.queries.ListParentConcurrencyKeys(ctx, tx, sqlcv1.ListParentConcurrencyKeysParams{
+				Tenantid:   tenantId,
+				Strategyid: strategy.ParentStrategyID.Int64,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			keyMaxRuns, err := c.getKeyLimits(ctx, tx, tenantId, strategy, keys)
+
+			if err != nil {
+				return nil, err
+			}
+
+			err = c.queries.RunParentWeightedRoundRobin(ctx, tx, sqlcv1.RunParentWeightedRoundRobinParams{
+				Tenantid:   tenantId,
+				Strategyid: strategy.ParentStrategyID.Int64,
+				Keys:       keys,
+				Maxruns:    keyMaxRuns,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the child strategy only follows the parent slots, so it's the same as for group round-robin
+		poppedResults, err := c.queries.RunChildGroupRoundRobin(ctx, tx, sqlcv1.RunChildGroupRoundRobinParams{
+			Tenantid:         tenantId,
+			Strategyid:       strategy.ID,
+			Parentstrategyid: strategy.ParentStrategyID.Int64,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		queued = make([]TaskWithQueue, 0, len(poppedResults))
+		cancelled = make([]TaskWithCancelledReason, 0, len(poppedResults))
+		nextConcurrencyStrategies = make([]int64, 0, len(poppedResults))
+
+		for _, r := range poppedResults {
+			idRetryCount := &TaskIdInsertedAtRetryCount{
+				Id:         r.TaskID,
+				InsertedAt: r.TaskInsertedAt,
+				RetryCount: r.TaskRetryCount,
+			}
+
+			switch {
+			case len(r.NextStrategyIds) > 0:
+				nextConcurrencyStrategies = append(nextConcurrencyStrategies, r.NextStrategyIds[0])
+			case r.Operation == "SCHEDULING_TIMED_OUT":
+				cancelled = append(cancelled, TaskWithCancelledReason{
+					TaskIdInsertedAtRetryCount: idRetryCount,
+					CancelledReason:            "SCHEDULING_TIMED_OUT",
+					TaskExternalId:             sqlchelpers.UUIDToStr(r.ExternalID),
+					WorkflowRunId:              sqlchelpers.UUIDToStr(r.WorkflowRunID),
+				})
+			default:
+				queued = append(queued, TaskWithQueue{
+					TaskIdInsertedAtRetryCount: idRetryCount,
+					Queue:                      r.QueueToNotify,
+				})
+			}
+		}
+	} else {
+		keys, err := c.queries.ListConcurrencyKeys(ctx, tx, sqlcv1.ListConcurrencyKeysParams{
+			Tenantid:   tenantId,
+			Strategyid: strategy.ID,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		keyMaxRuns, err := c.getKeyLimits(ctx, tx, tenantId, strategy, keys)
+
+		if err != nil {
+			return nil, err
+		}
+
+		poppedResults, err := c.queries.RunWeightedRoundRobin(ctx, tx, sqlcv1.RunWeightedRoundRobinParams{
+			Tenantid:   tenantId,
+			Strategyid: strategy.ID,
+			Keys:       keys,
+			Maxruns:    keyMaxRuns,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		queued = make([]TaskWithQueue, 0, len(poppedResults))
+		cancelled = make([]TaskWithCancelledReason, 0, len(poppedResults))
+		nextConcurrencyStrategies = make([]int64, 0, len(poppedResults))
+
+		for _, r := range poppedResults {
+			idRetryCount := &TaskIdInsertedAtRetryCount{
+				Id:         r.TaskID,
+				InsertedAt: r.TaskInsertedAt,
+				RetryCount: r.TaskRetryCount,
+			}
+
+			switch {
+			case len(r.NextStrategyIds) > 0:
+				nextConcurrencyStrategies = append(nextConcurrencyStrategies, r.NextStrategyIds[0])
+			case r.Operation == "SCHEDULING_TIMED_OUT":
+				cancelled = append(cancelled, TaskWithCancelledReason{
+					TaskIdInsertedAtRetryCount: idRetryCount,
+					CancelledReason:            "SCHEDULING_TIMED_OUT",
+					TaskExternalId:             sqlchelpers.UUIDToStr(r.ExternalID),
+					WorkflowRunId:              sqlchelpers.UUIDToStr(r.WorkflowRunID),
+				})
+			default:
+				queued = append(queued, TaskWithQueue{
+					TaskIdInsertedAtRetryCount: idRetryCount,
+					Queue:                      r.QueueToNotify,
+				})
+			}
+		}
+	}
+
+	if err = commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &RunConcurrencyResult{
+		Queued:                    queued,
+		Cancelled:                 cancelled,
+		NextConcurrencyStrategies: nextConcurrencyStrategies,
+	}, nil
+}
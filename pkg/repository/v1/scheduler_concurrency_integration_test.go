@@ -0,0 +1,198 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// setupWeightedWorkflow creates a tenant with a single-step workflow which is limited by a weighted round-robin
+// strategy on the "tier" input, and returns the tenant id and the strategy.
+func setupWeightedWorkflow(t *testing.T, conf *database.Layer, weightExpression string) (string, *sqlcv1.V1StepConcurrency) {
+	t.Helper()
+
+	ctx := context.Background()
+	tenantId := uuid.New().String()
+	maxRuns := int32(1)
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	_, err = conf.APIRepository.Tenant().CreateTenant(ctx, &repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+	require.NoError(t, err)
+
+	_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "weighted-workflow",
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     "weighted:step",
+						Concurrency: []repository.CreateStepConcurrencyOpts{
+							{
+								Expression:       "input.tier",
+								MaxRuns:          &maxRuns,
+								LimitStrategy:    repository.StringPtr(string(sqlcv1.V1ConcurrencyStrategyWEIGHTEDROUNDROBIN)),
+								WeightExpression: repository.StringPtr(weightExpression),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	strategies, err := conf.V1.Scheduler().Lease().ListConcurrencyStrategies(ctx, sqlchelpers.UUIDFromStr(tenantId))
+	require.NoError(t, err)
+	require.Len(t, strategies, 1)
+
+	return tenantId, strategies[0]
+}
+
+func triggerWeightedRuns(t *testing.T, conf *database.Layer, tenantId, tier string, count int) {
+	t.Helper()
+
+	opts := make([]*v1.WorkflowNameTriggerOpts, 0, count)
+
+	for i := 0; i < count; i++ {
+		opts = append(opts, &v1.WorkflowNameTriggerOpts{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: "weighted-workflow",
+				Data:         []byte(fmt.Sprintf(`{"tier":%q}`, tier)),
+			},
+			ExternalId: uuid.New().String(),
+		})
+	}
+
+	_, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(context.Background(), tenantId, opts)
+	require.NoError(t, err)
+}
+
+// countQueuedByKey returns the number of filled concurrency slots for each key of the strategy.
+func countQueuedByKey(t *testing.T, conf *database.Layer, strategy *sqlcv1.V1StepConcurrency) map[string]int {
+	t.Helper()
+
+	rows, err := conf.Pool.Query(
+		context.Background(),
+		`SELECT key, COUNT(*) FROM v1_concurrency_slot WHERE strategy_id = $1 AND is_filled GROUP BY key`,
+		strategy.ID,
+	)
+	require.NoError(t, err)
+
+	defer rows.Close()
+
+	res := make(map[string]int)
+
+	for rows.Next() {
+		var key string
+		var count int
+
+		require.NoError(t, rows.Scan(&key, &count))
+
+		res[key] = count
+	}
+
+	require.NoError(t, rows.Err())
+
+	return res
+}
+
+func TestRunWeightedRoundRobin(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId, strategy := setupWeightedWorkflow(t, conf, `key == "gold" ? 2 : 1`)
+		tenantUUID := sqlchelpers.UUIDFromStr(tenantId)
+
+		triggerWeightedRuns(t, conf, tenantId, "gold", 3)
+		triggerWeightedRuns(t, conf, tenantId, "free", 3)
+
+		// the weight expression gives the "gold" key two slots
+		res, err := conf.V1.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, tenantUUID, strategy)
+		require.NoError(t, err)
+
+		assert.Len(t, res.Queued, 3)
+		assert.Empty(t, res.Cancelled)
+		assert.Equal(t, map[string]int{"gold": 2, "free": 1}, countQueuedByKey(t, conf, strategy))
+
+		// the tenant weight of a key takes precedence over the weight expression
+		err = conf.V1.Scheduler().Concurrency().UpsertConcurrencyKeyWeight(ctx, tenantUUID, "free", 3)
+		require.NoError(t, err)
+
+		res, err = conf.V1.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, tenantUUID, strategy)
+		require.NoError(t, err)
+
+		assert.Len(t, res.Queued, 2)
+		assert.Equal(t, map[string]int{"gold": 2, "free": 3}, countQueuedByKey(t, conf, strategy))
+
+		// removing the tenant weight does not unqueue slots which were already filled
+		err = conf.V1.Scheduler().Concurrency().DeleteConcurrencyKeyWeight(ctx, tenantUUID, "free")
+		require.NoError(t, err)
+
+		res, err = conf.V1.Scheduler().Concurrency().RunConcurrencyStrategy(ctx, tenantUUID, strategy)
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Queued)
+		assert.Equal(t, map[string]int{"gold": 2, "free": 3}, countQueuedByKey(t, conf, strategy))
+
+		return nil
+	})
+}
+
+func TestRunWeightedRoundRobinQuery(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId, strategy := setupWeightedWorkflow(t, conf, "")
+		tenantUUID := sqlchelpers.UUIDFromStr(tenantId)
+
+		triggerWeightedRuns(t, conf, tenantId, "gold", 3)
+		triggerWeightedRuns(t, conf, tenantId, "silver", 3)
+		triggerWeightedRuns(t, conf, tenantId, "free", 1)
+
+		tx, err := conf.Pool.Begin(ctx)
+		require.NoError(t, err)
+
+		defer tx.Rollback(ctx) // nolint: errcheck
+
+		// each key fills up to its own limit, and a key without a limit is not filled
+		popped, err := sqlcv1.New().RunWeightedRoundRobin(ctx, tx, sqlcv1.RunWeightedRoundRobinParams{
+			Tenantid:   tenantUUID,
+			Strategyid: strategy.ID,
+			Keys:       []string{"gold", "silver"},
+			Maxruns:    []int32{3, 1},
+		})
+		require.NoError(t, err)
+
+		queuedByKey := make(map[string]int)
+
+		for _, r := range popped {
+			assert.Equal(t, "RUNNING", r.Operation)
+			queuedByKey[r.Key]++
+		}
+
+		assert.Equal(t, map[string]int{"gold": 3, "silver": 1}, queuedByKey)
+
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestGetWeightedMaxRuns(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	evalWeight := func(key string) int {
+		weight, err := parser.ParseAndEvalConcurrencyWeight(`key == "tier=gold" ? 3 : 1`, key)
+
+		if err != nil {
+			t.Fatalf("could not evaluate weight: %v", err)
+		}
+
+		return weight
+	}
+
+	keys := []string{"tier=gold", "tier=free", "tier=silver"}
+
+	t.Run("weights from expression", func(t *testing.T) {
+		res := getWeightedMaxRuns(keys, 2, nil, evalWeight)
+
+		assert.Equal(t, []int32{6, 2, 2}, res)
+	})
+
+	t.Run("tenant weights take precedence", func(t *testing.T) {
+		res := getWeightedMaxRuns(keys, 2, map[string]int32{
+			"tier=gold":   5,
+			"tier=silver": 2,
+		}, evalWeight)
+
+		assert.Equal(t, []int32{10, 2, 4}, res)
+	})
+
+	t.Run("no weights is the same as group round robin", func(t *testing.T) {
+		res := getWeightedMaxRuns(keys, 2, nil, nil)
+
+		assert.Equal(t, []int32{2, 2, 2}, res)
+	})
+
+	t.Run("weights are at least 1", func(t *testing.T) {
+		res := getWeightedMaxRuns(keys, 2, map[string]int32{"tier=gold": 0}, func(key string) int {
+			return -5
+		})
+
+		assert.Equal(t, []int32{2, 2, 2}, res)
+	})
+
+	t.Run("limits do not overflow", func(t *testing.T) {
+		res := getWeightedMaxRuns([]string{"tier=gold"}, math.MaxInt32, nil, func(key string) int {
+			return math.MaxInt64
+		})
+
+		assert.Equal(t, []int32{math.MaxInt32}, res)
+	})
+}
+
+func TestGetWeightExpression(t *testing.T) {
+	l := zerolog.Nop()
+
+	c := newConcurrencyRepository(&sharedRepository{
+		l:         &l,
+		celParser: cel.NewCELParser(),
+	}).(*ConcurrencyRepositoryImpl)
+
+	strategy := &sqlcv1.V1StepConcurrency{
+		ID:               1,
+		WeightExpression: pgtype.Text{String: `key == "tier=gold" ? 3 : 1`, Valid: true},
+	}
+
+	prg := c.getWeightExpression(strategy)
+	require.NotNil(t, prg)
+
+	weight, err := c.celParser.EvalConcurrencyWeight(prg, "tier=gold")
+	require.NoError(t, err)
+	assert.Equal(t, 3, weight)
+
+	// the compiled expression is cached per strategy
+	cached, ok := c.weightExpressionCache.Get(strategy.ID)
+	require.True(t, ok)
+	assert.Equal(t, prg, cached)
+	assert.Equal(t, prg, c.getWeightExpression(strategy))
+
+	assert.Nil(t, c.getWeightExpression(&sqlcv1.V1StepConcurrency{ID: 2}))
+	assert.Nil(t, c.getWeightExpression(&sqlcv1.V1StepConcurrency{
+		ID:               3,
+		WeightExpression: pgtype.Text{String: `key ==`, Valid: true},
+	}))
+}
@@ -63,21 +63,25 @@ type queueRepository struct {
 	updateMinIdMu sync.Mutex
 
 	cachedStepIdHasRateLimit *cache.Cache
+	cachedStepIdSlots        *cache.Cache
 }
 
 func newQueueRepository(shared *sharedRepository, tenantId pgtype.UUID, queueName string) *queueRepository {
 	c := cache.New(5 * time.Minute)
+	slotsCache := cache.New(5 * time.Minute)
 
 	return &queueRepository{
 		sharedRepository:         shared,
 		tenantId:                 tenantId,
 		queueName:                queueName,
 		cachedStepIdHasRateLimit: c,
+		cachedStepIdSlots:        slotsCache,
 	}
 }
 
 func (d *queueRepository) Cleanup() {
 	d.cachedStepIdHasRateLimit.Stop()
+	d.cachedStepIdSlots.Stop()
 }
 
 func (d *queueRepository) setMinId(id int64) {
@@ -392,6 +396,7 @@ func (d *queueRepository) GetTaskRateLimits(ctx context.Context, queueItems []*s
 	for key, evals := range rateLimitKeyToEvals {
 		var duration string
 		var limitValue int
+		var parentKey string
 		var skip bool
 
 		for _, eval := range evals {
@@ -469,6 +474,11 @@ func (d *queueRepository) GetTaskRateLimits(ctx context.Context, queueItems []*s
 
 				taskIdToKeyToUnits[taskId][key] = eval.ValueInt.Int32
 			}
+
+			// a rate limit cannot be its own parent
+			if eval.Kind == sqlcv1.StepExpressionKindDYNAMICRATELIMITPARENT && eval.ValueStr.String != key {
+				parentKey = eval.ValueStr.String
+			}
 		}
 
 		if skip {
@@ -478,6 +488,7 @@ func (d *queueRepository) GetTaskRateLimits(ctx context.Context, queueItems []*s
 		upsertRateLimitBulkParams.Keys = append(upsertRateLimitBulkParams.Keys, key)
 		upsertRateLimitBulkParams.Windows = append(upsertRateLimitBulkParams.Windows, getWindowParamFromDurString(duration))
 		upsertRateLimitBulkParams.Limitvalues = append(upsertRateLimitBulkParams.Limitvalues, int32(limitValue)) // nolint: gosec
+		upsertRateLimitBulkParams.Parentkeys = append(upsertRateLimitBulkParams.Parentkeys, parentKey)
 	}
 
 	var stepRateLimits []*sqlcv1.StepRateLimit
@@ -557,6 +568,54 @@ func (d *queueRepository) GetDesiredLabels(ctx context.Context, stepIds []pgtype
 	return stepIdToLabels, nil
 }
 
+func (d *queueRepository) GetStepSlots(ctx context.Context, stepIds []pgtype.UUID) (map[string]StepSlots, error) {
+	ctx, span := telemetry.NewSpan(ctx, "get-step-slots")
+	defer span.End()
+
+	uniqueStepIds := sqlchelpers.UniqueSet(stepIds)
+
+	stepIdToSlots := make(map[string]StepSlots, len(uniqueStepIds))
+
+	// the slots of a step don't change, since a new workflow version creates new steps, so we only
+	// query the steps which aren't cached
+	stepIdsToQuery := make([]pgtype.UUID, 0, len(uniqueStepIds))
+
+	for _, stepId := range uniqueStepIds {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+
+		if slots, ok := d.cachedStepIdSlots.Get(stepIdStr); ok {
+			stepIdToSlots[stepIdStr] = slots.(StepSlots)
+			continue
+		}
+
+		stepIdsToQuery = append(stepIdsToQuery, stepId)
+	}
+
+	if len(stepIdsToQuery) == 0 {
+		return stepIdToSlots, nil
+	}
+
+	rows, err := d.queries.GetStepSlots(ctx, d.pool, stepIdsToQuery)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stepId := sqlchelpers.UUIDToStr(row.ID)
+
+		slots := StepSlots{
+			Slots:    int(row.Slots),
+			SlotPool: row.SlotPool.String,
+		}
+
+		stepIdToSlots[stepId] = slots
+		d.cachedStepIdSlots.Set(stepId, slots)
+	}
+
+	return stepIdToSlots, nil
+}
+
 func getLargerDuration(s1, s2 string) (string, error) {
 	i1, err := getDurationIndex(s1)
 	if err != nil {
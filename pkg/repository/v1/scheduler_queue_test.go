@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+)
+
+func TestGetStepSlotsCached(t *testing.T) {
+	// the repository has no database, so any step which isn't cached fails the test
+	d := newQueueRepository(&sharedRepository{}, pgtype.UUID{}, "default")
+	defer d.Cleanup()
+
+	stepId := sqlchelpers.UUIDFromStr("c0a4f1b4-5b5e-4f3b-9f4e-7a1f0f3c7e21")
+
+	d.cachedStepIdSlots.Set(sqlchelpers.UUIDToStr(stepId), StepSlots{Slots: 2, SlotPool: "gpu"})
+
+	res, err := d.GetStepSlots(context.Background(), []pgtype.UUID{stepId, stepId})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]StepSlots{
+		sqlchelpers.UUIDToStr(stepId): {Slots: 2, SlotPool: "gpu"},
+	}, res)
+}
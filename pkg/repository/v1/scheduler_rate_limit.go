@@ -2,15 +2,19 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const MAX_TENANT_RATE_LIMITS = 10000
 
+var ErrRateLimitInUse = errors.New("rate limit is used by a step or another rate limit")
+
 type rateLimitRepository struct {
 	*sharedRepository
 }
@@ -22,25 +26,13 @@ func newRateLimitRepository(shared *sharedRepository) *rateLimitRepository {
 }
 
 func (d *rateLimitRepository) ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error) {
-	rls, err := d.queries.ListRateLimitsForTenantNoMutate(ctx, d.pool, sqlcv1.ListRateLimitsForTenantNoMutateParams{
+	return d.queries.ListRateLimitKeysForTenant(ctx, d.pool, sqlcv1.ListRateLimitKeysForTenantParams{
 		Tenantid: tenantId,
 		Limit:    MAX_TENANT_RATE_LIMITS,
 	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	ids := make([]string, len(rls))
-
-	for i, rl := range rls {
-		ids[i] = rl.Key
-	}
-
-	return ids, nil
 }
 
-func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, *time.Time, error) {
+func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]RateLimitState, *time.Time, error) {
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
 
 	if err != nil {
@@ -49,49 +41,155 @@ func (d *rateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId pgt
 
 	defer rollback()
 
-	params := sqlcv1.BulkUpdateRateLimitsParams{
-		Tenantid: tenantId,
-		Keys:     make([]string, 0, len(updates)),
-		Units:    make([]int32, 0, len(updates)),
+	rls, err := d.queries.ListRateLimitStatesForTenant(ctx, tx, tenantId)
+
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for k, v := range updates {
-		params.Keys = append(params.Keys, k)
-		params.Units = append(params.Units, int32(v)) // nolint: gosec
+	// the rate limits are returned relative to the local clock, so the scheduler can refill them in
+	// memory without depending on the clock of the database
+	localNow := time.Now().UTC()
+
+	res := make(map[string]RateLimitState, len(rls))
+	nextRefillAt := localNow.Add(time.Second * 2)
+
+	setResult := func(key string, dbNow time.Time, state RateLimitState) {
+		state.LastRefill = localNow.Add(state.LastRefill.Sub(dbNow))
+		res[key] = state
+
+		if refillAt, ok := state.NextRefillAt(); ok && refillAt.Before(nextRefillAt) {
+			nextRefillAt = refillAt
+		}
 	}
 
-	_, err = d.queries.BulkUpdateRateLimits(ctx, tx, params)
+	// only the rate limits which are consumed or due for a refill are locked and written
+	keysToLock := make([]string, 0, len(updates))
 
-	if err != nil {
-		return nil, nil, err
+	for _, rl := range rls {
+		dbNow := rl.Now.Time
+		curr := newRateLimitState(&rl.RateLimit, rl.WindowInterval)
+
+		if _, ok := updates[rl.RateLimit.Key]; ok || curr.Refill(dbNow).ChangedFrom(curr) {
+			keysToLock = append(keysToLock, rl.RateLimit.Key)
+			continue
+		}
+
+		setResult(rl.RateLimit.Key, dbNow, curr)
 	}
 
-	newRls, err := d.queries.ListRateLimitsForTenantWithMutate(ctx, tx, tenantId)
+	if len(keysToLock) > 0 {
+		lockedRls, err := d.queries.ListRateLimitStatesForUpdate(ctx, tx, sqlcv1.ListRateLimitStatesForUpdateParams{
+			Tenantid: tenantId,
+			Keys:     keysToLock,
+		})
 
-	if err != nil {
-		return nil, nil, err
+		if err != nil {
+			return nil, nil, err
+		}
+
+		params := sqlcv1.BulkUpdateRateLimitStatesParams{
+			Tenantid: tenantId,
+		}
+
+		for _, rl := range lockedRls {
+			dbNow := rl.Now.Time
+			curr := newRateLimitState(&rl.RateLimit, rl.WindowInterval)
+
+			next := curr.Refill(dbNow)
+
+			if units, ok := updates[rl.RateLimit.Key]; ok {
+				next = next.Consume(dbNow, units)
+			}
+
+			if next.ChangedFrom(curr) {
+				params.Keys = append(params.Keys, rl.RateLimit.Key)
+				params.Values = append(params.Values, int32(next.Value)) // nolint: gosec
+				params.Lastrefills = append(params.Lastrefills, sqlchelpers.TimestampFromTime(next.LastRefill))
+				params.Previoususeds = append(params.Previoususeds, int32(next.PreviousUsed)) // nolint: gosec
+			}
+
+			setResult(rl.RateLimit.Key, dbNow, next)
+		}
+
+		if len(params.Keys) > 0 {
+			err = d.queries.BulkUpdateRateLimitStates(ctx, tx, params)
+
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 	}
 
 	if err := commit(ctx); err != nil {
 		return nil, nil, err
 	}
 
-	res := make(map[string]int, len(newRls))
+	return res, &nextRefillAt, nil
+}
 
-	for _, rl := range newRls {
-		res[rl.Key] = int(rl.Value)
+func newRateLimitState(rl *sqlcv1.RateLimit, window pgtype.Interval) RateLimitState {
+	return RateLimitState{
+		Value:        int(rl.Value),
+		ParentKey:    rl.ParentKey.String,
+		Algorithm:    rl.Algorithm,
+		LimitValue:   int(rl.LimitValue),
+		Burst:        int(rl.Burst.Int32),
+		Window:       sqlchelpers.PgIntervalToDuration(window),
+		LastRefill:   rl.LastRefill.Time,
+		PreviousUsed: int(rl.PreviousUsed.Int32),
 	}
+}
 
-	nextRefillAt := time.Now().Add(time.Second * 2)
+func (d *rateLimitRepository) DeleteRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
 
-	if len(newRls) > 0 {
-		// get min of all next refill times
-		for _, rl := range newRls {
-			if rl.NextRefillAt.Time.Before(nextRefillAt) {
-				nextRefillAt = rl.NextRefillAt.Time
-			}
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	// tasks which consume a child rate limit would never run without the parent rate limit
+	children, err := d.queries.CountChildRateLimits(ctx, tx, sqlcv1.CountChildRateLimitsParams{
+		Tenantid: tenantId,
+		Key:      key,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if children > 0 {
+		return nil, ErrRateLimitInUse
+	}
+
+	rl, err := d.queries.DeleteRateLimit(ctx, tx, sqlcv1.DeleteRateLimitParams{
+		Tenantid: tenantId,
+		Key:      key,
+	})
+
+	if err != nil {
+		// step rate limits reference the rate limit with ON DELETE RESTRICT
+		var pgErr *pgconn.PgError
+
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return nil, ErrRateLimitInUse
 		}
+
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
 	}
 
-	return res, &nextRefillAt, err
+	return rl, nil
+}
+
+func (d *rateLimitRepository) ResetRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error) {
+	return d.queries.ResetRateLimit(ctx, d.pool, sqlcv1.ResetRateLimitParams{
+		Tenantid: tenantId,
+		Key:      key,
+	})
 }
@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"math"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+// fixedWindowTolerance refills fixed window rate limits slightly before the end of the window, so a
+// scheduler which reads rate limits on an interval does not miss a refill by a few milliseconds.
+const fixedWindowTolerance = 10 * time.Millisecond
+
+// Refill returns the state of the rate limit at the given time.
+func (s RateLimitState) Refill(now time.Time) RateLimitState {
+	if s.Window <= 0 || s.LimitValue <= 0 {
+		return s
+	}
+
+	switch s.Algorithm {
+	case sqlcv1.RateLimitAlgorithmTOKENBUCKET:
+		return s.refillTokenBucket(now)
+	case sqlcv1.RateLimitAlgorithmSLIDINGWINDOW:
+		return s.refillSlidingWindow(now)
+	default:
+		return s.refillFixedWindow(now)
+	}
+}
+
+// Consume returns the state of the rate limit after consuming units at the given time.
+func (s RateLimitState) Consume(now time.Time, units int) RateLimitState {
+	s = s.Refill(now)
+
+	if s.Algorithm == sqlcv1.RateLimitAlgorithmTOKENBUCKET && s.Value >= s.capacity() {
+		// a full bucket does not accrue tokens, so accruing starts when the first tokens are taken
+		s.LastRefill = now
+	}
+
+	s.Value -= units
+
+	return s
+}
+
+// Available returns the number of units which can be consumed at the given time.
+func (s RateLimitState) Available(now time.Time) int {
+	s = s.Refill(now)
+
+	if s.Algorithm != sqlcv1.RateLimitAlgorithmSLIDINGWINDOW || s.Window <= 0 || s.PreviousUsed <= 0 {
+		return s.Value
+	}
+
+	// the units consumed in the previous window are weighted by how much of the previous window
+	// still overlaps with the sliding window ending now
+	overlap := s.Window - now.Sub(s.LastRefill)
+
+	if overlap <= 0 {
+		return s.Value
+	}
+
+	if overlap > s.Window {
+		overlap = s.Window
+	}
+
+	previous := int(math.Ceil(float64(s.PreviousUsed) * float64(overlap) / float64(s.Window)))
+
+	return s.Value - previous
+}
+
+// NextRefillAt returns the next time at which units are refilled, or false if the rate limit is not
+// going to be refilled.
+func (s RateLimitState) NextRefillAt() (time.Time, bool) {
+	if s.Window <= 0 || s.LimitValue <= 0 {
+		return time.Time{}, false
+	}
+
+	switch s.Algorithm {
+	case sqlcv1.RateLimitAlgorithmTOKENBUCKET:
+		if s.Value >= s.capacity() {
+			return time.Time{}, false
+		}
+
+		return s.LastRefill.Add(s.Window / time.Duration(s.LimitValue)), true
+	case sqlcv1.RateLimitAlgorithmSLIDINGWINDOW:
+		return s.LastRefill.Add(s.Window), true
+	default:
+		return s.LastRefill.Add(s.Window - fixedWindowTolerance), true
+	}
+}
+
+// refillFixedWindow refills the rate limit to its limit value once the window has passed.
+func (s RateLimitState) refillFixedWindow(now time.Time) RateLimitState {
+	if now.Sub(s.LastRefill) >= s.Window-fixedWindowTolerance {
+		s.Value = s.LimitValue
+		s.LastRefill = now
+	}
+
+	return s
+}
+
+// refillTokenBucket adds the limit value in tokens to the bucket over each window, up to the capacity
+// of the bucket. LastRefill only advances by the time it took to accrue the added tokens, so partial
+// tokens are not lost between refills.
+func (s RateLimitState) refillTokenBucket(now time.Time) RateLimitState {
+	capacity := s.capacity()
+
+	if s.Value >= capacity {
+		return s
+	}
+
+	elapsed := now.Sub(s.LastRefill)
+
+	if elapsed <= 0 {
+		return s
+	}
+
+	tokens := math.Floor(float64(elapsed) * float64(s.LimitValue) / float64(s.Window))
+
+	if tokens < 1 {
+		return s
+	}
+
+	if tokens >= float64(capacity-s.Value) {
+		s.Value = capacity
+		s.LastRefill = now
+
+		return s
+	}
+
+	s.Value += int(tokens)
+	s.LastRefill = s.LastRefill.Add(time.Duration(tokens * float64(s.Window) / float64(s.LimitValue)))
+
+	return s
+}
+
+// refillSlidingWindow starts a new window once the current window has passed, keeping the units
+// consumed in the window which just ended.
+func (s RateLimitState) refillSlidingWindow(now time.Time) RateLimitState {
+	elapsed := now.Sub(s.LastRefill)
+
+	if elapsed < s.Window {
+		return s
+	}
+
+	windows := elapsed / s.Window
+
+	if windows == 1 {
+		s.PreviousUsed = s.LimitValue - s.Value
+	} else {
+		s.PreviousUsed = 0
+	}
+
+	s.Value = s.LimitValue
+	s.LastRefill = s.LastRefill.Add(windows * s.Window)
+
+	return s
+}
+
+// ChangedFrom returns true if the stored state of the rate limit differs from the other state.
+func (s RateLimitState) ChangedFrom(other RateLimitState) bool {
+	return s.Value != other.Value || !s.LastRefill.Equal(other.LastRefill) || s.PreviousUsed != other.PreviousUsed
+}
+
+func (s RateLimitState) capacity() int {
+	if s.Burst > 0 {
+		return s.Burst
+	}
+
+	return s.LimitValue
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+var rateLimitT0 = time.Date(2025, 4, 8, 12, 0, 0, 0, time.UTC)
+
+func TestRateLimitState_FixedWindow(t *testing.T) {
+	s := RateLimitState{
+		Algorithm:  sqlcv1.RateLimitAlgorithmFIXEDWINDOW,
+		LimitValue: 10,
+		Window:     10 * time.Second,
+		Value:      10,
+		LastRefill: rateLimitT0,
+	}
+
+	s = s.Consume(rateLimitT0.Add(9*time.Second), 10)
+	assert.Equal(t, 0, s.Available(rateLimitT0.Add(9*time.Second)))
+
+	// the full limit is available as soon as the window passes, which allows a burst of twice the limit
+	assert.Equal(t, 10, s.Available(rateLimitT0.Add(10*time.Second)))
+	assert.Equal(t, 10, s.Available(rateLimitT0.Add(10*time.Second-fixedWindowTolerance)))
+
+	refilled := s.Refill(rateLimitT0.Add(10 * time.Second))
+	assert.Equal(t, rateLimitT0.Add(10*time.Second), refilled.LastRefill)
+
+	next, ok := refilled.NextRefillAt()
+	assert.True(t, ok)
+	assert.Equal(t, rateLimitT0.Add(20*time.Second-fixedWindowTolerance), next)
+}
+
+func TestRateLimitState_TokenBucket(t *testing.T) {
+	s := RateLimitState{
+		Algorithm:  sqlcv1.RateLimitAlgorithmTOKENBUCKET,
+		LimitValue: 10,
+		Burst:      5,
+		Window:     10 * time.Second,
+		Value:      5,
+		LastRefill: rateLimitT0.Add(-time.Hour),
+	}
+
+	t.Run("full bucket does not accrue tokens", func(t *testing.T) {
+		assert.Equal(t, 5, s.Available(rateLimitT0))
+
+		_, ok := s.NextRefillAt()
+		assert.False(t, ok)
+	})
+
+	t.Run("accrues tokens after consuming", func(t *testing.T) {
+		s := s.Consume(rateLimitT0, 5)
+		assert.Equal(t, rateLimitT0, s.LastRefill)
+		assert.Equal(t, 0, s.Available(rateLimitT0))
+		assert.Equal(t, 0, s.Available(rateLimitT0.Add(999*time.Millisecond)))
+		assert.Equal(t, 1, s.Available(rateLimitT0.Add(time.Second)))
+		assert.Equal(t, 3, s.Available(rateLimitT0.Add(3500*time.Millisecond)))
+
+		next, ok := s.NextRefillAt()
+		assert.True(t, ok)
+		assert.Equal(t, rateLimitT0.Add(time.Second), next)
+	})
+
+	t.Run("keeps partial tokens between refills", func(t *testing.T) {
+		s := s.Consume(rateLimitT0, 5)
+
+		s = s.Refill(rateLimitT0.Add(1500 * time.Millisecond))
+		assert.Equal(t, 1, s.Value)
+		assert.Equal(t, rateLimitT0.Add(time.Second), s.LastRefill)
+
+		s = s.Refill(rateLimitT0.Add(2 * time.Second))
+		assert.Equal(t, 2, s.Value)
+		assert.Equal(t, rateLimitT0.Add(2*time.Second), s.LastRefill)
+	})
+
+	t.Run("caps tokens at the burst size", func(t *testing.T) {
+		s := s.Consume(rateLimitT0, 5)
+
+		s = s.Refill(rateLimitT0.Add(time.Minute))
+		assert.Equal(t, 5, s.Value)
+		assert.Equal(t, rateLimitT0.Add(time.Minute), s.LastRefill)
+	})
+
+	t.Run("defaults the burst size to the limit", func(t *testing.T) {
+		bucket := s
+		bucket.Burst = 0
+		bucket.Value = 0
+		bucket.LastRefill = rateLimitT0
+
+		assert.Equal(t, 10, bucket.Available(rateLimitT0.Add(time.Minute)))
+	})
+}
+
+func TestRateLimitState_SlidingWindow(t *testing.T) {
+	s := RateLimitState{
+		Algorithm:  sqlcv1.RateLimitAlgorithmSLIDINGWINDOW,
+		LimitValue: 10,
+		Window:     10 * time.Second,
+		Value:      10,
+		LastRefill: rateLimitT0,
+	}
+
+	// consume the whole limit at the end of the first window
+	s = s.Consume(rateLimitT0.Add(9*time.Second), 10)
+	assert.Equal(t, 0, s.Available(rateLimitT0.Add(9*time.Second)))
+
+	// the units consumed in the previous window still count at the start of the next window
+	assert.Equal(t, 0, s.Available(rateLimitT0.Add(10*time.Second)))
+	assert.Equal(t, 2, s.Available(rateLimitT0.Add(12*time.Second)))
+	assert.Equal(t, 5, s.Available(rateLimitT0.Add(15*time.Second)))
+
+	s = s.Consume(rateLimitT0.Add(15*time.Second), 5)
+	assert.Equal(t, 10, s.PreviousUsed)
+	assert.Equal(t, 5, s.Value)
+	assert.Equal(t, rateLimitT0.Add(10*time.Second), s.LastRefill)
+	assert.Equal(t, 0, s.Available(rateLimitT0.Add(15*time.Second)))
+
+	// in the third window, the units consumed in the second window are weighted
+	assert.Equal(t, 8, s.Available(rateLimitT0.Add(26*time.Second)))
+
+	// after two windows, nothing consumed earlier counts
+	assert.Equal(t, 10, s.Available(rateLimitT0.Add(30*time.Second)))
+
+	refilled := s.Refill(rateLimitT0.Add(35 * time.Second))
+	assert.Equal(t, 0, refilled.PreviousUsed)
+	assert.Equal(t, rateLimitT0.Add(30*time.Second), refilled.LastRefill)
+
+	next, ok := refilled.NextRefillAt()
+	assert.True(t, ok)
+	assert.Equal(t, rateLimitT0.Add(40*time.Second), next)
+}
+
+func TestRateLimitState_NoWindow(t *testing.T) {
+	s := RateLimitState{Value: 3}
+
+	assert.Equal(t, 3, s.Available(rateLimitT0))
+	assert.Equal(t, 1, s.Consume(rateLimitT0, 2).Value)
+
+	_, ok := s.NextRefillAt()
+	assert.False(t, ok)
+}
+
+func TestPgIntervalToDuration(t *testing.T) {
+	assert.Equal(t, time.Minute, sqlchelpers.PgIntervalToDuration(durationToPgInterval(time.Minute)))
+	assert.Equal(t, 30*24*time.Hour, sqlchelpers.PgIntervalToDuration(pgtype.Interval{Months: 1, Valid: true}))
+}
+
+func TestNewRateLimitState(t *testing.T) {
+	s := newRateLimitState(&sqlcv1.RateLimit{
+		Key:          "api",
+		Algorithm:    sqlcv1.RateLimitAlgorithmSLIDINGWINDOW,
+		LimitValue:   10,
+		Value:        4,
+		LastRefill:   pgtype.Timestamp{Time: rateLimitT0, Valid: true},
+		ParentKey:    pgtype.Text{String: "global", Valid: true},
+		PreviousUsed: pgtype.Int4{Int32: 8, Valid: true},
+	}, pgtype.Interval{Microseconds: 10 * 1000 * 1000, Valid: true})
+
+	assert.Equal(t, RateLimitState{
+		Algorithm:    sqlcv1.RateLimitAlgorithmSLIDINGWINDOW,
+		LimitValue:   10,
+		Value:        4,
+		Window:       10 * time.Second,
+		LastRefill:   rateLimitT0,
+		ParentKey:    "global",
+		PreviousUsed: 8,
+	}, s)
+
+	// samples of a sliding window include the units consumed in the overlapping previous window
+	assert.Equal(t, 0, s.Available(rateLimitT0.Add(5*time.Second)))
+
+	// a rate limit which is not due for a refill is not locked or written
+	assert.False(t, s.Refill(rateLimitT0.Add(5*time.Second)).ChangedFrom(s))
+	assert.True(t, s.Refill(rateLimitT0.Add(10*time.Second)).ChangedFrom(s))
+}
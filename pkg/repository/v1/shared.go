@@ -12,9 +12,6 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
-
-	celgo "github.com/google/cel-go/cel"
-	"github.com/google/cel-go/checker/decls"
 )
 
 // implements comparable for the lru cache
@@ -32,7 +29,6 @@ type sharedRepository struct {
 	stepExpressionCache       *cache.Cache
 	tenantIdWorkflowNameCache *cache.Cache
 	celParser                 *cel.CELParser
-	env                       *celgo.Env
 	taskLookupCache           *lru.Cache[taskExternalIdTenantIdTuple, *sqlcv1.FlattenExternalIdsRow]
 }
 
@@ -44,16 +40,6 @@ func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 
 	celParser := cel.NewCELParser()
 
-	env, err := celgo.NewEnv(
-		celgo.Declarations(
-			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
-		),
-	)
-
-	if err != nil {
-		log.Fatalf("failed to create CEL environment: %v", err)
-	}
-
 	lookupCache, err := lru.New[taskExternalIdTenantIdTuple, *sqlcv1.FlattenExternalIdsRow](20000)
 
 	if err != nil {
@@ -69,7 +55,6 @@ func newSharedRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.L
 			stepExpressionCache:       stepExpressionCache,
 			tenantIdWorkflowNameCache: tenantIdWorkflowNameCache,
 			celParser:                 celParser,
-			env:                       env,
 			taskLookupCache:           lookupCache,
 		}, func() error {
 			queueCache.Stop()
@@ -0,0 +1,94 @@
+-- name: CreateBulkOperation :one
+INSERT INTO v1_bulk_operation (
+    tenant_id,
+    kind,
+    filter
+) VALUES (
+    @tenantId::uuid,
+    @kind::v1_bulk_operation_kind,
+    @filter::jsonb
+)
+RETURNING *;
+
+-- name: GetBulkOperation :one
+SELECT
+    *
+FROM
+    v1_bulk_operation
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid;
+
+-- name: InsertBulkOperationItems :execrows
+INSERT INTO v1_bulk_operation_item (
+    bulk_operation_id,
+    external_id
+)
+SELECT
+    @bulkOperationId::uuid,
+    unnest(@externalIds::uuid[])
+ON CONFLICT (bulk_operation_id, external_id) DO NOTHING;
+
+-- name: UpdateBulkOperationMatched :one
+UPDATE
+    v1_bulk_operation
+SET
+    matched_count = matched_count + @matchedCount::bigint,
+    status = CASE WHEN @isLastPage::boolean THEN 'RUNNING'::v1_bulk_operation_status ELSE status END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+    AND status = 'PENDING'
+RETURNING *;
+
+-- name: ListBulkOperationItems :many
+SELECT
+    *
+FROM
+    v1_bulk_operation_item
+WHERE
+    bulk_operation_id = @bulkOperationId::uuid
+    AND id > @lastItemId::bigint
+ORDER BY
+    id ASC
+LIMIT
+    @batchSize::integer;
+
+-- name: CompleteBulkOperationBatch :one
+-- Moves the last item of a running bulk operation past a batch of items and records the result of
+-- processing the batch, so that the batch is only counted once.
+UPDATE
+    v1_bulk_operation
+SET
+    last_item_id = @lastItemId::bigint,
+    processed_count = processed_count + @processedCount::bigint,
+    failed_count = failed_count + @failedCount::bigint,
+    updated_at = CURRENT_TIMESTAMP
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+    AND status = 'RUNNING'
+    -- guards against recording the same batch twice
+    AND last_item_id = @previousLastItemId::bigint
+RETURNING *;
+
+-- name: FinishBulkOperation :one
+UPDATE
+    v1_bulk_operation
+SET
+    status = @status::v1_bulk_operation_status,
+    error_message = sqlc.narg('errorMessage')::text,
+    updated_at = CURRENT_TIMESTAMP,
+    finished_at = CURRENT_TIMESTAMP
+WHERE
+    tenant_id = @tenantId::uuid
+    AND id = @id::uuid
+    AND status IN ('PENDING', 'RUNNING')
+RETURNING *;
+
+-- name: DeleteBulkOperationItems :exec
+DELETE FROM
+    v1_bulk_operation_item
+WHERE
+    bulk_operation_id = @bulkOperationId::uuid;
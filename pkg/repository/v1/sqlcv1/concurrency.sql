@@ -269,6 +269,196 @@ SELECT
 FROM
     updated_slots;
 
+-- name: ListConcurrencyKeys :many
+SELECT DISTINCT
+    key
+FROM
+    v1_concurrency_slot
+WHERE
+    tenant_id = @tenantId::uuid
+    AND strategy_id = @strategyId::bigint;
+
+-- name: ListParentConcurrencyKeys :many
+SELECT DISTINCT
+    key
+FROM
+    v1_workflow_concurrency_slot
+WHERE
+    tenant_id = @tenantId::uuid
+    AND strategy_id = @strategyId::bigint;
+
+-- name: ListConcurrencyKeyWeights :many
+SELECT
+    *
+FROM
+    v1_concurrency_key_weight
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = ANY(@keys::text[]);
+
+-- name: UpsertConcurrencyKeyWeight :exec
+INSERT INTO v1_concurrency_key_weight (
+    tenant_id,
+    key,
+    weight
+) VALUES (
+    @tenantId::uuid,
+    @key::text,
+    @weight::int
+)
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    weight = EXCLUDED.weight;
+
+-- name: DeleteConcurrencyKeyWeight :exec
+DELETE FROM
+    v1_concurrency_key_weight
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = @key::text;
+
+-- name: RunWeightedRoundRobin :many
+-- Used for weighted round-robin scheduling when a strategy doesn't have a parent strategy. Each key
+-- can fill up to the corresponding number of slots in maxRuns, and slots are filled in priority order.
+WITH eligible_slots_per_group AS (
+    SELECT cs.*
+    FROM (
+        SELECT
+            unnest(@keys::text[]) AS key,
+            unnest(@maxRuns::int[]) AS max_runs
+    ) key_limits
+    JOIN LATERAL (
+        SELECT *
+        FROM v1_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = key_limits.key
+            AND wcs_all.tenant_id = @tenantId::uuid
+            AND wcs_all.strategy_id = @strategyId::bigint
+        ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
+        LIMIT key_limits.max_runs
+    ) cs ON true
+), schedule_timeout_slots AS (
+    SELECT
+        *
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = @tenantId::uuid AND
+        strategy_id = @strategyId::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), eligible_slots AS (
+    SELECT
+        cs.*
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                es.task_inserted_at,
+                es.task_id,
+                es.task_retry_count,
+                es.tenant_id,
+                es.strategy_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        eligible_slots
+    WHERE
+        v1_concurrency_slot.task_id = eligible_slots.task_id AND
+        v1_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
+        v1_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
+        v1_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+        v1_concurrency_slot.key = eligible_slots.key
+    RETURNING
+        v1_concurrency_slot.*
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                schedule_timeout_slots c
+        )
+)
+SELECT
+    *,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    *,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots;
+
+-- name: RunParentWeightedRoundRobin :exec
+WITH eligible_slots_per_group AS (
+    SELECT wsc.*
+    FROM (
+        SELECT
+            unnest(@keys::text[]) AS key,
+            unnest(@maxRuns::int[]) AS max_runs
+    ) key_limits
+    JOIN LATERAL (
+        SELECT *
+        FROM v1_workflow_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = key_limits.key
+            AND wcs_all.tenant_id = @tenantId::uuid
+            AND wcs_all.strategy_id = @strategyId::bigint
+        ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
+        LIMIT key_limits.max_runs
+    ) wsc ON true
+), eligible_slots AS (
+    SELECT
+        *
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        (strategy_id, workflow_version_id, workflow_run_id) IN (
+            SELECT
+                es.strategy_id,
+                es.workflow_version_id,
+                es.workflow_run_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        strategy_id, workflow_version_id, workflow_run_id
+    FOR UPDATE
+)
+UPDATE
+    v1_workflow_concurrency_slot
+SET
+    is_filled = TRUE
+FROM
+    eligible_slots
+WHERE
+    v1_workflow_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+    v1_workflow_concurrency_slot.workflow_version_id = eligible_slots.workflow_version_id AND
+    v1_workflow_concurrency_slot.workflow_run_id = eligible_slots.workflow_run_id;
+
 -- name: RunChildGroupRoundRobin :many
 -- Used for round-robin scheduling when a strategy has a parent strategy. It inherits the concurrency
 -- settings of the parent, so we just set the is_filled flag to true if the parent slot is filled.
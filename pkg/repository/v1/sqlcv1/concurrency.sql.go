@@ -108,6 +108,24 @@ func (q *Queries) ConcurrencyAdvisoryLock(ctx context.Context, db DBTX, key int6
 	return err
 }
 
+const deleteConcurrencyKeyWeight = `-- name: DeleteConcurrencyKeyWeight :exec
+DELETE FROM
+    v1_concurrency_key_weight
+WHERE
+    tenant_id = $1::uuid
+    AND key = $2::text
+`
+
+type DeleteConcurrencyKeyWeightParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) DeleteConcurrencyKeyWeight(ctx context.Context, db DBTX, arg DeleteConcurrencyKeyWeightParams) error {
+	_, err := db.Exec(ctx, deleteConcurrencyKeyWeight, arg.Tenantid, arg.Key)
+	return err
+}
+
 const listActiveConcurrencyStrategies = `-- name: ListActiveConcurrencyStrategies :many
 WITH earliest_workflow_versions AS (
     -- We select the earliest workflow versions with an active concurrency queue. The reason
@@ -127,7 +145,7 @@ WITH earliest_workflow_versions AS (
         "workflowId", wv."order" ASC
 )
 SELECT
-    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency
+    sc.id, sc.parent_strategy_id, sc.workflow_id, sc.workflow_version_id, sc.step_id, sc.is_active, sc.strategy, sc.expression, sc.tenant_id, sc.max_concurrency, sc.weight_expression
 FROM
     v1_step_concurrency sc
 JOIN
@@ -159,6 +177,7 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
 		); err != nil {
 			return nil, err
 		}
@@ -170,9 +189,79 @@ func (q *Queries) ListActiveConcurrencyStrategies(ctx context.Context, db DBTX,
 	return items, nil
 }
 
+const listConcurrencyKeyWeights = `-- name: ListConcurrencyKeyWeights :many
+SELECT
+    tenant_id, key, weight
+FROM
+    v1_concurrency_key_weight
+WHERE
+    tenant_id = $1::uuid
+    AND key = ANY($2::text[])
+`
+
+type ListConcurrencyKeyWeightsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Keys     []string    `json:"keys"`
+}
+
+func (q *Queries) ListConcurrencyKeyWeights(ctx context.Context, db DBTX, arg ListConcurrencyKeyWeightsParams) ([]*V1ConcurrencyKeyWeight, error) {
+	rows, err := db.Query(ctx, listConcurrencyKeyWeights, arg.Tenantid, arg.Keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1ConcurrencyKeyWeight
+	for rows.Next() {
+		var i V1ConcurrencyKeyWeight
+		if err := rows.Scan(&i.TenantID, &i.Key, &i.Weight); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConcurrencyKeys = `-- name: ListConcurrencyKeys :many
+SELECT DISTINCT
+    key
+FROM
+    v1_concurrency_slot
+WHERE
+    tenant_id = $1::uuid
+    AND strategy_id = $2::bigint
+`
+
+type ListConcurrencyKeysParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+}
+
+func (q *Queries) ListConcurrencyKeys(ctx context.Context, db DBTX, arg ListConcurrencyKeysParams) ([]string, error) {
+	rows, err := db.Query(ctx, listConcurrencyKeys, arg.Tenantid, arg.Strategyid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listConcurrencyStrategiesByStepId = `-- name: ListConcurrencyStrategiesByStepId :many
 SELECT
-    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency
+    id, parent_strategy_id, workflow_id, workflow_version_id, step_id, is_active, strategy, expression, tenant_id, max_concurrency, weight_expression
 FROM
     v1_step_concurrency
 WHERE
@@ -207,6 +296,7 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 			&i.Expression,
 			&i.TenantID,
 			&i.MaxConcurrency,
+			&i.WeightExpression,
 		); err != nil {
 			return nil, err
 		}
@@ -218,6 +308,41 @@ func (q *Queries) ListConcurrencyStrategiesByStepId(ctx context.Context, db DBTX
 	return items, nil
 }
 
+const listParentConcurrencyKeys = `-- name: ListParentConcurrencyKeys :many
+SELECT DISTINCT
+    key
+FROM
+    v1_workflow_concurrency_slot
+WHERE
+    tenant_id = $1::uuid
+    AND strategy_id = $2::bigint
+`
+
+type ListParentConcurrencyKeysParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+}
+
+func (q *Queries) ListParentConcurrencyKeys(ctx context.Context, db DBTX, arg ListParentConcurrencyKeysParams) ([]string, error) {
+	rows, err := db.Query(ctx, listParentConcurrencyKeys, arg.Tenantid, arg.Strategyid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const runCancelInProgress = `-- name: RunCancelInProgress :many
 WITH slots AS (
     SELECT
@@ -1629,6 +1754,243 @@ func (q *Queries) RunParentGroupRoundRobin(ctx context.Context, db DBTX, arg Run
 	return err
 }
 
+const runParentWeightedRoundRobin = `-- name: RunParentWeightedRoundRobin :exec
+WITH eligible_slots_per_group AS (
+    SELECT wsc.sort_id, wsc.tenant_id, wsc.workflow_id, wsc.workflow_version_id, wsc.workflow_run_id, wsc.strategy_id, wsc.completed_child_strategy_ids, wsc.child_strategy_ids, wsc.priority, wsc.key, wsc.is_filled
+    FROM (
+        SELECT
+            unnest($1::text[]) AS key,
+            unnest($2::int[]) AS max_runs
+    ) key_limits
+    JOIN LATERAL (
+        SELECT sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+        FROM v1_workflow_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = key_limits.key
+            AND wcs_all.tenant_id = $3::uuid
+            AND wcs_all.strategy_id = $4::bigint
+        ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
+        LIMIT key_limits.max_runs
+    ) wsc ON true
+), eligible_slots AS (
+    SELECT
+        sort_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, completed_child_strategy_ids, child_strategy_ids, priority, key, is_filled
+    FROM
+        v1_workflow_concurrency_slot
+    WHERE
+        (strategy_id, workflow_version_id, workflow_run_id) IN (
+            SELECT
+                es.strategy_id,
+                es.workflow_version_id,
+                es.workflow_run_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        strategy_id, workflow_version_id, workflow_run_id
+    FOR UPDATE
+)
+UPDATE
+    v1_workflow_concurrency_slot
+SET
+    is_filled = TRUE
+FROM
+    eligible_slots
+WHERE
+    v1_workflow_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+    v1_workflow_concurrency_slot.workflow_version_id = eligible_slots.workflow_version_id AND
+    v1_workflow_concurrency_slot.workflow_run_id = eligible_slots.workflow_run_id
+`
+
+type RunParentWeightedRoundRobinParams struct {
+	Keys       []string    `json:"keys"`
+	Maxruns    []int32     `json:"maxruns"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+}
+
+func (q *Queries) RunParentWeightedRoundRobin(ctx context.Context, db DBTX, arg RunParentWeightedRoundRobinParams) error {
+	_, err := db.Exec(ctx, runParentWeightedRoundRobin,
+		arg.Keys,
+		arg.Maxruns,
+		arg.Tenantid,
+		arg.Strategyid,
+	)
+	return err
+}
+
+const runWeightedRoundRobin = `-- name: RunWeightedRoundRobin :many
+WITH eligible_slots_per_group AS (
+    SELECT cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
+    FROM (
+        SELECT
+            unnest($1::text[]) AS key,
+            unnest($2::int[]) AS max_runs
+    ) key_limits
+    JOIN LATERAL (
+        SELECT sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+        FROM v1_concurrency_slot wcs_all
+        WHERE
+            wcs_all.key = key_limits.key
+            AND wcs_all.tenant_id = $3::uuid
+            AND wcs_all.strategy_id = $4::bigint
+        ORDER BY wcs_all.priority DESC, wcs_all.sort_id ASC
+        LIMIT key_limits.max_runs
+    ) cs ON true
+), schedule_timeout_slots AS (
+    SELECT
+        sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at
+    FROM
+        v1_concurrency_slot
+    WHERE
+        tenant_id = $3::uuid AND
+        strategy_id = $4::bigint AND
+        schedule_timeout_at < NOW() AND
+        is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), eligible_slots AS (
+    SELECT
+        cs.sort_id, cs.task_id, cs.task_inserted_at, cs.task_retry_count, cs.external_id, cs.tenant_id, cs.workflow_id, cs.workflow_version_id, cs.workflow_run_id, cs.strategy_id, cs.parent_strategy_id, cs.priority, cs.key, cs.is_filled, cs.next_parent_strategy_ids, cs.next_strategy_ids, cs.next_keys, cs.queue_to_notify, cs.schedule_timeout_at
+    FROM
+        v1_concurrency_slot cs
+    WHERE
+        (task_inserted_at, task_id, task_retry_count, tenant_id, strategy_id) IN (
+            SELECT
+                es.task_inserted_at,
+                es.task_id,
+                es.task_retry_count,
+                es.tenant_id,
+                es.strategy_id
+            FROM
+                eligible_slots_per_group es
+        )
+        AND is_filled = FALSE
+    ORDER BY
+        task_id, task_inserted_at
+    FOR UPDATE
+), updated_slots AS (
+    UPDATE
+        v1_concurrency_slot
+    SET
+        is_filled = TRUE
+    FROM
+        eligible_slots
+    WHERE
+        v1_concurrency_slot.task_id = eligible_slots.task_id AND
+        v1_concurrency_slot.task_inserted_at = eligible_slots.task_inserted_at AND
+        v1_concurrency_slot.task_retry_count = eligible_slots.task_retry_count AND
+        v1_concurrency_slot.tenant_id = eligible_slots.tenant_id AND
+        v1_concurrency_slot.strategy_id = eligible_slots.strategy_id AND
+        v1_concurrency_slot.key = eligible_slots.key
+    RETURNING
+        v1_concurrency_slot.sort_id, v1_concurrency_slot.task_id, v1_concurrency_slot.task_inserted_at, v1_concurrency_slot.task_retry_count, v1_concurrency_slot.external_id, v1_concurrency_slot.tenant_id, v1_concurrency_slot.workflow_id, v1_concurrency_slot.workflow_version_id, v1_concurrency_slot.workflow_run_id, v1_concurrency_slot.strategy_id, v1_concurrency_slot.parent_strategy_id, v1_concurrency_slot.priority, v1_concurrency_slot.key, v1_concurrency_slot.is_filled, v1_concurrency_slot.next_parent_strategy_ids, v1_concurrency_slot.next_strategy_ids, v1_concurrency_slot.next_keys, v1_concurrency_slot.queue_to_notify, v1_concurrency_slot.schedule_timeout_at
+), deleted_slots AS (
+    DELETE FROM
+        v1_concurrency_slot
+    WHERE
+        (task_inserted_at, task_id, task_retry_count) IN (
+            SELECT
+                c.task_inserted_at,
+                c.task_id,
+                c.task_retry_count
+            FROM
+                schedule_timeout_slots c
+        )
+)
+SELECT
+    sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at,
+    'SCHEDULING_TIMED_OUT' AS "operation"
+FROM
+    schedule_timeout_slots
+UNION ALL
+SELECT
+    sort_id, task_id, task_inserted_at, task_retry_count, external_id, tenant_id, workflow_id, workflow_version_id, workflow_run_id, strategy_id, parent_strategy_id, priority, key, is_filled, next_parent_strategy_ids, next_strategy_ids, next_keys, queue_to_notify, schedule_timeout_at,
+    'RUNNING' AS "operation"
+FROM
+    updated_slots
+`
+
+type RunWeightedRoundRobinParams struct {
+	Keys       []string    `json:"keys"`
+	Maxruns    []int32     `json:"maxruns"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Strategyid int64       `json:"strategyid"`
+}
+
+type RunWeightedRoundRobinRow struct {
+	SortID                pgtype.Int8        `json:"sort_id"`
+	TaskID                int64              `json:"task_id"`
+	TaskInsertedAt        pgtype.Timestamptz `json:"task_inserted_at"`
+	TaskRetryCount        int32              `json:"task_retry_count"`
+	ExternalID            pgtype.UUID        `json:"external_id"`
+	TenantID              pgtype.UUID        `json:"tenant_id"`
+	WorkflowID            pgtype.UUID        `json:"workflow_id"`
+	WorkflowVersionID     pgtype.UUID        `json:"workflow_version_id"`
+	WorkflowRunID         pgtype.UUID        `json:"workflow_run_id"`
+	StrategyID            int64              `json:"strategy_id"`
+	ParentStrategyID      pgtype.Int8        `json:"parent_strategy_id"`
+	Priority              int32              `json:"priority"`
+	Key                   string             `json:"key"`
+	IsFilled              bool               `json:"is_filled"`
+	NextParentStrategyIds []int64            `json:"next_parent_strategy_ids"`
+	NextStrategyIds       []int64            `json:"next_strategy_ids"`
+	NextKeys              []string           `json:"next_keys"`
+	QueueToNotify         string             `json:"queue_to_notify"`
+	ScheduleTimeoutAt     pgtype.Timestamp   `json:"schedule_timeout_at"`
+	Operation             string             `json:"operation"`
+}
+
+// Used for weighted round-robin scheduling when a strategy doesn't have a parent strategy. Each key
+// can fill up to the corresponding number of slots in maxRuns, and slots are filled in priority order.
+func (q *Queries) RunWeightedRoundRobin(ctx context.Context, db DBTX, arg RunWeightedRoundRobinParams) ([]*RunWeightedRoundRobinRow, error) {
+	rows, err := db.Query(ctx, runWeightedRoundRobin,
+		arg.Keys,
+		arg.Maxruns,
+		arg.Tenantid,
+		arg.Strategyid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*RunWeightedRoundRobinRow
+	for rows.Next() {
+		var i RunWeightedRoundRobinRow
+		if err := rows.Scan(
+			&i.SortID,
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.TaskRetryCount,
+			&i.ExternalID,
+			&i.TenantID,
+			&i.WorkflowID,
+			&i.WorkflowVersionID,
+			&i.WorkflowRunID,
+			&i.StrategyID,
+			&i.ParentStrategyID,
+			&i.Priority,
+			&i.Key,
+			&i.IsFilled,
+			&i.NextParentStrategyIds,
+			&i.NextStrategyIds,
+			&i.NextKeys,
+			&i.QueueToNotify,
+			&i.ScheduleTimeoutAt,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setConcurrencyStrategyInactive = `-- name: SetConcurrencyStrategyInactive :exec
 UPDATE
     v1_step_concurrency
@@ -1668,3 +2030,29 @@ func (q *Queries) TryConcurrencyAdvisoryLock(ctx context.Context, db DBTX, key i
 	err := row.Scan(&locked)
 	return locked, err
 }
+
+const upsertConcurrencyKeyWeight = `-- name: UpsertConcurrencyKeyWeight :exec
+INSERT INTO v1_concurrency_key_weight (
+    tenant_id,
+    key,
+    weight
+) VALUES (
+    $1::uuid,
+    $2::text,
+    $3::int
+)
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET
+    weight = EXCLUDED.weight
+`
+
+type UpsertConcurrencyKeyWeightParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+	Weight   int32       `json:"weight"`
+}
+
+func (q *Queries) UpsertConcurrencyKeyWeight(ctx context.Context, db DBTX, arg UpsertConcurrencyKeyWeightParams) error {
+	_, err := db.Exec(ctx, upsertConcurrencyKeyWeight, arg.Tenantid, arg.Key, arg.Weight)
+	return err
+}
@@ -33,6 +33,7 @@ func (r iteratorForCreateDAGData) Values() ([]interface{}, error) {
 		r.rows[0].DagInsertedAt,
 		r.rows[0].Input,
 		r.rows[0].AdditionalMetadata,
+		r.rows[0].EventKey,
 	}, nil
 }
 
@@ -41,7 +42,7 @@ func (r iteratorForCreateDAGData) Err() error {
 }
 
 func (q *Queries) CreateDAGData(ctx context.Context, db DBTX, arg []CreateDAGDataParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"v1_dag_data"}, []string{"dag_id", "dag_inserted_at", "input", "additional_metadata"}, &iteratorForCreateDAGData{rows: arg})
+	return db.CopyFrom(ctx, []string{"v1_dag_data"}, []string{"dag_id", "dag_inserted_at", "input", "additional_metadata", "event_key"}, &iteratorForCreateDAGData{rows: arg})
 }
 
 // iteratorForCreateDAGsOLAP implements pgx.CopyFromSource.
@@ -74,6 +75,7 @@ func (r iteratorForCreateDAGsOLAP) Values() ([]interface{}, error) {
 		r.rows[0].Input,
 		r.rows[0].AdditionalMetadata,
 		r.rows[0].ParentTaskExternalID,
+		r.rows[0].IdempotencyKey,
 	}, nil
 }
 
@@ -82,7 +84,7 @@ func (r iteratorForCreateDAGsOLAP) Err() error {
 }
 
 func (q *Queries) CreateDAGsOLAP(ctx context.Context, db DBTX, arg []CreateDAGsOLAPParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"v1_dags_olap"}, []string{"tenant_id", "id", "inserted_at", "external_id", "display_name", "workflow_id", "workflow_version_id", "input", "additional_metadata", "parent_task_external_id"}, &iteratorForCreateDAGsOLAP{rows: arg})
+	return db.CopyFrom(ctx, []string{"v1_dags_olap"}, []string{"tenant_id", "id", "inserted_at", "external_id", "display_name", "workflow_id", "workflow_version_id", "input", "additional_metadata", "parent_task_external_id", "idempotency_key"}, &iteratorForCreateDAGsOLAP{rows: arg})
 }
 
 // iteratorForCreateMatchConditions implements pgx.CopyFromSource.
@@ -250,6 +252,7 @@ func (r iteratorForCreateTasksOLAP) Values() ([]interface{}, error) {
 		r.rows[0].DagID,
 		r.rows[0].DagInsertedAt,
 		r.rows[0].ParentTaskExternalID,
+		r.rows[0].IdempotencyKey,
 	}, nil
 }
 
@@ -258,7 +261,7 @@ func (r iteratorForCreateTasksOLAP) Err() error {
 }
 
 func (q *Queries) CreateTasksOLAP(ctx context.Context, db DBTX, arg []CreateTasksOLAPParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"v1_tasks_olap"}, []string{"tenant_id", "id", "inserted_at", "queue", "action_id", "step_id", "workflow_id", "workflow_version_id", "workflow_run_id", "schedule_timeout", "step_timeout", "priority", "sticky", "desired_worker_id", "external_id", "display_name", "input", "additional_metadata", "dag_id", "dag_inserted_at", "parent_task_external_id"}, &iteratorForCreateTasksOLAP{rows: arg})
+	return db.CopyFrom(ctx, []string{"v1_tasks_olap"}, []string{"tenant_id", "id", "inserted_at", "queue", "action_id", "step_id", "workflow_id", "workflow_version_id", "workflow_run_id", "schedule_timeout", "step_timeout", "priority", "sticky", "desired_worker_id", "external_id", "display_name", "input", "additional_metadata", "dag_id", "dag_inserted_at", "parent_task_external_id", "idempotency_key"}, &iteratorForCreateTasksOLAP{rows: arg})
 }
 
 // iteratorForInsertLogLine implements pgx.CopyFromSource.
@@ -285,6 +288,7 @@ func (r iteratorForInsertLogLine) Values() ([]interface{}, error) {
 		r.rows[0].TaskID,
 		r.rows[0].TaskInsertedAt,
 		r.rows[0].Message,
+		r.rows[0].Level,
 		r.rows[0].Metadata,
 	}, nil
 }
@@ -294,5 +298,5 @@ func (r iteratorForInsertLogLine) Err() error {
 }
 
 func (q *Queries) InsertLogLine(ctx context.Context, db DBTX, arg []InsertLogLineParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"v1_log_line"}, []string{"tenant_id", "task_id", "task_inserted_at", "message", "metadata"}, &iteratorForInsertLogLine{rows: arg})
+	return db.CopyFrom(ctx, []string{"v1_log_line"}, []string{"tenant_id", "task_id", "task_inserted_at", "message", "level", "metadata"}, &iteratorForInsertLogLine{rows: arg})
 }
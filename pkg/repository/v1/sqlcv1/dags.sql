@@ -28,7 +28,8 @@ WITH input AS (
                 unnest(@displayNames::text[]) AS display_name,
                 unnest(@workflowIds::uuid[]) AS workflow_id,
                 unnest(@workflowVersionIds::uuid[]) AS workflow_version_id,
-                unnest(@parentTaskExternalIds::uuid[]) AS parent_task_external_id
+                unnest(@parentTaskExternalIds::uuid[]) AS parent_task_external_id,
+                unnest(@idempotencyKeys::text[]) AS idempotency_key
         ) AS subquery
 )
 INSERT INTO v1_dag (
@@ -37,7 +38,8 @@ INSERT INTO v1_dag (
     display_name,
     workflow_id,
     workflow_version_id,
-    parent_task_external_id
+    parent_task_external_id,
+    idempotency_key
 )
 SELECT
     i.tenant_id,
@@ -45,7 +47,9 @@ SELECT
     i.display_name,
     i.workflow_id,
     i.workflow_version_id,
-    i.parent_task_external_id
+    i.parent_task_external_id,
+    -- idempotency keys cannot be empty, so an empty key means the DAG does not have one
+    NULLIF(i.idempotency_key, '')
 FROM
     input i
 RETURNING
@@ -56,10 +60,12 @@ INSERT INTO v1_dag_data (
     dag_id,
     dag_inserted_at,
     input,
-    additional_metadata
+    additional_metadata,
+    event_key
 ) VALUES (
     $1,
     $2,
     $3,
-    $4
+    $4,
+    $5
 );
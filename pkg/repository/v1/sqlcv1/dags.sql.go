@@ -16,12 +16,13 @@ type CreateDAGDataParams struct {
 	DagInsertedAt      pgtype.Timestamptz `json:"dag_inserted_at"`
 	Input              []byte             `json:"input"`
 	AdditionalMetadata []byte             `json:"additional_metadata"`
+	EventKey           pgtype.Text        `json:"event_key"`
 }
 
 const createDAGs = `-- name: CreateDAGs :many
 WITH input AS (
     SELECT
-        tenant_id, external_id, display_name, workflow_id, workflow_version_id, parent_task_external_id
+        tenant_id, external_id, display_name, workflow_id, workflow_version_id, parent_task_external_id, idempotency_key
     FROM
         (
             SELECT
@@ -30,7 +31,8 @@ WITH input AS (
                 unnest($3::text[]) AS display_name,
                 unnest($4::uuid[]) AS workflow_id,
                 unnest($5::uuid[]) AS workflow_version_id,
-                unnest($6::uuid[]) AS parent_task_external_id
+                unnest($6::uuid[]) AS parent_task_external_id,
+                unnest($7::text[]) AS idempotency_key
         ) AS subquery
 )
 INSERT INTO v1_dag (
@@ -39,7 +41,8 @@ INSERT INTO v1_dag (
     display_name,
     workflow_id,
     workflow_version_id,
-    parent_task_external_id
+    parent_task_external_id,
+    idempotency_key
 )
 SELECT
     i.tenant_id,
@@ -47,11 +50,13 @@ SELECT
     i.display_name,
     i.workflow_id,
     i.workflow_version_id,
-    i.parent_task_external_id
+    i.parent_task_external_id,
+    -- idempotency keys cannot be empty, so an empty key means the DAG does not have one
+    NULLIF(i.idempotency_key, '')
 FROM
     input i
 RETURNING
-    id, inserted_at, tenant_id, external_id, display_name, workflow_id, workflow_version_id, parent_task_external_id
+    id, inserted_at, tenant_id, external_id, display_name, workflow_id, workflow_version_id, parent_task_external_id, idempotency_key
 `
 
 type CreateDAGsParams struct {
@@ -61,6 +66,7 @@ type CreateDAGsParams struct {
 	Workflowids           []pgtype.UUID `json:"workflowids"`
 	Workflowversionids    []pgtype.UUID `json:"workflowversionids"`
 	Parenttaskexternalids []pgtype.UUID `json:"parenttaskexternalids"`
+	Idempotencykeys       []string      `json:"idempotencykeys"`
 }
 
 func (q *Queries) CreateDAGs(ctx context.Context, db DBTX, arg CreateDAGsParams) ([]*V1Dag, error) {
@@ -71,6 +77,7 @@ func (q *Queries) CreateDAGs(ctx context.Context, db DBTX, arg CreateDAGsParams)
 		arg.Workflowids,
 		arg.Workflowversionids,
 		arg.Parenttaskexternalids,
+		arg.Idempotencykeys,
 	)
 	if err != nil {
 		return nil, err
@@ -88,6 +95,7 @@ func (q *Queries) CreateDAGs(ctx context.Context, db DBTX, arg CreateDAGsParams)
 			&i.WorkflowID,
 			&i.WorkflowVersionID,
 			&i.ParentTaskExternalID,
+			&i.IdempotencyKey,
 		); err != nil {
 			return nil, err
 		}
@@ -111,7 +119,7 @@ WITH input AS (
         ) AS subquery
 )
 SELECT
-    v1_dag_data.dag_id, v1_dag_data.dag_inserted_at, input, additional_metadata, input.dag_id, input.dag_inserted_at
+    v1_dag_data.dag_id, v1_dag_data.dag_inserted_at, input, additional_metadata, event_key, input.dag_id, input.dag_inserted_at
 FROM
     v1_dag_data
 JOIN
@@ -128,6 +136,7 @@ type GetDAGDataRow struct {
 	DagInsertedAt      pgtype.Timestamptz `json:"dag_inserted_at"`
 	Input              []byte             `json:"input"`
 	AdditionalMetadata []byte             `json:"additional_metadata"`
+	EventKey           pgtype.Text        `json:"event_key"`
 	DagID_2            interface{}        `json:"dag_id_2"`
 	DagInsertedAt_2    interface{}        `json:"dag_inserted_at_2"`
 }
@@ -146,6 +155,7 @@ func (q *Queries) GetDAGData(ctx context.Context, db DBTX, arg GetDAGDataParams)
 			&i.DagInsertedAt,
 			&i.Input,
 			&i.AdditionalMetadata,
+			&i.EventKey,
 			&i.DagID_2,
 			&i.DagInsertedAt_2,
 		); err != nil {
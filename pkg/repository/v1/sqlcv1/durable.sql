@@ -0,0 +1,214 @@
+-- name: CreateDurableSleeps :many
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@sleepDurations::text[]) AS sleep_duration
+        ) AS subquery
+)
+INSERT INTO v1_durable_sleep (
+    tenant_id,
+    sleep_until,
+    sleep_duration
+)
+SELECT
+    @tenantId::uuid,
+    CURRENT_TIMESTAMP + convert_duration_to_interval(i.sleep_duration),
+    i.sleep_duration
+FROM
+    input i
+RETURNING
+    *;
+
+-- name: PopDurableSleeps :many
+WITH sleeps_to_delete AS (
+    SELECT
+        *
+    FROM
+        v1_durable_sleep ds
+    WHERE
+        ds.tenant_id = @tenantId::uuid
+        AND ds.sleep_until <= CURRENT_TIMESTAMP
+    ORDER BY
+        ds.sleep_until, ds.id
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 1000)
+    FOR UPDATE SKIP LOCKED
+)
+DELETE FROM
+    v1_durable_sleep
+WHERE
+    (tenant_id, sleep_until, id) IN (SELECT tenant_id, sleep_until, id FROM sleeps_to_delete)
+RETURNING
+    *;
+
+-- name: ReleaseDurableTaskSlot :one
+-- Releases the slot of a durable task which is waiting on a durable event. The timeout of the task
+-- is pushed back to the end of the wait, so the task doesn't time out while it isn't running.
+WITH task AS (
+    SELECT
+        t.id,
+        t.inserted_at,
+        t.retry_count,
+        t.tenant_id,
+        t.step_timeout
+    FROM
+        v1_lookup_table lt
+    JOIN
+        v1_task t ON t.id = lt.task_id AND t.inserted_at = lt.inserted_at
+    WHERE
+        lt.external_id = @externalId::uuid AND
+        lt.tenant_id = @tenantId::uuid
+), locked_runtime AS (
+    SELECT
+        tr.task_id,
+        tr.task_inserted_at,
+        tr.retry_count,
+        tr.worker_id
+    FROM
+        v1_task_runtime tr
+    WHERE
+        (tr.task_id, tr.task_inserted_at, tr.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
+    ORDER BY
+        task_id, task_inserted_at, retry_count
+    FOR UPDATE
+)
+UPDATE
+    v1_task_runtime
+SET
+    worker_id = NULL,
+    timeout_at = sqlc.arg('waitUntil')::timestamptz + convert_duration_to_interval(task.step_timeout)
+FROM
+    task, locked_runtime
+WHERE
+    (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) =
+        (locked_runtime.task_id, locked_runtime.task_inserted_at, locked_runtime.retry_count)
+RETURNING
+    v1_task_runtime.task_id,
+    v1_task_runtime.task_inserted_at,
+    v1_task_runtime.retry_count,
+    v1_task_runtime.timeout_at,
+    locked_runtime.worker_id AS released_worker_id;
+
+-- name: LockWorkersForDurableResume :many
+-- Locks the workers which durable tasks are resumed on, so that concurrent resumes on the same worker
+-- can't fill the same slots. Only the workers which belong to the tenant are returned.
+SELECT
+    "id"
+FROM
+    "Worker"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = ANY(@workerIds::uuid[])
+ORDER BY
+    "id"
+FOR UPDATE;
+
+-- name: ResumeDurableTask :one
+-- Assigns a durable task which has finished waiting back to a worker, and resets its timeout. The task
+-- is only assigned if the worker belongs to the tenant and has enough free slots in the task's slot pool,
+-- otherwise it stays unassigned and resumed is false.
+WITH task AS (
+    SELECT
+        t.id,
+        t.inserted_at,
+        t.retry_count,
+        t.tenant_id,
+        t.step_timeout
+    FROM
+        v1_lookup_table lt
+    JOIN
+        v1_task t ON t.id = lt.task_id AND t.inserted_at = lt.inserted_at
+    WHERE
+        lt.external_id = @externalId::uuid AND
+        lt.tenant_id = @tenantId::uuid
+), locked_runtime AS (
+    SELECT
+        tr.task_id,
+        tr.task_inserted_at,
+        tr.retry_count,
+        tr.slots,
+        COALESCE(tr.slot_pool, '') AS slot_pool
+    FROM
+        v1_task_runtime tr
+    WHERE
+        (tr.task_id, tr.task_inserted_at, tr.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
+    ORDER BY
+        task_id, task_inserted_at, retry_count
+    FOR UPDATE
+), worker_max_runs AS (
+    -- the default slot pool of a worker has an empty name
+    SELECT
+        w."maxRuns"
+    FROM
+        "Worker" w, locked_runtime lr
+    WHERE
+        w."id" = @workerId::uuid
+        AND w."tenantId" = @tenantId::uuid
+        AND lr.slot_pool = ''
+    UNION ALL
+    SELECT
+        wsp."maxRuns"
+    FROM
+        "WorkerSlotPool" wsp
+    JOIN
+        "Worker" w ON w."id" = wsp."workerId"
+    JOIN
+        locked_runtime lr ON lr.slot_pool = wsp."name"
+    WHERE
+        wsp."workerId" = @workerId::uuid
+        AND w."tenantId" = @tenantId::uuid
+), worker_filled_slots AS (
+    SELECT
+        COALESCE(SUM(tr.slots), 0)::integer AS filled_slots
+    FROM
+        v1_task_runtime tr, locked_runtime lr
+    WHERE
+        tr.tenant_id = @tenantId::uuid
+        AND tr.worker_id = @workerId::uuid
+        AND COALESCE(tr.slot_pool, '') = lr.slot_pool
+        AND (tr.task_id, tr.task_inserted_at, tr.retry_count) != (lr.task_id, lr.task_inserted_at, lr.retry_count)
+), capacity AS (
+    SELECT
+        EXISTS (
+            SELECT
+                1
+            FROM
+                worker_max_runs wmr, worker_filled_slots wfs, locked_runtime lr
+            WHERE
+                wmr."maxRuns" IS NULL
+                OR wmr."maxRuns" - wfs.filled_slots >= lr.slots
+        ) AS has_capacity
+)
+UPDATE
+    v1_task_runtime
+SET
+    worker_id = CASE WHEN capacity.has_capacity THEN @workerId::uuid ELSE NULL END,
+    timeout_at = CASE
+        WHEN capacity.has_capacity THEN CURRENT_TIMESTAMP + convert_duration_to_interval(task.step_timeout)
+        ELSE v1_task_runtime.timeout_at
+    END
+FROM
+    task, locked_runtime, capacity
+WHERE
+    (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) =
+        (locked_runtime.task_id, locked_runtime.task_inserted_at, locked_runtime.retry_count)
+RETURNING
+    v1_task_runtime.*,
+    capacity.has_capacity::boolean AS resumed;
+
+-- name: UnassignResumedDurableTask :execrows
+-- Unassigns a durable task which was resumed on a worker that did not receive the result of its wait, so
+-- that the wait can be resumed again once the worker listens for it.
+UPDATE
+    v1_task_runtime
+SET
+    worker_id = NULL
+WHERE
+    task_id = @taskId::bigint
+    AND task_inserted_at = @taskInsertedAt::timestamptz
+    AND retry_count = @retryCount::int
+    AND tenant_id = @tenantId::uuid
+    AND worker_id = @workerId::uuid;
@@ -0,0 +1,101 @@
+-- name: UpsertEventSchema :one
+INSERT INTO v1_event_schema (
+    tenant_id,
+    event_key,
+    schema,
+    policy
+) VALUES (
+    @tenantId::uuid,
+    @eventKey::text,
+    @schema::jsonb,
+    @policy::v1_event_schema_policy
+)
+ON CONFLICT (tenant_id, event_key) DO UPDATE
+SET
+    schema = EXCLUDED.schema,
+    policy = EXCLUDED.policy,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetEventSchema :one
+SELECT
+    *
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = @eventKey::text;
+
+-- name: ListEventSchemas :many
+SELECT
+    *
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+ORDER BY
+    event_key ASC;
+
+-- name: ListEventSchemasByKeys :many
+SELECT
+    *
+FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = ANY(@eventKeys::text[]);
+
+-- name: DeleteEventSchema :one
+DELETE FROM
+    v1_event_schema
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_key = @eventKey::text
+RETURNING *;
+
+-- name: CreateQuarantinedEvents :exec
+INSERT INTO v1_quarantined_event (
+    tenant_id,
+    event_id,
+    event_key,
+    data,
+    additional_metadata,
+    error_message
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@eventIds::uuid[]),
+    unnest(@eventKeys::text[]),
+    unnest(@datas::jsonb[]),
+    unnest(@additionalMetadatas::jsonb[]),
+    unnest(@errorMessages::text[]);
+
+-- name: ListQuarantinedEvents :many
+SELECT
+    *
+FROM
+    v1_quarantined_event
+WHERE
+    tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('keys')::text[] IS NULL OR
+        event_key = ANY(sqlc.narg('keys')::text[])
+    )
+ORDER BY
+    inserted_at DESC, id DESC
+OFFSET
+    COALESCE(sqlc.narg('offset')::bigint, 0)
+LIMIT
+    COALESCE(sqlc.narg('limit')::bigint, 50);
+
+-- name: CountQuarantinedEvents :one
+SELECT
+    COUNT(*) AS total
+FROM
+    v1_quarantined_event
+WHERE
+    tenant_id = @tenantId::uuid
+    AND (
+        sqlc.narg('keys')::text[] IS NULL OR
+        event_key = ANY(sqlc.narg('keys')::text[])
+    );
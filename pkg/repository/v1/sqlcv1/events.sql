@@ -0,0 +1,61 @@
+-- name: CreateEventPartitions :exec
+SELECT
+    create_v1_range_partition('v1_event_deduplication', @date::date);
+
+-- name: ListEventPartitionsBeforeDate :many
+SELECT
+    'v1_event_deduplication' AS parent_table,
+    p::text AS partition_name
+FROM
+    get_v1_partitions_before_date('v1_event_deduplication', @date::date) AS p;
+
+-- name: LockEventDeduplicationKeys :exec
+SELECT
+    pg_advisory_xact_lock(hashtextextended(@tenantId::text || ':' || k.key, 0))
+FROM (
+    SELECT DISTINCT
+        key
+    FROM
+        unnest(@deduplicationKeys::text[]) AS key
+    ORDER BY
+        key
+) AS k;
+
+-- name: ListActiveEventDeduplications :many
+SELECT
+    *
+FROM
+    v1_event_deduplication
+WHERE
+    tenant_id = @tenantId::uuid
+    AND deduplication_key = ANY(@deduplicationKeys::text[])
+    AND expires_at > NOW()
+    -- keys cannot be valid for longer than a day, so only recent partitions are scanned
+    AND inserted_at > NOW() - INTERVAL '1 day';
+
+-- name: CreateEventDeduplications :exec
+INSERT INTO v1_event_deduplication (
+    tenant_id,
+    deduplication_key,
+    event_id,
+    event_key,
+    data,
+    additional_metadata,
+    expires_at
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@deduplicationKeys::text[]),
+    unnest(@eventIds::uuid[]),
+    unnest(@eventKeys::text[]),
+    unnest(@datas::jsonb[]),
+    unnest(@additionalMetadatas::jsonb[]),
+    NOW() + make_interval(secs => unnest(@ttlSeconds::bigint[]));
+
+-- name: DeleteEventDeduplications :exec
+DELETE FROM
+    v1_event_deduplication
+WHERE
+    tenant_id = @tenantId::uuid
+    AND event_id = ANY(@eventIds::uuid[])
+    AND inserted_at > NOW() - INTERVAL '1 day';
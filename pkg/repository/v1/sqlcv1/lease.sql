@@ -59,4 +59,5 @@ WHERE
     AND w."dispatcherId" IS NOT NULL
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
-    AND w."isPaused" = false;
+    AND w."isPaused" = false
+    AND w."isCordoned" = false;
@@ -115,6 +115,7 @@ WHERE
     AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
     AND w."isActive" = true
     AND w."isPaused" = false
+    AND w."isCordoned" = false
 `
 
 type ListActiveWorkersRow struct {
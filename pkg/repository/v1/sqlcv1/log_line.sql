@@ -4,13 +4,15 @@ INSERT INTO v1_log_line (
     task_id,
     task_inserted_at,
     message,
+    level,
     metadata
 ) VALUES (
     $1,
     $2,
     $3,
     $4,
-    $5
+    $5,
+    $6
 );
 
 -- name: ListLogLines :many
@@ -23,6 +25,8 @@ WHERE
     AND l.task_id = @taskId::bigint
     AND l.task_inserted_at = @taskInsertedAt::timestamptz
     AND (sqlc.narg('search')::text IS NULL OR l.message iLIKE concat('%', sqlc.narg('search')::text, '%'))
+    AND (sqlc.narg('levels')::v1_log_line_level[] IS NULL OR l.level = ANY(sqlc.narg('levels')::v1_log_line_level[]))
+    AND (sqlc.narg('fields')::jsonb IS NULL OR l.metadata @> sqlc.narg('fields')::jsonb)
 ORDER BY
     l.created_at ASC
 LIMIT COALESCE(sqlc.narg('limit'), 1000)
@@ -16,6 +16,7 @@ type InsertLogLineParams struct {
 	TaskID         int64              `json:"task_id"`
 	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
 	Message        string             `json:"message"`
+	Level          V1LogLineLevel     `json:"level"`
 	Metadata       []byte             `json:"metadata"`
 }
 
@@ -29,10 +30,12 @@ WHERE
     AND l.task_id = $2::bigint
     AND l.task_inserted_at = $3::timestamptz
     AND ($4::text IS NULL OR l.message iLIKE concat('%', $4::text, '%'))
+    AND ($5::v1_log_line_level[] IS NULL OR l.level = ANY($5::v1_log_line_level[]))
+    AND ($6::jsonb IS NULL OR l.metadata @> $6::jsonb)
 ORDER BY
     l.created_at ASC
-LIMIT COALESCE($6, 1000)
-OFFSET COALESCE($5, 0)
+LIMIT COALESCE($8, 1000)
+OFFSET COALESCE($7, 0)
 `
 
 type ListLogLinesParams struct {
@@ -40,6 +43,8 @@ type ListLogLinesParams struct {
 	Taskid         int64              `json:"taskid"`
 	Taskinsertedat pgtype.Timestamptz `json:"taskinsertedat"`
 	Search         pgtype.Text        `json:"search"`
+	Levels         []V1LogLineLevel   `json:"levels"`
+	Fields         []byte             `json:"fields"`
 	Offset         interface{}        `json:"offset"`
 	Limit          interface{}        `json:"limit"`
 }
@@ -50,6 +55,8 @@ func (q *Queries) ListLogLines(ctx context.Context, db DBTX, arg ListLogLinesPar
 		arg.Taskid,
 		arg.Taskinsertedat,
 		arg.Search,
+		arg.Levels,
+		arg.Fields,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -22,8 +22,10 @@ SELECT
 FROM
     v1_match_condition m
 JOIN
-    input i ON (m.tenant_id, m.event_type, m.event_key, m.is_satisfied, m.event_resource_hint) =
-        (@tenantId::uuid, @eventType::v1_event_type, i.event_key, FALSE, i.event_resource_hint);
+    input i ON (m.tenant_id, m.event_type, m.event_key, m.is_satisfied) =
+        (@tenantId::uuid, @eventType::v1_event_type, i.event_key, FALSE)
+        -- NOTE: user events don't have a resource hint, so we match null hints as well
+        AND m.event_resource_hint IS NOT DISTINCT FROM i.event_resource_hint;
 
 -- name: CreateMatchesForDAGTriggers :many
 WITH input AS (
@@ -30,8 +30,10 @@ SELECT
 FROM
     v1_match_condition m
 JOIN
-    input i ON (m.tenant_id, m.event_type, m.event_key, m.is_satisfied, m.event_resource_hint) =
-        ($1::uuid, $2::v1_event_type, i.event_key, FALSE, i.event_resource_hint)
+    input i ON (m.tenant_id, m.event_type, m.event_key, m.is_satisfied) =
+        ($1::uuid, $2::v1_event_type, i.event_key, FALSE)
+        -- NOTE: user events don't have a resource hint, so we match null hints as well
+        AND m.event_resource_hint IS NOT DISTINCT FROM i.event_resource_hint
 `
 
 type ListMatchConditionsForEventParams struct {
@@ -89,7 +91,7 @@ func (q *Queries) ListMatchConditionsForEvent(ctx context.Context, db DBTX, arg
 const createMatchesForDAGTriggers = `-- name: CreateMatchesForDAGTriggers :many
 WITH input AS (
     SELECT
-        tenant_id, kind, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_step_index, trigger_external_id, trigger_workflow_run_id, trigger_existing_task_id, trigger_existing_task_inserted_at, trigger_parent_task_external_id, trigger_parent_task_id, trigger_parent_task_inserted_at, trigger_child_index, trigger_child_key
+        tenant_id, kind, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_step_index, trigger_external_id, trigger_workflow_run_id, trigger_existing_task_id, trigger_existing_task_inserted_at, trigger_parent_task_external_id, trigger_parent_task_id, trigger_parent_task_inserted_at, trigger_child_index, trigger_child_key, trigger_deferred_step_conditions
     FROM
         (
             SELECT
@@ -107,7 +109,8 @@ WITH input AS (
 				unnest($12::bigint[]) AS trigger_parent_task_id,
 				unnest($13::timestamptz[]) AS trigger_parent_task_inserted_at,
 				unnest($14::bigint[]) AS trigger_child_index,
-				unnest($15::text[]) AS trigger_child_key
+				unnest($15::text[]) AS trigger_child_key,
+				unnest($16::boolean[]) AS trigger_deferred_step_conditions
         ) AS subquery
 )
 INSERT INTO v1_match (
@@ -125,7 +128,8 @@ INSERT INTO v1_match (
 	trigger_parent_task_id,
 	trigger_parent_task_inserted_at,
     trigger_child_index,
-    trigger_child_key
+    trigger_child_key,
+    trigger_deferred_step_conditions
 )
 SELECT
     i.tenant_id,
@@ -142,11 +146,12 @@ SELECT
 	i.trigger_parent_task_id,
 	i.trigger_parent_task_inserted_at,
 	i.trigger_child_index,
-	i.trigger_child_key
+	i.trigger_child_key,
+	i.trigger_deferred_step_conditions
 FROM
     input i
 RETURNING
-    id, tenant_id, kind, is_satisfied, signal_task_id, signal_task_inserted_at, signal_external_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_step_index, trigger_external_id, trigger_workflow_run_id, trigger_existing_task_id, trigger_existing_task_inserted_at, trigger_parent_task_external_id, trigger_parent_task_id, trigger_parent_task_inserted_at, trigger_child_index, trigger_child_key
+    id, tenant_id, kind, is_satisfied, signal_task_id, signal_task_inserted_at, signal_external_id, signal_key, trigger_dag_id, trigger_dag_inserted_at, trigger_step_id, trigger_step_index, trigger_external_id, trigger_workflow_run_id, trigger_existing_task_id, trigger_existing_task_inserted_at, trigger_parent_task_external_id, trigger_parent_task_id, trigger_parent_task_inserted_at, trigger_child_index, trigger_child_key, trigger_deferred_step_conditions
 `
 
 type CreateMatchesForDAGTriggersParams struct {
@@ -165,6 +170,7 @@ type CreateMatchesForDAGTriggersParams struct {
 	TriggerParentTaskInsertedAt   []pgtype.Timestamptz `json:"triggerparentTaskInsertedAt"`
 	TriggerChildIndex             []pgtype.Int8        `json:"triggerchildIndex"`
 	TriggerChildKey               []pgtype.Text        `json:"triggerchildKey"`
+	TriggerDeferredStepConditions []bool               `json:"triggerdeferredStepConditions"`
 }
 
 func (q *Queries) CreateMatchesForDAGTriggers(ctx context.Context, db DBTX, arg CreateMatchesForDAGTriggersParams) ([]*V1Match, error) {
@@ -184,6 +190,7 @@ func (q *Queries) CreateMatchesForDAGTriggers(ctx context.Context, db DBTX, arg
 		arg.TriggerParentTaskInsertedAt,
 		arg.TriggerChildIndex,
 		arg.TriggerChildKey,
+		arg.TriggerDeferredStepConditions,
 	)
 	if err != nil {
 		return nil, err
@@ -214,6 +221,7 @@ func (q *Queries) CreateMatchesForDAGTriggers(ctx context.Context, db DBTX, arg
 			&i.TriggerParentTaskInsertedAt,
 			&i.TriggerChildIndex,
 			&i.TriggerChildKey,
+			&i.TriggerDeferredStepConditions,
 		); err != nil {
 			return nil, err
 		}
@@ -14,11 +14,12 @@ import (
 type ConcurrencyLimitStrategy string
 
 const (
-	ConcurrencyLimitStrategyCANCELINPROGRESS ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
-	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
-	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
-	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
-	ConcurrencyLimitStrategyCANCELNEWEST     ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyCANCELINPROGRESS   ConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
+	ConcurrencyLimitStrategyDROPNEWEST         ConcurrencyLimitStrategy = "DROP_NEWEST"
+	ConcurrencyLimitStrategyQUEUENEWEST        ConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	ConcurrencyLimitStrategyGROUPROUNDROBIN    ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyCANCELNEWEST       ConcurrencyLimitStrategy = "CANCEL_NEWEST"
+	ConcurrencyLimitStrategyWEIGHTEDROUNDROBIN ConcurrencyLimitStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 func (e *ConcurrencyLimitStrategy) Scan(src interface{}) error {
@@ -406,6 +407,49 @@ func (ns NullMessageQueueItemStatus) Value() (driver.Value, error) {
 	return string(ns.MessageQueueItemStatus), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -413,6 +457,7 @@ const (
 	StepExpressionKindDYNAMICRATELIMITVALUE  StepExpressionKind = "DYNAMIC_RATE_LIMIT_VALUE"
 	StepExpressionKindDYNAMICRATELIMITUNITS  StepExpressionKind = "DYNAMIC_RATE_LIMIT_UNITS"
 	StepExpressionKindDYNAMICRATELIMITWINDOW StepExpressionKind = "DYNAMIC_RATE_LIMIT_WINDOW"
+	StepExpressionKindDYNAMICRATELIMITPARENT StepExpressionKind = "DYNAMIC_RATE_LIMIT_PARENT"
 )
 
 func (e *StepExpressionKind) Scan(src interface{}) error {
@@ -450,6 +495,91 @@ func (ns NullStepExpressionKind) Value() (driver.Value, error) {
 	return string(ns.StepExpressionKind), nil
 }
 
+type StepMatchConditionAction string
+
+const (
+	StepMatchConditionActionQUEUE  StepMatchConditionAction = "QUEUE"
+	StepMatchConditionActionSKIP   StepMatchConditionAction = "SKIP"
+	StepMatchConditionActionCANCEL StepMatchConditionAction = "CANCEL"
+)
+
+func (e *StepMatchConditionAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepMatchConditionAction(s)
+	case string:
+		*e = StepMatchConditionAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepMatchConditionAction: %T", src)
+	}
+	return nil
+}
+
+type NullStepMatchConditionAction struct {
+	StepMatchConditionAction StepMatchConditionAction `json:"StepMatchConditionAction"`
+	Valid                    bool                     `json:"valid"` // Valid is true if StepMatchConditionAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepMatchConditionAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepMatchConditionAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepMatchConditionAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepMatchConditionAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepMatchConditionAction), nil
+}
+
+type StepMatchConditionKind string
+
+const (
+	StepMatchConditionKindSLEEP     StepMatchConditionKind = "SLEEP"
+	StepMatchConditionKindUSEREVENT StepMatchConditionKind = "USER_EVENT"
+)
+
+func (e *StepMatchConditionKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepMatchConditionKind(s)
+	case string:
+		*e = StepMatchConditionKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepMatchConditionKind: %T", src)
+	}
+	return nil
+}
+
+type NullStepMatchConditionKind struct {
+	StepMatchConditionKind StepMatchConditionKind `json:"StepMatchConditionKind"`
+	Valid                  bool                   `json:"valid"` // Valid is true if StepMatchConditionKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepMatchConditionKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepMatchConditionKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepMatchConditionKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepMatchConditionKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepMatchConditionKind), nil
+}
+
 type StepRateLimitKind string
 
 const (
@@ -812,13 +942,100 @@ func (ns NullTenantResourceLimitAlertType) Value() (driver.Value, error) {
 	return string(ns.TenantResourceLimitAlertType), nil
 }
 
+type V1BulkOperationKind string
+
+const (
+	V1BulkOperationKindCANCEL V1BulkOperationKind = "CANCEL"
+	V1BulkOperationKindREPLAY V1BulkOperationKind = "REPLAY"
+)
+
+func (e *V1BulkOperationKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkOperationKind(s)
+	case string:
+		*e = V1BulkOperationKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkOperationKind: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkOperationKind struct {
+	V1BulkOperationKind V1BulkOperationKind `json:"v1_bulk_operation_kind"`
+	Valid               bool                `json:"valid"` // Valid is true if V1BulkOperationKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkOperationKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkOperationKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkOperationKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkOperationKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkOperationKind), nil
+}
+
+type V1BulkOperationStatus string
+
+const (
+	V1BulkOperationStatusPENDING   V1BulkOperationStatus = "PENDING"
+	V1BulkOperationStatusRUNNING   V1BulkOperationStatus = "RUNNING"
+	V1BulkOperationStatusCOMPLETED V1BulkOperationStatus = "COMPLETED"
+	V1BulkOperationStatusFAILED    V1BulkOperationStatus = "FAILED"
+)
+
+func (e *V1BulkOperationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1BulkOperationStatus(s)
+	case string:
+		*e = V1BulkOperationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1BulkOperationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullV1BulkOperationStatus struct {
+	V1BulkOperationStatus V1BulkOperationStatus `json:"v1_bulk_operation_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if V1BulkOperationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1BulkOperationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1BulkOperationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1BulkOperationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1BulkOperationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1BulkOperationStatus), nil
+}
+
 type V1ConcurrencyStrategy string
 
 const (
-	V1ConcurrencyStrategyNONE             V1ConcurrencyStrategy = "NONE"
-	V1ConcurrencyStrategyGROUPROUNDROBIN  V1ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
-	V1ConcurrencyStrategyCANCELINPROGRESS V1ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
-	V1ConcurrencyStrategyCANCELNEWEST     V1ConcurrencyStrategy = "CANCEL_NEWEST"
+	V1ConcurrencyStrategyNONE               V1ConcurrencyStrategy = "NONE"
+	V1ConcurrencyStrategyGROUPROUNDROBIN    V1ConcurrencyStrategy = "GROUP_ROUND_ROBIN"
+	V1ConcurrencyStrategyCANCELINPROGRESS   V1ConcurrencyStrategy = "CANCEL_IN_PROGRESS"
+	V1ConcurrencyStrategyCANCELNEWEST       V1ConcurrencyStrategy = "CANCEL_NEWEST"
+	V1ConcurrencyStrategyWEIGHTEDROUNDROBIN V1ConcurrencyStrategy = "WEIGHTED_ROUND_ROBIN"
 )
 
 func (e *V1ConcurrencyStrategy) Scan(src interface{}) error {
@@ -856,6 +1073,48 @@ func (ns NullV1ConcurrencyStrategy) Value() (driver.Value, error) {
 	return string(ns.V1ConcurrencyStrategy), nil
 }
 
+type V1EventSchemaPolicy string
+
+const (
+	V1EventSchemaPolicyREJECT     V1EventSchemaPolicy = "REJECT"
+	V1EventSchemaPolicyQUARANTINE V1EventSchemaPolicy = "QUARANTINE"
+)
+
+func (e *V1EventSchemaPolicy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = V1EventSchemaPolicy(s)
+	case string:
+		*e = V1EventSchemaPolicy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for V1EventSchemaPolicy: %T", src)
+	}
+	return nil
+}
+
+type NullV1EventSchemaPolicy struct {
+	V1EventSchemaPolicy V1EventSchemaPolicy `json:"v1_event_schema_policy"`
+	Valid               bool                `json:"valid"` // Valid is true if V1EventSchemaPolicy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullV1EventSchemaPolicy) Scan(value interface{}) error {
+	if value == nil {
+		ns.V1EventSchemaPolicy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.V1EventSchemaPolicy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullV1EventSchemaPolicy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.V1EventSchemaPolicy), nil
+}
+
 type V1EventType string
 
 const (
@@ -1355,6 +1614,7 @@ const (
 	V1TaskInitialStateCANCELLED V1TaskInitialState = "CANCELLED"
 	V1TaskInitialStateSKIPPED   V1TaskInitialState = "SKIPPED"
 	V1TaskInitialStateFAILED    V1TaskInitialState = "FAILED"
+	V1TaskInitialStateMAPPED    V1TaskInitialState = "MAPPED"
 )
 
 func (e *V1TaskInitialState) Scan(src interface{}) error {
@@ -1978,12 +2238,16 @@ type QueueItem struct {
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId     pgtype.UUID        `json:"tenantId"`
+	Key          string             `json:"key"`
+	LimitValue   int32              `json:"limitValue"`
+	Value        int32              `json:"value"`
+	Window       string             `json:"window"`
+	LastRefill   pgtype.Timestamp   `json:"lastRefill"`
+	ParentKey    pgtype.Text        `json:"parentKey"`
+	Algorithm    RateLimitAlgorithm `json:"algorithm"`
+	Burst        pgtype.Int4        `json:"burst"`
+	PreviousUsed pgtype.Int4        `json:"previousUsed"`
 }
 
 type RetryQueueItem struct {
@@ -2063,6 +2327,21 @@ type Step struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	SkipIf             pgtype.Text      `json:"skipIf"`
+	MapExpression      pgtype.Text      `json:"mapExpression"`
+	MapMaxParallelism  pgtype.Int4      `json:"mapMaxParallelism"`
+	Slots              int32            `json:"slots"`
+	SlotPool           pgtype.Text      `json:"slotPool"`
+}
+
+type StepConcurrency struct {
+	ID               int64                    `json:"id"`
+	TenantId         pgtype.UUID              `json:"tenantId"`
+	StepId           pgtype.UUID              `json:"stepId"`
+	Expression       string                   `json:"expression"`
+	MaxRuns          int32                    `json:"maxRuns"`
+	LimitStrategy    ConcurrencyLimitStrategy `json:"limitStrategy"`
+	WeightExpression pgtype.Text              `json:"weightExpression"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -2085,6 +2364,19 @@ type StepExpression struct {
 	Kind       StepExpressionKind `json:"kind"`
 }
 
+type StepMatchCondition struct {
+	ID              int64                    `json:"id"`
+	TenantId        pgtype.UUID              `json:"tenantId"`
+	StepId          pgtype.UUID              `json:"stepId"`
+	OrGroupId       string                   `json:"orGroupId"`
+	Action          StepMatchConditionAction `json:"action"`
+	Kind            StepMatchConditionKind   `json:"kind"`
+	ReadableDataKey string                   `json:"readableDataKey"`
+	SleepDuration   pgtype.Text              `json:"sleepDuration"`
+	EventKey        pgtype.Text              `json:"eventKey"`
+	Expression      pgtype.Text              `json:"expression"`
+}
+
 type StepOrder struct {
 	A pgtype.UUID `json:"A"`
 	B pgtype.UUID `json:"B"`
@@ -2357,6 +2649,34 @@ type UserSession struct {
 	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
 }
 
+type V1BulkOperation struct {
+	ID             pgtype.UUID           `json:"id"`
+	TenantID       pgtype.UUID           `json:"tenant_id"`
+	Kind           V1BulkOperationKind   `json:"kind"`
+	Status         V1BulkOperationStatus `json:"status"`
+	Filter         []byte                `json:"filter"`
+	MatchedCount   int64                 `json:"matched_count"`
+	ProcessedCount int64                 `json:"processed_count"`
+	FailedCount    int64                 `json:"failed_count"`
+	LastItemID     int64                 `json:"last_item_id"`
+	ErrorMessage   pgtype.Text           `json:"error_message"`
+	CreatedAt      pgtype.Timestamptz    `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz    `json:"updated_at"`
+	FinishedAt     pgtype.Timestamptz    `json:"finished_at"`
+}
+
+type V1BulkOperationItem struct {
+	ID              int64       `json:"id"`
+	BulkOperationID pgtype.UUID `json:"bulk_operation_id"`
+	ExternalID      pgtype.UUID `json:"external_id"`
+}
+
+type V1ConcurrencyKeyWeight struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	Key      string      `json:"key"`
+	Weight   int32       `json:"weight"`
+}
+
 type V1ConcurrencySlot struct {
 	SortID                pgtype.Int8        `json:"sort_id"`
 	TaskID                int64              `json:"task_id"`
@@ -2388,6 +2708,7 @@ type V1Dag struct {
 	WorkflowID           pgtype.UUID        `json:"workflow_id"`
 	WorkflowVersionID    pgtype.UUID        `json:"workflow_version_id"`
 	ParentTaskExternalID pgtype.UUID        `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text        `json:"idempotency_key"`
 }
 
 type V1DagData struct {
@@ -2395,6 +2716,7 @@ type V1DagData struct {
 	DagInsertedAt      pgtype.Timestamptz `json:"dag_inserted_at"`
 	Input              []byte             `json:"input"`
 	AdditionalMetadata []byte             `json:"additional_metadata"`
+	EventKey           pgtype.Text        `json:"event_key"`
 }
 
 type V1DagToTask struct {
@@ -2423,6 +2745,54 @@ type V1DagsOlap struct {
 	Input                []byte               `json:"input"`
 	AdditionalMetadata   []byte               `json:"additional_metadata"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
+}
+
+type V1DurableSleep struct {
+	ID            int64              `json:"id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	SleepUntil    pgtype.Timestamptz `json:"sleep_until"`
+	SleepDuration string             `json:"sleep_duration"`
+}
+
+type V1EventDeduplication struct {
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	DeduplicationKey   string             `json:"deduplication_key"`
+	EventID            pgtype.UUID        `json:"event_id"`
+	EventKey           string             `json:"event_key"`
+	Data               []byte             `json:"data"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	InsertedAt         pgtype.Timestamptz `json:"inserted_at"`
+	ExpiresAt          pgtype.Timestamptz `json:"expires_at"`
+}
+
+type V1EventSchema struct {
+	TenantID  pgtype.UUID         `json:"tenant_id"`
+	EventKey  string              `json:"event_key"`
+	Schema    []byte              `json:"schema"`
+	Policy    V1EventSchemaPolicy `json:"policy"`
+	CreatedAt pgtype.Timestamptz  `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz  `json:"updated_at"`
+}
+
+type V1EventTriggerSkip struct {
+	ID                int64              `json:"id"`
+	TenantID          pgtype.UUID        `json:"tenant_id"`
+	EventID           pgtype.UUID        `json:"event_id"`
+	EventKey          string             `json:"event_key"`
+	WorkflowID        pgtype.UUID        `json:"workflow_id"`
+	WorkflowVersionID pgtype.UUID        `json:"workflow_version_id"`
+	Expression        string             `json:"expression"`
+	ErrorMessage      pgtype.Text        `json:"error_message"`
+	InsertedAt        pgtype.Timestamptz `json:"inserted_at"`
+}
+
+type V1IdempotencyKey struct {
+	TenantID   pgtype.UUID        `json:"tenant_id"`
+	Key        string             `json:"key"`
+	ExternalID pgtype.UUID        `json:"external_id"`
+	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
 }
 
 type V1LogLine struct {
@@ -2452,6 +2822,18 @@ type V1LookupTableOlap struct {
 	InsertedAt pgtype.Timestamptz `json:"inserted_at"`
 }
 
+type V1MapTask struct {
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount     int32              `json:"retry_count"`
+	Items          []byte             `json:"items"`
+	ItemCount      int32              `json:"item_count"`
+	CreatedCount   int32              `json:"created_count"`
+	CompletedCount int32              `json:"completed_count"`
+	ErrorMessage   pgtype.Text        `json:"error_message"`
+}
+
 type V1Match struct {
 	ID                            int64              `json:"id"`
 	TenantID                      pgtype.UUID        `json:"tenant_id"`
@@ -2474,6 +2856,7 @@ type V1Match struct {
 	TriggerChildKey               pgtype.Text        `json:"trigger_child_key"`
 	TriggerExistingTaskID         pgtype.Int8        `json:"trigger_existing_task_id"`
 	TriggerExistingTaskInsertedAt pgtype.Timestamptz `json:"trigger_existing_task_inserted_at"`
+	TriggerDeferredStepConditions bool               `json:"trigger_deferred_step_conditions"`
 }
 
 type V1MatchCondition struct {
@@ -2492,6 +2875,17 @@ type V1MatchCondition struct {
 	Data              []byte                 `json:"data"`
 }
 
+type V1QuarantinedEvent struct {
+	ID                 int64              `json:"id"`
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	EventID            pgtype.UUID        `json:"event_id"`
+	EventKey           string             `json:"event_key"`
+	Data               []byte             `json:"data"`
+	AdditionalMetadata []byte             `json:"additional_metadata"`
+	ErrorMessage       string             `json:"error_message"`
+	InsertedAt         pgtype.Timestamptz `json:"inserted_at"`
+}
+
 type V1Queue struct {
 	TenantID   pgtype.UUID      `json:"tenant_id"`
 	Name       string           `json:"name"`
@@ -2517,6 +2911,14 @@ type V1QueueItem struct {
 	RetryCount        int32              `json:"retry_count"`
 }
 
+type V1RateLimitSamplesOlap struct {
+	TenantID   pgtype.UUID        `json:"tenant_id"`
+	Key        string             `json:"key"`
+	SampledAt  pgtype.Timestamptz `json:"sampled_at"`
+	Value      int32              `json:"value"`
+	LimitValue int32              `json:"limit_value"`
+}
+
 type V1RetryQueueItem struct {
 	TaskID         int64              `json:"task_id"`
 	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
@@ -2536,6 +2938,7 @@ type V1RunsOlap struct {
 	WorkflowVersionID    pgtype.UUID          `json:"workflow_version_id"`
 	AdditionalMetadata   []byte               `json:"additional_metadata"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 }
 
 type V1StatusesOlap struct {
@@ -2558,6 +2961,7 @@ type V1StepConcurrency struct {
 	Expression        string                `json:"expression"`
 	TenantID          pgtype.UUID           `json:"tenant_id"`
 	MaxConcurrency    int32                 `json:"max_concurrency"`
+	WeightExpression  pgtype.Text           `json:"weight_expression"`
 }
 
 type V1Task struct {
@@ -2591,6 +2995,7 @@ type V1Task struct {
 	ParentTaskInsertedAt         pgtype.Timestamptz `json:"parent_task_inserted_at"`
 	ChildIndex                   pgtype.Int8        `json:"child_index"`
 	ChildKey                     pgtype.Text        `json:"child_key"`
+	IdempotencyKey               pgtype.Text        `json:"idempotency_key"`
 	InitialState                 V1TaskInitialState `json:"initial_state"`
 	InitialStateReason           pgtype.Text        `json:"initial_state_reason"`
 	ConcurrencyParentStrategyIds []pgtype.Int8      `json:"concurrency_parent_strategy_ids"`
@@ -2598,6 +3003,7 @@ type V1Task struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	EventKey                     pgtype.Text        `json:"event_key"`
 }
 
 type V1TaskEvent struct {
@@ -2659,6 +3065,8 @@ type V1TaskRuntime struct {
 	WorkerID       pgtype.UUID        `json:"worker_id"`
 	TenantID       pgtype.UUID        `json:"tenant_id"`
 	TimeoutAt      pgtype.Timestamp   `json:"timeout_at"`
+	Slots          int32              `json:"slots"`
+	SlotPool       pgtype.Text        `json:"slot_pool"`
 }
 
 type V1TaskStatusUpdatesTmp struct {
@@ -2695,6 +3103,7 @@ type V1TasksOlap struct {
 	DagID                pgtype.Int8          `json:"dag_id"`
 	DagInsertedAt        pgtype.Timestamptz   `json:"dag_inserted_at"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 }
 
 type V1WorkflowConcurrency struct {
@@ -2770,6 +3179,7 @@ type Worker struct {
 	Os                      pgtype.Text      `json:"os"`
 	RuntimeExtra            pgtype.Text      `json:"runtimeExtra"`
 	SdkVersion              pgtype.Text      `json:"sdkVersion"`
+	IsCordoned              bool             `json:"isCordoned"`
 }
 
 type WorkerAssignEvent struct {
@@ -2788,6 +3198,12 @@ type WorkerLabel struct {
 	IntValue  pgtype.Int4      `json:"intValue"`
 }
 
+type WorkerSlotPool struct {
+	WorkerId pgtype.UUID `json:"workerId"`
+	Name     string      `json:"name"`
+	MaxRuns  int32       `json:"maxRuns"`
+}
+
 type Workflow struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -2808,6 +3224,7 @@ type WorkflowConcurrency struct {
 	MaxRuns                    int32                    `json:"maxRuns"`
 	LimitStrategy              ConcurrencyLimitStrategy `json:"limitStrategy"`
 	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
+	WeightExpression           pgtype.Text              `json:"weightExpression"`
 }
 
 type WorkflowRun struct {
@@ -2898,8 +3315,9 @@ type WorkflowTriggerCronRef struct {
 }
 
 type WorkflowTriggerEventRef struct {
-	ParentId pgtype.UUID `json:"parentId"`
-	EventKey string      `json:"eventKey"`
+	ParentId   pgtype.UUID `json:"parentId"`
+	EventKey   string      `json:"eventKey"`
+	Expression pgtype.Text `json:"expression"`
 }
 
 type WorkflowTriggerScheduledRef struct {
@@ -107,6 +107,10 @@ WHERE
         sqlc.narg('parentTaskExternalId')::UUID IS NULL
         OR parent_task_external_id = sqlc.narg('parentTaskExternalId')::UUID
     )
+    AND (
+        sqlc.narg('afterInsertedAt')::timestamptz IS NULL
+        OR (inserted_at, id) < (sqlc.narg('afterInsertedAt')::timestamptz, sqlc.narg('afterId')::bigint)
+    )
 ORDER BY inserted_at DESC, id DESC
 LIMIT @listWorkflowRunsLimit::integer
 OFFSET @listWorkflowRunsOffset::integer
@@ -162,6 +162,10 @@ WHERE
         $10::UUID IS NULL
         OR parent_task_external_id = $10::UUID
     )
+    AND (
+        $11::timestamptz IS NULL
+        OR (inserted_at, id) < ($11::timestamptz, $12::bigint)
+    )
 
 ORDER BY inserted_at DESC, id DESC
 LIMIT $9::integer
@@ -179,6 +183,8 @@ type FetchWorkflowRunIdsParams struct {
 	Listworkflowrunsoffset int32              `json:"listworkflowrunsoffset"`
 	Listworkflowrunslimit  int32              `json:"listworkflowrunslimit"`
 	ParentTaskExternalId   pgtype.UUID        `json:"parentTaskExternalId"`
+	AfterInsertedAt        pgtype.Timestamptz `json:"afterInsertedAt"`
+	AfterId                pgtype.Int8        `json:"afterId"`
 }
 
 type FetchWorkflowRunIdsRow struct {
@@ -200,6 +206,8 @@ func (q *Queries) FetchWorkflowRunIds(ctx context.Context, db DBTX, arg FetchWor
 		arg.Listworkflowrunsoffset,
 		arg.Listworkflowrunslimit,
 		arg.ParentTaskExternalId,
+		arg.AfterInsertedAt,
+		arg.AfterId,
 	)
 
 	if err != nil {
@@ -4,7 +4,8 @@ SELECT
     create_v1_hash_partitions('v1_task_status_updates_tmp'::text, @partitions::int),
     create_v1_olap_partition_with_date_and_status('v1_tasks_olap'::text, @date::date),
     create_v1_olap_partition_with_date_and_status('v1_runs_olap'::text, @date::date),
-    create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, @date::date);
+    create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, @date::date),
+    create_v1_range_partition('v1_rate_limit_samples_olap'::text, @date::date);
 
 -- name: ListOLAPPartitionsBeforeDate :many
 WITH task_partitions AS (
@@ -13,6 +14,8 @@ WITH task_partitions AS (
     SELECT 'v1_dags_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_dags_olap', @date::date) AS p
 ), runs_partitions AS (
     SELECT 'v1_runs_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_runs_olap', @date::date) AS p
+), rate_limit_sample_partitions AS (
+    SELECT 'v1_rate_limit_samples_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_rate_limit_samples_olap', @date::date) AS p
 )
 SELECT
     *
@@ -31,7 +34,14 @@ UNION ALL
 SELECT
     *
 FROM
-    runs_partitions;
+    runs_partitions
+
+UNION ALL
+
+SELECT
+    *
+FROM
+    rate_limit_sample_partitions;
 
 -- name: CreateTasksOLAP :copyfrom
 INSERT INTO v1_tasks_olap (
@@ -55,7 +65,8 @@ INSERT INTO v1_tasks_olap (
     additional_metadata,
     dag_id,
     dag_inserted_at,
-    parent_task_external_id
+    parent_task_external_id,
+    idempotency_key
 ) VALUES (
     $1,
     $2,
@@ -77,7 +88,8 @@ INSERT INTO v1_tasks_olap (
     $18,
     $19,
     $20,
-    $21
+    $21,
+    $22
 );
 
 -- name: CreateDAGsOLAP :copyfrom
@@ -91,7 +103,8 @@ INSERT INTO v1_dags_olap (
     workflow_version_id,
     input,
     additional_metadata,
-    parent_task_external_id
+    parent_task_external_id,
+    idempotency_key
 ) VALUES (
     $1,
     $2,
@@ -102,7 +115,8 @@ INSERT INTO v1_dags_olap (
     $7,
     $8,
     $9,
-    $10
+    $10,
+    $11
 );
 
 -- name: CreateTaskEventsOLAPTmp :copyfrom
@@ -1159,3 +1173,37 @@ WHERE
     AND lt.tenant_id = @tenantId::uuid
 LIMIT 10000
 ;
+
+-- name: CreateRateLimitSamplesOLAP :exec
+-- Inserts samples of the units available for rate limits, which are computed by the caller from
+-- the algorithm of each rate limit
+INSERT INTO v1_rate_limit_samples_olap (
+    tenant_id,
+    key,
+    value,
+    limit_value
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@keys::text[]),
+    unnest(@values::int[]),
+    unnest(@limitValues::int[])
+ON CONFLICT (tenant_id, key, sampled_at) DO NOTHING;
+
+-- name: ListRateLimitSamples :many
+SELECT
+    DATE_BIN(
+        COALESCE(sqlc.narg('interval')::INTERVAL, '1 minute'),
+        sampled_at,
+        TIMESTAMPTZ '1970-01-01 00:00:00+00'
+    ) :: TIMESTAMPTZ AS bucket,
+    MIN(value)::int AS min_value,
+    MAX(limit_value)::int AS limit_value
+FROM
+    v1_rate_limit_samples_olap
+WHERE
+    tenant_id = @tenantId::UUID
+    AND key = @key::TEXT
+    AND sampled_at BETWEEN @sampledAfter::TIMESTAMPTZ AND @sampledBefore::TIMESTAMPTZ
+GROUP BY bucket
+ORDER BY bucket;
@@ -22,6 +22,7 @@ type CreateDAGsOLAPParams struct {
 	Input                []byte             `json:"input"`
 	AdditionalMetadata   []byte             `json:"additional_metadata"`
 	ParentTaskExternalID pgtype.UUID        `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text        `json:"idempotency_key"`
 }
 
 const createOLAPPartitions = `-- name: CreateOLAPPartitions :exec
@@ -30,7 +31,8 @@ SELECT
     create_v1_hash_partitions('v1_task_status_updates_tmp'::text, $1::int),
     create_v1_olap_partition_with_date_and_status('v1_tasks_olap'::text, $2::date),
     create_v1_olap_partition_with_date_and_status('v1_runs_olap'::text, $2::date),
-    create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, $2::date)
+    create_v1_olap_partition_with_date_and_status('v1_dags_olap'::text, $2::date),
+    create_v1_range_partition('v1_rate_limit_samples_olap'::text, $2::date)
 `
 
 type CreateOLAPPartitionsParams struct {
@@ -43,6 +45,40 @@ func (q *Queries) CreateOLAPPartitions(ctx context.Context, db DBTX, arg CreateO
 	return err
 }
 
+const createRateLimitSamplesOLAP = `-- name: CreateRateLimitSamplesOLAP :exec
+INSERT INTO v1_rate_limit_samples_olap (
+    tenant_id,
+    key,
+    value,
+    limit_value
+)
+SELECT
+    $1::uuid,
+    unnest($2::text[]),
+    unnest($3::int[]),
+    unnest($4::int[])
+ON CONFLICT (tenant_id, key, sampled_at) DO NOTHING
+`
+
+type CreateRateLimitSamplesOLAPParams struct {
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Keys        []string    `json:"keys"`
+	Values      []int32     `json:"values"`
+	Limitvalues []int32     `json:"limitvalues"`
+}
+
+// Inserts samples of the units available for rate limits, which are computed by the caller from
+// the algorithm of each rate limit
+func (q *Queries) CreateRateLimitSamplesOLAP(ctx context.Context, db DBTX, arg CreateRateLimitSamplesOLAPParams) error {
+	_, err := db.Exec(ctx, createRateLimitSamplesOLAP,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Values,
+		arg.Limitvalues,
+	)
+	return err
+}
+
 type CreateTaskEventsOLAPParams struct {
 	TenantID               pgtype.UUID          `json:"tenant_id"`
 	TaskID                 int64                `json:"task_id"`
@@ -91,6 +127,7 @@ type CreateTasksOLAPParams struct {
 	DagID                pgtype.Int8          `json:"dag_id"`
 	DagInsertedAt        pgtype.Timestamptz   `json:"dag_inserted_at"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 }
 
 const flattenTasksByExternalIds = `-- name: FlattenTasksByExternalIds :many
@@ -324,6 +361,8 @@ WITH task_partitions AS (
     SELECT 'v1_dags_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_dags_olap', $1::date) AS p
 ), runs_partitions AS (
     SELECT 'v1_runs_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_runs_olap', $1::date) AS p
+), rate_limit_sample_partitions AS (
+    SELECT 'v1_rate_limit_samples_olap' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_rate_limit_samples_olap', $1::date) AS p
 )
 SELECT
     parent_table, partition_name
@@ -343,6 +382,13 @@ SELECT
     parent_table, partition_name
 FROM
     runs_partitions
+
+UNION ALL
+
+SELECT
+    parent_table, partition_name
+FROM
+    rate_limit_sample_partitions
 `
 
 type ListOLAPPartitionsBeforeDateRow struct {
@@ -370,6 +416,65 @@ func (q *Queries) ListOLAPPartitionsBeforeDate(ctx context.Context, db DBTX, dat
 	return items, nil
 }
 
+const listRateLimitSamples = `-- name: ListRateLimitSamples :many
+SELECT
+    DATE_BIN(
+        COALESCE($1::INTERVAL, '1 minute'),
+        sampled_at,
+        TIMESTAMPTZ '1970-01-01 00:00:00+00'
+    ) :: TIMESTAMPTZ AS bucket,
+    MIN(value)::int AS min_value,
+    MAX(limit_value)::int AS limit_value
+FROM
+    v1_rate_limit_samples_olap
+WHERE
+    tenant_id = $2::UUID
+    AND key = $3::TEXT
+    AND sampled_at BETWEEN $4::TIMESTAMPTZ AND $5::TIMESTAMPTZ
+GROUP BY bucket
+ORDER BY bucket
+`
+
+type ListRateLimitSamplesParams struct {
+	Interval      pgtype.Interval    `json:"interval"`
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+	Key           string             `json:"key"`
+	Sampledafter  pgtype.Timestamptz `json:"sampledafter"`
+	Sampledbefore pgtype.Timestamptz `json:"sampledbefore"`
+}
+
+type ListRateLimitSamplesRow struct {
+	Bucket     pgtype.Timestamptz `json:"bucket"`
+	MinValue   int32              `json:"min_value"`
+	LimitValue int32              `json:"limit_value"`
+}
+
+func (q *Queries) ListRateLimitSamples(ctx context.Context, db DBTX, arg ListRateLimitSamplesParams) ([]*ListRateLimitSamplesRow, error) {
+	rows, err := db.Query(ctx, listRateLimitSamples,
+		arg.Interval,
+		arg.Tenantid,
+		arg.Key,
+		arg.Sampledafter,
+		arg.Sampledbefore,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListRateLimitSamplesRow
+	for rows.Next() {
+		var i ListRateLimitSamplesRow
+		if err := rows.Scan(&i.Bucket, &i.MinValue, &i.LimitValue); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTaskEvents = `-- name: ListTaskEvents :many
 WITH aggregated_events AS (
   SELECT
@@ -961,7 +1066,7 @@ WITH latest_retry_count AS (
     )
 )
 SELECT
-    t.tenant_id, t.id, t.inserted_at, t.external_id, t.queue, t.action_id, t.step_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.display_name, t.input, t.additional_metadata, t.readable_status, t.latest_retry_count, t.latest_worker_id, t.dag_id, t.dag_inserted_at, t.parent_task_external_id,
+    t.tenant_id, t.id, t.inserted_at, t.external_id, t.queue, t.action_id, t.step_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.display_name, t.input, t.additional_metadata, t.readable_status, t.latest_retry_count, t.latest_worker_id, t.dag_id, t.dag_inserted_at, t.parent_task_external_id, t.idempotency_key,
     st.readable_status::v1_readable_status_olap as status,
     f.finished_at::timestamptz as finished_at,
     s.started_at::timestamptz as started_at,
@@ -1017,6 +1122,7 @@ type PopulateSingleTaskRunDataRow struct {
 	DagID                pgtype.Int8          `json:"dag_id"`
 	DagInsertedAt        pgtype.Timestamptz   `json:"dag_inserted_at"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 	Status               V1ReadableStatusOlap `json:"status"`
 	FinishedAt           pgtype.Timestamptz   `json:"finished_at"`
 	StartedAt            pgtype.Timestamptz   `json:"started_at"`
@@ -1053,6 +1159,7 @@ func (q *Queries) PopulateSingleTaskRunData(ctx context.Context, db DBTX, arg Po
 		&i.DagID,
 		&i.DagInsertedAt,
 		&i.ParentTaskExternalID,
+		&i.IdempotencyKey,
 		&i.Status,
 		&i.FinishedAt,
 		&i.StartedAt,
@@ -1285,7 +1392,7 @@ WITH lookup_task AS (
         external_id = $1::uuid
 )
 SELECT
-    d.id, d.inserted_at, d.tenant_id, d.external_id, d.display_name, d.workflow_id, d.workflow_version_id, d.readable_status, d.input, d.additional_metadata, d.parent_task_external_id
+    d.id, d.inserted_at, d.tenant_id, d.external_id, d.display_name, d.workflow_id, d.workflow_version_id, d.readable_status, d.input, d.additional_metadata, d.parent_task_external_id, d.idempotency_key
 FROM
     v1_dags_olap d
 JOIN
@@ -1307,6 +1414,7 @@ func (q *Queries) ReadDAGByExternalID(ctx context.Context, db DBTX, externalid p
 		&i.Input,
 		&i.AdditionalMetadata,
 		&i.ParentTaskExternalID,
+		&i.IdempotencyKey,
 	)
 	return &i, err
 }
@@ -1323,7 +1431,7 @@ WITH lookup_task AS (
         external_id = $1::uuid
 )
 SELECT
-    t.tenant_id, t.id, t.inserted_at, t.external_id, t.queue, t.action_id, t.step_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.display_name, t.input, t.additional_metadata, t.readable_status, t.latest_retry_count, t.latest_worker_id, t.dag_id, t.dag_inserted_at, t.parent_task_external_id,
+    t.tenant_id, t.id, t.inserted_at, t.external_id, t.queue, t.action_id, t.step_id, t.workflow_id, t.workflow_version_id, t.workflow_run_id, t.schedule_timeout, t.step_timeout, t.priority, t.sticky, t.desired_worker_id, t.display_name, t.input, t.additional_metadata, t.readable_status, t.latest_retry_count, t.latest_worker_id, t.dag_id, t.dag_inserted_at, t.parent_task_external_id, t.idempotency_key,
     e.output,
     e.error_message
 FROM
@@ -1359,6 +1467,7 @@ type ReadTaskByExternalIDRow struct {
 	DagID                pgtype.Int8          `json:"dag_id"`
 	DagInsertedAt        pgtype.Timestamptz   `json:"dag_inserted_at"`
 	ParentTaskExternalID pgtype.UUID          `json:"parent_task_external_id"`
+	IdempotencyKey       pgtype.Text          `json:"idempotency_key"`
 	Output               []byte               `json:"output"`
 	ErrorMessage         pgtype.Text          `json:"error_message"`
 }
@@ -1391,6 +1500,7 @@ func (q *Queries) ReadTaskByExternalID(ctx context.Context, db DBTX, externalid
 		&i.DagID,
 		&i.DagInsertedAt,
 		&i.ParentTaskExternalID,
+		&i.IdempotencyKey,
 		&i.Output,
 		&i.ErrorMessage,
 	)
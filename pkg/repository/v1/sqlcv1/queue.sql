@@ -38,34 +38,50 @@ WHERE
 
 -- name: ListAvailableSlotsForWorkers :many
 WITH worker_max_runs AS (
+    -- the default slot pool of a worker has an empty name
     SELECT
         "id",
+        ''::text AS "slotPool",
         "maxRuns"
     FROM
         "Worker"
     WHERE
         "tenantId" = @tenantId::uuid
         AND "id" = ANY(@workerIds::uuid[])
+    UNION ALL
+    SELECT
+        wsp."workerId" AS "id",
+        wsp."name" AS "slotPool",
+        wsp."maxRuns"
+    FROM
+        "WorkerSlotPool" wsp
+    JOIN
+        "Worker" w ON w."id" = wsp."workerId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND wsp."workerId" = ANY(@workerIds::uuid[])
 ), worker_filled_slots AS (
     SELECT
         worker_id,
-        COUNT(task_id) AS "filledSlots"
+        COALESCE(slot_pool, '') AS "slotPool",
+        SUM(slots) AS "filledSlots"
     FROM
         v1_task_runtime
     WHERE
         tenant_id = @tenantId::uuid
         AND worker_id = ANY(@workerIds::uuid[])
     GROUP BY
-        worker_id
+        worker_id, COALESCE(slot_pool, '')
 )
 -- subtract the filled slots from the max runs to get the available slots
 SELECT
     wmr."id",
+    wmr."slotPool"::text AS "slotPool",
     wmr."maxRuns" - COALESCE(wfs."filledSlots", 0) AS "availableSlots"
 FROM
     worker_max_runs wmr
 LEFT JOIN
-    worker_filled_slots wfs ON wmr."id" = wfs.worker_id;
+    worker_filled_slots wfs ON wmr."id" = wfs.worker_id AND wmr."slotPool" = wfs."slotPool";
 
 -- name: ListQueues :many
 SELECT
@@ -90,6 +106,14 @@ WHERE
     )
     -- Added to ensure that the index is used
     AND qi.priority >= 1 AND qi.priority <= 4
+    -- queue items for paused workflows stay in the queue until the workflow is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "Workflow" w
+        WHERE
+            w."id" = qi.workflow_id
+            AND w."isPaused" = true
+    )
 ORDER BY
     qi.priority DESC,
     qi.id ASC
@@ -199,11 +223,15 @@ WITH input AS (
         t.retry_count,
         input.worker_id,
         t.tenant_id,
-        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at
+        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at,
+        s."slots",
+        s."slotPool"
     FROM
         input
     JOIN
         v1_task t ON t.id = input.id
+    JOIN
+        "Step" s ON s."id" = t.step_id
     ORDER BY t.id
 ), assigned_tasks AS (
     INSERT INTO v1_task_runtime (
@@ -212,7 +240,9 @@ WITH input AS (
         retry_count,
         worker_id,
         tenant_id,
-        timeout_at
+        timeout_at,
+        slots,
+        slot_pool
     )
     SELECT
         t.id,
@@ -220,7 +250,9 @@ WITH input AS (
         t.retry_count,
         t.worker_id,
         @tenantId::uuid,
-        t.timeout_at
+        t.timeout_at,
+        t.slots,
+        t."slotPool"
     FROM
         updated_tasks t
     ON CONFLICT (task_id, task_inserted_at, retry_count) DO NOTHING
@@ -247,6 +279,16 @@ FROM
 WHERE
     "stepId" = ANY(@stepIds::uuid[]);
 
+-- name: GetStepSlots :many
+SELECT
+    "id",
+    "slots",
+    "slotPool"
+FROM
+    "Step"
+WHERE
+    "id" = ANY(@stepIds::uuid[]);
+
 -- name: GetQueuedCounts :many
 SELECT
     queue,
@@ -258,6 +300,34 @@ WHERE
 GROUP BY
     qi.queue;
 
+-- name: GetQueuedCountsForUnavailableSlotPools :many
+-- Returns the number of queued tasks for each slot pool which isn't registered by any active worker
+SELECT
+    s."slotPool"::text AS slot_pool,
+    COUNT(*) AS count
+FROM
+    v1_queue_item qi
+JOIN
+    "Step" s ON s."id" = qi.step_id
+WHERE
+    qi.tenant_id = @tenantId::uuid
+    AND s."slotPool" IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM
+            "WorkerSlotPool" wsp
+        JOIN
+            "Worker" w ON w."id" = wsp."workerId"
+        WHERE
+            w."tenantId" = @tenantId::uuid
+            AND wsp."name" = s."slotPool"
+            AND w."dispatcherId" IS NOT NULL
+            AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+            AND w."isActive" = true
+    )
+GROUP BY
+    s."slotPool";
+
 -- name: DeleteTasksFromQueue :exec
 WITH input AS (
     SELECT
@@ -256,6 +256,97 @@ func (q *Queries) GetQueuedCounts(ctx context.Context, db DBTX, tenantid pgtype.
 	return items, nil
 }
 
+const getQueuedCountsForUnavailableSlotPools = `-- name: GetQueuedCountsForUnavailableSlotPools :many
+SELECT
+    s."slotPool"::text AS slot_pool,
+    COUNT(*) AS count
+FROM
+    v1_queue_item qi
+JOIN
+    "Step" s ON s."id" = qi.step_id
+WHERE
+    qi.tenant_id = $1::uuid
+    AND s."slotPool" IS NOT NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM
+            "WorkerSlotPool" wsp
+        JOIN
+            "Worker" w ON w."id" = wsp."workerId"
+        WHERE
+            w."tenantId" = $1::uuid
+            AND wsp."name" = s."slotPool"
+            AND w."dispatcherId" IS NOT NULL
+            AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+            AND w."isActive" = true
+    )
+GROUP BY
+    s."slotPool"
+`
+
+type GetQueuedCountsForUnavailableSlotPoolsRow struct {
+	SlotPool string `json:"slot_pool"`
+	Count    int64  `json:"count"`
+}
+
+// Returns the number of queued tasks for each slot pool which isn't registered by any active worker
+func (q *Queries) GetQueuedCountsForUnavailableSlotPools(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*GetQueuedCountsForUnavailableSlotPoolsRow, error) {
+	rows, err := db.Query(ctx, getQueuedCountsForUnavailableSlotPools, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetQueuedCountsForUnavailableSlotPoolsRow
+	for rows.Next() {
+		var i GetQueuedCountsForUnavailableSlotPoolsRow
+		if err := rows.Scan(&i.SlotPool, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStepSlots = `-- name: GetStepSlots :many
+SELECT
+    "id",
+    "slots",
+    "slotPool"
+FROM
+    "Step"
+WHERE
+    "id" = ANY($1::uuid[])
+`
+
+type GetStepSlotsRow struct {
+	ID       pgtype.UUID `json:"id"`
+	Slots    int32       `json:"slots"`
+	SlotPool pgtype.Text `json:"slotPool"`
+}
+
+func (q *Queries) GetStepSlots(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*GetStepSlotsRow, error) {
+	rows, err := db.Query(ctx, getStepSlots, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetStepSlotsRow
+	for rows.Next() {
+		var i GetStepSlotsRow
+		if err := rows.Scan(&i.ID, &i.Slots, &i.SlotPool); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listActionsForWorkers = `-- name: ListActionsForWorkers :many
 SELECT
     w."id" as "workerId",
@@ -307,33 +398,49 @@ func (q *Queries) ListActionsForWorkers(ctx context.Context, db DBTX, arg ListAc
 
 const listAvailableSlotsForWorkers = `-- name: ListAvailableSlotsForWorkers :many
 WITH worker_max_runs AS (
+    -- the default slot pool of a worker has an empty name
     SELECT
         "id",
+        ''::text AS "slotPool",
         "maxRuns"
     FROM
         "Worker"
     WHERE
         "tenantId" = $1::uuid
         AND "id" = ANY($2::uuid[])
+    UNION ALL
+    SELECT
+        wsp."workerId" AS "id",
+        wsp."name" AS "slotPool",
+        wsp."maxRuns"
+    FROM
+        "WorkerSlotPool" wsp
+    JOIN
+        "Worker" w ON w."id" = wsp."workerId"
+    WHERE
+        w."tenantId" = $1::uuid
+        AND wsp."workerId" = ANY($2::uuid[])
 ), worker_filled_slots AS (
     SELECT
         worker_id,
-        COUNT(task_id) AS "filledSlots"
+        COALESCE(slot_pool, '') AS "slotPool",
+        SUM(slots) AS "filledSlots"
     FROM
         v1_task_runtime
     WHERE
         tenant_id = $1::uuid
         AND worker_id = ANY($2::uuid[])
     GROUP BY
-        worker_id
+        worker_id, COALESCE(slot_pool, '')
 )
 SELECT
     wmr."id",
+    wmr."slotPool"::text AS "slotPool",
     wmr."maxRuns" - COALESCE(wfs."filledSlots", 0) AS "availableSlots"
 FROM
     worker_max_runs wmr
 LEFT JOIN
-    worker_filled_slots wfs ON wmr."id" = wfs.worker_id
+    worker_filled_slots wfs ON wmr."id" = wfs.worker_id AND wmr."slotPool" = wfs."slotPool"
 `
 
 type ListAvailableSlotsForWorkersParams struct {
@@ -343,6 +450,7 @@ type ListAvailableSlotsForWorkersParams struct {
 
 type ListAvailableSlotsForWorkersRow struct {
 	ID             pgtype.UUID `json:"id"`
+	SlotPool       string      `json:"slotPool"`
 	AvailableSlots int32       `json:"availableSlots"`
 }
 
@@ -356,7 +464,7 @@ func (q *Queries) ListAvailableSlotsForWorkers(ctx context.Context, db DBTX, arg
 	var items []*ListAvailableSlotsForWorkersRow
 	for rows.Next() {
 		var i ListAvailableSlotsForWorkersRow
-		if err := rows.Scan(&i.ID, &i.AvailableSlots); err != nil {
+		if err := rows.Scan(&i.ID, &i.SlotPool, &i.AvailableSlots); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -381,6 +489,14 @@ WHERE
     )
     -- Added to ensure that the index is used
     AND qi.priority >= 1 AND qi.priority <= 4
+    -- queue items for paused workflows stay in the queue until the workflow is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "Workflow" w
+        WHERE
+            w."id" = qi.workflow_id
+            AND w."isPaused" = true
+    )
 ORDER BY
     qi.priority DESC,
     qi.id ASC
@@ -486,11 +602,15 @@ WITH input AS (
         t.retry_count,
         input.worker_id,
         t.tenant_id,
-        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at
+        CURRENT_TIMESTAMP + convert_duration_to_interval(t.step_timeout) AS timeout_at,
+        s."slots",
+        s."slotPool"
     FROM
         input
     JOIN
         v1_task t ON t.id = input.id
+    JOIN
+        "Step" s ON s."id" = t.step_id
     ORDER BY t.id
 ), assigned_tasks AS (
     INSERT INTO v1_task_runtime (
@@ -499,7 +619,9 @@ WITH input AS (
         retry_count,
         worker_id,
         tenant_id,
-        timeout_at
+        timeout_at,
+        slots,
+        slot_pool
     )
     SELECT
         t.id,
@@ -507,7 +629,9 @@ WITH input AS (
         t.retry_count,
         t.worker_id,
         $3::uuid,
-        t.timeout_at
+        t.timeout_at,
+        t.slots,
+        t."slotPool"
     FROM
         updated_tasks t
     ON CONFLICT (task_id, task_inserted_at, retry_count) DO NOTHING
@@ -1,13 +1,14 @@
 -- name: UpsertRateLimitsBulk :exec
 WITH input_values AS (
     SELECT
-        "key", "limitValue", "window"
+        "key", "limitValue", "window", "parentKey"
     FROM
         (
             SELECT
                 unnest(@keys::text[]) AS "key",
                 unnest(@limitValues::int[]) AS "limitValue",
-                unnest(@windows::text[]) AS "window"
+                unnest(@windows::text[]) AS "window",
+                unnest(@parentKeys::text[]) AS "parentKey"
         ) AS subquery
     ORDER BY
         "key"
@@ -17,97 +18,63 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "parentKey"
 )
 SELECT
     @tenantId::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    NULLIF(iv."parentKey", '')
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END;
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    "parentKey" = EXCLUDED."parentKey";
 
--- name: ListRateLimitsForTenantNoMutate :many
--- Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
+-- name: ListRateLimitKeysForTenant :many
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    "key"
 FROM
     "RateLimit" rl
 WHERE
     "tenantId" = @tenantId::uuid
-    AND (
-        sqlc.narg('search')::text IS NULL OR
-        rl."key" like concat('%', sqlc.narg('search')::text, '%')
-    )
 ORDER BY
     rl."key" ASC
-OFFSET
-    COALESCE(sqlc.narg('offset'), 0)
 LIMIT
-    COALESCE(sqlc.narg('limit'), 50);
+    @limit::integer;
 
--- name: ListRateLimitsForTenantWithMutate :many
-WITH rls_to_update AS (
-    SELECT
-        rl.*
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = @tenantId::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
-), refill AS (
-    UPDATE
-        "RateLimit" rl
-    SET
-        "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
-    FROM
-        rls_to_update
-    WHERE
-        rl."tenantId" = rls_to_update."tenantId"
-        AND rl."key" = rls_to_update."key"
-    RETURNING rl.*
-)
+-- name: ListRateLimitStatesForTenant :many
+-- Returns all rate limits for the tenant along with the database time, so the caller can refill
+-- the rate limits according to their algorithm.
 SELECT
-    rl.*,
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    sqlc.embed(rl),
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
     "RateLimit" rl
 WHERE
-    rl."tenantId" = @tenantId::uuid
-    AND rl."key" NOT IN (SELECT "key" FROM refill)
-
-UNION ALL
+    rl."tenantId" = @tenantId::uuid;
 
+-- name: ListRateLimitStatesForUpdate :many
+-- Locks the given rate limits and returns them along with the database time, so the caller can
+-- refill and consume the rate limits according to their algorithm.
 SELECT
-    refill.*,
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    sqlc.embed(rl),
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
-    refill;
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = ANY(@keys::text[])
+ORDER BY
+    rl."tenantId" ASC, rl."key" ASC
+FOR UPDATE;
 
 -- name: ListRateLimitsForSteps :many
 SELECT
@@ -118,41 +85,56 @@ WHERE
     srl."stepId" = ANY(@stepIds::uuid[])
     AND srl."tenantId" = @tenantId::uuid;
 
--- name: BulkUpdateRateLimits :many
+-- name: BulkUpdateRateLimitStates :exec
 WITH input AS (
     SELECT
-        "key", "units"
+        "key", "value", "lastRefill", "previousUsed"
     FROM
         (
             SELECT
                 unnest(@keys::text[]) AS "key",
-                unnest(@units::int[]) AS "units"
+                unnest(@values::int[]) AS "value",
+                unnest(@lastRefills::timestamp[]) AS "lastRefill",
+                unnest(@previousUseds::int[]) AS "previousUsed"
         ) AS subquery
-), rls_to_update AS (
-    SELECT
-        rl.*
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = @tenantId::uuid
-        AND rl."key" = ANY(SELECT "key" FROM input)
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
 )
 UPDATE
     "RateLimit" rl
 SET
-    "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "value" = input."value",
+    "lastRefill" = input."lastRefill",
+    "previousUsed" = input."previousUsed"
+FROM
+    input
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."key" = input."key";
+
+-- name: DeleteRateLimit :one
+DELETE FROM
+    "RateLimit"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "key" = @key::text
+RETURNING *;
+
+-- name: ResetRateLimit :one
+UPDATE
+    "RateLimit"
+SET
+    "value" = COALESCE("burst", "limitValue"),
+    "previousUsed" = NULL,
+    "lastRefill" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "key" = @key::text
+RETURNING *;
+
+-- name: CountChildRateLimits :one
+SELECT
+    COUNT(*) AS total
 FROM
-    rls_to_update rl2
+    "RateLimit"
 WHERE
-    rl2."tenantId" = rl."tenantId"
-    AND rl2."key" = rl."key"
-RETURNING rl.*;
+    "tenantId" = @tenantId::uuid
+    AND "parentKey" = @key::text;
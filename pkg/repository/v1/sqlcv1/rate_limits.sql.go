@@ -11,72 +11,136 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const bulkUpdateRateLimits = `-- name: BulkUpdateRateLimits :many
+const bulkUpdateRateLimitStates = `-- name: BulkUpdateRateLimitStates :exec
 WITH input AS (
     SELECT
-        "key", "units"
+        "key", "value", "lastRefill", "previousUsed"
     FROM
         (
             SELECT
-                unnest($1::text[]) AS "key",
-                unnest($2::int[]) AS "units"
+                unnest($2::text[]) AS "key",
+                unnest($3::int[]) AS "value",
+                unnest($4::timestamp[]) AS "lastRefill",
+                unnest($5::int[]) AS "previousUsed"
         ) AS subquery
-), rls_to_update AS (
-    SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = $3::uuid
-        AND rl."key" = ANY(SELECT "key" FROM input)
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
 )
 UPDATE
     "RateLimit" rl
 SET
-    "value" = get_refill_value(rl) - (SELECT "units" FROM input WHERE "key" = rl."key"),
-    "lastRefill" = CASE
-        WHEN NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds') THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END
+    "value" = input."value",
+    "lastRefill" = input."lastRefill",
+    "previousUsed" = input."previousUsed"
 FROM
-    rls_to_update rl2
+    input
 WHERE
-    rl2."tenantId" = rl."tenantId"
-    AND rl2."key" = rl."key"
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+    rl."tenantId" = $1::uuid
+    AND rl."key" = input."key"
 `
 
-type BulkUpdateRateLimitsParams struct {
-	Keys     []string    `json:"keys"`
-	Units    []int32     `json:"units"`
+type BulkUpdateRateLimitStatesParams struct {
+	Tenantid      pgtype.UUID        `json:"tenantid"`
+	Keys          []string           `json:"keys"`
+	Values        []int32            `json:"values"`
+	Lastrefills   []pgtype.Timestamp `json:"lastrefills"`
+	Previoususeds []int32            `json:"previoususeds"`
+}
+
+func (q *Queries) BulkUpdateRateLimitStates(ctx context.Context, db DBTX, arg BulkUpdateRateLimitStatesParams) error {
+	_, err := db.Exec(ctx, bulkUpdateRateLimitStates,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Values,
+		arg.Lastrefills,
+		arg.Previoususeds,
+	)
+	return err
+}
+
+const countChildRateLimits = `-- name: CountChildRateLimits :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "RateLimit"
+WHERE
+    "tenantId" = $1::uuid
+    AND "parentKey" = $2::text
+`
+
+type CountChildRateLimitsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) CountChildRateLimits(ctx context.Context, db DBTX, arg CountChildRateLimitsParams) (int64, error) {
+	row := db.QueryRow(ctx, countChildRateLimits, arg.Tenantid, arg.Key)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const deleteRateLimit = `-- name: DeleteRateLimit :one
+DELETE FROM
+    "RateLimit"
+WHERE
+    "tenantId" = $1::uuid
+    AND "key" = $2::text
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", "parentKey", algorithm, burst, "previousUsed"
+`
+
+type DeleteRateLimitParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) DeleteRateLimit(ctx context.Context, db DBTX, arg DeleteRateLimitParams) (*RateLimit, error) {
+	row := db.QueryRow(ctx, deleteRateLimit, arg.Tenantid, arg.Key)
+	var i RateLimit
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+		&i.ParentKey,
+		&i.Algorithm,
+		&i.Burst,
+		&i.PreviousUsed,
+	)
+	return &i, err
+}
+
+const listRateLimitKeysForTenant = `-- name: ListRateLimitKeysForTenant :many
+SELECT
+    "key"
+FROM
+    "RateLimit" rl
+WHERE
+    "tenantId" = $1::uuid
+ORDER BY
+    rl."key" ASC
+LIMIT
+    $2::integer
+`
+
+type ListRateLimitKeysForTenantParams struct {
 	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    int32       `json:"limit"`
 }
 
-func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpdateRateLimitsParams) ([]*RateLimit, error) {
-	rows, err := db.Query(ctx, bulkUpdateRateLimits, arg.Keys, arg.Units, arg.Tenantid)
+func (q *Queries) ListRateLimitKeysForTenant(ctx context.Context, db DBTX, arg ListRateLimitKeysForTenantParams) ([]string, error) {
+	rows, err := db.Query(ctx, listRateLimitKeysForTenant, arg.Tenantid, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*RateLimit
+	var items []string
 	for rows.Next() {
-		var i RateLimit
-		if err := rows.Scan(
-			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
-		); err != nil {
+		var key string
+		if err := rows.Scan(&key); err != nil {
 			return nil, err
 		}
-		items = append(items, &i)
+		items = append(items, key)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -84,36 +148,47 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 	return items, nil
 }
 
-const listRateLimitsForSteps = `-- name: ListRateLimitsForSteps :many
+const listRateLimitStatesForTenant = `-- name: ListRateLimitStatesForTenant :many
 SELECT
-    units, "stepId", "rateLimitKey", "tenantId", kind
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl."parentKey", rl.algorithm, rl.burst, rl."previousUsed",
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
-    "StepRateLimit" srl
+    "RateLimit" rl
 WHERE
-    srl."stepId" = ANY($1::uuid[])
-    AND srl."tenantId" = $2::uuid
+    rl."tenantId" = $1::uuid
 `
 
-type ListRateLimitsForStepsParams struct {
-	Stepids  []pgtype.UUID `json:"stepids"`
-	Tenantid pgtype.UUID   `json:"tenantid"`
+type ListRateLimitStatesForTenantRow struct {
+	RateLimit      RateLimit        `json:"rate_limit"`
+	WindowInterval pgtype.Interval  `json:"windowInterval"`
+	Now            pgtype.Timestamp `json:"now"`
 }
 
-func (q *Queries) ListRateLimitsForSteps(ctx context.Context, db DBTX, arg ListRateLimitsForStepsParams) ([]*StepRateLimit, error) {
-	rows, err := db.Query(ctx, listRateLimitsForSteps, arg.Stepids, arg.Tenantid)
+// Returns all rate limits for the tenant along with the database time, so the caller can refill
+// the rate limits according to their algorithm.
+func (q *Queries) ListRateLimitStatesForTenant(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitStatesForTenantRow, error) {
+	rows, err := db.Query(ctx, listRateLimitStatesForTenant, tenantid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*StepRateLimit
+	var items []*ListRateLimitStatesForTenantRow
 	for rows.Next() {
-		var i StepRateLimit
+		var i ListRateLimitStatesForTenantRow
 		if err := rows.Scan(
-			&i.Units,
-			&i.StepId,
-			&i.RateLimitKey,
-			&i.TenantId,
-			&i.Kind,
+			&i.RateLimit.TenantId,
+			&i.RateLimit.Key,
+			&i.RateLimit.LimitValue,
+			&i.RateLimit.Value,
+			&i.RateLimit.Window,
+			&i.RateLimit.LastRefill,
+			&i.RateLimit.ParentKey,
+			&i.RateLimit.Algorithm,
+			&i.RateLimit.Burst,
+			&i.RateLimit.PreviousUsed,
+			&i.WindowInterval,
+			&i.Now,
 		); err != nil {
 			return nil, err
 		}
@@ -125,78 +200,56 @@ func (q *Queries) ListRateLimitsForSteps(ctx context.Context, db DBTX, arg ListR
 	return items, nil
 }
 
-const listRateLimitsForTenantNoMutate = `-- name: ListRateLimitsForTenantNoMutate :many
+const listRateLimitStatesForUpdate = `-- name: ListRateLimitStatesForUpdate :many
 SELECT
-    "tenantId",
-    "key",
-    "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
-    "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl."parentKey", rl.algorithm, rl.burst, rl."previousUsed",
+    rl."window"::INTERVAL AS "windowInterval",
+    NOW()::timestamp AS "now"
 FROM
     "RateLimit" rl
 WHERE
-    "tenantId" = $1::uuid
-    AND (
-        $2::text IS NULL OR
-        rl."key" like concat('%', $2::text, '%')
-    )
+    rl."tenantId" = $1::uuid
+    AND rl."key" = ANY($2::text[])
 ORDER BY
-    rl."key" ASC
-OFFSET
-    COALESCE($3, 0)
-LIMIT
-    COALESCE($4, 50)
+    rl."tenantId" ASC, rl."key" ASC
+FOR UPDATE
 `
 
-type ListRateLimitsForTenantNoMutateParams struct {
+type ListRateLimitStatesForUpdateParams struct {
 	Tenantid pgtype.UUID `json:"tenantid"`
-	Search   pgtype.Text `json:"search"`
-	Offset   interface{} `json:"offset"`
-	Limit    interface{} `json:"limit"`
+	Keys     []string    `json:"keys"`
 }
 
-type ListRateLimitsForTenantNoMutateRow struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+type ListRateLimitStatesForUpdateRow struct {
+	RateLimit      RateLimit        `json:"rate_limit"`
+	WindowInterval pgtype.Interval  `json:"windowInterval"`
+	Now            pgtype.Timestamp `json:"now"`
 }
 
-// Returns the same results as ListRateLimitsForTenantWithMutate but does not update the rate limit values
-func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX, arg ListRateLimitsForTenantNoMutateParams) ([]*ListRateLimitsForTenantNoMutateRow, error) {
-	rows, err := db.Query(ctx, listRateLimitsForTenantNoMutate,
-		arg.Tenantid,
-		arg.Search,
-		arg.Offset,
-		arg.Limit,
-	)
+// Locks the given rate limits and returns them along with the database time, so the caller can
+// refill and consume the rate limits according to their algorithm.
+func (q *Queries) ListRateLimitStatesForUpdate(ctx context.Context, db DBTX, arg ListRateLimitStatesForUpdateParams) ([]*ListRateLimitStatesForUpdateRow, error) {
+	rows, err := db.Query(ctx, listRateLimitStatesForUpdate, arg.Tenantid, arg.Keys)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*ListRateLimitsForTenantNoMutateRow
+	var items []*ListRateLimitStatesForUpdateRow
 	for rows.Next() {
-		var i ListRateLimitsForTenantNoMutateRow
+		var i ListRateLimitStatesForUpdateRow
 		if err := rows.Scan(
-			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
+			&i.RateLimit.TenantId,
+			&i.RateLimit.Key,
+			&i.RateLimit.LimitValue,
+			&i.RateLimit.Value,
+			&i.RateLimit.Window,
+			&i.RateLimit.LastRefill,
+			&i.RateLimit.ParentKey,
+			&i.RateLimit.Algorithm,
+			&i.RateLimit.Burst,
+			&i.RateLimit.PreviousUsed,
+			&i.WindowInterval,
+			&i.Now,
 		); err != nil {
 			return nil, err
 		}
@@ -208,77 +261,36 @@ func (q *Queries) ListRateLimitsForTenantNoMutate(ctx context.Context, db DBTX,
 	return items, nil
 }
 
-const listRateLimitsForTenantWithMutate = `-- name: ListRateLimitsForTenantWithMutate :many
-WITH rls_to_update AS (
-    SELECT
-        rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-    FROM
-        "RateLimit" rl
-    WHERE
-        rl."tenantId" = $1::uuid
-        AND NOW() - rl."lastRefill" >= (rl."window"::INTERVAL - INTERVAL '10 milliseconds')
-    ORDER BY
-        rl."tenantId" ASC, rl."key" ASC
-    FOR UPDATE
-), refill AS (
-    UPDATE
-        "RateLimit" rl
-    SET
-        "value" = get_refill_value(rl),
-        "lastRefill" = CURRENT_TIMESTAMP
-    FROM
-        rls_to_update
-    WHERE
-        rl."tenantId" = rls_to_update."tenantId"
-        AND rl."key" = rls_to_update."key"
-    RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
-)
+const listRateLimitsForSteps = `-- name: ListRateLimitsForSteps :many
 SELECT
-    rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill",
-    (rl."lastRefill" + rl."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
+    units, "stepId", "rateLimitKey", "tenantId", kind
 FROM
-    "RateLimit" rl
+    "StepRateLimit" srl
 WHERE
-    rl."tenantId" = $1::uuid
-    AND rl."key" NOT IN (SELECT "key" FROM refill)
-
-UNION ALL
-
-SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
-    -- return the next refill time
-    (refill."lastRefill" + refill."window"::INTERVAL - INTERVAL '10 milliseconds')::timestamp AS "nextRefillAt"
-FROM
-    refill
+    srl."stepId" = ANY($1::uuid[])
+    AND srl."tenantId" = $2::uuid
 `
 
-type ListRateLimitsForTenantWithMutateRow struct {
-	TenantId     pgtype.UUID      `json:"tenantId"`
-	Key          string           `json:"key"`
-	LimitValue   int32            `json:"limitValue"`
-	Value        int32            `json:"value"`
-	Window       string           `json:"window"`
-	LastRefill   pgtype.Timestamp `json:"lastRefill"`
-	NextRefillAt pgtype.Timestamp `json:"nextRefillAt"`
+type ListRateLimitsForStepsParams struct {
+	Stepids  []pgtype.UUID `json:"stepids"`
+	Tenantid pgtype.UUID   `json:"tenantid"`
 }
 
-func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListRateLimitsForTenantWithMutateRow, error) {
-	rows, err := db.Query(ctx, listRateLimitsForTenantWithMutate, tenantid)
+func (q *Queries) ListRateLimitsForSteps(ctx context.Context, db DBTX, arg ListRateLimitsForStepsParams) ([]*StepRateLimit, error) {
+	rows, err := db.Query(ctx, listRateLimitsForSteps, arg.Stepids, arg.Tenantid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*ListRateLimitsForTenantWithMutateRow
+	var items []*StepRateLimit
 	for rows.Next() {
-		var i ListRateLimitsForTenantWithMutateRow
+		var i StepRateLimit
 		if err := rows.Scan(
+			&i.Units,
+			&i.StepId,
+			&i.RateLimitKey,
 			&i.TenantId,
-			&i.Key,
-			&i.LimitValue,
-			&i.Value,
-			&i.Window,
-			&i.LastRefill,
-			&i.NextRefillAt,
+			&i.Kind,
 		); err != nil {
 			return nil, err
 		}
@@ -290,16 +302,53 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 	return items, nil
 }
 
+const resetRateLimit = `-- name: ResetRateLimit :one
+UPDATE
+    "RateLimit"
+SET
+    "value" = COALESCE("burst", "limitValue"),
+    "previousUsed" = NULL,
+    "lastRefill" = CURRENT_TIMESTAMP
+WHERE
+    "tenantId" = $1::uuid
+    AND "key" = $2::text
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", "parentKey", algorithm, burst, "previousUsed"
+`
+
+type ResetRateLimitParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Key      string      `json:"key"`
+}
+
+func (q *Queries) ResetRateLimit(ctx context.Context, db DBTX, arg ResetRateLimitParams) (*RateLimit, error) {
+	row := db.QueryRow(ctx, resetRateLimit, arg.Tenantid, arg.Key)
+	var i RateLimit
+	err := row.Scan(
+		&i.TenantId,
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+		&i.ParentKey,
+		&i.Algorithm,
+		&i.Burst,
+		&i.PreviousUsed,
+	)
+	return &i, err
+}
+
 const upsertRateLimitsBulk = `-- name: UpsertRateLimitsBulk :exec
 WITH input_values AS (
     SELECT
-        "key", "limitValue", "window"
+        "key", "limitValue", "window", "parentKey"
     FROM
         (
             SELECT
                 unnest($2::text[]) AS "key",
                 unnest($3::int[]) AS "limitValue",
-                unnest($4::text[]) AS "window"
+                unnest($4::text[]) AS "window",
+                unnest($5::text[]) AS "parentKey"
         ) AS subquery
     ORDER BY
         "key"
@@ -309,20 +358,23 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "parentKey"
 )
 SELECT
     $1::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    NULLIF(iv."parentKey", '')
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    "parentKey" = EXCLUDED."parentKey"
 `
 
 type UpsertRateLimitsBulkParams struct {
@@ -330,6 +382,7 @@ type UpsertRateLimitsBulkParams struct {
 	Keys        []string    `json:"keys"`
 	Limitvalues []int32     `json:"limitvalues"`
 	Windows     []string    `json:"windows"`
+	Parentkeys  []string    `json:"parentkeys"`
 }
 
 func (q *Queries) UpsertRateLimitsBulk(ctx context.Context, db DBTX, arg UpsertRateLimitsBulkParams) error {
@@ -338,6 +391,7 @@ func (q *Queries) UpsertRateLimitsBulk(ctx context.Context, db DBTX, arg UpsertR
 		arg.Keys,
 		arg.Limitvalues,
 		arg.Windows,
+		arg.Parentkeys,
 	)
 	return err
 }
@@ -3,7 +3,9 @@ SELECT
     create_v1_range_partition('v1_task', @date::date),
     create_v1_range_partition('v1_dag', @date::date),
     create_v1_range_partition('v1_task_event', @date::date),
-    create_v1_range_partition('v1_log_line', @date::date);
+    create_v1_range_partition('v1_log_line', @date::date),
+    create_v1_range_partition('v1_event_trigger_skip', @date::date),
+    create_v1_range_partition('v1_map_task', @date::date);
 
 -- name: ListPartitionsBeforeDate :many
 WITH task_partitions AS (
@@ -14,6 +16,10 @@ WITH task_partitions AS (
     SELECT 'v1_task_event' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_task_event', @date::date) AS p
 ), log_line_partitions AS (
     SELECT 'v1_log_line' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_log_line', @date::date) AS p
+), event_trigger_skip_partitions AS (
+    SELECT 'v1_event_trigger_skip' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_event_trigger_skip', @date::date) AS p
+), map_task_partitions AS (
+    SELECT 'v1_map_task' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_map_task', @date::date) AS p
 )
 SELECT
     *
@@ -39,7 +45,21 @@ UNION ALL
 SELECT
     *
 FROM
-    log_line_partitions;
+    log_line_partitions
+
+UNION ALL
+
+SELECT
+    *
+FROM
+    event_trigger_skip_partitions
+
+UNION ALL
+
+SELECT
+    *
+FROM
+    map_task_partitions;
 
 -- name: FlattenExternalIds :many
 WITH lookup_rows AS (
@@ -121,6 +141,188 @@ WHERE
     tenant_id = $1
     AND id = ANY(@ids::bigint[]);
 
+-- name: LockMapTasks :many
+-- Locks the tasks of map steps, so that the items of a map task are only created once.
+SELECT
+    *
+FROM
+    v1_task
+WHERE
+    (id, inserted_at) IN (
+        SELECT
+            unnest(@taskIds::bigint[]),
+            unnest(@taskInsertedAts::timestamptz[])
+    )
+    AND tenant_id = @tenantId::uuid
+    AND initial_state = 'MAPPED'
+ORDER BY
+    id
+FOR UPDATE;
+
+-- name: LockMapTaskStates :many
+-- Locks the progress of map tasks. The items are not returned, see ListMapTaskItems.
+SELECT
+    task_id,
+    task_inserted_at,
+    retry_count,
+    item_count,
+    created_count,
+    completed_count,
+    error_message
+FROM
+    v1_map_task
+WHERE
+    (task_id, task_inserted_at) IN (
+        SELECT
+            unnest(@taskIds::bigint[]),
+            unnest(@taskInsertedAts::timestamptz[])
+    )
+    AND tenant_id = @tenantId::uuid
+ORDER BY
+    task_id
+FOR UPDATE;
+
+-- name: UpsertMapTaskStates :exec
+-- Stores the items of map tasks. The progress of a map task is reset when it is stored for a new retry count.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@retryCounts::integer[]) AS retry_count,
+                unnest(@items::jsonb[]) AS items,
+                unnest(@itemCounts::integer[]) AS item_count,
+                unnest(@createdCounts::integer[]) AS created_count,
+                unnest(@completedCounts::integer[]) AS completed_count,
+                unnest(@errorMessages::text[]) AS error_message
+        ) AS subquery
+)
+INSERT INTO v1_map_task (
+    tenant_id,
+    task_id,
+    task_inserted_at,
+    retry_count,
+    items,
+    item_count,
+    created_count,
+    completed_count,
+    error_message
+)
+SELECT
+    @tenantId::uuid,
+    i.task_id,
+    i.task_inserted_at,
+    i.retry_count,
+    i.items,
+    i.item_count,
+    i.created_count,
+    i.completed_count,
+    NULLIF(i.error_message, '')
+FROM
+    input i
+ON CONFLICT (task_id, task_inserted_at) DO UPDATE
+SET
+    retry_count = EXCLUDED.retry_count,
+    items = EXCLUDED.items,
+    item_count = EXCLUDED.item_count,
+    created_count = EXCLUDED.created_count,
+    completed_count = EXCLUDED.completed_count,
+    error_message = EXCLUDED.error_message;
+
+-- name: ListMapTaskItems :many
+-- Lists a range of the items of each map task, starting at the offset.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@offsets::integer[]) AS item_offset,
+                unnest(@counts::integer[]) AS item_count
+        ) AS subquery
+)
+SELECT
+    m.task_id,
+    m.task_inserted_at,
+    jsonb_path_query_array(
+        m.items,
+        format('$[%s to %s]', i.item_offset, i.item_offset + i.item_count - 1)::jsonpath
+    )::jsonb AS items
+FROM
+    v1_map_task m
+JOIN
+    input i ON i.task_id = m.task_id AND i.task_inserted_at = m.task_inserted_at
+WHERE
+    m.tenant_id = @tenantId::uuid;
+
+-- name: UpdateMapTaskCreatedCounts :exec
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@createdCounts::integer[]) AS created_count
+        ) AS subquery
+)
+UPDATE
+    v1_map_task m
+SET
+    created_count = i.created_count
+FROM
+    input i
+WHERE
+    m.task_id = i.task_id
+    AND m.task_inserted_at = i.task_inserted_at
+    AND m.tenant_id = @tenantId::uuid;
+
+-- name: IncrementMapTaskCompletedCounts :exec
+-- Records completed items on their map tasks. Items of a previous retry of the map task are ignored, and only
+-- the first error message of a map task is kept.
+WITH input AS (
+    SELECT
+        *
+    FROM
+        (
+            SELECT
+                unnest(@taskIds::bigint[]) AS task_id,
+                unnest(@taskInsertedAts::timestamptz[]) AS task_inserted_at,
+                unnest(@retryCounts::integer[]) AS retry_count,
+                unnest(@completedCounts::integer[]) AS completed_count,
+                unnest(@errorMessages::text[]) AS error_message
+        ) AS subquery
+)
+UPDATE
+    v1_map_task m
+SET
+    completed_count = m.completed_count + i.completed_count,
+    error_message = COALESCE(m.error_message, NULLIF(i.error_message, ''))
+FROM
+    input i
+WHERE
+    m.task_id = i.task_id
+    AND m.task_inserted_at = i.task_inserted_at
+    AND m.retry_count = i.retry_count
+    AND m.tenant_id = @tenantId::uuid;
+
+-- name: DeleteMapTaskStates :exec
+DELETE FROM
+    v1_map_task
+WHERE
+    (task_id, task_inserted_at) IN (
+        SELECT
+            unnest(@taskIds::bigint[]),
+            unnest(@taskInsertedAts::timestamptz[])
+    )
+    AND tenant_id = @tenantId::uuid;
+
 -- name: ListTaskMetas :many
 SELECT
     id,
@@ -589,7 +791,9 @@ WITH RECURSIVE augmented_tasks AS (
         t.parent_task_inserted_at,
         t.step_index,
         t.child_index,
-        t.child_key
+        t.child_key,
+        t.workflow_run_id,
+        t.event_key
     FROM
         v1_task t
     WHERE
@@ -635,6 +839,8 @@ SELECT
     t.step_index,
     t.child_index,
     t.child_key,
+    t.workflow_run_id,
+    t.event_key,
     j."kind" as "jobKind",
     COALESCE(so."parents", '{}'::uuid[]) as "parents"
 FROM
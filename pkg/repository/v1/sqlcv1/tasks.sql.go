@@ -16,7 +16,9 @@ SELECT
     create_v1_range_partition('v1_task', $1::date),
     create_v1_range_partition('v1_dag', $1::date),
     create_v1_range_partition('v1_task_event', $1::date),
-    create_v1_range_partition('v1_log_line', $1::date)
+    create_v1_range_partition('v1_log_line', $1::date),
+    create_v1_range_partition('v1_event_trigger_skip', $1::date),
+    create_v1_range_partition('v1_map_task', $1::date)
 `
 
 func (q *Queries) CreatePartitions(ctx context.Context, db DBTX, date pgtype.Date) error {
@@ -24,6 +26,29 @@ func (q *Queries) CreatePartitions(ctx context.Context, db DBTX, date pgtype.Dat
 	return err
 }
 
+const deleteMapTaskStates = `-- name: DeleteMapTaskStates :exec
+DELETE FROM
+    v1_map_task
+WHERE
+    (task_id, task_inserted_at) IN (
+        SELECT
+            unnest($1::bigint[]),
+            unnest($2::timestamptz[])
+    )
+    AND tenant_id = $3::uuid
+`
+
+type DeleteMapTaskStatesParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+func (q *Queries) DeleteMapTaskStates(ctx context.Context, db DBTX, arg DeleteMapTaskStatesParams) error {
+	_, err := db.Exec(ctx, deleteMapTaskStates, arg.Taskids, arg.Taskinsertedats, arg.Tenantid)
+	return err
+}
+
 const deleteMatchingSignalEvents = `-- name: DeleteMatchingSignalEvents :exec
 WITH input AS (
     SELECT
@@ -374,6 +399,57 @@ func (q *Queries) FlattenExternalIds(ctx context.Context, db DBTX, arg FlattenEx
 	return items, nil
 }
 
+const incrementMapTaskCompletedCounts = `-- name: IncrementMapTaskCompletedCounts :exec
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, retry_count, completed_count, error_message
+    FROM
+        (
+            SELECT
+                unnest($2::bigint[]) AS task_id,
+                unnest($3::timestamptz[]) AS task_inserted_at,
+                unnest($4::integer[]) AS retry_count,
+                unnest($5::integer[]) AS completed_count,
+                unnest($6::text[]) AS error_message
+        ) AS subquery
+)
+UPDATE
+    v1_map_task m
+SET
+    completed_count = m.completed_count + i.completed_count,
+    error_message = COALESCE(m.error_message, NULLIF(i.error_message, ''))
+FROM
+    input i
+WHERE
+    m.task_id = i.task_id
+    AND m.task_inserted_at = i.task_inserted_at
+    AND m.retry_count = i.retry_count
+    AND m.tenant_id = $1::uuid
+`
+
+type IncrementMapTaskCompletedCountsParams struct {
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Retrycounts     []int32              `json:"retrycounts"`
+	Completedcounts []int32              `json:"completedcounts"`
+	Errormessages   []string             `json:"errormessages"`
+}
+
+// Records completed items on their map tasks. Items of a previous retry of the map task are ignored, and only
+// the first error message of a map task is kept.
+func (q *Queries) IncrementMapTaskCompletedCounts(ctx context.Context, db DBTX, arg IncrementMapTaskCompletedCountsParams) error {
+	_, err := db.Exec(ctx, incrementMapTaskCompletedCounts,
+		arg.Tenantid,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Retrycounts,
+		arg.Completedcounts,
+		arg.Errormessages,
+	)
+	return err
+}
+
 const listAllTasksInDags = `-- name: ListAllTasksInDags :many
 SELECT
     t.id,
@@ -441,6 +517,75 @@ func (q *Queries) ListAllTasksInDags(ctx context.Context, db DBTX, arg ListAllTa
 	return items, nil
 }
 
+const listMapTaskItems = `-- name: ListMapTaskItems :many
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, item_offset, item_count
+    FROM
+        (
+            SELECT
+                unnest($2::bigint[]) AS task_id,
+                unnest($3::timestamptz[]) AS task_inserted_at,
+                unnest($4::integer[]) AS item_offset,
+                unnest($5::integer[]) AS item_count
+        ) AS subquery
+)
+SELECT
+    m.task_id,
+    m.task_inserted_at,
+    jsonb_path_query_array(
+        m.items,
+        format('$[%s to %s]', i.item_offset, i.item_offset + i.item_count - 1)::jsonpath
+    )::jsonb AS items
+FROM
+    v1_map_task m
+JOIN
+    input i ON i.task_id = m.task_id AND i.task_inserted_at = m.task_inserted_at
+WHERE
+    m.tenant_id = $1::uuid
+`
+
+type ListMapTaskItemsParams struct {
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Offsets         []int32              `json:"offsets"`
+	Counts          []int32              `json:"counts"`
+}
+
+type ListMapTaskItemsRow struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	Items          []byte             `json:"items"`
+}
+
+// Lists a range of the items of each map task, starting at the offset.
+func (q *Queries) ListMapTaskItems(ctx context.Context, db DBTX, arg ListMapTaskItemsParams) ([]*ListMapTaskItemsRow, error) {
+	rows, err := db.Query(ctx, listMapTaskItems,
+		arg.Tenantid,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Offsets,
+		arg.Counts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListMapTaskItemsRow
+	for rows.Next() {
+		var i ListMapTaskItemsRow
+		if err := rows.Scan(&i.TaskID, &i.TaskInsertedAt, &i.Items); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMatchingSignalEvents = `-- name: ListMatchingSignalEvents :many
 WITH input AS (
     SELECT
@@ -598,6 +743,10 @@ WITH task_partitions AS (
     SELECT 'v1_task_event' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_task_event', $1::date) AS p
 ), log_line_partitions AS (
     SELECT 'v1_log_line' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_log_line', $1::date) AS p
+), event_trigger_skip_partitions AS (
+    SELECT 'v1_event_trigger_skip' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_event_trigger_skip', $1::date) AS p
+), map_task_partitions AS (
+    SELECT 'v1_map_task' AS parent_table, p::text as partition_name FROM get_v1_partitions_before_date('v1_map_task', $1::date) AS p
 )
 SELECT
     parent_table, partition_name
@@ -624,6 +773,20 @@ SELECT
     parent_table, partition_name
 FROM
     log_line_partitions
+
+UNION ALL
+
+SELECT
+    parent_table, partition_name
+FROM
+    event_trigger_skip_partitions
+
+UNION ALL
+
+SELECT
+    parent_table, partition_name
+FROM
+    map_task_partitions
 `
 
 type ListPartitionsBeforeDateRow struct {
@@ -890,7 +1053,7 @@ func (q *Queries) ListTaskParentOutputs(ctx context.Context, db DBTX, arg ListTa
 
 const listTasks = `-- name: ListTasks :many
 SELECT
-    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
+    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, idempotency_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, event_key
 FROM
     v1_task
 WHERE
@@ -943,6 +1106,7 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 			&i.ParentTaskInsertedAt,
 			&i.ChildIndex,
 			&i.ChildKey,
+			&i.IdempotencyKey,
 			&i.InitialState,
 			&i.InitialStateReason,
 			&i.ConcurrencyParentStrategyIds,
@@ -950,6 +1114,7 @@ func (q *Queries) ListTasks(ctx context.Context, db DBTX, arg ListTasksParams) (
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.EventKey,
 		); err != nil {
 			return nil, err
 		}
@@ -1021,7 +1186,9 @@ WITH RECURSIVE augmented_tasks AS (
         t.parent_task_inserted_at,
         t.step_index,
         t.child_index,
-        t.child_key
+        t.child_key,
+        t.workflow_run_id,
+        t.event_key
     FROM
         v1_task t
     WHERE
@@ -1067,6 +1234,8 @@ SELECT
     t.step_index,
     t.child_index,
     t.child_key,
+    t.workflow_run_id,
+    t.event_key,
     j."kind" as "jobKind",
     COALESCE(so."parents", '{}'::uuid[]) as "parents"
 FROM
@@ -1103,6 +1272,8 @@ type ListTasksForReplayRow struct {
 	StepIndex            int64              `json:"step_index"`
 	ChildIndex           pgtype.Int8        `json:"child_index"`
 	ChildKey             pgtype.Text        `json:"child_key"`
+	WorkflowRunID        pgtype.UUID        `json:"workflow_run_id"`
+	EventKey             pgtype.Text        `json:"event_key"`
 	JobKind              JobKind            `json:"jobKind"`
 	Parents              []pgtype.UUID      `json:"parents"`
 }
@@ -1136,6 +1307,8 @@ func (q *Queries) ListTasksForReplay(ctx context.Context, db DBTX, arg ListTasks
 			&i.StepIndex,
 			&i.ChildIndex,
 			&i.ChildKey,
+			&i.WorkflowRunID,
+			&i.EventKey,
 			&i.JobKind,
 			&i.Parents,
 		); err != nil {
@@ -1334,6 +1507,159 @@ func (q *Queries) LockDAGsForReplay(ctx context.Context, db DBTX, arg LockDAGsFo
 	return items, nil
 }
 
+const lockMapTaskStates = `-- name: LockMapTaskStates :many
+SELECT
+    task_id,
+    task_inserted_at,
+    retry_count,
+    item_count,
+    created_count,
+    completed_count,
+    error_message
+FROM
+    v1_map_task
+WHERE
+    (task_id, task_inserted_at) IN (
+        SELECT
+            unnest($1::bigint[]),
+            unnest($2::timestamptz[])
+    )
+    AND tenant_id = $3::uuid
+ORDER BY
+    task_id
+FOR UPDATE
+`
+
+type LockMapTaskStatesParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+type LockMapTaskStatesRow struct {
+	TaskID         int64              `json:"task_id"`
+	TaskInsertedAt pgtype.Timestamptz `json:"task_inserted_at"`
+	RetryCount     int32              `json:"retry_count"`
+	ItemCount      int32              `json:"item_count"`
+	CreatedCount   int32              `json:"created_count"`
+	CompletedCount int32              `json:"completed_count"`
+	ErrorMessage   pgtype.Text        `json:"error_message"`
+}
+
+// Locks the progress of map tasks. The items are not returned, see ListMapTaskItems.
+func (q *Queries) LockMapTaskStates(ctx context.Context, db DBTX, arg LockMapTaskStatesParams) ([]*LockMapTaskStatesRow, error) {
+	rows, err := db.Query(ctx, lockMapTaskStates, arg.Taskids, arg.Taskinsertedats, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*LockMapTaskStatesRow
+	for rows.Next() {
+		var i LockMapTaskStatesRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.TaskInsertedAt,
+			&i.RetryCount,
+			&i.ItemCount,
+			&i.CreatedCount,
+			&i.CompletedCount,
+			&i.ErrorMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockMapTasks = `-- name: LockMapTasks :many
+SELECT
+    id, inserted_at, tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, idempotency_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, event_key
+FROM
+    v1_task
+WHERE
+    (id, inserted_at) IN (
+        SELECT
+            unnest($1::bigint[]),
+            unnest($2::timestamptz[])
+    )
+    AND tenant_id = $3::uuid
+    AND initial_state = 'MAPPED'
+ORDER BY
+    id
+FOR UPDATE
+`
+
+type LockMapTasksParams struct {
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+}
+
+// Locks the tasks of map steps, so that the items of a map task are only created once.
+func (q *Queries) LockMapTasks(ctx context.Context, db DBTX, arg LockMapTasksParams) ([]*V1Task, error) {
+	rows, err := db.Query(ctx, lockMapTasks, arg.Taskids, arg.Taskinsertedats, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1Task
+	for rows.Next() {
+		var i V1Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.InsertedAt,
+			&i.TenantID,
+			&i.Queue,
+			&i.ActionID,
+			&i.StepID,
+			&i.StepReadableID,
+			&i.WorkflowID,
+			&i.WorkflowVersionID,
+			&i.WorkflowRunID,
+			&i.ScheduleTimeout,
+			&i.StepTimeout,
+			&i.Priority,
+			&i.Sticky,
+			&i.DesiredWorkerID,
+			&i.ExternalID,
+			&i.DisplayName,
+			&i.Input,
+			&i.RetryCount,
+			&i.InternalRetryCount,
+			&i.AppRetryCount,
+			&i.StepIndex,
+			&i.AdditionalMetadata,
+			&i.DagID,
+			&i.DagInsertedAt,
+			&i.ParentTaskExternalID,
+			&i.ParentTaskID,
+			&i.ParentTaskInsertedAt,
+			&i.ChildIndex,
+			&i.ChildKey,
+			&i.IdempotencyKey,
+			&i.InitialState,
+			&i.InitialStateReason,
+			&i.ConcurrencyParentStrategyIds,
+			&i.ConcurrencyStrategyIds,
+			&i.ConcurrencyKeys,
+			&i.RetryBackoffFactor,
+			&i.RetryMaxBackoff,
+			&i.EventKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const lockSignalCreatedEvents = `-- name: LockSignalCreatedEvents :many
 WITH input AS (
     SELECT
@@ -1480,7 +1806,7 @@ FROM
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at
+    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.slots, v1_task_runtime.slot_pool
 `
 
 type ManualSlotReleaseParams struct {
@@ -1498,6 +1824,8 @@ func (q *Queries) ManualSlotRelease(ctx context.Context, db DBTX, arg ManualSlot
 		&i.WorkerID,
 		&i.TenantID,
 		&i.TimeoutAt,
+		&i.Slots,
+		&i.SlotPool,
 	)
 	return &i, err
 }
@@ -1740,7 +2068,7 @@ FROM
 WHERE
     (v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count) IN (SELECT id, inserted_at, retry_count FROM task)
 RETURNING
-    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at
+    v1_task_runtime.task_id, v1_task_runtime.task_inserted_at, v1_task_runtime.retry_count, v1_task_runtime.worker_id, v1_task_runtime.tenant_id, v1_task_runtime.timeout_at, v1_task_runtime.slots, v1_task_runtime.slot_pool
 `
 
 type RefreshTimeoutByParams struct {
@@ -1759,6 +2087,8 @@ func (q *Queries) RefreshTimeoutBy(ctx context.Context, db DBTX, arg RefreshTime
 		&i.WorkerID,
 		&i.TenantID,
 		&i.TimeoutAt,
+		&i.Slots,
+		&i.SlotPool,
 	)
 	return &i, err
 }
@@ -1908,3 +2238,122 @@ func (q *Queries) ReleaseTasks(ctx context.Context, db DBTX, arg ReleaseTasksPar
 	}
 	return items, nil
 }
+
+const updateMapTaskCreatedCounts = `-- name: UpdateMapTaskCreatedCounts :exec
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, created_count
+    FROM
+        (
+            SELECT
+                unnest($2::bigint[]) AS task_id,
+                unnest($3::timestamptz[]) AS task_inserted_at,
+                unnest($4::integer[]) AS created_count
+        ) AS subquery
+)
+UPDATE
+    v1_map_task m
+SET
+    created_count = i.created_count
+FROM
+    input i
+WHERE
+    m.task_id = i.task_id
+    AND m.task_inserted_at = i.task_inserted_at
+    AND m.tenant_id = $1::uuid
+`
+
+type UpdateMapTaskCreatedCountsParams struct {
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Createdcounts   []int32              `json:"createdcounts"`
+}
+
+func (q *Queries) UpdateMapTaskCreatedCounts(ctx context.Context, db DBTX, arg UpdateMapTaskCreatedCountsParams) error {
+	_, err := db.Exec(ctx, updateMapTaskCreatedCounts,
+		arg.Tenantid,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Createdcounts,
+	)
+	return err
+}
+
+const upsertMapTaskStates = `-- name: UpsertMapTaskStates :exec
+WITH input AS (
+    SELECT
+        task_id, task_inserted_at, retry_count, items, item_count, created_count, completed_count, error_message
+    FROM
+        (
+            SELECT
+                unnest($2::bigint[]) AS task_id,
+                unnest($3::timestamptz[]) AS task_inserted_at,
+                unnest($4::integer[]) AS retry_count,
+                unnest($5::jsonb[]) AS items,
+                unnest($6::integer[]) AS item_count,
+                unnest($7::integer[]) AS created_count,
+                unnest($8::integer[]) AS completed_count,
+                unnest($9::text[]) AS error_message
+        ) AS subquery
+)
+INSERT INTO v1_map_task (
+    tenant_id,
+    task_id,
+    task_inserted_at,
+    retry_count,
+    items,
+    item_count,
+    created_count,
+    completed_count,
+    error_message
+)
+SELECT
+    $1::uuid,
+    i.task_id,
+    i.task_inserted_at,
+    i.retry_count,
+    i.items,
+    i.item_count,
+    i.created_count,
+    i.completed_count,
+    NULLIF(i.error_message, '')
+FROM
+    input i
+ON CONFLICT (task_id, task_inserted_at) DO UPDATE
+SET
+    retry_count = EXCLUDED.retry_count,
+    items = EXCLUDED.items,
+    item_count = EXCLUDED.item_count,
+    created_count = EXCLUDED.created_count,
+    completed_count = EXCLUDED.completed_count,
+    error_message = EXCLUDED.error_message
+`
+
+type UpsertMapTaskStatesParams struct {
+	Tenantid        pgtype.UUID          `json:"tenantid"`
+	Taskids         []int64              `json:"taskids"`
+	Taskinsertedats []pgtype.Timestamptz `json:"taskinsertedats"`
+	Retrycounts     []int32              `json:"retrycounts"`
+	Items           [][]byte             `json:"items"`
+	Itemcounts      []int32              `json:"itemcounts"`
+	Createdcounts   []int32              `json:"createdcounts"`
+	Completedcounts []int32              `json:"completedcounts"`
+	Errormessages   []string             `json:"errormessages"`
+}
+
+// Stores the items of map tasks. The progress of a map task is reset when it is stored for a new retry count.
+func (q *Queries) UpsertMapTaskStates(ctx context.Context, db DBTX, arg UpsertMapTaskStatesParams) error {
+	_, err := db.Exec(ctx, upsertMapTaskStates,
+		arg.Tenantid,
+		arg.Taskids,
+		arg.Taskinsertedats,
+		arg.Retrycounts,
+		arg.Items,
+		arg.Itemcounts,
+		arg.Createdcounts,
+		arg.Completedcounts,
+		arg.Errormessages,
+	)
+	return err
+}
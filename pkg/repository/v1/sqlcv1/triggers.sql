@@ -19,7 +19,8 @@ SELECT
     latest_versions."workflowVersionId",
     latest_versions."workflowId",
     latest_versions."workflowName",
-    eventRef."eventKey" as "eventKey"
+    eventRef."eventKey" as "eventKey",
+    eventRef."expression" as "expression"
 FROM
     latest_versions
 JOIN
@@ -43,3 +44,93 @@ WHERE
     AND workflowVersions."deletedAt" IS NULL
     AND workflow."name" = ANY(@workflowNames::text[])
 ORDER BY "workflowId", "order" DESC;
+
+-- name: CreateEventTriggerSkips :exec
+INSERT INTO v1_event_trigger_skip (
+    tenant_id,
+    event_id,
+    event_key,
+    workflow_id,
+    workflow_version_id,
+    expression,
+    error_message
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@eventIds::uuid[]),
+    unnest(@eventKeys::text[]),
+    unnest(@workflowIds::uuid[]),
+    unnest(@workflowVersionIds::uuid[]),
+    unnest(@expressions::text[]),
+    -- empty error messages are stored as NULL
+    NULLIF(unnest(@errorMessages::text[]), '');
+
+-- name: ListEventTriggerSkips :many
+SELECT
+    s.*,
+    w."name" AS "workflowName"
+FROM
+    v1_event_trigger_skip s
+JOIN
+    "Workflow" w ON w."id" = s.workflow_id
+WHERE
+    s.tenant_id = @tenantId::uuid
+    AND s.event_id = @eventId::uuid
+ORDER BY
+    s.id ASC;
+
+-- name: CreateIdempotencyKeyPartitions :exec
+SELECT
+    create_v1_range_partition('v1_idempotency_key', @date::date);
+
+-- name: ListIdempotencyKeyPartitionsBeforeDate :many
+SELECT
+    'v1_idempotency_key' AS parent_table,
+    p::text AS partition_name
+FROM
+    get_v1_partitions_before_date('v1_idempotency_key', @date::date) AS p;
+
+-- name: LockIdempotencyKeys :exec
+SELECT
+    pg_advisory_xact_lock(hashtextextended('idempotency:' || @tenantId::text || ':' || k.key, 0))
+FROM (
+    SELECT DISTINCT
+        key
+    FROM
+        unnest(@keys::text[]) AS key
+    ORDER BY
+        key
+) AS k;
+
+-- name: ListActiveIdempotencyKeys :many
+SELECT
+    *
+FROM
+    v1_idempotency_key
+WHERE
+    tenant_id = @tenantId::uuid
+    AND key = ANY(@keys::text[])
+    AND expires_at > NOW()
+    -- keys cannot be valid for longer than a day, so only recent partitions are scanned
+    AND inserted_at > NOW() - INTERVAL '1 day';
+
+-- name: CreateIdempotencyKeys :exec
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at
+)
+SELECT
+    @tenantId::uuid,
+    unnest(@keys::text[]),
+    unnest(@externalIds::uuid[]),
+    NOW() + make_interval(secs => unnest(@ttlSeconds::bigint[]));
+
+-- name: DeleteIdempotencyKeys :exec
+DELETE FROM
+    v1_idempotency_key
+WHERE
+    tenant_id = @tenantId::uuid
+    AND external_id = ANY(@externalIds::uuid[])
+    AND inserted_at > NOW() - INTERVAL '1 day';
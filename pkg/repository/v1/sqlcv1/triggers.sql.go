@@ -11,6 +11,251 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const createEventTriggerSkips = `-- name: CreateEventTriggerSkips :exec
+INSERT INTO v1_event_trigger_skip (
+    tenant_id,
+    event_id,
+    event_key,
+    workflow_id,
+    workflow_version_id,
+    expression,
+    error_message
+)
+SELECT
+    $1::uuid,
+    unnest($2::uuid[]),
+    unnest($3::text[]),
+    unnest($4::uuid[]),
+    unnest($5::uuid[]),
+    unnest($6::text[]),
+    -- empty error messages are stored as NULL
+    NULLIF(unnest($7::text[]), '')
+`
+
+type CreateEventTriggerSkipsParams struct {
+	Tenantid           pgtype.UUID   `json:"tenantid"`
+	Eventids           []pgtype.UUID `json:"eventids"`
+	Eventkeys          []string      `json:"eventkeys"`
+	Workflowids        []pgtype.UUID `json:"workflowids"`
+	Workflowversionids []pgtype.UUID `json:"workflowversionids"`
+	Expressions        []string      `json:"expressions"`
+	Errormessages      []string      `json:"errormessages"`
+}
+
+func (q *Queries) CreateEventTriggerSkips(ctx context.Context, db DBTX, arg CreateEventTriggerSkipsParams) error {
+	_, err := db.Exec(ctx, createEventTriggerSkips,
+		arg.Tenantid,
+		arg.Eventids,
+		arg.Eventkeys,
+		arg.Workflowids,
+		arg.Workflowversionids,
+		arg.Expressions,
+		arg.Errormessages,
+	)
+	return err
+}
+
+const createIdempotencyKeyPartitions = `-- name: CreateIdempotencyKeyPartitions :exec
+SELECT
+    create_v1_range_partition('v1_idempotency_key', $1::date)
+`
+
+func (q *Queries) CreateIdempotencyKeyPartitions(ctx context.Context, db DBTX, date pgtype.Date) error {
+	_, err := db.Exec(ctx, createIdempotencyKeyPartitions, date)
+	return err
+}
+
+const createIdempotencyKeys = `-- name: CreateIdempotencyKeys :exec
+INSERT INTO v1_idempotency_key (
+    tenant_id,
+    key,
+    external_id,
+    expires_at
+)
+SELECT
+    $1::uuid,
+    unnest($2::text[]),
+    unnest($3::uuid[]),
+    NOW() + make_interval(secs => unnest($4::bigint[]))
+`
+
+type CreateIdempotencyKeysParams struct {
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	Keys        []string      `json:"keys"`
+	Externalids []pgtype.UUID `json:"externalids"`
+	Ttlseconds  []int64       `json:"ttlseconds"`
+}
+
+func (q *Queries) CreateIdempotencyKeys(ctx context.Context, db DBTX, arg CreateIdempotencyKeysParams) error {
+	_, err := db.Exec(ctx, createIdempotencyKeys,
+		arg.Tenantid,
+		arg.Keys,
+		arg.Externalids,
+		arg.Ttlseconds,
+	)
+	return err
+}
+
+const deleteIdempotencyKeys = `-- name: DeleteIdempotencyKeys :exec
+DELETE FROM
+    v1_idempotency_key
+WHERE
+    tenant_id = $1::uuid
+    AND external_id = ANY($2::uuid[])
+    AND inserted_at > NOW() - INTERVAL '1 day'
+`
+
+type DeleteIdempotencyKeysParams struct {
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	Externalids []pgtype.UUID `json:"externalids"`
+}
+
+func (q *Queries) DeleteIdempotencyKeys(ctx context.Context, db DBTX, arg DeleteIdempotencyKeysParams) error {
+	_, err := db.Exec(ctx, deleteIdempotencyKeys, arg.Tenantid, arg.Externalids)
+	return err
+}
+
+const listActiveIdempotencyKeys = `-- name: ListActiveIdempotencyKeys :many
+SELECT
+    tenant_id, key, external_id, inserted_at, expires_at
+FROM
+    v1_idempotency_key
+WHERE
+    tenant_id = $1::uuid
+    AND key = ANY($2::text[])
+    AND expires_at > NOW()
+    -- keys cannot be valid for longer than a day, so only recent partitions are scanned
+    AND inserted_at > NOW() - INTERVAL '1 day'
+`
+
+type ListActiveIdempotencyKeysParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Keys     []string    `json:"keys"`
+}
+
+func (q *Queries) ListActiveIdempotencyKeys(ctx context.Context, db DBTX, arg ListActiveIdempotencyKeysParams) ([]*V1IdempotencyKey, error) {
+	rows, err := db.Query(ctx, listActiveIdempotencyKeys, arg.Tenantid, arg.Keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*V1IdempotencyKey
+	for rows.Next() {
+		var i V1IdempotencyKey
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.Key,
+			&i.ExternalID,
+			&i.InsertedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTriggerSkips = `-- name: ListEventTriggerSkips :many
+SELECT
+    s.id, s.tenant_id, s.event_id, s.event_key, s.workflow_id, s.workflow_version_id, s.expression, s.error_message, s.inserted_at,
+    w."name" AS "workflowName"
+FROM
+    v1_event_trigger_skip s
+JOIN
+    "Workflow" w ON w."id" = s.workflow_id
+WHERE
+    s.tenant_id = $1::uuid
+    AND s.event_id = $2::uuid
+ORDER BY
+    s.id ASC
+`
+
+type ListEventTriggerSkipsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Eventid  pgtype.UUID `json:"eventid"`
+}
+
+type ListEventTriggerSkipsRow struct {
+	ID                int64              `json:"id"`
+	TenantID          pgtype.UUID        `json:"tenant_id"`
+	EventID           pgtype.UUID        `json:"event_id"`
+	EventKey          string             `json:"event_key"`
+	WorkflowID        pgtype.UUID        `json:"workflow_id"`
+	WorkflowVersionID pgtype.UUID        `json:"workflow_version_id"`
+	Expression        string             `json:"expression"`
+	ErrorMessage      pgtype.Text        `json:"error_message"`
+	InsertedAt        pgtype.Timestamptz `json:"inserted_at"`
+	WorkflowName      string             `json:"workflowName"`
+}
+
+func (q *Queries) ListEventTriggerSkips(ctx context.Context, db DBTX, arg ListEventTriggerSkipsParams) ([]*ListEventTriggerSkipsRow, error) {
+	rows, err := db.Query(ctx, listEventTriggerSkips, arg.Tenantid, arg.Eventid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListEventTriggerSkipsRow
+	for rows.Next() {
+		var i ListEventTriggerSkipsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.EventID,
+			&i.EventKey,
+			&i.WorkflowID,
+			&i.WorkflowVersionID,
+			&i.Expression,
+			&i.ErrorMessage,
+			&i.InsertedAt,
+			&i.WorkflowName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIdempotencyKeyPartitionsBeforeDate = `-- name: ListIdempotencyKeyPartitionsBeforeDate :many
+SELECT
+    'v1_idempotency_key' AS parent_table,
+    p::text AS partition_name
+FROM
+    get_v1_partitions_before_date('v1_idempotency_key', $1::date) AS p
+`
+
+type ListIdempotencyKeyPartitionsBeforeDateRow struct {
+	ParentTable   string `json:"parent_table"`
+	PartitionName string `json:"partition_name"`
+}
+
+func (q *Queries) ListIdempotencyKeyPartitionsBeforeDate(ctx context.Context, db DBTX, date pgtype.Date) ([]*ListIdempotencyKeyPartitionsBeforeDateRow, error) {
+	rows, err := db.Query(ctx, listIdempotencyKeyPartitionsBeforeDate, date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListIdempotencyKeyPartitionsBeforeDateRow
+	for rows.Next() {
+		var i ListIdempotencyKeyPartitionsBeforeDateRow
+		if err := rows.Scan(&i.ParentTable, &i.PartitionName); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflowsByNames = `-- name: ListWorkflowsByNames :many
 SELECT DISTINCT ON("workflowId")
     "workflowId",
@@ -77,7 +322,8 @@ SELECT
     latest_versions."workflowVersionId",
     latest_versions."workflowId",
     latest_versions."workflowName",
-    eventRef."eventKey" as "eventKey"
+    eventRef."eventKey" as "eventKey",
+    eventRef."expression" as "expression"
 FROM
     latest_versions
 JOIN
@@ -98,6 +344,7 @@ type ListWorkflowsForEventsRow struct {
 	WorkflowId        pgtype.UUID `json:"workflowId"`
 	WorkflowName      string      `json:"workflowName"`
 	EventKey          string      `json:"eventKey"`
+	Expression        pgtype.Text `json:"expression"`
 }
 
 // Get all of the latest workflow versions
@@ -116,6 +363,7 @@ func (q *Queries) ListWorkflowsForEvents(ctx context.Context, db DBTX, arg ListW
 			&i.WorkflowId,
 			&i.WorkflowName,
 			&i.EventKey,
+			&i.Expression,
 		); err != nil {
 			return nil, err
 		}
@@ -126,3 +374,26 @@ func (q *Queries) ListWorkflowsForEvents(ctx context.Context, db DBTX, arg ListW
 	}
 	return items, nil
 }
+
+const lockIdempotencyKeys = `-- name: LockIdempotencyKeys :exec
+SELECT
+    pg_advisory_xact_lock(hashtextextended('idempotency:' || $1::text || ':' || k.key, 0))
+FROM (
+    SELECT DISTINCT
+        key
+    FROM
+        unnest($2::text[]) AS key
+    ORDER BY
+        key
+) AS k
+`
+
+type LockIdempotencyKeysParams struct {
+	Tenantid string   `json:"tenantid"`
+	Keys     []string `json:"keys"`
+}
+
+func (q *Queries) LockIdempotencyKeys(ctx context.Context, db DBTX, arg LockIdempotencyKeysParams) error {
+	_, err := db.Exec(ctx, lockIdempotencyKeys, arg.Tenantid, arg.Keys)
+	return err
+}
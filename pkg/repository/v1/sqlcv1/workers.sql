@@ -16,11 +16,12 @@ SELECT
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slots), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = workers."tenantId" AND
-            runtime.worker_id = workers."id"
+            runtime.worker_id = workers."id" AND
+            runtime.slot_pool IS NULL
     ) AS "remainingSlots"
 FROM
     "Worker" workers
@@ -58,11 +59,12 @@ SELECT
     sqlc.embed(w),
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slots), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = w."tenantId" AND
-            runtime.worker_id = w."id"
+            runtime.worker_id = w."id" AND
+            runtime.slot_pool IS NULL
     ) AS "remainingSlots"
 FROM
     "Worker" w
@@ -71,6 +73,38 @@ LEFT JOIN
 WHERE
     w."id" = @id::uuid;
 
+-- name: ListWorkerSlotPools :many
+SELECT
+    wsp."workerId",
+    wsp."name",
+    wsp."maxRuns",
+    (wsp."maxRuns" - (
+        SELECT COALESCE(SUM(runtime.slots), 0)
+        FROM v1_task_runtime runtime
+        WHERE
+            runtime.tenant_id = @tenantId::uuid AND
+            runtime.worker_id = wsp."workerId" AND
+            runtime.slot_pool = wsp."name"
+    ))::int AS "remainingSlots"
+FROM
+    "WorkerSlotPool" wsp
+WHERE
+    wsp."workerId" = ANY(@workerIds::uuid[])
+ORDER BY
+    wsp."workerId", wsp."name";
+
+-- name: ListRegisteredSlotPools :many
+-- Returns the names of the given slot pools which are registered by at least one worker of the tenant
+SELECT DISTINCT
+    wsp."name"
+FROM
+    "WorkerSlotPool" wsp
+JOIN
+    "Worker" w ON w."id" = wsp."workerId"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND wsp."name" = ANY(@names::text[]);
+
 -- name: ListSemaphoreSlotsWithStateForWorker :many
 SELECT
     *
@@ -83,3 +117,13 @@ WHERE
     AND runtime.worker_id = @workerId::uuid
 LIMIT
     COALESCE(sqlc.narg('limit')::int, 100);
+
+-- name: HasAssignedTasks :one
+-- Returns whether any task is assigned to the worker
+SELECT EXISTS (
+    SELECT 1
+    FROM v1_task_runtime runtime
+    WHERE
+        runtime.tenant_id = @tenantId::uuid
+        AND runtime.worker_id = @workerId::uuid
+) AS "hasAssignedTasks";
@@ -13,14 +13,15 @@ import (
 
 const getWorkerById = `-- name: GetWorkerById :one
 SELECT
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion", w."isCordoned",
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slots), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = w."tenantId" AND
-            runtime.worker_id = w."id"
+            runtime.worker_id = w."id" AND
+            runtime.slot_pool IS NULL
     ) AS "remainingSlots"
 FROM
     "Worker" w
@@ -59,12 +60,36 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.Worker.Os,
 		&i.Worker.RuntimeExtra,
 		&i.Worker.SdkVersion,
+		&i.Worker.IsCordoned,
 		&i.WebhookUrl,
 		&i.RemainingSlots,
 	)
 	return &i, err
 }
 
+const hasAssignedTasks = `-- name: HasAssignedTasks :one
+SELECT EXISTS (
+    SELECT 1
+    FROM v1_task_runtime runtime
+    WHERE
+        runtime.tenant_id = $1::uuid
+        AND runtime.worker_id = $2::uuid
+) AS "hasAssignedTasks"
+`
+
+type HasAssignedTasksParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Workerid pgtype.UUID `json:"workerid"`
+}
+
+// Returns whether any task is assigned to the worker
+func (q *Queries) HasAssignedTasks(ctx context.Context, db DBTX, arg HasAssignedTasksParams) (bool, error) {
+	row := db.QueryRow(ctx, hasAssignedTasks, arg.Tenantid, arg.Workerid)
+	var hasAssignedTasks bool
+	err := row.Scan(&hasAssignedTasks)
+	return hasAssignedTasks, err
+}
+
 const listManyWorkerLabels = `-- name: ListManyWorkerLabels :many
 SELECT
     "id",
@@ -116,9 +141,47 @@ func (q *Queries) ListManyWorkerLabels(ctx context.Context, db DBTX, workerids [
 	return items, nil
 }
 
+const listRegisteredSlotPools = `-- name: ListRegisteredSlotPools :many
+SELECT DISTINCT
+    wsp."name"
+FROM
+    "WorkerSlotPool" wsp
+JOIN
+    "Worker" w ON w."id" = wsp."workerId"
+WHERE
+    w."tenantId" = $1::uuid
+    AND wsp."name" = ANY($2::text[])
+`
+
+type ListRegisteredSlotPoolsParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Names    []string    `json:"names"`
+}
+
+// Returns the names of the given slot pools which are registered by at least one worker of the tenant
+func (q *Queries) ListRegisteredSlotPools(ctx context.Context, db DBTX, arg ListRegisteredSlotPoolsParams) ([]string, error) {
+	rows, err := db.Query(ctx, listRegisteredSlotPools, arg.Tenantid, arg.Names)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSemaphoreSlotsWithStateForWorker = `-- name: ListSemaphoreSlotsWithStateForWorker :many
 SELECT
-    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff
+    task_id, task_inserted_at, runtime.retry_count, worker_id, runtime.tenant_id, timeout_at, slots, slot_pool, id, inserted_at, v1_task.tenant_id, queue, action_id, step_id, step_readable_id, workflow_id, workflow_version_id, workflow_run_id, schedule_timeout, step_timeout, priority, sticky, desired_worker_id, external_id, display_name, input, v1_task.retry_count, internal_retry_count, app_retry_count, step_index, additional_metadata, dag_id, dag_inserted_at, parent_task_external_id, parent_task_id, parent_task_inserted_at, child_index, child_key, idempotency_key, initial_state, initial_state_reason, concurrency_parent_strategy_ids, concurrency_strategy_ids, concurrency_keys, retry_backoff_factor, retry_max_backoff, event_key
 FROM
     v1_task_runtime runtime
 JOIN
@@ -143,6 +206,8 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	WorkerID                     pgtype.UUID        `json:"worker_id"`
 	TenantID                     pgtype.UUID        `json:"tenant_id"`
 	TimeoutAt                    pgtype.Timestamp   `json:"timeout_at"`
+	Slots                        int32              `json:"slots"`
+	SlotPool                     pgtype.Text        `json:"slot_pool"`
 	ID                           int64              `json:"id"`
 	InsertedAt                   pgtype.Timestamptz `json:"inserted_at"`
 	TenantID_2                   pgtype.UUID        `json:"tenant_id_2"`
@@ -173,6 +238,7 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	ParentTaskInsertedAt         pgtype.Timestamptz `json:"parent_task_inserted_at"`
 	ChildIndex                   pgtype.Int8        `json:"child_index"`
 	ChildKey                     pgtype.Text        `json:"child_key"`
+	IdempotencyKey               pgtype.Text        `json:"idempotency_key"`
 	InitialState                 V1TaskInitialState `json:"initial_state"`
 	InitialStateReason           pgtype.Text        `json:"initial_state_reason"`
 	ConcurrencyParentStrategyIds []pgtype.Int8      `json:"concurrency_parent_strategy_ids"`
@@ -180,6 +246,7 @@ type ListSemaphoreSlotsWithStateForWorkerRow struct {
 	ConcurrencyKeys              []string           `json:"concurrency_keys"`
 	RetryBackoffFactor           pgtype.Float8      `json:"retry_backoff_factor"`
 	RetryMaxBackoff              pgtype.Int4        `json:"retry_max_backoff"`
+	EventKey                     pgtype.Text        `json:"event_key"`
 }
 
 func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db DBTX, arg ListSemaphoreSlotsWithStateForWorkerParams) ([]*ListSemaphoreSlotsWithStateForWorkerRow, error) {
@@ -198,6 +265,8 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.WorkerID,
 			&i.TenantID,
 			&i.TimeoutAt,
+			&i.Slots,
+			&i.SlotPool,
 			&i.ID,
 			&i.InsertedAt,
 			&i.TenantID_2,
@@ -228,6 +297,7 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.ParentTaskInsertedAt,
 			&i.ChildIndex,
 			&i.ChildKey,
+			&i.IdempotencyKey,
 			&i.InitialState,
 			&i.InitialStateReason,
 			&i.ConcurrencyParentStrategyIds,
@@ -235,6 +305,65 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 			&i.ConcurrencyKeys,
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
+			&i.EventKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWorkerSlotPools = `-- name: ListWorkerSlotPools :many
+SELECT
+    wsp."workerId",
+    wsp."name",
+    wsp."maxRuns",
+    (wsp."maxRuns" - (
+        SELECT COALESCE(SUM(runtime.slots), 0)
+        FROM v1_task_runtime runtime
+        WHERE
+            runtime.tenant_id = $1::uuid AND
+            runtime.worker_id = wsp."workerId" AND
+            runtime.slot_pool = wsp."name"
+    ))::int AS "remainingSlots"
+FROM
+    "WorkerSlotPool" wsp
+WHERE
+    wsp."workerId" = ANY($2::uuid[])
+ORDER BY
+    wsp."workerId", wsp."name"
+`
+
+type ListWorkerSlotPoolsParams struct {
+	Tenantid  pgtype.UUID   `json:"tenantid"`
+	Workerids []pgtype.UUID `json:"workerids"`
+}
+
+type ListWorkerSlotPoolsRow struct {
+	WorkerId       pgtype.UUID `json:"workerId"`
+	Name           string      `json:"name"`
+	MaxRuns        int32       `json:"maxRuns"`
+	RemainingSlots int32       `json:"remainingSlots"`
+}
+
+func (q *Queries) ListWorkerSlotPools(ctx context.Context, db DBTX, arg ListWorkerSlotPoolsParams) ([]*ListWorkerSlotPoolsRow, error) {
+	rows, err := db.Query(ctx, listWorkerSlotPools, arg.Tenantid, arg.Workerids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkerSlotPoolsRow
+	for rows.Next() {
+		var i ListWorkerSlotPoolsRow
+		if err := rows.Scan(
+			&i.WorkerId,
+			&i.Name,
+			&i.MaxRuns,
+			&i.RemainingSlots,
 		); err != nil {
 			return nil, err
 		}
@@ -248,15 +377,16 @@ func (q *Queries) ListSemaphoreSlotsWithStateForWorker(ctx context.Context, db D
 
 const listWorkersWithSlotCount = `-- name: ListWorkersWithSlotCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion", workers."isCordoned",
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
-        SELECT COUNT(*)
+        SELECT COALESCE(SUM(runtime.slots), 0)
         FROM v1_task_runtime runtime
         WHERE
             runtime.tenant_id = workers."tenantId" AND
-            runtime.worker_id = workers."id"
+            runtime.worker_id = workers."id" AND
+            runtime.slot_pool IS NULL
     ) AS "remainingSlots"
 FROM
     "Worker" workers
@@ -338,6 +468,7 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Os,
 			&i.Worker.RuntimeExtra,
 			&i.Worker.SdkVersion,
+			&i.Worker.IsCordoned,
 			&i.WebhookUrl,
 			&i.WebhookId,
 			&i.RemainingSlots,
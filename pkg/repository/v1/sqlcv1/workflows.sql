@@ -75,8 +75,39 @@ FROM
 WHERE
     "stepId" = ANY(@stepIds::uuid[]);
 
+-- name: ListStepMatchConditions :many
+SELECT
+    *
+FROM
+    "StepMatchCondition"
+WHERE
+    "stepId" = ANY(@stepIds::uuid[])
+    AND "tenantId" = @tenantId::uuid
+ORDER BY
+    "id" ASC;
+
 -- name: ListWorkflowNamesByIds :many
 SELECT id, name
 FROM "Workflow"
 WHERE id = ANY(@ids::uuid[])
 ;
+
+-- name: UpdateWorkflowIsPausedByName :one
+UPDATE "Workflow"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "isPaused" = @isPaused::boolean
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "name" = @name::text
+    AND "deletedAt" IS NULL
+RETURNING *;
+
+-- name: ListPausedWorkflowIdsByIds :many
+SELECT id
+FROM "Workflow"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND id = ANY(@ids::uuid[])
+    AND "isPaused" = true
+;
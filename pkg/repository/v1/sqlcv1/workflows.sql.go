@@ -11,6 +11,40 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const listPausedWorkflowIdsByIds = `-- name: ListPausedWorkflowIdsByIds :many
+SELECT id
+FROM "Workflow"
+WHERE
+    "tenantId" = $1::uuid
+    AND id = ANY($2::uuid[])
+    AND "isPaused" = true
+`
+
+type ListPausedWorkflowIdsByIdsParams struct {
+	Tenantid pgtype.UUID   `json:"tenantid"`
+	Ids      []pgtype.UUID `json:"ids"`
+}
+
+func (q *Queries) ListPausedWorkflowIdsByIds(ctx context.Context, db DBTX, arg ListPausedWorkflowIdsByIdsParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, listPausedWorkflowIdsByIds, arg.Tenantid, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepExpressions = `-- name: ListStepExpressions :many
 SELECT
     key, "stepId", expression, kind
@@ -45,9 +79,57 @@ func (q *Queries) ListStepExpressions(ctx context.Context, db DBTX, stepids []pg
 	return items, nil
 }
 
+const listStepMatchConditions = `-- name: ListStepMatchConditions :many
+SELECT
+    id, "tenantId", "stepId", "orGroupId", action, kind, "readableDataKey", "sleepDuration", "eventKey", expression
+FROM
+    "StepMatchCondition"
+WHERE
+    "stepId" = ANY($1::uuid[])
+    AND "tenantId" = $2::uuid
+ORDER BY
+    "id" ASC
+`
+
+type ListStepMatchConditionsParams struct {
+	Stepids  []pgtype.UUID `json:"stepids"`
+	Tenantid pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) ListStepMatchConditions(ctx context.Context, db DBTX, arg ListStepMatchConditionsParams) ([]*StepMatchCondition, error) {
+	rows, err := db.Query(ctx, listStepMatchConditions, arg.Stepids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepMatchCondition
+	for rows.Next() {
+		var i StepMatchCondition
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantId,
+			&i.StepId,
+			&i.OrGroupId,
+			&i.Action,
+			&i.Kind,
+			&i.ReadableDataKey,
+			&i.SleepDuration,
+			&i.EventKey,
+			&i.Expression,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepsByIds = `-- name: ListStepsByIds :many
 SELECT
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."skipIf", s."mapExpression", s."mapMaxParallelism", s.slots, s."slotPool",
     wv."id" as "workflowVersionId",
     wv."sticky" as "workflowVersionSticky",
     w."name" as "workflowName",
@@ -95,6 +177,11 @@ type ListStepsByIdsRow struct {
 	RetryBackoffFactor    pgtype.Float8      `json:"retryBackoffFactor"`
 	RetryMaxBackoff       pgtype.Int4        `json:"retryMaxBackoff"`
 	ScheduleTimeout       string             `json:"scheduleTimeout"`
+	SkipIf                pgtype.Text        `json:"skipIf"`
+	MapExpression         pgtype.Text        `json:"mapExpression"`
+	MapMaxParallelism     pgtype.Int4        `json:"mapMaxParallelism"`
+	Slots                 int32              `json:"slots"`
+	SlotPool              pgtype.Text        `json:"slotPool"`
 	WorkflowVersionId     pgtype.UUID        `json:"workflowVersionId"`
 	WorkflowVersionSticky NullStickyStrategy `json:"workflowVersionSticky"`
 	WorkflowName          string             `json:"workflowName"`
@@ -127,6 +214,11 @@ func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsById
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SkipIf,
+			&i.MapExpression,
+			&i.MapMaxParallelism,
+			&i.Slots,
+			&i.SlotPool,
 			&i.WorkflowVersionId,
 			&i.WorkflowVersionSticky,
 			&i.WorkflowName,
@@ -147,7 +239,7 @@ func (q *Queries) ListStepsByIds(ctx context.Context, db DBTX, arg ListStepsById
 const listStepsByWorkflowVersionIds = `-- name: ListStepsByWorkflowVersionIds :many
 WITH steps AS (
     SELECT
-        s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+        s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."skipIf", s."mapExpression", s."mapMaxParallelism", s.slots, s."slotPool",
         wv."id" as "workflowVersionId",
         w."name" as "workflowName",
         w."id" as "workflowId",
@@ -177,7 +269,7 @@ WITH steps AS (
         so."B"
 )
 SELECT
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."workflowVersionId", s."workflowName", s."workflowId", s."jobKind",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."skipIf", s."mapExpression", s."mapMaxParallelism", s.slots, s."slotPool", s."workflowVersionId", s."workflowName", s."workflowId", s."jobKind",
     COALESCE(so."parents", '{}'::uuid[]) as "parents"
 FROM
     steps s
@@ -205,6 +297,11 @@ type ListStepsByWorkflowVersionIdsRow struct {
 	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
 	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
 	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	SkipIf             pgtype.Text      `json:"skipIf"`
+	MapExpression      pgtype.Text      `json:"mapExpression"`
+	MapMaxParallelism  pgtype.Int4      `json:"mapMaxParallelism"`
+	Slots              int32            `json:"slots"`
+	SlotPool           pgtype.Text      `json:"slotPool"`
 	WorkflowVersionId  pgtype.UUID      `json:"workflowVersionId"`
 	WorkflowName       string           `json:"workflowName"`
 	WorkflowId         pgtype.UUID      `json:"workflowId"`
@@ -236,6 +333,11 @@ func (q *Queries) ListStepsByWorkflowVersionIds(ctx context.Context, db DBTX, ar
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SkipIf,
+			&i.MapExpression,
+			&i.MapMaxParallelism,
+			&i.Slots,
+			&i.SlotPool,
 			&i.WorkflowVersionId,
 			&i.WorkflowName,
 			&i.WorkflowId,
@@ -282,3 +384,37 @@ func (q *Queries) ListWorkflowNamesByIds(ctx context.Context, db DBTX, ids []pgt
 	}
 	return items, nil
 }
+
+const updateWorkflowIsPausedByName = `-- name: UpdateWorkflowIsPausedByName :one
+UPDATE "Workflow"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "isPaused" = $1::boolean
+WHERE
+    "tenantId" = $2::uuid
+    AND "name" = $3::text
+    AND "deletedAt" IS NULL
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", name, description, "isPaused"
+`
+
+type UpdateWorkflowIsPausedByNameParams struct {
+	Ispaused bool        `json:"ispaused"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Name     string      `json:"name"`
+}
+
+func (q *Queries) UpdateWorkflowIsPausedByName(ctx context.Context, db DBTX, arg UpdateWorkflowIsPausedByNameParams) (*Workflow, error) {
+	row := db.QueryRow(ctx, updateWorkflowIsPausedByName, arg.Ispaused, arg.Tenantid, arg.Name)
+	var i Workflow
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TenantId,
+		&i.Name,
+		&i.Description,
+		&i.IsPaused,
+	)
+	return &i, err
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
-	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
@@ -63,6 +62,12 @@ type CreateTaskOpts struct {
 
 	// (optional) the child key for the task
 	ChildKey *string
+
+	// (optional) the key of the event which triggered the task
+	EventKey *string
+
+	// (optional) the idempotency key of the workflow run, if the task is not part of a DAG
+	IdempotencyKey *string
 }
 
 type ReplayTasksResult struct {
@@ -83,6 +88,9 @@ type ReplayTaskOpts struct {
 	// (required) the external id
 	ExternalId string
 
+	// (required) the workflow run id
+	WorkflowRunId string
+
 	// (required) the step id
 	StepId string
 
@@ -94,6 +102,12 @@ type ReplayTaskOpts struct {
 
 	// (optional) the additional metadata for the task
 	AdditionalMetadata []byte
+
+	// (optional) the DAG id for the task, if the task belongs to a DAG
+	DagId *int64
+
+	// (optional) the key of the event which triggered the task
+	EventKey *string
 }
 
 type TaskIdInsertedAtRetryCount struct {
@@ -218,6 +232,10 @@ type TaskRepository interface {
 
 	GetQueueCounts(ctx context.Context, tenantId string) (map[string]int, error)
 
+	// GetUnavailableSlotPoolCounts returns the number of queued tasks for each slot pool which isn't registered
+	// by any active worker, and which therefore can't be assigned.
+	GetUnavailableSlotPoolCounts(ctx context.Context, tenantId string) (map[string]int, error)
+
 	ReplayTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*ReplayTasksResult, error)
 
 	RefreshTimeoutBy(ctx context.Context, tenantId string, opt RefreshTimeoutBy) (*sqlcv1.V1TaskRuntime, error)
@@ -1117,6 +1135,22 @@ func (r *TaskRepositoryImpl) GetQueueCounts(ctx context.Context, tenantId string
 	return res, nil
 }
 
+func (r *TaskRepositoryImpl) GetUnavailableSlotPoolCounts(ctx context.Context, tenantId string) (map[string]int, error) {
+	counts, err := r.queries.GetQueuedCountsForUnavailableSlotPools(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]int, len(counts))
+
+	for _, count := range counts {
+		res[count.SlotPool] = int(count.Count)
+	}
+
+	return res, nil
+}
+
 func (r *TaskRepositoryImpl) RefreshTimeoutBy(ctx context.Context, tenantId string, opt RefreshTimeoutBy) (*sqlcv1.V1TaskRuntime, error) {
 	if err := r.v.Validate(opt); err != nil {
 		return nil, err
@@ -1261,6 +1295,30 @@ func getQueueCacheKey(tenantId string, queue string) string {
 	return fmt.Sprintf("%s:%s", tenantId, queue)
 }
 
+// shouldSkipTask evaluates a step's skip_if expression against the task input. An error is returned if the
+// expression can't be evaluated or doesn't evaluate to a bool.
+func (r *sharedRepository) shouldSkipTask(expr string, input *TaskInput, additionalMetadata []byte, workflowRunId string, eventKey *string) (bool, error) {
+	var additionalMeta map[string]interface{}
+
+	if len(additionalMetadata) > 0 {
+		if err := json.Unmarshal(additionalMetadata, &additionalMeta); err != nil {
+			return false, fmt.Errorf("failed to process additional metadata: not a json object")
+		}
+	}
+
+	res, err := r.celParser.ParseAndEvalStepRun(expr, r.newCELInput(input, additionalMeta, workflowRunId, eventKey))
+
+	if err != nil {
+		return false, fmt.Errorf("failed to parse skip_if expression (%s): %w", expr, err)
+	}
+
+	if res.Bool == nil {
+		return false, fmt.Errorf("failed to parse skip_if expression (%s): expected bool output, got %s", expr, res.Type)
+	}
+
+	return *res.Bool, nil
+}
+
 func (r *sharedRepository) createTasks(
 	ctx context.Context,
 	tx sqlcv1.DBTX,
@@ -1349,6 +1407,8 @@ func (r *sharedRepository) insertTasks(
 	parentTaskInsertedAts := make([]pgtype.Timestamptz, len(tasks))
 	childIndices := make([]pgtype.Int8, len(tasks))
 	childKeys := make([]pgtype.Text, len(tasks))
+	idempotencyKeys := make([]pgtype.Text, len(tasks))
+	eventKeys := make([]pgtype.Text, len(tasks))
 	stepIndices := make([]int64, len(tasks))
 	retryBackoffFactors := make([]pgtype.Float8, len(tasks))
 	retryMaxBackoffs := make([]pgtype.Int4, len(tasks))
@@ -1401,6 +1461,31 @@ func (r *sharedRepository) insertTasks(
 			additionalMetadatas[i] = task.AdditionalMetadata
 		}
 
+		// evaluate the skip expression before concurrency keys and step expressions, which don't need to be
+		// evaluated for a skipped task
+		if task.InitialState == sqlcv1.V1TaskInitialStateQUEUED && stepConfig.SkipIf.Valid && !isMapItem(stepConfig.MapExpression, task.DagId) {
+			skip, err := r.shouldSkipTask(stepConfig.SkipIf.String, task.Input, additionalMetadatas[i], task.WorkflowRunId, task.EventKey)
+
+			if err != nil {
+				task.InitialState = sqlcv1.V1TaskInitialStateFAILED
+
+				initialStateReasons[i] = pgtype.Text{
+					String: err.Error(),
+					Valid:  true,
+				}
+			} else if skip {
+				task.InitialState = sqlcv1.V1TaskInitialStateSKIPPED
+			}
+
+			initialStates[i] = string(task.InitialState)
+		}
+
+		// map tasks aren't queued, their items are created once the map task has been inserted
+		if task.InitialState == sqlcv1.V1TaskInitialStateQUEUED && stepConfig.MapExpression.Valid && task.DagId != nil {
+			task.InitialState = sqlcv1.V1TaskInitialStateMAPPED
+			initialStates[i] = string(task.InitialState)
+		}
+
 		if task.DagId != nil && task.DagInsertedAt.Valid {
 			dagIds[i] = pgtype.Int8{
 				Int64: *task.DagId,
@@ -1439,6 +1524,21 @@ func (r *sharedRepository) insertTasks(
 			}
 		}
 
+		if task.IdempotencyKey != nil {
+			idempotencyKeys[i] = pgtype.Text{
+				String: *task.IdempotencyKey,
+				Valid:  true,
+			}
+		}
+
+		// the event key is stored on the task so that expressions are evaluated the same way on replay
+		if task.EventKey != nil {
+			eventKeys[i] = pgtype.Text{
+				String: *task.EventKey,
+				Valid:  true,
+			}
+		}
+
 		concurrencyKeys[i] = make([]string, 0)
 
 		// we write any parent strategy ids to the task regardless of initial state, as we need to know
@@ -1477,12 +1577,7 @@ func (r *sharedRepository) insertTasks(
 						}
 					}
 
-					res, err := r.celParser.ParseAndEvalStepRun(strat.Expression, cel.NewInput(
-						cel.WithInput(task.Input.Input),
-						cel.WithAdditionalMetadata(additionalMeta),
-						cel.WithWorkflowRunID(task.ExternalId),
-						cel.WithParents(task.Input.TriggerData),
-					))
+					res, err := r.celParser.ParseAndEvalStepRun(strat.Expression, r.newCELInput(task.Input, additionalMeta, task.ExternalId, task.EventKey))
 
 					if err != nil {
 						failTaskError = fmt.Errorf("failed to parse step expression (%s): %w", strat.Expression, err)
@@ -1537,12 +1632,7 @@ func (r *sharedRepository) insertTasks(
 						}
 					}
 
-					res, err := r.celParser.ParseAndEvalStepRun(expr.Expression, cel.NewInput(
-						cel.WithInput(task.Input.Input),
-						cel.WithAdditionalMetadata(additionalMeta),
-						cel.WithWorkflowRunID(task.ExternalId),
-						cel.WithParents(task.Input.TriggerData),
-					))
+					res, err := r.celParser.ParseAndEvalStepRun(expr.Expression, r.newCELInput(task.Input, additionalMeta, task.ExternalId, task.EventKey))
 
 					if err != nil {
 						failTaskError = fmt.Errorf("failed to parse step expression (%s): %w", expr.Expression, err)
@@ -1626,6 +1716,7 @@ func (r *sharedRepository) insertTasks(
 				RetryMaxBackoff:              make([]pgtype.Int4, 0),
 				WorkflowVersionIds:           make([]pgtype.UUID, 0),
 				WorkflowRunIds:               make([]pgtype.UUID, 0),
+				IdempotencyKeys:              make([]pgtype.Text, 0),
 			}
 		}
 
@@ -1662,6 +1753,8 @@ func (r *sharedRepository) insertTasks(
 		params.RetryMaxBackoff = append(params.RetryMaxBackoff, retryMaxBackoffs[i])
 		params.WorkflowVersionIds = append(params.WorkflowVersionIds, workflowVersionIds[i])
 		params.WorkflowRunIds = append(params.WorkflowRunIds, workflowRunIds[i])
+		params.IdempotencyKeys = append(params.IdempotencyKeys, idempotencyKeys[i])
+		params.EventKeys = append(params.EventKeys, eventKeys[i])
 
 		stepIdsToParams[task.StepId] = params
 	}
@@ -1809,6 +1902,29 @@ func (r *sharedRepository) replayTasks(
 			additionalMetadatas[i] = task.AdditionalMetadata
 		}
 
+		if task.InitialState == sqlcv1.V1TaskInitialStateQUEUED && stepConfig.SkipIf.Valid && !isMapItem(stepConfig.MapExpression, task.DagId) {
+			skip, err := r.shouldSkipTask(stepConfig.SkipIf.String, task.Input, additionalMetadatas[i], task.WorkflowRunId, task.EventKey)
+
+			if err != nil {
+				task.InitialState = sqlcv1.V1TaskInitialStateFAILED
+
+				initialStateReasons[i] = pgtype.Text{
+					String: err.Error(),
+					Valid:  true,
+				}
+			} else if skip {
+				task.InitialState = sqlcv1.V1TaskInitialStateSKIPPED
+			}
+
+			initialStates[i] = string(task.InitialState)
+		}
+
+		// map tasks aren't queued, their items are created once the map task has been inserted
+		if task.InitialState == sqlcv1.V1TaskInitialStateQUEUED && stepConfig.MapExpression.Valid && task.DagId != nil {
+			task.InitialState = sqlcv1.V1TaskInitialStateMAPPED
+			initialStates[i] = string(task.InitialState)
+		}
+
 		// only check for concurrency if the task is in a queued state, otherwise we don't need to
 		// evaluate the expression (and it will likely fail if we do)
 		if task.InitialState == sqlcv1.V1TaskInitialStateQUEUED {
@@ -1833,12 +1949,7 @@ func (r *sharedRepository) replayTasks(
 						break
 					}
 
-					res, err := r.celParser.ParseAndEvalStepRun(strat.Expression, cel.NewInput(
-						cel.WithInput(task.Input.Input),
-						cel.WithAdditionalMetadata(additionalMeta),
-						cel.WithWorkflowRunID(task.ExternalId),
-						cel.WithParents(task.Input.TriggerData),
-					))
+					res, err := r.celParser.ParseAndEvalStepRun(strat.Expression, r.newCELInput(task.Input, additionalMeta, task.ExternalId, task.EventKey))
 
 					if err != nil {
 						failTaskError = fmt.Errorf("failed to parse step expression (%s): %w", strat.Expression, err)
@@ -2383,15 +2494,26 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 			}
 		}
 
-		replayOpts = append(replayOpts, ReplayTaskOpts{
+		replayOpt := ReplayTaskOpts{
 			TaskId:             task.ID,
 			InsertedAt:         task.InsertedAt,
 			StepId:             sqlchelpers.UUIDToStr(task.StepID),
 			ExternalId:         sqlchelpers.UUIDToStr(task.ExternalID),
+			WorkflowRunId:      sqlchelpers.UUIDToStr(task.WorkflowRunID),
 			InitialState:       sqlcv1.V1TaskInitialStateQUEUED,
 			AdditionalMetadata: task.AdditionalMetadata,
 			Input:              r.newTaskInputFromExistingBytes(task.Input),
-		})
+		}
+
+		if task.DagID.Valid {
+			replayOpt.DagId = &task.DagID.Int64
+		}
+
+		if task.EventKey.Valid {
+			replayOpt.EventKey = &task.EventKey.String
+		}
+
+		replayOpts = append(replayOpts, replayOpt)
 	}
 
 	dagIdsArr := make([]int64, 0, len(dagIds))
@@ -2467,14 +2589,14 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 			parentTaskIds = append(parentTaskIds, task.ParentTaskID.Int64)
 			parentTaskInsertedAts = append(parentTaskInsertedAts, task.ParentTaskInsertedAt)
 
-			eventMatches = append(eventMatches, CreateMatchOpts{
-				Kind:                 sqlcv1.V1MatchKindSIGNAL,
-				Conditions:           getChildWorkflowGroupMatches(sqlchelpers.UUIDToStr(task.ExternalID), task.StepReadableID),
-				SignalExternalId:     &parentExternalId,
-				SignalTaskId:         &task.ParentTaskID.Int64,
-				SignalTaskInsertedAt: task.ParentTaskInsertedAt,
-				SignalKey:            &k,
-			})
+			eventMatches = append(eventMatches, getChildSignalMatch(
+				parentExternalId,
+				task.ParentTaskID.Int64,
+				task.ParentTaskInsertedAt,
+				sqlchelpers.UUIDToStr(task.ExternalID),
+				task.StepReadableID,
+				k,
+			))
 		}
 
 		err = r.queries.DeleteMatchingSignalEvents(ctx, tx, sqlcv1.DeleteMatchingSignalEventsParams{
@@ -2490,6 +2612,34 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 		}
 	}
 
+	// list the conditions of the child steps, since steps which wait on their own conditions register them
+	// once their parents have completed
+	childStepIds := make([]pgtype.UUID, 0)
+
+	for _, tasks := range dagIdsToChildTasks {
+		for _, task := range tasks {
+			childStepIds = append(childStepIds, task.StepID)
+		}
+	}
+
+	stepIdsToConditions := make(map[string][]*sqlcv1.StepMatchCondition)
+
+	if len(childStepIds) > 0 {
+		stepConditions, err := r.queries.ListStepMatchConditions(ctx, tx, sqlcv1.ListStepMatchConditionsParams{
+			Stepids:  childStepIds,
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list step match conditions: %w", err)
+		}
+
+		for _, condition := range stepConditions {
+			stepId := sqlchelpers.UUIDToStr(condition.StepId)
+			stepIdsToConditions[stepId] = append(stepIdsToConditions[stepId], condition)
+		}
+	}
+
 	// For any DAGs, reset all match conditions which refer to internal events within the subtree of the DAG.
 	// we do not reset other match conditions (for example, ones which refer to completed events for tasks
 	// which are outside of this subtree). otherwise, we would end up in a state where these events would
@@ -2499,6 +2649,7 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 
 		for _, task := range tasks {
 			taskExternalId := sqlchelpers.UUIDToStr(task.ExternalID)
+			workflowRunId := sqlchelpers.UUIDToStr(task.WorkflowRunID)
 			stepId := sqlchelpers.UUIDToStr(task.StepID)
 			switch {
 			case task.JobKind == sqlcv1.JobKindONFAILURE:
@@ -2527,6 +2678,7 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 					},
 					TriggerDAGId:         &task.DagID.Int64,
 					TriggerDAGInsertedAt: task.DagInsertedAt,
+					TriggerWorkflowRunId: &workflowRunId,
 					// NOTE: we don't need to set parent task id/child index/child key because
 					// the task already exists
 					TriggerExistingTaskId:         &task.ID,
@@ -2536,6 +2688,7 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 				conditions := make([]GroupMatchCondition, 0)
 
 				cancelGroupId := uuid.NewString()
+				skipGroupId := uuid.NewString()
 
 				for _, parent := range task.Parents {
 					// FIXME: n^2 complexity here, fix it.
@@ -2544,7 +2697,7 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 							parentExternalId := sqlchelpers.UUIDToStr(otherTask.ExternalID)
 							readableId := otherTask.StepReadableID
 
-							conditions = append(conditions, getParentInDAGGroupMatch(cancelGroupId, parentExternalId, readableId)...)
+							conditions = append(conditions, getParentInDAGGroupMatch(cancelGroupId, skipGroupId, parentExternalId, readableId)...)
 						}
 					}
 				}
@@ -2561,10 +2714,12 @@ func (r *TaskRepositoryImpl) ReplayTasks(ctx context.Context, tenantId string, t
 					},
 					TriggerDAGId:         &task.DagID.Int64,
 					TriggerDAGInsertedAt: task.DagInsertedAt,
+					TriggerWorkflowRunId: &workflowRunId,
 					// NOTE: we don't need to set parent task id/child index/child key because
 					// the task already exists
 					TriggerExistingTaskId:         &task.ID,
 					TriggerExistingTaskInsertedAt: task.InsertedAt,
+					TriggerDeferredStepConditions: hasQueueStepConditions(stepIdsToConditions[stepId]),
 				})
 			}
 		}
@@ -2,14 +2,20 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	celgo "github.com/google/cel-go/cel"
 	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
@@ -51,6 +57,12 @@ type TriggerTaskData struct {
 
 	// (optional) the child key
 	ChildKey *string `json:"child_key"`
+
+	// (optional) the idempotency key of the workflow run
+	IdempotencyKey *string `json:"idempotency_key,omitempty" validate:"omitempty,min=1,max=255"`
+
+	// (optional) how long the idempotency key is valid for
+	IdempotencyKeyTTL *time.Duration `json:"idempotency_key_ttl,omitempty" validate:"omitempty,min=1s,max=24h"`
 }
 
 type createDAGOpts struct {
@@ -76,25 +88,55 @@ type createDAGOpts struct {
 	AdditionalMetadata []byte
 
 	ParentTaskExternalID *string
+
+	// (optional) the idempotency key of the DAG
+	IdempotencyKey *string
+
+	// (optional) the key of the event which triggered the DAG
+	EventKey *string
 }
 
 type TriggerRepository interface {
 	TriggerFromEvents(ctx context.Context, tenantId string, opts []EventTriggerOpts) ([]*sqlcv1.V1Task, []*DAGWithData, error)
 
+	// ListEventTriggerSkips lists the workflows which were not triggered by an event because the event did not
+	// match the filter on the workflow's event trigger.
+	ListEventTriggerSkips(ctx context.Context, tenantId, eventId string) ([]*sqlcv1.ListEventTriggerSkipsRow, error)
+
 	TriggerFromWorkflowNames(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) ([]*sqlcv1.V1Task, []*DAGWithData, error)
 
 	PopulateExternalIdsForWorkflow(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error
 
 	PreflightVerifyWorkflowNameOpts(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) error
+
+	// ExpandMapTasks creates the items of map tasks, and completes or fails map tasks once all of their items
+	// have finished.
+	ExpandMapTasks(ctx context.Context, tenantId string, tasks []TaskIdInsertedAtRetryCount) (*ExpandMapTasksResult, error)
+
+	// ReleaseIdempotencyKeys releases the idempotency keys claimed by the given workflow runs, for example if
+	// the workflow runs could not be triggered.
+	ReleaseIdempotencyKeys(ctx context.Context, tenantId string, externalIds []string) error
+
+	UpdateTablePartitions(ctx context.Context) error
 }
 
 type TriggerRepositoryImpl struct {
 	*sharedRepository
+
+	// eventFilterCache caches the compiled event trigger filters, keyed by expression
+	eventFilterCache *lru.Cache[string, celgo.Program]
 }
 
 func newTriggerRepository(s *sharedRepository) TriggerRepository {
+	eventFilterCache, err := lru.New[string, celgo.Program](1000)
+
+	if err != nil {
+		log.Fatalf("failed to create LRU cache: %v", err)
+	}
+
 	return &TriggerRepositoryImpl{
 		sharedRepository: s,
+		eventFilterCache: eventFilterCache,
 	}
 }
 
@@ -126,6 +168,7 @@ func (r *TriggerRepositoryImpl) TriggerFromEvents(ctx context.Context, tenantId
 
 	// each (workflowVersionId, eventKey, opt) is a separate workflow that we need to create
 	triggerOpts := make([]triggerTuple, 0)
+	skips := make([]eventTriggerSkip, 0)
 
 	for _, workflow := range workflowVersionIdsAndEventKeys {
 		opts, ok := eventKeysToOpts[workflow.EventKey]
@@ -135,6 +178,24 @@ func (r *TriggerRepositoryImpl) TriggerFromEvents(ctx context.Context, tenantId
 		}
 
 		for _, opt := range opts {
+			if workflow.Expression.Valid {
+				matches, err := r.evalEventTriggerFilter(workflow.Expression.String, opt)
+
+				if !matches {
+					skip := eventTriggerSkip{
+						event:    opt,
+						workflow: workflow,
+					}
+
+					if err != nil {
+						skip.errorMessage = err.Error()
+					}
+
+					skips = append(skips, skip)
+					continue
+				}
+			}
+
 			triggerOpts = append(triggerOpts, triggerTuple{
 				workflowVersionId:  sqlchelpers.UUIDToStr(workflow.WorkflowVersionId),
 				workflowId:         sqlchelpers.UUIDToStr(workflow.WorkflowId),
@@ -142,13 +203,101 @@ func (r *TriggerRepositoryImpl) TriggerFromEvents(ctx context.Context, tenantId
 				externalId:         uuid.NewString(),
 				input:              opt.Data,
 				additionalMetadata: opt.AdditionalMetadata,
+				eventKey:           &opt.Key,
 			})
 		}
 	}
 
+	if len(skips) > 0 {
+		if err := r.createEventTriggerSkips(ctx, tenantId, skips); err != nil {
+			// we don't fail the trigger if we can't record the skips
+			r.l.Error().Err(err).Msg("failed to record skipped event triggers")
+		}
+	}
+
 	return r.triggerWorkflows(ctx, tenantId, triggerOpts)
 }
 
+// eventTriggerSkip is a workflow which was not triggered by an event because the event did not match the
+// filter on the event trigger
+type eventTriggerSkip struct {
+	event    EventTriggerOpts
+	workflow *sqlcv1.ListWorkflowsForEventsRow
+
+	// set if the filter could not be evaluated
+	errorMessage string
+}
+
+// evalEventTriggerFilter evaluates the filter of an event trigger against the event payload and additional
+// metadata. If the filter cannot be evaluated, the event does not match.
+func (r *TriggerRepositoryImpl) evalEventTriggerFilter(expr string, opt EventTriggerOpts) (bool, error) {
+	input := map[string]interface{}{}
+
+	if len(opt.Data) > 0 {
+		if err := json.Unmarshal(opt.Data, &input); err != nil {
+			return false, fmt.Errorf("event data is not a json object: %w", err)
+		}
+	}
+
+	additionalMeta := map[string]interface{}{}
+
+	if len(opt.AdditionalMetadata) > 0 {
+		if err := json.Unmarshal(opt.AdditionalMetadata, &additionalMeta); err != nil {
+			return false, fmt.Errorf("event additional metadata is not a json object: %w", err)
+		}
+	}
+
+	prg, ok := r.eventFilterCache.Get(expr)
+
+	if !ok {
+		var err error
+
+		prg, err = r.celParser.ParseEvent(expr)
+
+		if err != nil {
+			return false, err
+		}
+
+		r.eventFilterCache.Add(expr, prg)
+	}
+
+	return r.celParser.EvalEvent(prg, cel.NewInput(
+		cel.WithInput(input),
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithEventKey(opt.Key),
+	))
+}
+
+func (r *TriggerRepositoryImpl) createEventTriggerSkips(ctx context.Context, tenantId string, skips []eventTriggerSkip) error {
+	params := sqlcv1.CreateEventTriggerSkipsParams{
+		Tenantid:           sqlchelpers.UUIDFromStr(tenantId),
+		Eventids:           make([]pgtype.UUID, len(skips)),
+		Eventkeys:          make([]string, len(skips)),
+		Workflowids:        make([]pgtype.UUID, len(skips)),
+		Workflowversionids: make([]pgtype.UUID, len(skips)),
+		Expressions:        make([]string, len(skips)),
+		Errormessages:      make([]string, len(skips)),
+	}
+
+	for i, skip := range skips {
+		params.Eventids[i] = sqlchelpers.UUIDFromStr(skip.event.EventId)
+		params.Eventkeys[i] = skip.event.Key
+		params.Workflowids[i] = skip.workflow.WorkflowId
+		params.Workflowversionids[i] = skip.workflow.WorkflowVersionId
+		params.Expressions[i] = skip.workflow.Expression.String
+		params.Errormessages[i] = skip.errorMessage
+	}
+
+	return r.queries.CreateEventTriggerSkips(ctx, r.pool, params)
+}
+
+func (r *TriggerRepositoryImpl) ListEventTriggerSkips(ctx context.Context, tenantId, eventId string) ([]*sqlcv1.ListEventTriggerSkipsRow, error) {
+	return r.queries.ListEventTriggerSkips(ctx, r.pool, sqlcv1.ListEventTriggerSkipsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventid:  sqlchelpers.UUIDFromStr(eventId),
+	})
+}
+
 func (r *TriggerRepositoryImpl) TriggerFromWorkflowNames(ctx context.Context, tenantId string, opts []*WorkflowNameTriggerOpts) ([]*sqlcv1.V1Task, []*DAGWithData, error) {
 	workflowNames := make([]string, 0, len(opts))
 	uniqueNames := make(map[string]struct{})
@@ -199,6 +348,8 @@ func (r *TriggerRepositoryImpl) TriggerFromWorkflowNames(ctx context.Context, te
 				parentTaskInsertedAt: opt.ParentTaskInsertedAt,
 				childIndex:           opt.ChildIndex,
 				childKey:             opt.ChildKey,
+				idempotencyKey:       opt.IdempotencyKey,
+				idempotencyKeyTTL:    opt.IdempotencyKeyTTL,
 			})
 		}
 	}
@@ -296,6 +447,13 @@ type triggerTuple struct {
 	parentTaskInsertedAt *time.Time
 	childIndex           *int64
 	childKey             *string
+
+	// the key of the event which triggered the workflow, if any
+	eventKey *string
+
+	// the idempotency key of the workflow run, if any
+	idempotencyKey    *string
+	idempotencyKeyTTL *time.Duration
 }
 
 func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId string, tuples []triggerTuple) ([]*sqlcv1.V1Task, []*DAGWithData, error) {
@@ -326,6 +484,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 	// group steps by workflow version ids
 	workflowVersionToSteps := make(map[string][]*sqlcv1.ListStepsByWorkflowVersionIdsRow)
 	stepIdsToReadableIds := make(map[string]string)
+	stepIds := make([]pgtype.UUID, 0, len(steps))
 
 	for _, step := range steps {
 		workflowVersionId := sqlchelpers.UUIDToStr(step.WorkflowVersionId)
@@ -333,6 +492,40 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 		workflowVersionToSteps[workflowVersionId] = append(workflowVersionToSteps[workflowVersionId], step)
 
 		stepIdsToReadableIds[sqlchelpers.UUIDToStr(step.ID)] = step.ReadableId.String
+		stepIds = append(stepIds, step.ID)
+	}
+
+	stepConditions, err := r.queries.ListStepMatchConditions(ctx, r.pool, sqlcv1.ListStepMatchConditionsParams{
+		Stepids:  stepIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list step match conditions: %w", err)
+	}
+
+	stepIdsToConditions := make(map[string][]*sqlcv1.StepMatchCondition)
+
+	for _, condition := range stepConditions {
+		stepId := sqlchelpers.UUIDToStr(condition.StepId)
+		stepIdsToConditions[stepId] = append(stepIdsToConditions[stepId], condition)
+	}
+
+	// a workflow version is run as a DAG if it has multiple steps, or if any of its steps has conditions or is a
+	// map step, since match conditions are attached to the DAG and the items of a map step are told apart from
+	// the map task by not belonging to a DAG
+	dagWorkflowVersions := make(map[string]bool)
+
+	for workflowVersionId, versionSteps := range workflowVersionToSteps {
+		isDag := len(versionSteps) > 1
+
+		for _, step := range versionSteps {
+			if len(stepIdsToConditions[sqlchelpers.UUIDToStr(step.ID)]) > 0 || step.MapExpression.Valid {
+				isDag = true
+			}
+		}
+
+		dagWorkflowVersions[workflowVersionId] = isDag
 	}
 
 	// start constructing options for creating tasks, DAGs, and triggers. logic is as follows:
@@ -357,6 +550,9 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 	eventMatches := make(map[string][]CreateMatchOpts)
 	createMatchOpts := make([]CreateMatchOpts, 0)
 
+	// sleep conditions which need a durable sleep to be created
+	pendingSleeps := make([]pendingStepSleep, 0)
+
 	// a map of trigger tuples to step external IDs
 	stepsToExternalIds := make([]map[string]string, len(tuples))
 	dagToTaskIds := make(map[string][]string)
@@ -379,11 +575,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 			continue
 		}
 
-		isDag := false
-
-		if len(steps) > 1 {
-			isDag = true
-		}
+		isDag := dagWorkflowVersions[tuple.workflowVersionId]
 
 		for _, step := range steps {
 			if !isDag {
@@ -404,6 +596,14 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 
 	defer rollback()
 
+	// check if we should skip the creation of any workflows if they have an idempotency key which
+	// belongs to another workflow run
+	duplicateTuples, err := r.registerIdempotencyKeys(ctx, tx, tenantId, tuples)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to register idempotency keys: %w", err)
+	}
+
 	// check if we should skip the creation of any workflows if they're child workflows which
 	// already have a signal registered
 	tuplesToSkip, err := r.registerChildWorkflows(ctx, tx, tenantId, tuples, stepsToExternalIds, workflowVersionToSteps)
@@ -412,6 +612,14 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 		return nil, nil, fmt.Errorf("failed to register child workflows: %w", err)
 	}
 
+	if tuplesToSkip == nil {
+		tuplesToSkip = make(map[string]struct{}, len(duplicateTuples))
+	}
+
+	for externalId := range duplicateTuples {
+		tuplesToSkip[externalId] = struct{}{}
+	}
+
 	for i, tuple := range tuples {
 		if _, ok := tuplesToSkip[tuple.externalId]; ok {
 			continue
@@ -433,11 +641,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 			continue
 		}
 
-		isDag := false
-
-		if len(steps) > 1 {
-			isDag = true
-		}
+		isDag := dagWorkflowVersions[tuple.workflowVersionId]
 
 		for stepIndex, step := range orderSteps(steps) {
 			stepId := sqlchelpers.UUIDToStr(step.ID)
@@ -513,7 +717,7 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 					TriggerChildIndex:           childIndex,
 					TriggerChildKey:             childKey,
 				})
-			case len(step.Parents) == 0:
+			case len(step.Parents) == 0 && len(stepIdsToConditions[stepId]) == 0:
 				opt := CreateTaskOpts{
 					ExternalId:           taskExternalId,
 					WorkflowRunId:        tuple.externalId,
@@ -528,6 +732,12 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 					StepIndex:            stepIndex,
 					ChildIndex:           tuple.childIndex,
 					ChildKey:             tuple.childKey,
+					EventKey:             tuple.eventKey,
+				}
+
+				// the idempotency key is written to the DAG if there is one, since the DAG is the workflow run
+				if !isDag {
+					opt.IdempotencyKey = tuple.idempotencyKey
 				}
 
 				if isDag {
@@ -539,12 +749,25 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 				conditions := make([]GroupMatchCondition, 0)
 
 				cancelGroupId := uuid.NewString()
+				skipGroupId := uuid.NewString()
 
 				for _, parent := range step.Parents {
 					parentExternalId := stepsToExternalIds[i][sqlchelpers.UUIDToStr(parent)]
 					readableId := stepIdsToReadableIds[sqlchelpers.UUIDToStr(parent)]
 
-					conditions = append(conditions, getParentInDAGGroupMatch(cancelGroupId, parentExternalId, readableId)...)
+					conditions = append(conditions, getParentInDAGGroupMatch(cancelGroupId, skipGroupId, parentExternalId, readableId)...)
+				}
+
+				// if the step waits on its own conditions, they are registered once its parents have completed, so
+				// that sleeps are measured from that point
+				stepMatchConditions := stepIdsToConditions[stepId]
+				deferStepConditions := len(step.Parents) > 0 && hasQueueStepConditions(stepMatchConditions)
+
+				if len(stepMatchConditions) > 0 && !deferStepConditions {
+					var sleeps []pendingStepSleep
+
+					conditions, sleeps = getStepGroupMatches(conditions, stepMatchConditions)
+					pendingSleeps = append(pendingSleeps, sleeps...)
 				}
 
 				var (
@@ -595,11 +818,12 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 						Int64: int64(stepIndex),
 						Valid: true,
 					},
-					TriggerParentTaskExternalId: parentTaskExternalId,
-					TriggerParentTaskId:         parentTaskId,
-					TriggerParentTaskInsertedAt: parentTaskInsertedAt,
-					TriggerChildIndex:           childIndex,
-					TriggerChildKey:             childKey,
+					TriggerParentTaskExternalId:   parentTaskExternalId,
+					TriggerParentTaskId:           parentTaskId,
+					TriggerParentTaskInsertedAt:   parentTaskInsertedAt,
+					TriggerChildIndex:             childIndex,
+					TriggerChildKey:               childKey,
+					TriggerDeferredStepConditions: deferStepConditions,
 				})
 			}
 		}
@@ -614,10 +838,19 @@ func (r *TriggerRepositoryImpl) triggerWorkflows(ctx context.Context, tenantId s
 				WorkflowName:         tuple.workflowName,
 				AdditionalMetadata:   tuple.additionalMetadata,
 				ParentTaskExternalID: tuple.parentExternalId,
+				IdempotencyKey:       tuple.idempotencyKey,
+				EventKey:             tuple.eventKey,
 			})
 		}
 	}
 
+	// create durable sleeps for any sleep conditions, which are satisfied when the sleep expires
+	err = r.createStepSleeps(ctx, tx, tenantId, pendingSleeps)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create step sleeps: %w", err)
+	}
+
 	// create DAGs
 	dags, err := r.createDAGs(ctx, tx, tenantId, dagOpts)
 
@@ -696,6 +929,7 @@ func (r *TriggerRepositoryImpl) createDAGs(ctx context.Context, tx sqlcv1.DBTX,
 	workflowIds := make([]pgtype.UUID, 0, len(opts))
 	workflowVersionIds := make([]pgtype.UUID, 0, len(opts))
 	parentTaskExternalIds := make([]pgtype.UUID, 0, len(opts))
+	idempotencyKeys := make([]string, 0, len(opts))
 	dagIdToOpt := make(map[string]createDAGOpts, 0)
 
 	unix := time.Now().UnixMilli()
@@ -713,6 +947,12 @@ func (r *TriggerRepositoryImpl) createDAGs(ctx context.Context, tx sqlcv1.DBTX,
 			parentTaskExternalIds = append(parentTaskExternalIds, sqlchelpers.UUIDFromStr(*opt.ParentTaskExternalID))
 		}
 
+		if opt.IdempotencyKey == nil {
+			idempotencyKeys = append(idempotencyKeys, "")
+		} else {
+			idempotencyKeys = append(idempotencyKeys, *opt.IdempotencyKey)
+		}
+
 		dagIdToOpt[opt.ExternalId] = opt
 	}
 
@@ -723,6 +963,7 @@ func (r *TriggerRepositoryImpl) createDAGs(ctx context.Context, tx sqlcv1.DBTX,
 		Workflowids:           workflowIds,
 		Workflowversionids:    workflowVersionIds,
 		Parenttaskexternalids: parentTaskExternalIds,
+		Idempotencykeys:       idempotencyKeys,
 	})
 
 	if err != nil {
@@ -753,12 +994,21 @@ func (r *TriggerRepositoryImpl) createDAGs(ctx context.Context, tx sqlcv1.DBTX,
 			additionalMeta = []byte("{}")
 		}
 
-		dagDataParams = append(dagDataParams, sqlcv1.CreateDAGDataParams{
+		dagDataParam := sqlcv1.CreateDAGDataParams{
 			DagID:              dag.ID,
 			DagInsertedAt:      dag.InsertedAt,
 			Input:              input,
 			AdditionalMetadata: additionalMeta,
-		})
+		}
+
+		if opt.EventKey != nil {
+			dagDataParam.EventKey = pgtype.Text{
+				String: *opt.EventKey,
+				Valid:  true,
+			}
+		}
+
+		dagDataParams = append(dagDataParams, dagDataParam)
 
 		parentTaskExternalID := pgtype.UUID{}
 
@@ -928,14 +1178,14 @@ func (r *TriggerRepositoryImpl) registerChildWorkflows(
 
 				key := externalIdsToKeys[stepExternalId]
 
-				createMatchOpts = append(createMatchOpts, CreateMatchOpts{
-					Kind:                 sqlcv1.V1MatchKindSIGNAL,
-					Conditions:           getChildWorkflowGroupMatches(stepExternalId, stepReadableId),
-					SignalExternalId:     tuple.parentExternalId,
-					SignalTaskId:         tuple.parentTaskId,
-					SignalTaskInsertedAt: sqlchelpers.TimestamptzFromTime(*tuple.parentTaskInsertedAt),
-					SignalKey:            &key,
-				})
+				createMatchOpts = append(createMatchOpts, getChildSignalMatch(
+					*tuple.parentExternalId,
+					*tuple.parentTaskId,
+					sqlchelpers.TimestamptzFromTime(*tuple.parentTaskInsertedAt),
+					stepExternalId,
+					stepReadableId,
+					key,
+				))
 			}
 		}
 	}
@@ -950,7 +1200,10 @@ func (r *TriggerRepositoryImpl) registerChildWorkflows(
 	return tuplesToSkip, nil
 }
 
-func getParentInDAGGroupMatch(cancelGroupId, parentExternalId, parentReadableId string) []GroupMatchCondition {
+// getParentInDAGGroupMatch returns the conditions which a task waits on for one of its parents. The task is
+// queued once every parent has completed, and is skipped if any parent was skipped, since the task is missing
+// the output of that parent. The skip and cancel groups are shared by all parents of the task.
+func getParentInDAGGroupMatch(cancelGroupId, skipGroupId, parentExternalId, parentReadableId string) []GroupMatchCondition {
 	return []GroupMatchCondition{
 		{
 			GroupId:           uuid.NewString(),
@@ -962,7 +1215,7 @@ func getParentInDAGGroupMatch(cancelGroupId, parentExternalId, parentReadableId
 			Action:            sqlcv1.V1MatchConditionActionQUEUE,
 		},
 		{
-			GroupId:           uuid.NewString(),
+			GroupId:           skipGroupId,
 			EventType:         sqlcv1.V1EventTypeINTERNAL,
 			EventKey:          string(sqlcv1.V1TaskEventTypeCOMPLETED),
 			ReadableDataKey:   parentReadableId,
@@ -991,6 +1244,19 @@ func getParentInDAGGroupMatch(cancelGroupId, parentExternalId, parentReadableId
 	}
 }
 
+// getChildSignalMatch returns the match which signals a parent task with a SIGNAL_COMPLETED event, keyed by
+// signalKey, once the child task has finished.
+func getChildSignalMatch(parentExternalId string, parentTaskId int64, parentTaskInsertedAt pgtype.Timestamptz, childExternalId, childReadableId, signalKey string) CreateMatchOpts {
+	return CreateMatchOpts{
+		Kind:                 sqlcv1.V1MatchKindSIGNAL,
+		Conditions:           getChildWorkflowGroupMatches(childExternalId, childReadableId),
+		SignalExternalId:     &parentExternalId,
+		SignalTaskId:         &parentTaskId,
+		SignalTaskInsertedAt: parentTaskInsertedAt,
+		SignalKey:            &signalKey,
+	}
+}
+
 func getChildWorkflowGroupMatches(taskExternalId, stepReadableId string) []GroupMatchCondition {
 	groupId := uuid.NewString()
 
@@ -1059,6 +1325,252 @@ func getParentOnFailureGroupMatches(createGroupId, parentExternalId, parentReada
 	}
 }
 
+// pendingStepSleep is a sleep condition of a step which is waiting for its durable sleep to be created. The
+// resource hint is shared by every match condition which was compiled from the sleep condition.
+type pendingStepSleep struct {
+	hint *string
+
+	sleepDuration string
+}
+
+// getStepGroupMatches combines the match conditions for the parents of a step with the step's own conditions.
+// QUEUE groups are AND'd together, so the step is queued once its parents have completed and its own QUEUE
+// groups are satisfied. The step is skipped or cancelled when either the parent groups or its own groups for
+// that action are satisfied.
+func getStepGroupMatches(parentConditions []GroupMatchCondition, stepConditions []*sqlcv1.StepMatchCondition) ([]GroupMatchCondition, []pendingStepSleep) {
+	userGroups := make(map[sqlcv1.V1MatchConditionAction][][]GroupMatchCondition)
+	groupIndexes := make(map[string]int)
+	sleeps := make([]pendingStepSleep, 0)
+
+	for _, stepCondition := range stepConditions {
+		action := sqlcv1.V1MatchConditionAction(stepCondition.Action)
+
+		condition := GroupMatchCondition{
+			ReadableDataKey: stepCondition.ReadableDataKey,
+			Expression:      "true",
+			Action:          action,
+		}
+
+		switch stepCondition.Kind {
+		case sqlcv1.StepMatchConditionKindSLEEP:
+			hint := ""
+
+			condition.EventType = sqlcv1.V1EventTypeINTERNAL
+			condition.EventKey = durableSleepEventKey
+			condition.EventResourceHint = &hint
+
+			sleeps = append(sleeps, pendingStepSleep{
+				hint:          &hint,
+				sleepDuration: stepCondition.SleepDuration.String,
+			})
+		case sqlcv1.StepMatchConditionKindUSEREVENT:
+			condition.EventType = sqlcv1.V1EventTypeUSER
+			condition.EventKey = stepCondition.EventKey.String
+
+			if stepCondition.Expression.Valid && stepCondition.Expression.String != "" {
+				condition.Expression = stepCondition.Expression.String
+			}
+		}
+
+		groupKey := fmt.Sprintf("%s.%s", action, stepCondition.OrGroupId)
+
+		if i, ok := groupIndexes[groupKey]; ok {
+			userGroups[action][i] = append(userGroups[action][i], condition)
+			continue
+		}
+
+		groupIndexes[groupKey] = len(userGroups[action])
+		userGroups[action] = append(userGroups[action], []GroupMatchCondition{condition})
+	}
+
+	res := make([]GroupMatchCondition, 0, len(parentConditions)+len(stepConditions))
+
+	for _, action := range []sqlcv1.V1MatchConditionAction{
+		sqlcv1.V1MatchConditionActionQUEUE,
+		sqlcv1.V1MatchConditionActionSKIP,
+		sqlcv1.V1MatchConditionActionCANCEL,
+	} {
+		parentGroups := groupMatchConditions(parentConditions, action)
+
+		var groups [][]GroupMatchCondition
+
+		if action == sqlcv1.V1MatchConditionActionQUEUE {
+			groups = append(parentGroups, userGroups[action]...)
+		} else {
+			groups = orMatchGroups(parentGroups, userGroups[action])
+		}
+
+		for _, group := range groups {
+			groupId := uuid.NewString()
+
+			for _, condition := range group {
+				condition.GroupId = groupId
+				res = append(res, condition)
+			}
+		}
+	}
+
+	return res, sleeps
+}
+
+// groupMatchConditions returns the groups of match conditions with the given action, in the order in which
+// the groups first appear.
+func groupMatchConditions(conditions []GroupMatchCondition, action sqlcv1.V1MatchConditionAction) [][]GroupMatchCondition {
+	groups := make([][]GroupMatchCondition, 0)
+	groupIndexes := make(map[string]int)
+
+	for _, condition := range conditions {
+		if condition.Action != action {
+			continue
+		}
+
+		if i, ok := groupIndexes[condition.GroupId]; ok {
+			groups[i] = append(groups[i], condition)
+			continue
+		}
+
+		groupIndexes[condition.GroupId] = len(groups)
+		groups = append(groups, []GroupMatchCondition{condition})
+	}
+
+	return groups
+}
+
+// orMatchGroups returns groups which are satisfied when either all groups in a or all groups in b are satisfied.
+// Since groups with the same action are AND'd together, the OR is distributed over the groups:
+// (a1 AND a2) OR b1 = (a1 OR b1) AND (a2 OR b1). If either set is empty, it can never be satisfied, so the
+// other set is returned.
+func orMatchGroups(a, b [][]GroupMatchCondition) [][]GroupMatchCondition {
+	if len(a) == 0 {
+		return b
+	}
+
+	if len(b) == 0 {
+		return a
+	}
+
+	res := make([][]GroupMatchCondition, 0, len(a)*len(b))
+
+	for _, aGroup := range a {
+		for _, bGroup := range b {
+			group := make([]GroupMatchCondition, 0, len(aGroup)+len(bGroup))
+			group = append(group, aGroup...)
+			group = append(group, bGroup...)
+
+			res = append(res, group)
+		}
+	}
+
+	return res
+}
+
+// createStepSleeps creates a durable sleep for each pending sleep condition, and sets the resource hint of the
+// sleep's match conditions to the id of the durable sleep.
+func (s *sharedRepository) createStepSleeps(ctx context.Context, tx sqlcv1.DBTX, tenantId string, pendingSleeps []pendingStepSleep) error {
+	if len(pendingSleeps) == 0 {
+		return nil
+	}
+
+	sleepDurations := make([]string, 0, len(pendingSleeps))
+
+	for _, sleep := range pendingSleeps {
+		sleepDurations = append(sleepDurations, sleep.sleepDuration)
+	}
+
+	sleeps, err := s.queries.CreateDurableSleeps(ctx, tx, sqlcv1.CreateDurableSleepsParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Sleepdurations: sleepDurations,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(sleeps) != len(pendingSleeps) {
+		return fmt.Errorf("expected %d durable sleeps to be created, but only %d were created", len(pendingSleeps), len(sleeps))
+	}
+
+	for i, sleep := range sleeps {
+		*pendingSleeps[i].hint = strconv.FormatInt(sleep.ID, 10)
+	}
+
+	return nil
+}
+
+// hasQueueStepConditions returns true if any of the step's conditions has a QUEUE action. The conditions of a
+// step with parents are only deferred until its parents have completed if the step waits on one of them.
+func hasQueueStepConditions(stepConditions []*sqlcv1.StepMatchCondition) bool {
+	for _, condition := range stepConditions {
+		if sqlcv1.V1MatchConditionAction(condition.Action) == sqlcv1.V1MatchConditionActionQUEUE {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getDeferredStepMatch returns the match which registers the conditions of a step once the match for the step's
+// parents has been queued, so sleeps are measured from when the parents completed. The data of the parents is
+// carried over as a satisfied QUEUE group, so it's passed to the task once the step's own conditions are satisfied.
+func getDeferredStepMatch(match *sqlcv1.SaveSatisfiedMatchConditionsRow, matchData *MatchData, stepConditions []*sqlcv1.StepMatchCondition) (CreateMatchOpts, []pendingStepSleep, error) {
+	parentConditions := make([]GroupMatchCondition, 0)
+	groupId := uuid.NewString()
+
+	keys := matchData.DataKeys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		for _, value := range matchData.dataKeys[key] {
+			data, err := json.Marshal(value)
+
+			if err != nil {
+				return CreateMatchOpts{}, nil, fmt.Errorf("failed to marshal data for %s: %w", key, err)
+			}
+
+			parentConditions = append(parentConditions, GroupMatchCondition{
+				GroupId:         groupId,
+				EventType:       sqlcv1.V1EventTypeINTERNAL,
+				EventKey:        string(sqlcv1.V1TaskEventTypeCOMPLETED),
+				ReadableDataKey: key,
+				Expression:      "true",
+				Action:          sqlcv1.V1MatchConditionActionQUEUE,
+				Data:            data,
+				IsSatisfied:     true,
+			})
+		}
+	}
+
+	conditions, sleeps := getStepGroupMatches(parentConditions, stepConditions)
+
+	dagId := match.TriggerDagID.Int64
+	externalId := sqlchelpers.UUIDToStr(match.TriggerExternalID)
+	workflowRunId := sqlchelpers.UUIDToStr(match.TriggerWorkflowRunID)
+	stepId := sqlchelpers.UUIDToStr(match.TriggerStepID)
+
+	opt := CreateMatchOpts{
+		Kind:                        sqlcv1.V1MatchKindTRIGGER,
+		Conditions:                  conditions,
+		TriggerDAGId:                &dagId,
+		TriggerDAGInsertedAt:        match.TriggerDagInsertedAt,
+		TriggerExternalId:           &externalId,
+		TriggerWorkflowRunId:        &workflowRunId,
+		TriggerStepId:               &stepId,
+		TriggerStepIndex:            match.TriggerStepIndex,
+		TriggerParentTaskExternalId: match.TriggerParentTaskExternalID,
+		TriggerParentTaskId:         match.TriggerParentTaskID,
+		TriggerParentTaskInsertedAt: match.TriggerParentTaskInsertedAt,
+		TriggerChildIndex:           match.TriggerChildIndex,
+		TriggerChildKey:             match.TriggerChildKey,
+	}
+
+	if match.TriggerExistingTaskID.Valid {
+		opt.TriggerExistingTaskId = &match.TriggerExistingTaskID.Int64
+		opt.TriggerExistingTaskInsertedAt = match.TriggerExistingTaskInsertedAt
+	}
+
+	return opt, sleeps, nil
+}
+
 func orderSteps(steps []*sqlcv1.ListStepsByWorkflowVersionIdsRow) []*sqlcv1.ListStepsByWorkflowVersionIdsRow {
 	slices.SortStableFunc(steps, func(i, j *sqlcv1.ListStepsByWorkflowVersionIdsRow) int {
 		idA := sqlchelpers.UUIDToStr(i.ID)
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+func TestEvalEventTriggerFilter(t *testing.T) {
+	r := newTriggerRepository(&sharedRepository{
+		celParser: cel.NewCELParser(),
+	}).(*TriggerRepositoryImpl)
+
+	expr := `input.amount > 100 && additional_metadata.region == "eu"`
+
+	matches, err := r.evalEventTriggerFilter(expr, EventTriggerOpts{
+		Key:                "order:created",
+		Data:               []byte(`{"amount": 250}`),
+		AdditionalMetadata: []byte(`{"region": "eu"}`),
+	})
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = r.evalEventTriggerFilter(expr, EventTriggerOpts{
+		Key:                "order:created",
+		Data:               []byte(`{"amount": 50}`),
+		AdditionalMetadata: []byte(`{"region": "eu"}`),
+	})
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	// the filter is only compiled once
+	assert.Equal(t, 1, r.eventFilterCache.Len())
+
+	_, err = r.evalEventTriggerFilter(`input.amount >`, EventTriggerOpts{
+		Key: "order:created",
+	})
+	assert.Error(t, err)
+
+	// filters which fail to compile are not cached
+	assert.Equal(t, 1, r.eventFilterCache.Len())
+}
+
+func TestHasQueueStepConditions(t *testing.T) {
+	assert.False(t, hasQueueStepConditions(nil))
+	assert.False(t, hasQueueStepConditions([]*sqlcv1.StepMatchCondition{
+		{Action: sqlcv1.StepMatchConditionActionCANCEL},
+	}))
+	assert.True(t, hasQueueStepConditions([]*sqlcv1.StepMatchCondition{
+		{Action: sqlcv1.StepMatchConditionActionCANCEL},
+		{Action: sqlcv1.StepMatchConditionActionQUEUE},
+	}))
+}
+
+func TestGetDeferredStepMatch(t *testing.T) {
+	match := &sqlcv1.SaveSatisfiedMatchConditionsRow{
+		TriggerDagID:                  pgtype.Int8{Int64: 1, Valid: true},
+		TriggerDagInsertedAt:          sqlchelpers.TimestamptzFromTime(time.Now()),
+		TriggerStepID:                 sqlchelpers.UUIDFromStr(uuid.NewString()),
+		TriggerStepIndex:              pgtype.Int8{Int64: 2, Valid: true},
+		TriggerExternalID:             sqlchelpers.UUIDFromStr(uuid.NewString()),
+		TriggerWorkflowRunID:          sqlchelpers.UUIDFromStr(uuid.NewString()),
+		TriggerExistingTaskID:         pgtype.Int8{Int64: 3, Valid: true},
+		TriggerDeferredStepConditions: true,
+	}
+
+	matchData, err := NewMatchData([]byte(`{"QUEUE": {"step-one": [{"output": 1}], "step-two": [{"output": 2}]}}`))
+	require.NoError(t, err)
+
+	stepConditions := []*sqlcv1.StepMatchCondition{
+		{
+			OrGroupId:       "wait",
+			Action:          sqlcv1.StepMatchConditionActionQUEUE,
+			Kind:            sqlcv1.StepMatchConditionKindSLEEP,
+			ReadableDataKey: "sleep_1m",
+			SleepDuration:   pgtype.Text{String: "1m", Valid: true},
+		},
+		{
+			OrGroupId:       "wait",
+			Action:          sqlcv1.StepMatchConditionActionQUEUE,
+			Kind:            sqlcv1.StepMatchConditionKindUSEREVENT,
+			ReadableDataKey: "order:approved",
+			EventKey:        pgtype.Text{String: "order:approved", Valid: true},
+		},
+		{
+			OrGroupId:       "cancel",
+			Action:          sqlcv1.StepMatchConditionActionCANCEL,
+			Kind:            sqlcv1.StepMatchConditionKindUSEREVENT,
+			ReadableDataKey: "order:cancelled",
+			EventKey:        pgtype.Text{String: "order:cancelled", Valid: true},
+		},
+	}
+
+	opt, sleeps, err := getDeferredStepMatch(match, matchData, stepConditions)
+	require.NoError(t, err)
+
+	// the sleep is created when the deferred match is created, not when the workflow run is triggered
+	require.Len(t, sleeps, 1)
+	assert.Equal(t, "1m", sleeps[0].sleepDuration)
+
+	assert.Equal(t, sqlcv1.V1MatchKindTRIGGER, opt.Kind)
+	assert.False(t, opt.TriggerDeferredStepConditions)
+	assert.Equal(t, int64(1), *opt.TriggerDAGId)
+	assert.Equal(t, sqlchelpers.UUIDToStr(match.TriggerStepID), *opt.TriggerStepId)
+	assert.Equal(t, sqlchelpers.UUIDToStr(match.TriggerExternalID), *opt.TriggerExternalId)
+	assert.Equal(t, sqlchelpers.UUIDToStr(match.TriggerWorkflowRunID), *opt.TriggerWorkflowRunId)
+	assert.Equal(t, int64(2), opt.TriggerStepIndex.Int64)
+	assert.Equal(t, int64(3), *opt.TriggerExistingTaskId)
+
+	queueGroups := groupMatchConditions(opt.Conditions, sqlcv1.V1MatchConditionActionQUEUE)
+	require.Len(t, queueGroups, 2)
+
+	// the data of the parents is carried over as a satisfied group
+	parentGroup := queueGroups[0]
+	require.Len(t, parentGroup, 2)
+
+	for i, key := range []string{"step-one", "step-two"} {
+		assert.Equal(t, key, parentGroup[i].ReadableDataKey)
+		assert.True(t, parentGroup[i].IsSatisfied)
+	}
+
+	assert.JSONEq(t, `{"output": 1}`, string(parentGroup[0].Data))
+
+	// the step's own group is not satisfied yet
+	require.Len(t, queueGroups[1], 2)
+
+	for _, condition := range queueGroups[1] {
+		assert.False(t, condition.IsSatisfied)
+	}
+
+	cancelGroups := groupMatchConditions(opt.Conditions, sqlcv1.V1MatchConditionActionCANCEL)
+	require.Len(t, cancelGroups, 1)
+	assert.Equal(t, "order:cancelled", cancelGroups[0][0].EventKey)
+}
@@ -18,6 +18,15 @@ type WorkerRepository interface {
 	ListWorkers(tenantId string, opts *repository.ListWorkersOpts) ([]*sqlcv1.ListWorkersWithSlotCountRow, error)
 	GetWorkerById(workerId string) (*sqlcv1.GetWorkerByIdRow, error)
 	ListWorkerState(tenantId, workerId string, maxRuns int) ([]*sqlcv1.ListSemaphoreSlotsWithStateForWorkerRow, []*dbsqlc.GetStepRunForEngineRow, error)
+
+	// ListWorkerSlotPools returns the named slot pools of the workers, keyed by worker id
+	ListWorkerSlotPools(tenantId string, workerIds []string) (map[string][]*sqlcv1.ListWorkerSlotPoolsRow, error)
+
+	// ListRegisteredSlotPools returns the names of the given slot pools which are registered by any worker
+	ListRegisteredSlotPools(tenantId string, names []string) ([]string, error)
+
+	// HasAssignedTasks returns whether any task is assigned to the worker
+	HasAssignedTasks(ctx context.Context, tenantId, workerId string) (bool, error)
 }
 
 type workerRepository struct {
@@ -89,3 +98,55 @@ func (w *workerRepository) ListWorkerState(tenantId, workerId string, maxRuns in
 
 	return slots, []*dbsqlc.GetStepRunForEngineRow{}, nil
 }
+
+func (w *workerRepository) ListWorkerSlotPools(tenantId string, workerIds []string) (map[string][]*sqlcv1.ListWorkerSlotPoolsRow, error) {
+	workerUUIDs := make([]pgtype.UUID, len(workerIds))
+
+	for i, workerId := range workerIds {
+		workerUUIDs[i] = sqlchelpers.UUIDFromStr(workerId)
+	}
+
+	pools, err := w.queries.ListWorkerSlotPools(context.Background(), w.pool, sqlcv1.ListWorkerSlotPoolsParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Workerids: workerUUIDs,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list worker slot pools: %w", err)
+	}
+
+	res := make(map[string][]*sqlcv1.ListWorkerSlotPoolsRow)
+
+	for _, pool := range pools {
+		workerId := sqlchelpers.UUIDToStr(pool.WorkerId)
+		res[workerId] = append(res[workerId], pool)
+	}
+
+	return res, nil
+}
+
+func (w *workerRepository) ListRegisteredSlotPools(tenantId string, names []string) ([]string, error) {
+	pools, err := w.queries.ListRegisteredSlotPools(context.Background(), w.pool, sqlcv1.ListRegisteredSlotPoolsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Names:    names,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list registered slot pools: %w", err)
+	}
+
+	return pools, nil
+}
+
+func (w *workerRepository) HasAssignedTasks(ctx context.Context, tenantId, workerId string) (bool, error) {
+	hasAssignedTasks, err := w.queries.HasAssignedTasks(ctx, w.pool, sqlcv1.HasAssignedTasksParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Workerid: sqlchelpers.UUIDFromStr(workerId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not check for assigned tasks: %w", err)
+	}
+
+	return hasAssignedTasks, nil
+}
@@ -4,10 +4,20 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
 type WorkflowRepository interface {
 	ListWorkflowNamesByIds(ctx context.Context, tenantId string, workflowIds []pgtype.UUID) (map[pgtype.UUID]string, error)
+
+	// SetWorkflowPaused pauses or resumes a workflow by name. Tasks for a paused workflow are still created and
+	// queued, but are not assigned to workers until the workflow is resumed.
+	SetWorkflowPaused(ctx context.Context, tenantId, workflowName string, isPaused bool) (*sqlcv1.Workflow, error)
+
+	// ListPausedWorkflowIds returns the subset of the given workflow ids which are paused.
+	ListPausedWorkflowIds(ctx context.Context, tenantId string, workflowIds []pgtype.UUID) (map[pgtype.UUID]bool, error)
 }
 
 type workflowRepository struct {
@@ -35,3 +45,30 @@ func (w *workflowRepository) ListWorkflowNamesByIds(ctx context.Context, tenantI
 
 	return workflowIdToNameMap, nil
 }
+
+func (w *workflowRepository) SetWorkflowPaused(ctx context.Context, tenantId, workflowName string, isPaused bool) (*sqlcv1.Workflow, error) {
+	return w.queries.UpdateWorkflowIsPausedByName(ctx, w.pool, sqlcv1.UpdateWorkflowIsPausedByNameParams{
+		Ispaused: isPaused,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     workflowName,
+	})
+}
+
+func (w *workflowRepository) ListPausedWorkflowIds(ctx context.Context, tenantId string, workflowIds []pgtype.UUID) (map[pgtype.UUID]bool, error) {
+	pausedIds, err := w.queries.ListPausedWorkflowIdsByIds(ctx, w.pool, sqlcv1.ListPausedWorkflowIdsByIdsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Ids:      workflowIds,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[pgtype.UUID]bool, len(pausedIds))
+
+	for _, id := range pausedIds {
+		res[id] = true
+	}
+
+	return res, nil
+}
@@ -0,0 +1,158 @@
+//go:build integration
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
+)
+
+const (
+	pausableWorkflowName = "pausable-workflow"
+	pausableActionId     = "pausable:step"
+)
+
+// setupQueuedTask creates a tenant with a single-step workflow and triggers the workflow, returning the tenant
+// id and the queued task.
+func setupQueuedTask(t *testing.T, conf *database.Layer) (string, *sqlcv1.V1Task) {
+	t.Helper()
+
+	ctx := context.Background()
+	tenantId := uuid.New().String()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	_, err = conf.APIRepository.Tenant().CreateTenant(ctx, &repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+	require.NoError(t, err)
+
+	_, err = conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: pausableWorkflowName,
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{ReadableId: "step", Action: pausableActionId},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tasks, _, err := conf.V1.Triggers().TriggerFromWorkflowNames(ctx, tenantId, []*v1.WorkflowNameTriggerOpts{
+		{
+			TriggerTaskData: &v1.TriggerTaskData{
+				WorkflowName: pausableWorkflowName,
+				Data:         []byte(`{}`),
+			},
+			ExternalId: uuid.New().String(),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	return tenantId, tasks[0]
+}
+
+func listQueueItems(t *testing.T, conf *database.Layer, tenantId string) []*sqlcv1.V1QueueItem {
+	t.Helper()
+
+	queue := conf.V1.Scheduler().QueueFactory().NewQueue(sqlchelpers.UUIDFromStr(tenantId), pausableActionId)
+	defer queue.Cleanup()
+
+	qis, err := queue.ListQueueItems(context.Background(), 100)
+	require.NoError(t, err)
+
+	return qis
+}
+
+func TestPausedWorkflow_QueueItemsAreNotListedUntilResumed(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId, task := setupQueuedTask(t, conf)
+
+		require.Len(t, listQueueItems(t, conf, tenantId), 1)
+
+		workflow, err := conf.V1.Workflows().SetWorkflowPaused(ctx, tenantId, pausableWorkflowName, true)
+		require.NoError(t, err)
+		assert.True(t, workflow.IsPaused.Bool)
+
+		// the scheduler only assigns queue items which are listed, so a paused workflow is never assigned
+		assert.Empty(t, listQueueItems(t, conf, tenantId))
+
+		// the queue item is kept until the workflow is resumed
+		var count int
+
+		err = conf.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM v1_queue_item WHERE task_id = $1`, task.ID).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		workflow, err = conf.V1.Workflows().SetWorkflowPaused(ctx, tenantId, pausableWorkflowName, false)
+		require.NoError(t, err)
+		assert.False(t, workflow.IsPaused.Bool)
+
+		qis := listQueueItems(t, conf, tenantId)
+		require.Len(t, qis, 1)
+		assert.Equal(t, task.ID, qis[0].TaskID)
+
+		queue := conf.V1.Scheduler().QueueFactory().NewQueue(sqlchelpers.UUIDFromStr(tenantId), pausableActionId)
+		defer queue.Cleanup()
+
+		succeeded, failed, err := queue.MarkQueueItemsProcessed(ctx, &v1.AssignResults{
+			Assigned: []*v1.AssignedItem{
+				{
+					WorkerId:  sqlchelpers.UUIDFromStr(uuid.New().String()),
+					QueueItem: qis[0],
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, succeeded, 1)
+		assert.Empty(t, failed)
+
+		return nil
+	})
+}
+
+func TestSetWorkflowPaused_NotFound(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Layer) error {
+		ctx := context.Background()
+		tenantId, _ := setupQueuedTask(t, conf)
+		otherTenantId, _ := setupQueuedTask(t, conf)
+
+		_, err := conf.V1.Workflows().SetWorkflowPaused(ctx, tenantId, "unknown-workflow", true)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// a workflow can't be paused through a tenant which doesn't own it
+		_, err = conf.V1.Workflows().SetWorkflowPaused(ctx, uuid.New().String(), pausableWorkflowName, true)
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		// pausing the workflow of one tenant does not pause a workflow with the same name in another tenant
+		_, err = conf.V1.Workflows().SetWorkflowPaused(ctx, tenantId, pausableWorkflowName, true)
+		require.NoError(t, err)
+
+		assert.Empty(t, listQueueItems(t, conf, tenantId))
+		assert.Len(t, listQueueItems(t, conf, otherTenantId), 1)
+
+		return nil
+	})
+}
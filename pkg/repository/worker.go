@@ -39,6 +39,9 @@ type CreateWorkerOpts struct {
 
 	// (optional) Runtime info for the worker
 	RuntimeInfo *RuntimeInfo `validate:"omitempty"`
+
+	// (optional) Named slot pools of the worker, mapped to the maximum number of runs of each pool
+	SlotPools map[string]int `validate:"dive,keys,hatchetName,endkeys,gte=1"`
 }
 
 type UpdateWorkerOpts struct {
@@ -51,6 +54,9 @@ type UpdateWorkerOpts struct {
 	// If the worker is active and accepting new runs
 	IsActive *bool
 
+	// If the worker is cordoned, in which case it is not assigned new runs
+	IsCordoned *bool
+
 	// A list of actions this worker can run
 	Actions []string `validate:"dive,actionId"`
 }
@@ -71,6 +77,8 @@ type UpsertWorkerLabelOpts struct {
 
 type ApiUpdateWorkerOpts struct {
 	IsPaused *bool
+
+	IsCordoned *bool
 }
 
 type WorkerAPIRepository interface {
@@ -28,6 +28,10 @@ type CreateWorkflowVersionOpts struct {
 	// (optional) event triggers for the workflow
 	EventTriggers []string
 
+	// (optional) CEL filters for event triggers, keyed by event key. Events which do not match the filter
+	// do not trigger the workflow.
+	EventTriggerFilters map[string]string `json:"eventTriggerFilters,omitempty"`
+
 	// (optional) cron triggers for the workflow
 	CronTriggers []string `validate:"dive,cron"`
 
@@ -79,10 +83,13 @@ type CreateWorkflowConcurrencyOpts struct {
 	MaxRuns *int32
 
 	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
-	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST"`
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST WEIGHTED_ROUND_ROBIN"`
 
 	// (optional) a concurrency expression for evaluating the concurrency key
 	Expression *string `validate:"omitempty,celworkflowrunstr"`
+
+	// (optional) for WEIGHTED_ROUND_ROBIN, a CEL expression for evaluating the weight of a concurrency key
+	WeightExpression *string `validate:"omitempty,celconcurrencyweight"`
 }
 
 func (o *CreateWorkflowVersionOpts) Checksum() (string, error) {
@@ -169,6 +176,69 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry backoff max seconds (can't be greater than 86400)
 	RetryBackoffMaxSeconds *int `validate:"omitnil,min=1,max=86400"`
+
+	// (optional) conditions which must be satisfied before the step is queued, or which skip or cancel the step
+	Conditions []CreateStepMatchConditionOpts `validate:"dive"`
+
+	// (optional) a CEL expression which skips the step when it evaluates to true
+	SkipIf *string `validate:"omitnil,celsteprunstr"`
+
+	// (optional) runs the step once for each item of a list
+	Map *CreateStepMapOpts `validate:"omitnil"`
+
+	// (optional) concurrency limits for this step
+	Concurrency []CreateStepConcurrencyOpts `validate:"dive"`
+
+	// (optional) the number of worker slots which a run of the step uses, default 1
+	Slots *int `validate:"omitnil,min=1"`
+
+	// (optional) the name of the worker slot pool which the step uses slots from. If not set, the step uses
+	// the default slots of the worker.
+	SlotPool *string `validate:"omitnil,hatchetName"`
+}
+
+type CreateStepConcurrencyOpts struct {
+	// (required) a CEL expression for evaluating the concurrency key
+	Expression string `validate:"required,celsteprunstr"`
+
+	// (optional) the maximum number of concurrent step runs per key, default 1
+	MaxRuns *int32 `validate:"omitnil,min=1"`
+
+	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS GROUP_ROUND_ROBIN CANCEL_NEWEST WEIGHTED_ROUND_ROBIN"`
+
+	// (optional) for WEIGHTED_ROUND_ROBIN, a CEL expression for evaluating the weight of a concurrency key
+	WeightExpression *string `validate:"omitempty,celconcurrencyweight"`
+}
+
+type CreateStepMapOpts struct {
+	// (required) a CEL expression which returns the list of items to run the step for
+	Expression string `validate:"required,celsteprunstr"`
+
+	// (optional) the maximum number of items which run at the same time. If not set, every item runs at once.
+	MaxParallelism *int `validate:"omitnil,min=1"`
+}
+
+type CreateStepMatchConditionOpts struct {
+	// (required) the group which the condition belongs to. Conditions in the same group are OR'd together, while
+	// groups with the same action are AND'd together.
+	OrGroupId string `validate:"required"`
+
+	// (required) the action to take on the step when the condition's groups are satisfied
+	Action string `validate:"required,oneof=QUEUE SKIP CANCEL"`
+
+	// (required) the key which the condition data is stored under in the step input
+	ReadableDataKey string `validate:"required"`
+
+	// (optional) the duration to wait for, measured from when the step's conditions are registered. Exactly one
+	// of SleepFor and EventKey must be set.
+	SleepFor *string `validate:"omitnil,duration"`
+
+	// (optional) the key of the user event to wait for
+	EventKey *string `validate:"omitnil,min=1"`
+
+	// (optional) a CEL expression which the user event payload must satisfy
+	Expression *string `validate:"omitnil,celevent"`
 }
 
 type DesiredWorkerLabelOpts struct {
@@ -209,6 +279,10 @@ type CreateWorkflowStepRateLimitOpts struct {
 
 	// (optional) the rate limit duration, defaults to MINUTE
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the key of the parent rate limit, only for dynamic rate limits. The parent of a static rate
+	// limit is set when the rate limit is created.
+	ParentKey *string `validate:"omitnil,excluded_without_all=KeyExpr UnitsExpr LimitExpr"`
 }
 
 type ListWorkflowsOpts struct {
@@ -227,6 +301,19 @@ type ListWorkflowsResult struct {
 	Count int
 }
 
+type ListWorkflowVersionsOpts struct {
+	// (optional) number of workflow versions to skip
+	Offset *int
+
+	// (optional) number of workflow versions to return
+	Limit *int
+}
+
+type ListWorkflowVersionsResult struct {
+	Rows  []*dbsqlc.GetWorkflowVersionForEngineRow
+	Count int
+}
+
 type JobRunHasCycleError struct {
 	JobName string
 }
@@ -345,4 +432,17 @@ type WorkflowEngineRepository interface {
 	// GetWorkflowVersionById returns a workflow version by its id. It will return db.ErrNotFound if the workflow
 	// version does not exist.
 	GetWorkflowVersionById(ctx context.Context, tenantId, workflowVersionId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error)
+
+	// ListWorkflows returns all workflows for a given tenant.
+	ListWorkflows(ctx context.Context, tenantId string, opts *ListWorkflowsOpts) (*ListWorkflowsResult, error)
+
+	// DeleteWorkflow soft-deletes a workflow and all of its versions for a given tenant.
+	DeleteWorkflow(ctx context.Context, tenantId, workflowId string) (*dbsqlc.Workflow, error)
+
+	// ListWorkflowVersions returns a page of versions of a workflow, ordered from newest to oldest.
+	ListWorkflowVersions(ctx context.Context, tenantId, workflowId string, opts *ListWorkflowVersionsOpts) (*ListWorkflowVersionsResult, error)
+
+	// ListWorkflowVersionOpts rebuilds the options which were used to create each of the given workflow
+	// versions, keyed by workflow version id.
+	ListWorkflowVersionOpts(ctx context.Context, tenantId string, workflowVersions []*dbsqlc.GetWorkflowVersionForEngineRow) (map[string]*CreateWorkflowVersionOpts, error)
 }
@@ -476,6 +476,9 @@ type WorkflowRunAPIRepository interface {
 
 	GetStepsForJobs(ctx context.Context, tenantId string, jobIds []string) ([]*dbsqlc.GetStepsForJobsRow, error)
 
+	// GetStepConcurrenciesForSteps returns the concurrency limits of the given steps, ordered by id.
+	GetStepConcurrenciesForSteps(ctx context.Context, stepIds []string) ([]*dbsqlc.StepConcurrency, error)
+
 	GetStepRunsForJobRuns(ctx context.Context, tenantId string, jobRunIds []string) ([]*StepRunForJobRun, error)
 
 	GetWorkflowRunShape(ctx context.Context, workflowVersionId uuid.UUID) ([]*dbsqlc.GetWorkflowRunShapeRow, error)
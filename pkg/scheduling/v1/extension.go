@@ -18,15 +18,22 @@ type SnapshotInput struct {
 	WorkerSlotUtilization map[string]*SlotUtilization
 }
 
+// SlotUtilization counts slots in weighted units, so a task of a step which uses 4 slots counts as 4
+// utilized slots.
 type SlotUtilization struct {
 	UtilizedSlots    int
 	NonUtilizedSlots int
+
+	// SlotPools is the utilization of each named slot pool of the worker, which is included in the totals
+	SlotPools map[string]*SlotUtilization
 }
 
 type WorkerCp struct {
 	WorkerId string
-	MaxRuns  int
-	Labels   []*sqlcv1.ListManyWorkerLabelsRow
+
+	// MaxRuns is the number of slots of the worker. A task uses as many slots as its step declares.
+	MaxRuns int
+	Labels  []*sqlcv1.ListManyWorkerLabelsRow
 }
 
 type SlotCp struct {
@@ -162,6 +162,14 @@ func (p *SchedulingPool) NotifyConcurrency(ctx context.Context, tenantId string,
 	}
 }
 
+// UseSlots marks slots in the slot pool of a worker as used, for slots which were filled outside of the
+// scheduler.
+func (p *SchedulingPool) UseSlots(ctx context.Context, tenantId, workerId, pool string, count int) {
+	if tm := p.getTenantManager(tenantId, false); tm != nil {
+		tm.useSlots(workerId, pool, count)
+	}
+}
+
 func (p *SchedulingPool) getTenantManager(tenantId string, storeIfNotFound bool) *tenantManager {
 	tm, ok := p.tenants.Load(tenantId)
 
@@ -179,7 +179,19 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		desiredLabelsTime := time.Since(checkpoint)
 		checkpoint = time.Now()
 
-		assignCh := q.s.tryAssign(ctx, qis, labels, rls)
+		stepSlots, err := q.repo.GetStepSlots(ctx, stepIds)
+
+		if err != nil {
+			q.l.Error().Err(err).Msg("error getting step slots")
+
+			q.unackedToUnassigned(qis)
+			continue
+		}
+
+		stepSlotsTime := time.Since(checkpoint)
+		checkpoint = time.Now()
+
+		assignCh := q.s.tryAssign(ctx, qis, labels, stepSlots, rls)
 		count := 0
 
 		countMu := sync.Mutex{}
@@ -220,6 +232,8 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 				"rate_limit_time", rateLimitTime,
 			).Dur(
 				"desired_labels_time", desiredLabelsTime,
+			).Dur(
+				"step_slots_time", stepSlotsTime,
 			).Dur(
 				"assign_time", assignTime,
 			).Msgf("queue %s took longer than 100ms (%s) to process %d items", q.queueName, elapsed, len(qis))
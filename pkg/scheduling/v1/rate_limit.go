@@ -14,6 +14,10 @@ import (
 type rateLimit struct {
 	key string
 	val int
+
+	// parentKey and state are only set for rate limits read from the database
+	parentKey string
+	state     *v1.RateLimitState
 }
 
 type rateLimitSet map[string]*rateLimit
@@ -38,6 +42,9 @@ type rateLimiter struct {
 	dbRateLimitsMu sync.RWMutex
 	dbRateLimits   rateLimitSet
 
+	// now returns the current time, used to refill the db rate limits in memory between flushes
+	now func() time.Time
+
 	cleanup func()
 }
 
@@ -49,6 +56,7 @@ func newRateLimiter(conf *sharedConfig, tenantId pgtype.UUID) *rateLimiter {
 		unacked:       make(map[int64]rateLimitSet),
 		unflushed:     make(rateLimitSet),
 		dbRateLimits:  make(rateLimitSet),
+		now:           func() time.Time { return time.Now().UTC() },
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -96,9 +104,13 @@ type rateLimitResult struct {
 func (r *rateLimiter) use(ctx context.Context, taskId int64, rls map[string]int32) (res rateLimitResult) {
 	res.taskId = taskId
 
+	// consuming units of a rate limit also consumes units of its parents, so we check and consume
+	// the parents together with the rate limits of the task
+	candidateRls := r.withParentRateLimits(rls)
+
 	// start with the db rate limits as the source of truth
 	// if we don't have all rate limits in memory, check the database to determine if it exists
-	if !r.rateLimitsExist(rls) {
+	if !r.rateLimitsExist(candidateRls) {
 		err := r.flushToDatabase(ctx)
 
 		if err != nil {
@@ -106,7 +118,9 @@ func (r *rateLimiter) use(ctx context.Context, taskId int64, rls map[string]int3
 			return res
 		}
 
-		if !r.rateLimitsExist(rls) {
+		candidateRls = r.withParentRateLimits(rls)
+
+		if !r.rateLimitsExist(candidateRls) {
 			return res
 		}
 	} else if r.shouldRefill() {
@@ -121,11 +135,11 @@ func (r *rateLimiter) use(ctx context.Context, taskId int64, rls map[string]int3
 	currRls := r.copyDbRateLimits()
 
 	// we need to subtract any relevant unacked and unflushed rate limits for updates
-	r.subtractUnacked(rls, currRls)
-	r.subtractUnflushed(rls, currRls)
+	r.subtractUnacked(candidateRls, currRls)
+	r.subtractUnflushed(candidateRls, currRls)
 
 	// determine if we can use all the rate limits in the set
-	for k, v := range rls {
+	for k, v := range candidateRls {
 		if currRl, ok := currRls[k]; ok {
 			if currRl.val < int(v) {
 				res.exceededKey = k
@@ -138,7 +152,7 @@ func (r *rateLimiter) use(ctx context.Context, taskId int64, rls map[string]int3
 	}
 
 	// if we can use all the rate limits, add them to the unacked set
-	r.addToUnacked(taskId, rls)
+	r.addToUnacked(taskId, candidateRls)
 
 	return rateLimitResult{
 		succeeded: true,
@@ -164,6 +178,31 @@ func (r *rateLimiter) rateLimitsExist(rls map[string]int32) bool {
 	return true
 }
 
+// withParentRateLimits returns the units which are consumed from each rate limit, including the units
+// which are consumed from the parents of the rate limits in the set.
+func (r *rateLimiter) withParentRateLimits(rls map[string]int32) map[string]int32 {
+	r.dbRateLimitsMu.RLock()
+	defer r.dbRateLimitsMu.RUnlock()
+
+	res := make(map[string]int32, len(rls))
+
+	for k, units := range rls {
+		res[k] += units
+
+		// walk up the parents of the rate limit, stopping if there is a cycle
+		visited := map[string]bool{k: true}
+		curr := r.dbRateLimits[k]
+
+		for curr != nil && curr.parentKey != "" && !visited[curr.parentKey] {
+			visited[curr.parentKey] = true
+			res[curr.parentKey] += units
+			curr = r.dbRateLimits[curr.parentKey]
+		}
+	}
+
+	return res
+}
+
 func (r *rateLimiter) shouldRefill() bool {
 	r.nextRefillAtMu.Lock()
 	defer r.nextRefillAtMu.Unlock()
@@ -175,16 +214,34 @@ func (r *rateLimiter) shouldRefill() bool {
 	return r.nextRefillAt.After(time.Now().UTC())
 }
 
+func (r *rateLimiter) currentTime() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+
+	return time.Now().UTC()
+}
+
 func (r *rateLimiter) copyDbRateLimits() rateLimitSet {
 	r.dbRateLimitsMu.RLock()
 	defer r.dbRateLimitsMu.RUnlock()
 
 	rls := make(rateLimitSet)
+	now := r.currentTime()
 
 	for k, v := range r.dbRateLimits {
+		val := v.val
+
+		// token bucket and sliding window rate limits refill continuously, so we compute the available
+		// units from the state read from the database
+		if v.state != nil {
+			val = v.state.Available(now)
+		}
+
 		rls[k] = &rateLimit{
-			key: k,
-			val: v.val,
+			key:       k,
+			val:       val,
+			parentKey: v.parentKey,
 		}
 	}
 
@@ -299,9 +356,13 @@ func (r *rateLimiter) flushToDatabase(ctx context.Context) error {
 
 	// update the db rate limits
 	for key, newVal := range newRateLimits {
+		state := newVal
+
 		r.dbRateLimits[key] = &rateLimit{
-			key: key,
-			val: newVal,
+			key:       key,
+			val:       newVal.Value,
+			parentKey: newVal.ParentKey,
+			state:     &state,
 		}
 	}
 
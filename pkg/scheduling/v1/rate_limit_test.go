@@ -12,6 +12,9 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	v1 "github.com/hatchet-dev/hatchet/pkg/repository/v1"
+	"github.com/hatchet-dev/hatchet/pkg/repository/v1/sqlcv1"
 )
 
 type mockRateLimitRepo struct {
@@ -23,17 +26,27 @@ func (m *mockRateLimitRepo) ListCandidateRateLimits(ctx context.Context, tenantI
 	return args.Get(0).([]string), args.Error(1)
 }
 
-func (m *mockRateLimitRepo) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, *time.Time, error) {
+func (m *mockRateLimitRepo) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]v1.RateLimitState, *time.Time, error) {
 	args := m.Called(ctx, tenantId, updates)
 	arg1 := args.Get(1).(time.Time)
-	return args.Get(0).(map[string]int), &arg1, args.Error(2)
+	return args.Get(0).(map[string]v1.RateLimitState), &arg1, args.Error(2)
+}
+
+func (m *mockRateLimitRepo) DeleteRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error) {
+	args := m.Called(ctx, tenantId, key)
+	return args.Get(0).(*sqlcv1.RateLimit), args.Error(1)
+}
+
+func (m *mockRateLimitRepo) ResetRateLimit(ctx context.Context, tenantId pgtype.UUID, key string) (*sqlcv1.RateLimit, error) {
+	args := m.Called(ctx, tenantId, key)
+	return args.Get(0).(*sqlcv1.RateLimit), args.Error(1)
 }
 
 func TestRateLimiter_Use(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5, "key3": 7}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 10}, "key2": {Value: 5}, "key3": {Value: 7}}, time.Now().Add(2*time.Second), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -64,7 +77,7 @@ func TestRateLimiter_Ack(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 10}, "key2": {Value: 5}}, time.Now().Add(2*time.Second), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -89,7 +102,7 @@ func TestRateLimiter_Nack(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 10}, "key2": {Value: 5}}, time.Now().Add(2*time.Second), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -114,7 +127,7 @@ func TestRateLimiter_Concurrency(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 100, "key2": 100}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 100}, "key2": {Value: 100}}, time.Now().Add(2*time.Second), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -153,7 +166,7 @@ func TestRateLimiter_FlushToDatabase(t *testing.T) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{} // Mock implementation of rateLimitRepo
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 10, "key2": 5}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 10}, "key2": {Value: 5}}, time.Now().Add(2*time.Second), nil)
 
 	rateLimiter := &rateLimiter{
 		dbRateLimits: rateLimitSet{
@@ -182,11 +195,187 @@ func TestRateLimiter_FlushToDatabase(t *testing.T) {
 	assert.Empty(t, rateLimiter.unflushed)
 }
 
+func TestRateLimiter_ParentRateLimits(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{
+		"global":   {Value: 10},
+		"tenant-a": {Value: 8, ParentKey: "global"},
+		"tenant-b": {Value: 8, ParentKey: "global"},
+	}, time.Now().Add(2*time.Second), nil)
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"global":   {key: "global", val: 10},
+			"tenant-a": {key: "tenant-a", val: 8, parentKey: "global"},
+			"tenant-b": {key: "tenant-b", val: 8, parentKey: "global"},
+		},
+		unacked:       make(map[int64]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	// consuming a child also consumes the parent
+	res := rateLimiter.use(context.Background(), 1, map[string]int32{"tenant-a": 6})
+	assert.True(t, res.succeeded)
+	assert.Equal(t, 6, rateLimiter.unacked[1]["tenant-a"].val)
+	assert.Equal(t, 6, rateLimiter.unacked[1]["global"].val)
+
+	// the child has enough units left, but the parent does not, so nothing is consumed
+	res = rateLimiter.use(context.Background(), 2, map[string]int32{"tenant-b": 6})
+	assert.False(t, res.succeeded)
+	assert.Equal(t, "global", res.exceededKey)
+	assert.NotContains(t, rateLimiter.unacked, int64(2))
+
+	// the child is limited by its own value before the parent
+	res = rateLimiter.use(context.Background(), 3, map[string]int32{"tenant-a": 3})
+	assert.False(t, res.succeeded)
+	assert.Equal(t, "tenant-a", res.exceededKey)
+
+	res = rateLimiter.use(context.Background(), 4, map[string]int32{"tenant-b": 4})
+	assert.True(t, res.succeeded)
+
+	rateLimiter.ack(1)
+	rateLimiter.ack(4)
+
+	assert.Equal(t, 6, rateLimiter.unflushed["tenant-a"].val)
+	assert.Equal(t, 4, rateLimiter.unflushed["tenant-b"].val)
+	assert.Equal(t, 10, rateLimiter.unflushed["global"].val)
+
+	// flushing keeps the parents of the rate limits
+	err := rateLimiter.flushToDatabase(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "global", rateLimiter.dbRateLimits["tenant-a"].parentKey)
+	assert.Equal(t, "", rateLimiter.dbRateLimits["global"].parentKey)
+}
+
+func TestRateLimiter_MissingParentRateLimit(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{
+		"tenant-a": {Value: 8, ParentKey: "global"},
+	}, time.Now().Add(2*time.Second), nil)
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"tenant-a": {key: "tenant-a", val: 8, parentKey: "global"},
+		},
+		unacked:       make(map[int64]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	res := rateLimiter.use(context.Background(), 1, map[string]int32{"tenant-a": 1})
+	assert.False(t, res.succeeded)
+	assert.Empty(t, rateLimiter.unacked)
+}
+
+func TestRateLimiter_ParentRateLimitCycle(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"key1": {key: "key1", val: 10, parentKey: "key2"},
+			"key2": {key: "key2", val: 10, parentKey: "key1"},
+		},
+		unacked:       make(map[int64]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	res := rateLimiter.use(context.Background(), 1, map[string]int32{"key1": 3})
+	assert.True(t, res.succeeded)
+	assert.Equal(t, 3, rateLimiter.unacked[1]["key1"].val)
+	assert.Equal(t, 3, rateLimiter.unacked[1]["key2"].val)
+}
+
+func TestRateLimiter_RefillsInMemory(t *testing.T) {
+	l := zerolog.Nop()
+
+	t0 := time.Date(2025, 4, 8, 12, 0, 0, 0, time.UTC)
+	now := t0
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			// 1 token per second, up to 5 tokens
+			"bucket": {key: "bucket", state: &v1.RateLimitState{
+				Algorithm:  sqlcv1.RateLimitAlgorithmTOKENBUCKET,
+				LimitValue: 10,
+				Burst:      5,
+				Window:     10 * time.Second,
+				Value:      3,
+				LastRefill: t0,
+			}},
+			// the whole limit was consumed in the previous window
+			"sliding": {key: "sliding", state: &v1.RateLimitState{
+				Algorithm:    sqlcv1.RateLimitAlgorithmSLIDINGWINDOW,
+				LimitValue:   10,
+				Window:       10 * time.Second,
+				Value:        10,
+				PreviousUsed: 10,
+				LastRefill:   t0,
+			}},
+		},
+		unacked:       make(map[int64]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: &mockRateLimitRepo{},
+		now: func() time.Time {
+			return now
+		},
+	}
+
+	res := rateLimiter.use(context.Background(), 1, map[string]int32{"bucket": 3})
+	assert.True(t, res.succeeded)
+	res.ack()
+
+	res = rateLimiter.use(context.Background(), 2, map[string]int32{"bucket": 1})
+	assert.False(t, res.succeeded)
+	assert.Equal(t, int32(0), res.exceededVal)
+
+	res = rateLimiter.use(context.Background(), 3, map[string]int32{"sliding": 1})
+	assert.False(t, res.succeeded)
+
+	now = t0.Add(time.Second)
+
+	res = rateLimiter.use(context.Background(), 4, map[string]int32{"bucket": 1})
+	assert.True(t, res.succeeded)
+	res.ack()
+
+	res = rateLimiter.use(context.Background(), 5, map[string]int32{"bucket": 1})
+	assert.False(t, res.succeeded)
+
+	res = rateLimiter.use(context.Background(), 6, map[string]int32{"sliding": 1})
+	assert.True(t, res.succeeded)
+	res.ack()
+
+	res = rateLimiter.use(context.Background(), 7, map[string]int32{"sliding": 1})
+	assert.False(t, res.succeeded)
+
+	// the bucket never holds more than the burst size
+	now = t0.Add(time.Minute)
+
+	res = rateLimiter.use(context.Background(), 8, map[string]int32{"bucket": 2})
+	assert.False(t, res.succeeded)
+	assert.Equal(t, int32(1), res.exceededVal)
+
+	// the previous window no longer overlaps with the sliding window
+	res = rateLimiter.use(context.Background(), 9, map[string]int32{"sliding": 9})
+	assert.True(t, res.succeeded)
+}
+
 func BenchmarkRateLimiter(b *testing.B) {
 	l := zerolog.Nop()
 
 	mockRateLimitRepo := &mockRateLimitRepo{}
-	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]int{"key1": 1000, "key2": 1000}, time.Now().Add(2*time.Second), nil)
+	mockRateLimitRepo.On("UpdateRateLimits", context.Background(), mock.Anything, mock.Anything).Return(map[string]v1.RateLimitState{"key1": {Value: 1000}, "key2": {Value: 1000}}, time.Now().Add(2*time.Second), nil)
 
 	r := rateLimiter{
 		unacked:       make(map[int64]rateLimitSet),
@@ -36,8 +36,9 @@ type Scheduler struct {
 	assignedCountMu mutex
 
 	// unackedSlots are slots which have been assigned to a worker, but have not been flushed
-	// to the database yet. They negatively count towards a worker's available slot count.
-	unackedSlots map[int]*slot
+	// to the database yet. They negatively count towards a worker's available slot count. A
+	// single assignment holds multiple slots if its step uses more than one slot.
+	unackedSlots map[int][]*slot
 	unackedMu    mutex
 
 	rl   *rateLimiter
@@ -52,7 +53,7 @@ func newScheduler(cf *sharedConfig, tenantId pgtype.UUID, rl *rateLimiter, exts
 		tenantId:        tenantId,
 		l:               &l,
 		actions:         make(map[string]*action),
-		unackedSlots:    make(map[int]*slot),
+		unackedSlots:    make(map[int][]*slot),
 		rl:              rl,
 		actionsMu:       newRWMu(cf.l),
 		replenishMu:     newMu(cf.l),
@@ -68,8 +69,11 @@ func (s *Scheduler) ack(ids []int) {
 	defer s.unackedMu.Unlock()
 
 	for _, id := range ids {
-		if slot, ok := s.unackedSlots[id]; ok {
-			slot.ack()
+		if slots, ok := s.unackedSlots[id]; ok {
+			for _, slot := range slots {
+				slot.ack()
+			}
+
 			delete(s.unackedSlots, id)
 		}
 	}
@@ -80,8 +84,11 @@ func (s *Scheduler) nack(ids []int) {
 	defer s.unackedMu.Unlock()
 
 	for _, id := range ids {
-		if slot, ok := s.unackedSlots[id]; ok {
-			slot.nack()
+		if slots, ok := s.unackedSlots[id]; ok {
+			for _, slot := range slots {
+				slot.nack()
+			}
+
 			delete(s.unackedSlots, id)
 		}
 	}
@@ -109,6 +116,50 @@ func (s *Scheduler) getWorkers() map[string]*worker {
 	return s.workers
 }
 
+// useSlots marks count active slots in the slot pool of the worker as used, for slots which were filled
+// outside of the scheduler, i.e. when a durable task is resumed on the worker. The slots are loaded from the
+// database again on the next replenish.
+func (s *Scheduler) useSlots(workerId, pool string, count int) {
+	s.actionsMu.RLock()
+
+	actions := make([]*action, 0, len(s.actions))
+
+	for _, action := range s.actions {
+		actions = append(actions, action)
+	}
+
+	s.actionsMu.RUnlock()
+
+	// slots are shared across the actions of a worker, so each slot is only checked once
+	seen := make(map[*slot]bool)
+
+	for _, action := range actions {
+		if count <= 0 {
+			return
+		}
+
+		action.mu.Lock()
+
+		for _, slot := range action.slots {
+			if count <= 0 {
+				break
+			}
+
+			if seen[slot] || slot.getWorkerId() != workerId || slot.pool != pool {
+				continue
+			}
+
+			seen[slot] = true
+
+			if slot.active() && slot.use(nil, nil) {
+				count--
+			}
+		}
+
+		action.mu.Unlock()
+	}
+}
+
 // replenish loads new slots from the database.
 func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	if ok := s.replenishMu.TryLock(); !ok {
@@ -274,33 +325,35 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	s.l.Debug().Msgf("loading available slots took %s", time.Since(checkpoint))
 
 	// FUNCTION 3: list unacked slots (so they're not counted towards the worker slot count)
-	workersToUnackedSlots := make(map[string][]*slot)
+	workerPoolsToUnackedSlots := make(map[workerSlotPool][]*slot)
 
-	for _, unackedSlot := range s.unackedSlots {
-		s := unackedSlot
-		workerId := s.getWorkerId()
+	for _, unackedSlots := range s.unackedSlots {
+		for _, s := range unackedSlots {
+			key := workerSlotPool{workerId: s.getWorkerId(), pool: s.pool}
 
-		if _, ok := workersToUnackedSlots[workerId]; !ok {
-			workersToUnackedSlots[workerId] = make([]*slot, 0)
-		}
+			if _, ok := workerPoolsToUnackedSlots[key]; !ok {
+				workerPoolsToUnackedSlots[key] = make([]*slot, 0)
+			}
 
-		workersToUnackedSlots[workerId] = append(workersToUnackedSlots[workerId], s)
+			workerPoolsToUnackedSlots[key] = append(workerPoolsToUnackedSlots[key], s)
+		}
 	}
 
 	// FUNCTION 4: write the new slots to the scheduler and clean up expired slots
 	actionsToNewSlots := make(map[string][]*slot)
 	actionsToTotalSlots := make(map[string]int)
 
+	// there is a row for each slot pool of a worker
 	for _, worker := range availableSlots {
 		workerId := sqlchelpers.UUIDToStr(worker.ID)
 		actions := workerIdsToActions[workerId]
-		unackedSlots := workersToUnackedSlots[workerId]
+		unackedSlots := workerPoolsToUnackedSlots[workerSlotPool{workerId: workerId, pool: worker.SlotPool}]
 
 		// create a slot for each available slot
 		slots := make([]*slot, 0)
 
 		for i := 0; i < int(worker.AvailableSlots)-len(unackedSlots); i++ {
-			slots = append(slots, newSlot(workers[workerId], actions))
+			slots = append(slots, newPoolSlot(workers[workerId], actions, worker.SlotPool))
 		}
 
 		// extend expiry of all unacked slots
@@ -308,7 +361,7 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 			unackedSlot.extendExpiry()
 		}
 
-		s.l.Debug().Msgf("worker %s has %d total slots, %d unacked slots", workerId, worker.AvailableSlots, len(unackedSlots))
+		s.l.Debug().Msgf("worker %s has %d total slots, %d unacked slots in slot pool %q", workerId, worker.AvailableSlots, len(unackedSlots), worker.SlotPool)
 
 		slots = append(slots, unackedSlots...)
 
@@ -369,6 +422,11 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	return nil
 }
 
+type workerSlotPool struct {
+	workerId string
+	pool     string
+}
+
 func (s *Scheduler) loopReplenish(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
@@ -445,6 +503,7 @@ func (s *Scheduler) tryAssignBatch(
 	// slots concurrently.
 	ringOffset int,
 	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
+	stepIdsToSlots map[string]v1.StepSlots,
 	taskIdsToRateLimits map[int64]map[string]int32,
 ) (
 	res []*assignSingleResult, newRingOffset int, err error,
@@ -550,13 +609,15 @@ func (s *Scheduler) tryAssignBatch(
 		childRingOffset := newRingOffset % denom
 
 		qi := qis[i]
+		stepId := sqlchelpers.UUIDToStr(qi.StepID)
 
 		singleRes, err := s.tryAssignSingleton(
 			ctx,
 			qi,
 			candidateSlots,
 			childRingOffset,
-			stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepID)],
+			stepIdsToLabels[stepId],
+			stepIdsToSlots[stepId],
 			rlAcks[i],
 			rlNacks[i],
 		)
@@ -580,13 +641,14 @@ func (s *Scheduler) tryAssignBatch(
 
 func findSlot(
 	candidateSlots []*slot,
+	pool string,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) *slot {
 	var assignedSlot *slot
 
 	for _, slot := range candidateSlots {
-		if !slot.active() {
+		if slot.pool != pool || !slot.active() {
 			continue
 		}
 
@@ -601,6 +663,69 @@ func findSlot(
 	return assignedSlot
 }
 
+// findSlots finds numSlots active slots in the slot pool on the same worker and uses all of them, or returns
+// nil if no worker has enough active slots. The rate limit acks and nacks are only attached to the first slot,
+// so they are called once per assignment.
+func findSlots(
+	candidateSlots []*slot,
+	numSlots int,
+	pool string,
+	rateLimitAck func(),
+	rateLimitNack func(),
+) []*slot {
+	if numSlots <= 1 {
+		if assignedSlot := findSlot(candidateSlots, pool, rateLimitAck, rateLimitNack); assignedSlot != nil {
+			return []*slot{assignedSlot}
+		}
+
+		return nil
+	}
+
+	workerIdsToSlots := make(map[string][]*slot)
+
+	for _, candidate := range candidateSlots {
+		if candidate.pool != pool || !candidate.active() {
+			continue
+		}
+
+		workerId := candidate.getWorkerId()
+		workerIdsToSlots[workerId] = append(workerIdsToSlots[workerId], candidate)
+
+		if len(workerIdsToSlots[workerId]) < numSlots {
+			continue
+		}
+
+		used := make([]*slot, 0, numSlots)
+
+		for _, workerSlot := range workerIdsToSlots[workerId] {
+			var acks, nacks []func()
+
+			if len(used) == 0 {
+				acks = []func(){rateLimitAck}
+				nacks = []func(){rateLimitNack}
+			}
+
+			if workerSlot.use(acks, nacks) {
+				used = append(used, workerSlot)
+			}
+		}
+
+		if len(used) == numSlots {
+			return used
+		}
+
+		// another assignment used some of the slots in the meantime, so we release the slots we used and keep
+		// looking for slots on this worker
+		for _, usedSlot := range used {
+			usedSlot.release()
+		}
+
+		workerIdsToSlots[workerId] = used
+	}
+
+	return nil
+}
+
 // tryAssignSingleton attempts to assign a singleton step to a worker.
 func (s *Scheduler) tryAssignSingleton(
 	ctx context.Context,
@@ -608,6 +733,8 @@ func (s *Scheduler) tryAssignSingleton(
 	candidateSlots []*slot,
 	ringOffset int,
 	labels []*sqlcv1.GetDesiredLabelsRow,
+	// stepSlots is the number of slots which the step uses on a single worker, and their slot pool
+	stepSlots v1.StepSlots,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) (
@@ -621,13 +748,27 @@ func (s *Scheduler) tryAssignSingleton(
 		candidateSlots = getRankedSlots(qi, labels, candidateSlots)
 	}
 
-	assignedSlot := findSlot(candidateSlots[ringOffset:], rateLimitAck, rateLimitNack)
+	var assignedSlots []*slot
+
+	numSlots := stepSlots.Slots
+	pool := stepSlots.SlotPool
+
+	if numSlots <= 1 {
+		assignedSlots = findSlots(candidateSlots[ringOffset:], 1, pool, rateLimitAck, rateLimitNack)
+
+		if assignedSlots == nil {
+			assignedSlots = findSlots(candidateSlots[:ringOffset], 1, pool, rateLimitAck, rateLimitNack)
+		}
+	} else {
+		// the slots of a worker may be on both sides of the ring offset, so we search the whole ring at once
+		ring := make([]*slot, 0, len(candidateSlots))
+		ring = append(ring, candidateSlots[ringOffset:]...)
+		ring = append(ring, candidateSlots[:ringOffset]...)
 
-	if assignedSlot == nil {
-		assignedSlot = findSlot(candidateSlots[:ringOffset], rateLimitAck, rateLimitNack)
+		assignedSlots = findSlots(ring, numSlots, pool, rateLimitAck, rateLimitNack)
 	}
 
-	if assignedSlot == nil {
+	if assignedSlots == nil {
 		res.noSlots = true
 		return res, nil
 	}
@@ -638,10 +779,10 @@ func (s *Scheduler) tryAssignSingleton(
 	s.assignedCountMu.Unlock()
 
 	s.unackedMu.Lock()
-	s.unackedSlots[res.ackId] = assignedSlot
+	s.unackedSlots[res.ackId] = assignedSlots
 	s.unackedMu.Unlock()
 
-	res.workerId = sqlchelpers.UUIDFromStr(assignedSlot.getWorkerId())
+	res.workerId = sqlchelpers.UUIDFromStr(assignedSlots[0].getWorkerId())
 	res.succeeded = true
 
 	return res, nil
@@ -665,6 +806,7 @@ func (s *Scheduler) tryAssign(
 	ctx context.Context,
 	qis []*sqlcv1.V1QueueItem,
 	stepIdsToLabels map[string][]*sqlcv1.GetDesiredLabelsRow,
+	stepIdsToSlots map[string]v1.StepSlots,
 	taskIdsToRateLimits map[int64]map[string]int32,
 ) <-chan *assignResults {
 	ctx, span := telemetry.NewSpan(ctx, "try-assign")
@@ -727,7 +869,7 @@ func (s *Scheduler) tryAssign(
 
 					batchStart := time.Now()
 
-					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, taskIdsToRateLimits)
+					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepIdsToSlots, taskIdsToRateLimits)
 
 					if err != nil {
 						return err
@@ -877,10 +1019,30 @@ func (s *Scheduler) getSnapshotInput(mustSnapshot bool) (*SnapshotInput, bool) {
 
 			uniqueSlots[slot] = true
 
+			utilization := workerSlotUtilization[workerId]
+
+			if slot.pool != "" {
+				if utilization.SlotPools == nil {
+					utilization.SlotPools = make(map[string]*SlotUtilization)
+				}
+
+				if _, ok := utilization.SlotPools[slot.pool]; !ok {
+					utilization.SlotPools[slot.pool] = &SlotUtilization{}
+				}
+			}
+
 			if slot.used {
-				workerSlotUtilization[workerId].UtilizedSlots++
+				utilization.UtilizedSlots++
+
+				if slot.pool != "" {
+					utilization.SlotPools[slot.pool].UtilizedSlots++
+				}
 			} else {
-				workerSlotUtilization[workerId].NonUtilizedSlots++
+				utilization.NonUtilizedSlots++
+
+				if slot.pool != "" {
+					utilization.SlotPools[slot.pool].NonUtilizedSlots++
+				}
 			}
 		}
 		action.mu.RUnlock()
@@ -17,6 +17,10 @@ type slot struct {
 	worker  *worker
 	actions []string
 
+	// pool is the name of the worker slot pool which the slot belongs to, or an empty string for the default
+	// slot pool of the worker
+	pool string
+
 	// expiresAt is when the slot is no longer valid, but has not been cleaned up yet
 	expiresAt *time.Time
 	used      bool
@@ -30,11 +34,16 @@ type slot struct {
 }
 
 func newSlot(worker *worker, actions []string) *slot {
+	return newPoolSlot(worker, actions, "")
+}
+
+func newPoolSlot(worker *worker, actions []string, pool string) *slot {
 	expires := time.Now().Add(defaultSlotExpiry)
 
 	return &slot{
 		worker:    worker,
 		actions:   actions,
+		pool:      pool,
 		expiresAt: &expires,
 	}
 }
@@ -81,6 +90,17 @@ func (s *slot) use(additionalAcks []func(), additionalNacks []func()) bool {
 	return true
 }
 
+// release marks a slot which was used as part of a multi-slot assignment as unused again, without calling
+// the additional nacks, because the assignment did not go through.
+func (s *slot) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.used = false
+	s.additionalAcks = nil
+	s.additionalNacks = nil
+}
+
 func (s *slot) ack() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
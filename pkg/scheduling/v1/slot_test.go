@@ -1,10 +1,12 @@
 package v2
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/postgres/sqlchelpers"
@@ -108,3 +110,165 @@ func TestGetRankedSlots(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSlots_MultipleSlots(t *testing.T) {
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	slots := []*slot{
+		newSlot(worker1, []string{}),
+		newSlot(worker2, []string{}),
+		newSlot(worker1, []string{}),
+		newSlot(worker2, []string{}),
+		newSlot(worker2, []string{}),
+	}
+
+	acks := 0
+
+	assigned := findSlots(slots, 3, "", func() { acks++ }, func() {})
+
+	if assert.Len(t, assigned, 3) {
+		for _, s := range assigned {
+			assert.Equal(t, stableWorkerId2, s.getWorkerId())
+			assert.False(t, s.active())
+		}
+	}
+
+	// the slots of the other worker are not used
+	assert.True(t, slots[0].active())
+	assert.True(t, slots[2].active())
+
+	// the rate limit ack is only called once for the assignment
+	for _, s := range assigned {
+		s.ack()
+	}
+
+	assert.Equal(t, 1, acks)
+}
+
+func TestFindSlots_NotEnoughSlots(t *testing.T) {
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	slots := []*slot{
+		newSlot(worker1, []string{}),
+		newSlot(worker2, []string{}),
+		newSlot(worker1, []string{}),
+		newSlot(worker2, []string{}),
+	}
+
+	// a used slot does not count towards the free slots of a worker
+	assert.True(t, slots[3].use(nil, nil))
+
+	assert.Nil(t, findSlots(slots, 3, "", func() {}, func() {}))
+	assert.Len(t, findSlots(slots, 2, "", func() {}, func() {}), 2)
+
+	for _, s := range slots[:3] {
+		assert.Equal(t, s.getWorkerId() == stableWorkerId2, s.active())
+	}
+}
+
+func TestScheduler_AckMultipleSlots(t *testing.T) {
+	l := zerolog.Nop()
+
+	s := &Scheduler{
+		l:               &l,
+		unackedSlots:    make(map[int][]*slot),
+		assignedCountMu: newMu(&l),
+		unackedMu:       newMu(&l),
+	}
+
+	w := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+
+	slots := []*slot{
+		newSlot(w, []string{}),
+		newSlot(w, []string{}),
+		newSlot(w, []string{}),
+	}
+
+	nacks := 0
+
+	res, err := s.tryAssignSingleton(context.Background(), &sqlcv1.V1QueueItem{}, slots, 1, nil, v1.StepSlots{Slots: 2}, func() {}, func() { nacks++ })
+
+	assert.NoError(t, err)
+	assert.True(t, res.succeeded)
+	assert.Equal(t, stableWorkerId1, sqlchelpers.UUIDToStr(res.workerId))
+	assert.Len(t, s.unackedSlots[res.ackId], 2)
+
+	// a step which uses more slots than the worker has left is not assigned
+	res2, err := s.tryAssignSingleton(context.Background(), &sqlcv1.V1QueueItem{}, slots, 0, nil, v1.StepSlots{Slots: 2}, func() {}, func() {})
+
+	assert.NoError(t, err)
+	assert.True(t, res2.noSlots)
+
+	// nacking the assignment frees all of its slots
+	s.nack([]int{res.ackId})
+
+	assert.Equal(t, 1, nacks)
+	assert.Empty(t, s.unackedSlots)
+
+	for _, slot := range slots {
+		assert.True(t, slot.active())
+	}
+}
+
+func TestFindSlots_SlotPools(t *testing.T) {
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	slots := []*slot{
+		newSlot(worker1, []string{}),
+		newPoolSlot(worker1, []string{}, "io"),
+		newPoolSlot(worker2, []string{}, "cpu"),
+		newPoolSlot(worker2, []string{}, "cpu"),
+	}
+
+	// steps without a slot pool only use the default slots
+	if assigned := findSlots(slots, 1, "", func() {}, func() {}); assert.Len(t, assigned, 1) {
+		assert.Equal(t, slots[0], assigned[0])
+	}
+
+	assert.Nil(t, findSlots(slots, 1, "", func() {}, func() {}))
+
+	if assigned := findSlots(slots, 2, "cpu", func() {}, func() {}); assert.Len(t, assigned, 2) {
+		for _, s := range assigned {
+			assert.Equal(t, "cpu", s.pool)
+		}
+	}
+
+	assert.Nil(t, findSlots(slots, 1, "gpu", func() {}, func() {}))
+	assert.True(t, slots[1].active())
+}
+
+func TestScheduler_UseSlots(t *testing.T) {
+	l := zerolog.Nop()
+
+	worker1 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId1}}
+	worker2 := &worker{ListActiveWorkersResult: &v1.ListActiveWorkersResult{ID: stableWorkerId2}}
+
+	shared := newSlot(worker1, []string{"a", "b"})
+
+	slots := []*slot{
+		shared,
+		newSlot(worker1, []string{"a"}),
+		newPoolSlot(worker1, []string{"a"}, "io"),
+		newSlot(worker2, []string{"a"}),
+	}
+
+	s := &Scheduler{
+		l:         &l,
+		actionsMu: newRWMu(&l),
+		actions: map[string]*action{
+			"a": {actionId: "a", slots: slots},
+			"b": {actionId: "b", slots: []*slot{shared}},
+		},
+	}
+
+	// slots which are shared across actions are only used once
+	s.useSlots(stableWorkerId1, "", 3)
+
+	assert.False(t, slots[0].active())
+	assert.False(t, slots[1].active())
+	assert.True(t, slots[2].active(), "slots of other slot pools are not used")
+	assert.True(t, slots[3].active(), "slots of other workers are not used")
+}
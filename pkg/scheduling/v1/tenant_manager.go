@@ -233,6 +233,10 @@ func (t *tenantManager) replenish(ctx context.Context) {
 	}
 }
 
+func (t *tenantManager) useSlots(workerId, pool string, count int) {
+	t.scheduler.useSlots(workerId, pool, count)
+}
+
 func (t *tenantManager) notifyConcurrency(ctx context.Context, strategyIds []int64) {
 	strategyIdsMap := make(map[int64]struct{}, len(strategyIds))
 
@@ -77,6 +77,18 @@ func newValidator() *validator.Validate {
 		return err == nil
 	})
 
+	_ = validate.RegisterValidation("celevent", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseEvent(fl.Field().String())
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("celconcurrencyweight", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseConcurrencyWeight(fl.Field().String())
+
+		return err == nil
+	})
+
 	_ = validate.RegisterValidation("future", func(fl validator.FieldLevel) bool {
 		if t, ok := fl.Field().Interface().(time.Time); ok {
 			return t.After(time.Now())
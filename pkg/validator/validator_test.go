@@ -77,3 +77,27 @@ func TestValidatorInvalidDuration(t *testing.T) {
 
 	assert.ErrorContains(t, err, "validation for 'Duration' failed on the 'duration' tag", "should throw error on invalid duration")
 }
+
+func TestValidatorValidCELEvent(t *testing.T) {
+	v := newValidator()
+
+	err := v.Struct(&struct {
+		Expression string `validate:"celevent"`
+	}{
+		Expression: "input.approved == true",
+	})
+
+	assert.NoError(t, err, "no error")
+}
+
+func TestValidatorInvalidCELEvent(t *testing.T) {
+	v := newValidator()
+
+	err := v.Struct(&struct {
+		Expression string `validate:"celevent"`
+	}{
+		Expression: "input.approved ==",
+	})
+
+	assert.ErrorContains(t, err, "validation for 'Expression' failed on the 'celevent' tag", "should throw error on invalid CEL expression")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -77,6 +78,26 @@ type HatchetContext interface {
 	inc()
 }
 
+// StructuredLogger is implemented by contexts which can log a message with a level and structured fields. The
+// logs of a step run can be filtered by level and by field values. NewSlogHandler and NewZerologWriter ship
+// logs through LogWithFields if the context implements it, and through Log otherwise.
+type StructuredLogger interface {
+	LogWithFields(level client.LogLevel, message string, fields map[string]interface{})
+}
+
+// DurableContext is implemented by contexts which can durably wait, i.e. the context of a step run on a
+// worker. Use a type assertion on the HatchetContext to check whether durable waits are supported.
+type DurableContext interface {
+	// SleepFor durably sleeps for the given duration. The step run's slot is released while it sleeps, and
+	// the sleep is not repeated if the step run is retried.
+	SleepFor(duration time.Duration) (*SingleWaitResult, error)
+
+	// WaitForEvent durably waits for a user event with the given key. If expression is not empty, the event
+	// payload must satisfy the CEL expression. The step run's slot is released while it waits, and the wait
+	// is not repeated if the step run is retried.
+	WaitForEvent(eventKey, expression string) (*SingleWaitResult, error)
+}
+
 // TODO: move this into proto definitions
 type TriggeredBy string
 
@@ -114,6 +135,7 @@ type hatchetContext struct {
 	l        *zerolog.Logger
 
 	i          int
+	durableI   int
 	indexMu    sync.Mutex
 	listener   *client.WorkflowRunsListener
 	listenerMu sync.Mutex
@@ -239,6 +261,14 @@ func (h *hatchetContext) Log(message string) {
 	}
 }
 
+func (h *hatchetContext) LogWithFields(level client.LogLevel, message string, fields map[string]interface{}) {
+	err := h.c.Event().PutLog(h, h.a.StepRunId, message, client.WithLogLineLevel(level), client.WithLogLineFields(fields))
+
+	if err != nil {
+		h.l.Err(err).Msg("could not put log")
+	}
+}
+
 func (h *hatchetContext) ReleaseSlot() error {
 	err := h.c.Dispatcher().ReleaseSlot(h, h.a.StepRunId)
 
@@ -0,0 +1,191 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type fakeActionListener struct {
+	cordonCalled atomic.Bool
+	cordoned     chan struct{}
+	drained      chan struct{}
+}
+
+func newFakeActionListener() *fakeActionListener {
+	return &fakeActionListener{
+		cordoned: make(chan struct{}),
+		drained:  make(chan struct{}),
+	}
+}
+
+func (f *fakeActionListener) Actions(ctx context.Context) (<-chan *client.Action, <-chan error, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeActionListener) Unregister() error {
+	return nil
+}
+
+func (f *fakeActionListener) Cordon(ctx context.Context) error {
+	f.cordonCalled.Store(true)
+	return nil
+}
+
+func (f *fakeActionListener) Cordoned() <-chan struct{} {
+	return f.cordoned
+}
+
+func (f *fakeActionListener) Drained() <-chan struct{} {
+	return f.drained
+}
+
+func TestWorkerDrain(t *testing.T) {
+	w := &Worker{name: "test"}
+	listener := newFakeActionListener()
+
+	drained, stopped := w.setListener(listener)
+
+	// the worker unsubscribes once it is drained
+	go func() {
+		<-drained
+		close(stopped)
+	}()
+
+	w.running.Add(1)
+	close(listener.drained)
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- w.Drain(context.Background())
+	}()
+
+	select {
+	case <-errCh:
+		t.Fatal("drain returned while an action is running")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	assert.True(t, listener.cordonCalled.Load())
+
+	w.running.Add(-1)
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("drain did not return after the running action finished")
+	}
+}
+
+func TestWorkerDrainWaitsForEngine(t *testing.T) {
+	w := &Worker{name: "test"}
+	listener := newFakeActionListener()
+
+	drained, stopped := w.setListener(listener)
+
+	go func() {
+		<-drained
+		close(stopped)
+	}()
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- w.Drain(context.Background())
+	}()
+
+	// nothing is running, but the engine has not confirmed that no actions are assigned to the worker
+	select {
+	case <-errCh:
+		t.Fatal("drain returned before the engine reported the worker as drained")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	close(listener.drained)
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("drain did not return after the engine reported the worker as drained")
+	}
+}
+
+func TestWorkerDrainParkedStepRuns(t *testing.T) {
+	w := &Worker{name: "test"}
+	listener := newFakeActionListener()
+
+	drained, stopped := w.setListener(listener)
+
+	go func() {
+		<-drained
+		close(stopped)
+	}()
+
+	// one of the running actions is parked in a durable wait
+	w.running.Add(2)
+	unpark := w.park("step-run-1")
+	defer unpark()
+
+	close(listener.drained)
+
+	errCh := make(chan error)
+
+	go func() {
+		errCh <- w.Drain(context.Background())
+	}()
+
+	select {
+	case <-errCh:
+		t.Fatal("drain returned while an action which is not parked is running")
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	w.running.Add(-1)
+
+	select {
+	case err := <-errCh:
+		var parkedErr *ParkedStepRunsError
+		require.ErrorAs(t, err, &parkedErr)
+		assert.Equal(t, []string{"step-run-1"}, parkedErr.StepRunIds)
+	case <-time.After(2 * time.Second):
+		t.Fatal("drain was blocked by a parked step run")
+	}
+}
+
+func TestWorkerDrainContextCancelled(t *testing.T) {
+	w := &Worker{name: "test"}
+	listener := newFakeActionListener()
+
+	drained, _ := w.setListener(listener)
+
+	w.running.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	err := w.Drain(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the worker keeps running its actions
+	select {
+	case <-drained:
+		t.Fatal("worker was stopped with a running action")
+	default:
+	}
+}
+
+func TestWorkerDrainNotRunning(t *testing.T) {
+	w := &Worker{name: "test"}
+
+	err := w.Drain(context.Background())
+	assert.ErrorContains(t, err, "not running")
+}
@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// SingleWaitResult contains the data of the condition which satisfied a durable wait.
+type SingleWaitResult struct {
+	data json.RawMessage
+}
+
+// Unmarshal unmarshals the data of the satisfied condition into v. For user events, this is the event
+// payload.
+func (r *SingleWaitResult) Unmarshal(v interface{}) error {
+	if len(r.data) == 0 {
+		return fmt.Errorf("wait result has no data")
+	}
+
+	return json.Unmarshal(r.data, v)
+}
+
+func newSingleWaitResult(key string, aggregatedData []byte) (*SingleWaitResult, error) {
+	// the engine returns the data of the satisfied conditions keyed by action and readable data key
+	var data map[string]map[string][]json.RawMessage
+
+	if err := json.Unmarshal(aggregatedData, &data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal wait result: %w", err)
+	}
+
+	for _, keys := range data {
+		if values := keys[key]; len(values) > 0 {
+			return &SingleWaitResult{
+				data: values[0],
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("wait result does not contain data for %s", key)
+}
+
+func (h *hatchetContext) SleepFor(duration time.Duration) (*SingleWaitResult, error) {
+	key := h.nextSignalKey()
+
+	return h.waitFor(&client.RegisterDurableEventRequest{
+		StepRunId: h.a.StepRunId,
+		SignalKey: key,
+		SleepConditions: []client.DurableSleepCondition{
+			{
+				ReadableDataKey: key,
+				SleepFor:        duration,
+			},
+		},
+	})
+}
+
+func (h *hatchetContext) WaitForEvent(eventKey, expression string) (*SingleWaitResult, error) {
+	key := h.nextSignalKey()
+
+	return h.waitFor(&client.RegisterDurableEventRequest{
+		StepRunId: h.a.StepRunId,
+		SignalKey: key,
+		UserEventConditions: []client.DurableUserEventCondition{
+			{
+				ReadableDataKey: key,
+				EventKey:        eventKey,
+				Expression:      expression,
+			},
+		},
+	})
+}
+
+func (h *hatchetContext) waitFor(req *client.RegisterDurableEventRequest) (*SingleWaitResult, error) {
+	err := h.c.Dispatcher().RegisterDurableEvent(h, req)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to register durable event: %w", err)
+	}
+
+	// the step run's slot is released while it waits, so it must not keep the worker from draining
+	unpark := h.w.worker.park(h.a.StepRunId)
+	data, err := h.c.Dispatcher().ListenForDurableEvent(h, h.a.StepRunId, req.SignalKey, h.a.WorkerId)
+	unpark()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for durable event: %w", err)
+	}
+
+	return newSingleWaitResult(req.SignalKey, data)
+}
+
+// nextSignalKey returns a key for the next durable wait in the step run. Waits are identified by the order
+// in which they're called, so that a retried step run resumes from the waits which have already completed.
+func (h *hatchetContext) nextSignalKey() string {
+	h.indexMu.Lock()
+	defer h.indexMu.Unlock()
+
+	key := fmt.Sprintf("wait-%d", h.durableI)
+	h.durableI++
+
+	return key
+}
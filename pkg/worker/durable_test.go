@@ -0,0 +1,32 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleWaitResult(t *testing.T) {
+	res, err := newSingleWaitResult("wait-0", []byte(`{"CREATE": {"wait-0": [{"approved": true}]}}`))
+	require.NoError(t, err)
+
+	var payload struct {
+		Approved bool `json:"approved"`
+	}
+
+	require.NoError(t, res.Unmarshal(&payload))
+	assert.True(t, payload.Approved)
+}
+
+func TestSingleWaitResultMissingKey(t *testing.T) {
+	_, err := newSingleWaitResult("wait-1", []byte(`{"CREATE": {"wait-0": [{"approved": true}]}}`))
+	assert.ErrorContains(t, err, "does not contain data for wait-1")
+}
+
+func TestHatchetContextIsDurableContext(t *testing.T) {
+	var ctx HatchetContext = &hatchetContext{}
+
+	_, ok := ctx.(DurableContext)
+	assert.True(t, ok)
+}
@@ -0,0 +1,237 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// NewSlogHandler returns a slog.Handler which ships the logs of a step run to Hatchet, with the level of the
+// record and its attributes as structured fields. Groups are shipped as nested fields. If opts is nil, records
+// of level Info and above are shipped.
+func NewSlogHandler(ctx HatchetContext, opts *slog.HandlerOptions) slog.Handler {
+	h := &slogHandler{
+		ctx:    ctx,
+		fields: map[string]interface{}{},
+	}
+
+	if opts != nil {
+		h.opts = *opts
+	}
+
+	return h
+}
+
+type slogHandler struct {
+	ctx  HatchetContext
+	opts slog.HandlerOptions
+
+	// fields are the attributes added with WithAttrs, nested under groups
+	fields map[string]interface{}
+	groups []string
+}
+
+func (h *slogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	minLevel := slog.LevelInfo
+
+	if h.opts.Level != nil {
+		minLevel = h.opts.Level.Level()
+	}
+
+	return level >= minLevel
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	fields := copyFields(h.fields)
+	target := groupFields(fields, h.groups)
+
+	r.Attrs(func(attr slog.Attr) bool {
+		addSlogAttr(target, attr)
+		return true
+	})
+
+	logWithFields(h.ctx, slogToLogLevel(r.Level), r.Message, fields)
+
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	fields := copyFields(h.fields)
+	target := groupFields(fields, h.groups)
+
+	for _, attr := range attrs {
+		addSlogAttr(target, attr)
+	}
+
+	return &slogHandler{
+		ctx:    h.ctx,
+		opts:   h.opts,
+		fields: fields,
+		groups: h.groups,
+	}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	groups := make([]string, len(h.groups), len(h.groups)+1)
+	copy(groups, h.groups)
+
+	return &slogHandler{
+		ctx:    h.ctx,
+		opts:   h.opts,
+		fields: h.fields,
+		groups: append(groups, name),
+	}
+}
+
+func slogToLogLevel(level slog.Level) client.LogLevel {
+	switch {
+	case level < slog.LevelInfo:
+		return client.LogLevelDebug
+	case level < slog.LevelWarn:
+		return client.LogLevelInfo
+	case level < slog.LevelError:
+		return client.LogLevelWarn
+	default:
+		return client.LogLevelError
+	}
+}
+
+func addSlogAttr(fields map[string]interface{}, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		target := fields
+
+		// attributes of a group with an empty key are inlined
+		if attr.Key != "" {
+			target = groupFields(fields, []string{attr.Key})
+		}
+
+		for _, groupAttr := range attr.Value.Group() {
+			addSlogAttr(target, groupAttr)
+		}
+
+		return
+	}
+
+	switch v := attr.Value.Any().(type) {
+	case error:
+		fields[attr.Key] = v.Error()
+	default:
+		fields[attr.Key] = v
+	}
+}
+
+// groupFields returns the nested map of fields for the groups, creating it if it does not exist.
+func groupFields(fields map[string]interface{}, groups []string) map[string]interface{} {
+	for _, group := range groups {
+		nested, ok := fields[group].(map[string]interface{})
+
+		if !ok {
+			nested = map[string]interface{}{}
+			fields[group] = nested
+		}
+
+		fields = nested
+	}
+
+	return fields
+}
+
+func copyFields(fields map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(fields))
+
+	for k, v := range fields {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = copyFields(nested)
+		}
+
+		res[k] = v
+	}
+
+	return res
+}
+
+// NewZerologWriter returns a writer for a zerolog.Logger which ships the logs of a step run to Hatchet, with
+// the level of each event and its fields as structured fields. The logger must write JSON, which is the
+// default for zerolog, for example:
+//
+//	logger := zerolog.New(worker.NewZerologWriter(ctx)).With().Str("user_id", userId).Logger()
+func NewZerologWriter(ctx HatchetContext) io.Writer {
+	return &zerologWriter{
+		ctx: ctx,
+	}
+}
+
+type zerologWriter struct {
+	ctx HatchetContext
+}
+
+func (w *zerologWriter) Write(p []byte) (int, error) {
+	fields := map[string]interface{}{}
+
+	if err := json.Unmarshal(p, &fields); err != nil {
+		return 0, err
+	}
+
+	level := client.LogLevelInfo
+
+	if levelStr, ok := fields[zerolog.LevelFieldName].(string); ok {
+		if parsed, err := zerolog.ParseLevel(levelStr); err == nil {
+			level = zerologToLogLevel(parsed)
+		}
+	}
+
+	message, _ := fields[zerolog.MessageFieldName].(string)
+
+	delete(fields, zerolog.LevelFieldName)
+	delete(fields, zerolog.MessageFieldName)
+	delete(fields, zerolog.TimestampFieldName)
+
+	logWithFields(w.ctx, level, message, fields)
+
+	return len(p), nil
+}
+
+// logWithFields ships a log line through LogWithFields if the context is a StructuredLogger. Otherwise, the
+// fields are appended to the message as JSON and the message is shipped through Log.
+func logWithFields(ctx HatchetContext, level client.LogLevel, message string, fields map[string]interface{}) {
+	if sl, ok := ctx.(StructuredLogger); ok {
+		sl.LogWithFields(level, message, fields)
+		return
+	}
+
+	if len(fields) > 0 {
+		if fieldsBytes, err := json.Marshal(fields); err == nil {
+			message = message + " " + string(fieldsBytes)
+		}
+	}
+
+	ctx.Log(message)
+}
+
+func zerologToLogLevel(level zerolog.Level) client.LogLevel {
+	switch {
+	case level <= zerolog.DebugLevel:
+		return client.LogLevelDebug
+	case level == zerolog.InfoLevel || level == zerolog.NoLevel:
+		return client.LogLevelInfo
+	case level == zerolog.WarnLevel:
+		return client.LogLevelWarn
+	default:
+		return client.LogLevelError
+	}
+}
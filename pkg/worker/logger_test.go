@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+type loggedLine struct {
+	level   client.LogLevel
+	message string
+	fields  map[string]interface{}
+}
+
+type logRecordingContext struct {
+	HatchetContext
+
+	lines []loggedLine
+}
+
+func (c *logRecordingContext) LogWithFields(level client.LogLevel, message string, fields map[string]interface{}) {
+	c.lines = append(c.lines, loggedLine{
+		level:   level,
+		message: message,
+		fields:  fields,
+	})
+}
+
+func TestSlogHandler(t *testing.T) {
+	ctx := &logRecordingContext{}
+
+	logger := slog.New(NewSlogHandler(ctx, nil)).With("user_id", "1234").WithGroup("request")
+
+	logger.Debug("not shipped")
+	logger.Warn("slow request", "path", "/users", slog.Group("timing", "ms", 250))
+	logger.Error("request failed", "err", errors.New("boom"))
+
+	require.Len(t, ctx.lines, 2)
+
+	assert.Equal(t, client.LogLevelWarn, ctx.lines[0].level)
+	assert.Equal(t, "slow request", ctx.lines[0].message)
+	assert.Equal(t, map[string]interface{}{
+		"user_id": "1234",
+		"request": map[string]interface{}{
+			"path": "/users",
+			"timing": map[string]interface{}{
+				"ms": int64(250),
+			},
+		},
+	}, ctx.lines[0].fields)
+
+	assert.Equal(t, client.LogLevelError, ctx.lines[1].level)
+	assert.Equal(t, map[string]interface{}{
+		"user_id": "1234",
+		"request": map[string]interface{}{
+			"err": "boom",
+		},
+	}, ctx.lines[1].fields)
+}
+
+func TestSlogHandlerLevel(t *testing.T) {
+	ctx := &logRecordingContext{}
+
+	logger := slog.New(NewSlogHandler(ctx, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	logger.Debug("shipped")
+
+	require.Len(t, ctx.lines, 1)
+	assert.Equal(t, client.LogLevelDebug, ctx.lines[0].level)
+}
+
+func TestZerologWriter(t *testing.T) {
+	ctx := &logRecordingContext{}
+
+	logger := zerolog.New(NewZerologWriter(ctx)).With().Timestamp().Str("user_id", "1234").Logger()
+
+	logger.Warn().Int("attempt", 2).Msg("retrying")
+
+	require.Len(t, ctx.lines, 1)
+
+	assert.Equal(t, client.LogLevelWarn, ctx.lines[0].level)
+	assert.Equal(t, "retrying", ctx.lines[0].message)
+	assert.Equal(t, map[string]interface{}{
+		"user_id": "1234",
+		"attempt": float64(2),
+	}, ctx.lines[0].fields)
+}
+
+type plainLogContext struct {
+	HatchetContext
+
+	messages []string
+}
+
+func (c *plainLogContext) Log(message string) {
+	c.messages = append(c.messages, message)
+}
+
+func TestLoggersFallBackToLog(t *testing.T) {
+	ctx := &plainLogContext{}
+
+	slog.New(NewSlogHandler(ctx, nil)).Info("request succeeded", "user_id", "1234")
+	zlogger := zerolog.New(NewZerologWriter(ctx))
+	zlogger.Info().Msg("done")
+
+	// contexts which are not a StructuredLogger receive the fields as part of the message
+	assert.Equal(t, []string{`request succeeded {"user_id":"1234"}`, "done"}, ctx.messages)
+}
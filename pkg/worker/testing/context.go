@@ -0,0 +1,189 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+// stepContext is the HatchetContext which is passed to a step function by the harness. Methods which depend
+// on the engine, such as spawning child workflows, return an error. It does not implement worker.DurableContext,
+// since durable waits depend on the engine as well.
+type stepContext struct {
+	ctx context.Context
+
+	// the embedded interface is never set, it only satisfies the unexported methods of worker.HatchetContext
+	worker.HatchetContext
+
+	run    *runState
+	result *StepResult
+
+	stepId     string
+	stepRunId  string
+	retryCount int
+
+	parents       map[string]worker.StepData
+	stepRunErrors map[string]string
+}
+
+func (c *stepContext) Deadline() (time.Time, bool) {
+	return c.ctx.Deadline()
+}
+
+func (c *stepContext) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+func (c *stepContext) Err() error {
+	return c.ctx.Err()
+}
+
+func (c *stepContext) Value(key any) any {
+	return c.ctx.Value(key)
+}
+
+func (c *stepContext) SetContext(ctx context.Context) {
+	c.ctx = ctx
+}
+
+func (c *stepContext) GetContext() context.Context {
+	return c.ctx
+}
+
+func (c *stepContext) Worker() worker.HatchetWorkerContext {
+	return &workerContext{
+		Context: c.ctx,
+		labels:  map[string]interface{}{},
+	}
+}
+
+func (c *stepContext) StepOutput(step string, target interface{}) error {
+	if val, ok := c.parents[step]; ok {
+		return toTarget(val, target)
+	}
+
+	return fmt.Errorf("step %s not found in action payload", step)
+}
+
+func (c *stepContext) StepRunErrors() map[string]string {
+	return c.stepRunErrors
+}
+
+func (c *stepContext) TriggeredByEvent() bool {
+	return c.run.opts.eventKey != nil
+}
+
+func (c *stepContext) WorkflowInput(target interface{}) error {
+	return toTarget(c.run.input, target)
+}
+
+func (c *stepContext) UserData(target interface{}) error {
+	return toTarget(map[string]interface{}{}, target)
+}
+
+func (c *stepContext) AdditionalMetadata() map[string]string {
+	return c.run.opts.additionalMetadata
+}
+
+func (c *stepContext) StepName() string {
+	return c.result.Name
+}
+
+func (c *stepContext) StepRunId() string {
+	return c.stepRunId
+}
+
+func (c *stepContext) StepId() string {
+	return c.stepId
+}
+
+func (c *stepContext) WorkflowRunId() string {
+	return c.run.workflowRunId
+}
+
+func (c *stepContext) Log(message string) {
+	c.LogWithFields(client.LogLevelInfo, message, nil)
+}
+
+func (c *stepContext) LogWithFields(level client.LogLevel, message string, fields map[string]interface{}) {
+	c.run.mu.Lock()
+	defer c.run.mu.Unlock()
+
+	c.result.Logs = append(c.result.Logs, message)
+	c.result.LogLines = append(c.result.LogLines, LogLine{
+		Level:   level,
+		Message: message,
+		Fields:  fields,
+	})
+}
+
+func (c *stepContext) StreamEvent(message []byte) {
+	c.run.mu.Lock()
+	defer c.run.mu.Unlock()
+
+	c.result.StreamEvents = append(c.result.StreamEvents, message)
+}
+
+func (c *stepContext) SpawnWorkflow(workflowName string, input any, opts *worker.SpawnWorkflowOpts) (*client.Workflow, error) {
+	return nil, fmt.Errorf("could not spawn workflow %s: child workflows are not supported by the test harness", workflowName)
+}
+
+func (c *stepContext) SpawnWorkflows(childWorkflows []*worker.SpawnWorkflowsOpts) ([]*client.Workflow, error) {
+	return nil, fmt.Errorf("could not spawn workflows: child workflows are not supported by the test harness")
+}
+
+func (c *stepContext) ReleaseSlot() error {
+	return nil
+}
+
+func (c *stepContext) RefreshTimeout(incrementTimeoutBy string) error {
+	return fmt.Errorf("refreshing timeouts is not supported by the test harness")
+}
+
+func (c *stepContext) RetryCount() int {
+	return c.retryCount
+}
+
+type workerContext struct {
+	context.Context
+
+	labels map[string]interface{}
+}
+
+func (w *workerContext) SetContext(ctx context.Context) {
+	w.Context = ctx
+}
+
+func (w *workerContext) GetContext() context.Context {
+	return w.Context
+}
+
+func (w *workerContext) ID() string {
+	return "test-worker"
+}
+
+func (w *workerContext) GetLabels() map[string]interface{} {
+	return w.labels
+}
+
+func (w *workerContext) UpsertLabels(labels map[string]interface{}) error {
+	for k, v := range labels {
+		w.labels[k] = v
+	}
+
+	return nil
+}
+
+func toTarget(data interface{}, target interface{}) error {
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(dataBytes, target)
+}
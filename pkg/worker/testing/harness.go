@@ -0,0 +1,675 @@
+// Package testing runs the workflows of the Go SDK in process, without an engine, so that the orchestration
+// logic of a workflow can be covered by unit tests.
+//
+// The harness runs the steps of a job in the order of their parents, passing the outputs of the parents to
+// each step, and simulates retries with backoff, step timeouts, skip expressions and on-failure jobs in the
+// same way as the engine. Concurrency expressions are evaluated, so that a test can assert on the keys, but
+// runs are not limited by them.
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+// the default execution timeout of a step, which matches the engine
+const defaultStepTimeout = 60 * time.Second
+
+type StepStatus string
+
+const (
+	StepStatusSucceeded StepStatus = "SUCCEEDED"
+	StepStatusFailed    StepStatus = "FAILED"
+	StepStatusCancelled StepStatus = "CANCELLED"
+	StepStatusSkipped   StepStatus = "SKIPPED"
+)
+
+// StepResult is the result of a step in a run of the harness.
+type StepResult struct {
+	// Name is the readable id of the step
+	Name string
+
+	Status StepStatus
+
+	// Output is the JSON output of the step, if it succeeded
+	Output []byte
+
+	// Error is the error of the last attempt, if the step failed or was cancelled
+	Error error
+
+	// Attempts is the number of times the step function was called
+	Attempts int
+
+	// RetryDelays are the backoff delays before each retry
+	RetryDelays []time.Duration
+
+	// Logs are the messages which were logged with ctx.Log or ctx.LogWithFields, across all attempts
+	Logs []string
+
+	// LogLines are the logged messages with their levels and fields, across all attempts
+	LogLines []LogLine
+
+	// StreamEvents are the messages which were streamed with ctx.StreamEvent, across all attempts
+	StreamEvents [][]byte
+
+	// ConcurrencyKeys are the keys which the step's concurrency expressions evaluated to
+	ConcurrencyKeys []string
+}
+
+// LogLine is a message which was logged by a step.
+type LogLine struct {
+	Level   client.LogLevel
+	Message string
+	Fields  map[string]interface{}
+}
+
+// Run is a completed run of a workflow in the harness.
+type Run struct {
+	WorkflowRunId string
+
+	// ConcurrencyKey is the key which the workflow's concurrency expression evaluated to, if it has one
+	ConcurrencyKey *string
+
+	// Steps are the results of the steps of the job and of the on-failure job, keyed by step readable id
+	Steps map[string]*StepResult
+}
+
+// Step returns the result of a step, or nil if the workflow does not have the step.
+func (r *Run) Step(name string) *StepResult {
+	return r.Steps[name]
+}
+
+// StepOutput decodes the output of a step which succeeded into target.
+func (r *Run) StepOutput(name string, target interface{}) error {
+	step, ok := r.Steps[name]
+
+	if !ok {
+		return fmt.Errorf("step %s not found in run", name)
+	}
+
+	if step.Status != StepStatusSucceeded {
+		return fmt.Errorf("step %s did not succeed: status is %s", name, step.Status)
+	}
+
+	return json.Unmarshal(step.Output, target)
+}
+
+// Logs returns the messages which were logged by a step.
+func (r *Run) Logs(name string) []string {
+	if step, ok := r.Steps[name]; ok {
+		return step.Logs
+	}
+
+	return nil
+}
+
+type HarnessOpt func(*HarnessOpts)
+
+type HarnessOpts struct {
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// WithSleep sets the function which waits for the backoff delay before a step is retried. By default, the
+// harness records the delay in StepResult.RetryDelays and retries immediately.
+func WithSleep(sleep func(ctx context.Context, d time.Duration) error) HarnessOpt {
+	return func(opts *HarnessOpts) {
+		opts.sleep = sleep
+	}
+}
+
+// Harness runs workflows in process. A harness can be shared by multiple tests.
+type Harness struct {
+	sleep     func(ctx context.Context, d time.Duration) error
+	celParser *cel.CELParser
+}
+
+func NewHarness(fs ...HarnessOpt) *Harness {
+	opts := &HarnessOpts{
+		sleep: func(ctx context.Context, d time.Duration) error {
+			return ctx.Err()
+		},
+	}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	return &Harness{
+		sleep:     opts.sleep,
+		celParser: cel.NewCELParser(),
+	}
+}
+
+type RunOpt func(*runOpts)
+
+type runOpts struct {
+	additionalMetadata map[string]string
+	eventKey           *string
+}
+
+// WithAdditionalMetadata sets the additional metadata of the run.
+func WithAdditionalMetadata(metadata map[string]string) RunOpt {
+	return func(opts *runOpts) {
+		opts.additionalMetadata = metadata
+	}
+}
+
+// WithEventKey runs the workflow as if it was triggered by an event with the given key.
+func WithEventKey(eventKey string) RunOpt {
+	return func(opts *runOpts) {
+		opts.eventKey = &eventKey
+	}
+}
+
+type runState struct {
+	opts          *runOpts
+	input         map[string]interface{}
+	workflowRunId string
+
+	mu sync.Mutex
+}
+
+type harnessStep struct {
+	step    *worker.WorkflowStep
+	apiStep types.WorkflowStep
+}
+
+// Run runs the job with the given input until all of its steps have completed, failed, been skipped or been
+// cancelled. The returned error is the error of the first step which failed, or the error of ctx if ctx was
+// cancelled. The run is returned with the results of the steps even if the run failed, unless the job could
+// not be run by the harness.
+func (h *Harness) Run(ctx context.Context, job *worker.WorkflowJob, input any, fs ...RunOpt) (*Run, error) {
+	opts := &runOpts{
+		additionalMetadata: map[string]string{},
+	}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	steps, err := toHarnessSteps(job)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var onFailureSteps []harnessStep
+
+	if job.OnFailure != nil {
+		onFailureSteps, err = toHarnessSteps(job.OnFailure)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inputMap := map[string]interface{}{}
+
+	if input != nil {
+		if err := toTarget(input, &inputMap); err != nil {
+			return nil, fmt.Errorf("could not decode input: %w", err)
+		}
+	}
+
+	state := &runState{
+		opts:          opts,
+		input:         inputMap,
+		workflowRunId: uuid.New().String(),
+	}
+
+	run := &Run{
+		WorkflowRunId: state.workflowRunId,
+		Steps:         map[string]*StepResult{},
+	}
+
+	if job.Concurrency != nil {
+		key, err := h.evalWorkflowConcurrency(job.ToWorkflow("", "").Concurrency, state)
+
+		if err != nil {
+			return nil, err
+		}
+
+		run.ConcurrencyKey = key
+	}
+
+	runErr := h.runSteps(ctx, state, run, steps, nil)
+
+	if runErr != nil && len(onFailureSteps) > 0 && ctx.Err() == nil {
+		stepRunErrors := map[string]string{}
+
+		for _, step := range steps {
+			if res := run.Steps[step.apiStep.ID]; res.Status == StepStatusFailed {
+				stepRunErrors[res.Name] = res.Error.Error()
+			}
+		}
+
+		// the on-failure job reports its own failures, but the run has failed either way
+		_ = h.runSteps(ctx, state, run, onFailureSteps, stepRunErrors)
+	}
+
+	return run, runErr
+}
+
+func toHarnessSteps(job *worker.WorkflowJob) ([]harnessStep, error) {
+	res := make([]harnessStep, 0, len(job.Steps))
+
+	for i, step := range job.Steps {
+		converted, err := step.ToWorkflowStep("", i, "")
+
+		if err != nil {
+			return nil, fmt.Errorf("could not convert step %d: %w", i, err)
+		}
+
+		if converted.APIStep.Map != nil {
+			return nil, fmt.Errorf("step %s is a map step, which is not supported by the test harness", converted.Id)
+		}
+
+		if len(converted.APIStep.Conditions) > 0 {
+			return nil, fmt.Errorf("step %s has conditions, which are not supported by the test harness", converted.Id)
+		}
+
+		res = append(res, harnessStep{
+			step:    step,
+			apiStep: converted.APIStep,
+		})
+	}
+
+	if job.Concurrency != nil && job.ToWorkflow("", "").Concurrency.ActionID != nil {
+		return nil, fmt.Errorf("concurrency functions are not supported by the test harness, use worker.Expression instead")
+	}
+
+	return res, nil
+}
+
+// runSteps runs the steps in the order of their parents. Steps whose parents have all succeeded are run at the
+// same time, and the children of steps which failed, were cancelled or were skipped are not run.
+func (h *Harness) runSteps(ctx context.Context, state *runState, run *Run, steps []harnessStep, stepRunErrors map[string]string) error {
+	pending := map[string]harnessStep{}
+
+	for _, step := range steps {
+		run.Steps[step.apiStep.ID] = &StepResult{
+			Name: step.apiStep.ID,
+		}
+
+		pending[step.apiStep.ID] = step
+	}
+
+	var runErr error
+
+	for len(pending) > 0 {
+		ready := []harnessStep{}
+
+		for _, step := range steps {
+			if _, ok := pending[step.apiStep.ID]; !ok {
+				continue
+			}
+
+			status, done := parentsStatus(run, step.apiStep.Parents)
+
+			if !done {
+				continue
+			}
+
+			delete(pending, step.apiStep.ID)
+
+			switch {
+			case status != StepStatusSucceeded:
+				// children of skipped steps are skipped, and children of failed or cancelled steps are cancelled
+				res := run.Steps[step.apiStep.ID]
+
+				if status == StepStatusSkipped {
+					res.Status = StepStatusSkipped
+				} else {
+					res.Status = StepStatusCancelled
+				}
+			case ctx.Err() != nil:
+				run.Steps[step.apiStep.ID].Status = StepStatusCancelled
+				run.Steps[step.apiStep.ID].Error = ctx.Err()
+			default:
+				ready = append(ready, step)
+			}
+		}
+
+		if len(ready) == 0 && len(pending) > 0 {
+			for id := range pending {
+				run.Steps[id].Status = StepStatusCancelled
+				run.Steps[id].Error = fmt.Errorf("step %s has parents which are not part of the job", id)
+			}
+
+			return fmt.Errorf("job has steps with parents which are not part of the job")
+		}
+
+		wg := sync.WaitGroup{}
+		errs := make([]error, len(ready))
+
+		for i, step := range ready {
+			wg.Add(1)
+
+			go func(i int, step harnessStep) {
+				defer wg.Done()
+				errs[i] = h.runStep(ctx, state, run, step, stepRunErrors)
+			}(i, step)
+		}
+
+		wg.Wait()
+
+		if runErr == nil {
+			runErr = errors.Join(errs...)
+		}
+	}
+
+	if runErr == nil && ctx.Err() != nil {
+		runErr = ctx.Err()
+	}
+
+	return runErr
+}
+
+// parentsStatus returns whether all parents are done and, if so, the status which determines whether the
+// step runs: succeeded if all parents succeeded, otherwise skipped or cancelled.
+func parentsStatus(run *Run, parents []string) (StepStatus, bool) {
+	status := StepStatusSucceeded
+
+	for _, parent := range parents {
+		res, ok := run.Steps[parent]
+
+		if !ok {
+			return "", false
+		}
+
+		switch res.Status {
+		case "":
+			return "", false
+		case StepStatusFailed, StepStatusCancelled:
+			status = StepStatusCancelled
+		case StepStatusSkipped:
+			if status == StepStatusSucceeded {
+				status = StepStatusSkipped
+			}
+		}
+	}
+
+	return status, true
+}
+
+func (h *Harness) runStep(ctx context.Context, state *runState, run *Run, step harnessStep, stepRunErrors map[string]string) error {
+	res := run.Steps[step.apiStep.ID]
+
+	state.mu.Lock()
+	parents := map[string]worker.StepData{}
+
+	for _, parent := range step.apiStep.Parents {
+		data := worker.StepData{}
+
+		if err := json.Unmarshal(run.Steps[parent].Output, &data); err == nil {
+			parents[parent] = data
+		}
+	}
+	state.mu.Unlock()
+
+	celInput := h.newCELInput(state, parents)
+
+	if step.apiStep.SkipIf != nil {
+		out, err := h.celParser.ParseAndEvalStepRun(*step.apiStep.SkipIf, celInput)
+
+		if err == nil && out.Bool == nil {
+			err = fmt.Errorf("expected bool output, got %s", out.Type)
+		}
+
+		if err != nil {
+			return h.failStep(state, res, fmt.Errorf("failed to parse skip_if expression (%s): %w", *step.apiStep.SkipIf, err))
+		}
+
+		if *out.Bool {
+			state.mu.Lock()
+			res.Status = StepStatusSkipped
+			state.mu.Unlock()
+
+			return nil
+		}
+	}
+
+	for _, concurrency := range step.apiStep.Concurrency {
+		out, err := h.celParser.ParseAndEvalStepRun(concurrency.Expression, celInput)
+
+		if err == nil && out.String == nil {
+			err = fmt.Errorf("expected string output, got %s", out.Type)
+		}
+
+		if err != nil {
+			return h.failStep(state, res, fmt.Errorf("could not evaluate concurrency expression (%s): %w", concurrency.Expression, err))
+		}
+
+		state.mu.Lock()
+		res.ConcurrencyKeys = append(res.ConcurrencyKeys, *out.String)
+		state.mu.Unlock()
+	}
+
+	timeout := defaultStepTimeout
+
+	if step.apiStep.Timeout != "" {
+		var err error
+
+		timeout, err = time.ParseDuration(step.apiStep.Timeout)
+
+		if err != nil {
+			return h.failStep(state, res, fmt.Errorf("could not parse timeout %s: %w", step.apiStep.Timeout, err))
+		}
+	}
+
+	stepId := uuid.New().String()
+
+	for retryCount := 0; ; retryCount++ {
+		if retryCount > 0 {
+			delay := retryDelay(step.step, retryCount)
+
+			state.mu.Lock()
+			res.RetryDelays = append(res.RetryDelays, delay)
+			state.mu.Unlock()
+
+			if err := h.sleep(ctx, delay); err != nil {
+				return h.cancelStep(state, res, err)
+			}
+		}
+
+		stepCtx := &stepContext{
+			run:           state,
+			result:        res,
+			stepId:        stepId,
+			stepRunId:     uuid.New().String(),
+			retryCount:    retryCount,
+			parents:       parents,
+			stepRunErrors: stepRunErrors,
+		}
+
+		output, err := h.callStep(ctx, stepCtx, step.step.Function, timeout)
+
+		state.mu.Lock()
+		res.Attempts++
+		state.mu.Unlock()
+
+		if err == nil {
+			state.mu.Lock()
+			res.Status = StepStatusSucceeded
+			res.Output = output
+			res.Error = nil
+			state.mu.Unlock()
+
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return h.cancelStep(state, res, ctx.Err())
+		}
+
+		if retryCount >= step.apiStep.Retries {
+			return h.failStep(state, res, err)
+		}
+
+		state.mu.Lock()
+		res.Error = err
+		state.mu.Unlock()
+	}
+}
+
+func (h *Harness) failStep(state *runState, res *StepResult, err error) error {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	res.Status = StepStatusFailed
+	res.Error = err
+
+	return fmt.Errorf("step %s failed: %w", res.Name, err)
+}
+
+func (h *Harness) cancelStep(state *runState, res *StepResult, err error) error {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	res.Status = StepStatusCancelled
+	res.Error = err
+
+	return err
+}
+
+// callStep calls the step function with a context which is cancelled after the timeout or when ctx is
+// cancelled. If the step function does not return before its context is done, the attempt fails without
+// waiting for it.
+func (h *Harness) callStep(ctx context.Context, stepCtx *stepContext, fn any, timeout time.Duration) ([]byte, error) {
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stepCtx.ctx = runCtx
+
+	type result struct {
+		output []byte
+		err    error
+	}
+
+	resCh := make(chan result, 1)
+
+	go func() {
+		output, err := invoke(stepCtx, fn)
+		resCh <- result{output, err}
+	}()
+
+	select {
+	case res := <-resCh:
+		return res.output, res.err
+	case <-runCtx.Done():
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, fmt.Errorf("step timed out after %s", timeout)
+	}
+}
+
+// invoke calls a step function with the same signatures which the worker supports, and returns its output
+// as JSON.
+func invoke(ctx worker.HatchetContext, fn any) (output []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+
+	if fnType.Kind() != reflect.Func || (fnType.NumIn() != 1 && fnType.NumIn() != 2) || (fnType.NumOut() != 1 && fnType.NumOut() != 2) {
+		return nil, fmt.Errorf("step function must match the signatures supported by the worker")
+	}
+
+	args := []reflect.Value{reflect.ValueOf(ctx)}
+
+	if fnType.NumIn() == 2 {
+		// the worker passes an empty input, as the input is read with ctx.WorkflowInput
+		args = append(args, reflect.New(fnType.In(1).Elem()))
+	}
+
+	results := fnValue.Call(args)
+
+	if errVal := results[len(results)-1]; !errVal.IsNil() {
+		return nil, errVal.Interface().(error)
+	}
+
+	if len(results) == 1 {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(results[0].Interface())
+}
+
+// retryDelay returns the backoff delay before the given retry, which matches the engine:
+// min(retryMaxBackoff, retryBackoffFactor^retryCount) seconds.
+func retryDelay(step *worker.WorkflowStep, retryCount int) time.Duration {
+	if step.RetryBackoffFactor == nil {
+		return 0
+	}
+
+	seconds := math.Pow(float64(*step.RetryBackoffFactor), float64(retryCount))
+
+	if step.RetryMaxBackoffSeconds != nil {
+		seconds = math.Min(seconds, float64(*step.RetryMaxBackoffSeconds))
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func (h *Harness) newCELInput(state *runState, parents map[string]worker.StepData) cel.Input {
+	additionalMeta := map[string]interface{}{}
+
+	for k, v := range state.opts.additionalMetadata {
+		additionalMeta[k] = v
+	}
+
+	opts := []cel.InputOpts{
+		cel.WithInput(state.input),
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithWorkflowRunID(state.workflowRunId),
+	}
+
+	if parents != nil {
+		parentsMap := map[string]map[string]interface{}{}
+
+		for k, v := range parents {
+			parentsMap[k] = v
+		}
+
+		opts = append(opts, cel.WithParents(parentsMap))
+	}
+
+	if state.opts.eventKey != nil {
+		opts = append(opts, cel.WithEventKey(*state.opts.eventKey))
+	}
+
+	return cel.NewInput(opts...)
+}
+
+func (h *Harness) evalWorkflowConcurrency(concurrency *types.WorkflowConcurrency, state *runState) (*string, error) {
+	if concurrency == nil || concurrency.Expression == nil {
+		return nil, nil
+	}
+
+	key, err := h.celParser.ParseAndEvalWorkflowString(*concurrency.Expression, h.newCELInput(state, nil))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate concurrency group expression %s: %w", *concurrency.Expression, err)
+	}
+
+	return &key, nil
+}
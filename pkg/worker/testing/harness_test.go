@@ -0,0 +1,192 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type userInput struct {
+	UserId string `json:"user_id"`
+}
+
+type message struct {
+	Message string `json:"message"`
+}
+
+func TestHarness_ParentOutputs(t *testing.T) {
+	job := &worker.WorkflowJob{
+		Name:        "dag",
+		Concurrency: worker.Expression("input.user_id"),
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) (*message, error) {
+				input := &userInput{}
+
+				if err := ctx.WorkflowInput(input); err != nil {
+					return nil, err
+				}
+
+				ctx.Log("running step one")
+
+				return &message{Message: "user " + input.UserId}, nil
+			}).SetName("step-one"),
+			worker.Fn(func(ctx worker.HatchetContext) (*message, error) {
+				parent := &message{}
+
+				if err := ctx.StepOutput("step-one", parent); err != nil {
+					return nil, err
+				}
+
+				return &message{Message: parent.Message + " done"}, nil
+			}).SetName("step-two").AddParents("step-one"),
+		},
+	}
+
+	run, err := NewHarness().Run(context.Background(), job, userInput{UserId: "1234"})
+	require.NoError(t, err)
+
+	require.NotNil(t, run.ConcurrencyKey)
+	assert.Equal(t, "1234", *run.ConcurrencyKey)
+
+	out := &message{}
+	require.NoError(t, run.StepOutput("step-two", out))
+
+	assert.Equal(t, "user 1234 done", out.Message)
+	assert.Equal(t, []string{"running step one"}, run.Logs("step-one"))
+}
+
+func TestHarness_RetriesWithBackoff(t *testing.T) {
+	attempts := 0
+
+	job := &worker.WorkflowJob{
+		Name: "retries",
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) (*message, error) {
+				attempts++
+
+				if ctx.RetryCount() < 2 {
+					return nil, fmt.Errorf("attempt %d failed", attempts)
+				}
+
+				return &message{Message: "ok"}, nil
+			}).SetName("flaky").SetRetries(3).SetRetryBackoffFactor(2).SetRetryMaxBackoffSeconds(3),
+		},
+	}
+
+	run, err := NewHarness().Run(context.Background(), job, nil)
+	require.NoError(t, err)
+
+	step := run.Step("flaky")
+
+	assert.Equal(t, StepStatusSucceeded, step.Status)
+	assert.Equal(t, 3, step.Attempts)
+	assert.Equal(t, []time.Duration{2 * time.Second, 3 * time.Second}, step.RetryDelays)
+}
+
+func TestHarness_FailureCancelsChildren(t *testing.T) {
+	var stepRunErrors map[string]string
+
+	job := &worker.WorkflowJob{
+		Name: "failure",
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				return fmt.Errorf("boom")
+			}).SetName("step-one").SetRetries(1),
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				return nil
+			}).SetName("step-two").AddParents("step-one"),
+		},
+		OnFailure: &worker.WorkflowJob{
+			Name: "on-failure",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) error {
+					stepRunErrors = ctx.StepRunErrors()
+					return nil
+				}).SetName("on-failure"),
+			},
+		},
+	}
+
+	run, err := NewHarness().Run(context.Background(), job, nil)
+	require.Error(t, err)
+
+	assert.Equal(t, StepStatusFailed, run.Step("step-one").Status)
+	assert.Equal(t, 2, run.Step("step-one").Attempts)
+	assert.Equal(t, StepStatusCancelled, run.Step("step-two").Status)
+	assert.Equal(t, StepStatusSucceeded, run.Step("on-failure").Status)
+	assert.Equal(t, map[string]string{"step-one": "boom"}, stepRunErrors)
+}
+
+func TestHarness_Timeout(t *testing.T) {
+	job := &worker.WorkflowJob{
+		Name: "timeout",
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}).SetName("slow").SetTimeout("10ms"),
+		},
+	}
+
+	run, err := NewHarness().Run(context.Background(), job, nil)
+	require.Error(t, err)
+
+	assert.Equal(t, StepStatusFailed, run.Step("slow").Status)
+	assert.ErrorContains(t, run.Step("slow").Error, "timed out")
+}
+
+func TestHarness_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	job := &worker.WorkflowJob{
+		Name: "cancellation",
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				cancel()
+				<-ctx.Done()
+				return ctx.Err()
+			}).SetName("step-one"),
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				return nil
+			}).SetName("step-two").AddParents("step-one"),
+		},
+	}
+
+	run, err := NewHarness().Run(ctx, job, nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	assert.Equal(t, StepStatusCancelled, run.Step("step-one").Status)
+	assert.Equal(t, StepStatusCancelled, run.Step("step-two").Status)
+}
+
+func TestHarness_SkipIfAndStepConcurrency(t *testing.T) {
+	job := &worker.WorkflowJob{
+		Name: "skip",
+		Steps: []*worker.WorkflowStep{
+			worker.Fn(func(ctx worker.HatchetContext) (*message, error) {
+				return &message{Message: "skip"}, nil
+			}).SetName("step-one").AddConcurrency(worker.Expression("additional_metadata.tenant")),
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				return nil
+			}).SetName("step-two").AddParents("step-one").SkipIf(`parents["step-one"].message == "skip"`),
+			worker.Fn(func(ctx worker.HatchetContext) error {
+				return nil
+			}).SetName("step-three").AddParents("step-two"),
+		},
+	}
+
+	run, err := NewHarness().Run(context.Background(), job, nil, WithAdditionalMetadata(map[string]string{
+		"tenant": "acme",
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"acme"}, run.Step("step-one").ConcurrencyKeys)
+	assert.Equal(t, StepStatusSkipped, run.Step("step-two").Status)
+	assert.Equal(t, StepStatusSkipped, run.Step("step-three").Status)
+}
@@ -0,0 +1,211 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
+)
+
+// TypedTaskFn is the function of a Task. The input is the workflow input, decoded into In.
+type TypedTaskFn[In, Out any] func(ctx HatchetContext, input *In) (*Out, error)
+
+// Task is a step with a typed input and output, created with DefineTask. The input and output types must be
+// structs, as with Fn. A Task can be registered on its own with RegisterWorkflow, in which case it runs as a
+// workflow with a single step, or added to a workflow created with DefineWorkflow.
+type Task[In, Out any] struct {
+	step *WorkflowStep
+}
+
+// TaskOf is a Task which takes In as its input, regardless of its output type.
+type TaskOf[In any] interface {
+	Name() string
+
+	Step() *WorkflowStep
+
+	taskInput(*In)
+}
+
+// DefineTask creates a Task with the given name. The name is used as the step id, and must be unique within a
+// workflow.
+func DefineTask[In, Out any](name string, fn TypedTaskFn[In, Out]) *Task[In, Out] {
+	// the wrapper keeps the signature of fn, so the step is registered exactly as Fn(fn) would be. The worker
+	// passes an empty input to the function, so the input is decoded from the workflow input instead.
+	wrapped := func(ctx HatchetContext, _ *In) (*Out, error) {
+		input := new(In)
+
+		if err := ctx.WorkflowInput(input); err != nil {
+			return nil, fmt.Errorf("could not decode input of task %s: %w", name, err)
+		}
+
+		return fn(ctx, input)
+	}
+
+	return &Task[In, Out]{
+		step: Fn(wrapped).SetName(name),
+	}
+}
+
+// Name returns the name of the task, which is also its step id.
+func (t *Task[In, Out]) Name() string {
+	return t.step.Name
+}
+
+// Step returns the underlying step, which can be used to configure retries, timeouts, rate limits and other
+// options of the task.
+func (t *Task[In, Out]) Step() *WorkflowStep {
+	return t.step
+}
+
+func (t *Task[In, Out]) taskInput(*In) {}
+
+// AddParents adds tasks which must complete before the task runs. The outputs of the parents can be read with
+// ParentOutput.
+func (t *Task[In, Out]) AddParents(parents ...TaskOf[In]) *Task[In, Out] {
+	for _, parent := range parents {
+		t.step.AddParents(parent.Name())
+	}
+
+	return t
+}
+
+// Output reads the output of the task from the result of a workflow run.
+func (t *Task[In, Out]) Output(res *client.WorkflowResult) (*Out, error) {
+	out := new(Out)
+
+	if err := res.StepOutput(t.Name(), out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// RunNoWait triggers a run of the task, which must be registered on its own with RegisterWorkflow.
+func (t *Task[In, Out]) RunNoWait(c client.Client, input In, opts ...client.RunOptFunc) (*TaskRun[In, Out], error) {
+	run, err := c.Admin().RunWorkflow(t.Name(), input, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskRun[In, Out]{
+		task: t,
+		run:  run,
+	}, nil
+}
+
+// Run triggers a run of the task, which must be registered on its own with RegisterWorkflow, and waits for its
+// output.
+func (t *Task[In, Out]) Run(c client.Client, input In, opts ...client.RunOptFunc) (*Out, error) {
+	run, err := t.RunNoWait(c, input, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return run.Result()
+}
+
+func (t *Task[In, Out]) ToWorkflow(svcName string, namespace string) types.Workflow {
+	return t.step.ToWorkflow(svcName, namespace)
+}
+
+func (t *Task[In, Out]) ToActionMap(svcName string) ActionMap {
+	return t.step.ToActionMap(svcName)
+}
+
+func (t *Task[In, Out]) ToWorkflowTrigger() triggerConverter {
+	return t.step.ToWorkflowTrigger()
+}
+
+// TaskRun is a run of a Task triggered with RunNoWait.
+type TaskRun[In, Out any] struct {
+	task *Task[In, Out]
+	run  *client.Workflow
+}
+
+func (r *TaskRun[In, Out]) WorkflowRunId() string {
+	return r.run.WorkflowRunId()
+}
+
+// Result waits for the run to complete and returns the output of the task.
+func (r *TaskRun[In, Out]) Result() (*Out, error) {
+	res, err := r.run.Result()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.task.Output(res)
+}
+
+// ParentOutput reads the output of a parent task from the context of a running task.
+func ParentOutput[In, Out any](ctx HatchetContext, parent *Task[In, Out]) (*Out, error) {
+	out := new(Out)
+
+	if err := ctx.StepOutput(parent.Name(), out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// TypedWorkflow is a workflow with a typed input, created with DefineWorkflow.
+type TypedWorkflow[In any] struct {
+	job *WorkflowJob
+}
+
+// DefineWorkflow creates a workflow with a typed input from a job. Tasks are added to the workflow with
+// AddTasks, after any steps which are already part of the job.
+func DefineWorkflow[In any](job *WorkflowJob) *TypedWorkflow[In] {
+	return &TypedWorkflow[In]{
+		job: job,
+	}
+}
+
+// AddTasks adds tasks to the workflow. Parents must be added before their children.
+func (w *TypedWorkflow[In]) AddTasks(tasks ...TaskOf[In]) *TypedWorkflow[In] {
+	for _, task := range tasks {
+		w.job.Steps = append(w.job.Steps, task.Step())
+	}
+
+	return w
+}
+
+// Job returns the underlying job of the workflow.
+func (w *TypedWorkflow[In]) Job() *WorkflowJob {
+	return w.job
+}
+
+// RunNoWait triggers a run of the workflow. The outputs of its tasks can be read with the Output method of
+// each task once the run has completed.
+func (w *TypedWorkflow[In]) RunNoWait(c client.Client, input In, opts ...client.RunOptFunc) (*client.Workflow, error) {
+	return c.Admin().RunWorkflow(w.job.Name, input, opts...)
+}
+
+// Run triggers a run of the workflow and waits for it to complete.
+func (w *TypedWorkflow[In]) Run(c client.Client, input In, opts ...client.RunOptFunc) (*client.WorkflowResult, error) {
+	run, err := w.RunNoWait(c, input, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return run.Result()
+}
+
+func (w *TypedWorkflow[In]) ToWorkflow(svcName string, namespace string) types.Workflow {
+	return w.job.ToWorkflow(svcName, namespace)
+}
+
+func (w *TypedWorkflow[In]) ToActionMap(svcName string) ActionMap {
+	return w.job.ToActionMap(svcName)
+}
+
+func (w *TypedWorkflow[In]) ToWorkflowTrigger() triggerConverter {
+	return w.job.ToWorkflowTrigger()
+}
+
+func (w *TypedWorkflow[In]) workflowJob() *WorkflowJob {
+	return w.job
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedTestContext struct {
+	HatchetContext
+
+	input   map[string]interface{}
+	parents map[string]StepData
+}
+
+func (c *typedTestContext) WorkflowInput(target interface{}) error {
+	return toTarget(c.input, target)
+}
+
+func (c *typedTestContext) StepOutput(step string, target interface{}) error {
+	return toTarget(c.parents[step], target)
+}
+
+func TestTypedWorkflowToWorkflow(t *testing.T) {
+	stepOne := DefineTask("step-one", func(ctx HatchetContext, input *actionInput) (*stepOneOutput, error) {
+		return nil, nil
+	})
+
+	stepTwo := DefineTask("step-two", func(ctx HatchetContext, input *actionInput) (*stepTwoOutput, error) {
+		return nil, nil
+	}).AddParents(stepOne)
+
+	stepTwo.Step().SetRetries(3)
+
+	typed := DefineWorkflow[actionInput](&WorkflowJob{
+		Name:        "typed",
+		Description: "typed",
+		On:          Events("user:create"),
+	}).AddTasks(stepOne, stepTwo)
+
+	untyped := &WorkflowJob{
+		Name:        "typed",
+		Description: "typed",
+		On:          Events("user:create"),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx HatchetContext, input *actionInput) (*stepOneOutput, error) {
+				return nil, nil
+			}).SetName("step-one"),
+			Fn(func(ctx HatchetContext, input *actionInput) (*stepTwoOutput, error) {
+				return nil, nil
+			}).SetName("step-two").AddParents("step-one").SetRetries(3),
+		},
+	}
+
+	assert.Equal(t, untyped.ToWorkflow("default", ""), typed.ToWorkflow("default", ""))
+
+	actions := typed.ToActionMap("default")
+
+	assert.Contains(t, actions, "default:step-one")
+	assert.Contains(t, actions, "default:step-two")
+}
+
+func TestTypedTaskToWorkflow(t *testing.T) {
+	task := DefineTask("standalone", func(ctx HatchetContext, input *actionInput) (*stepOneOutput, error) {
+		return nil, nil
+	})
+
+	wf := task.ToWorkflow("default", "ns_")
+
+	assert.Equal(t, "ns_standalone", wf.Name)
+	assert.Equal(t, "default:standalone", wf.Jobs["standalone"].Steps[0].ActionID)
+}
+
+func TestTypedTaskInputAndParentOutput(t *testing.T) {
+	stepOne := DefineTask("step-one", func(ctx HatchetContext, input *actionInput) (*stepOneOutput, error) {
+		return &stepOneOutput{Message: input.Message}, nil
+	})
+
+	stepTwo := DefineTask("step-two", func(ctx HatchetContext, input *actionInput) (*stepTwoOutput, error) {
+		parent, err := ParentOutput(ctx, stepOne)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &stepTwoOutput{Message: parent.Message + " " + input.Message}, nil
+	}).AddParents(stepOne)
+
+	ctx := &typedTestContext{
+		input: map[string]interface{}{"message": "world"},
+		parents: map[string]StepData{
+			"step-one": {"message": "hello"},
+		},
+	}
+
+	fn, err := getFnFromMethod(stepTwo.Step().Function)
+	require.NoError(t, err)
+
+	res := fn(ctx, &actionInput{})
+	require.Nil(t, res[1])
+
+	out, err := json.Marshal(res[0])
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"message": "hello world"}`, string(out))
+}
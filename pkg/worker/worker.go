@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -99,11 +100,30 @@ type Worker struct {
 
 	maxRuns *int
 
+	slotPools map[string]int
+
 	initActionNames []string
 
 	labels map[string]interface{}
 
 	id *string
+
+	// running is the number of actions which are currently running on the worker
+	running atomic.Int64
+
+	// parked contains the step runs which are running on the worker but are parked in a durable wait,
+	// keyed by step run id. Parked step runs do not hold a slot, so they don't keep the worker from draining.
+	parkedMu sync.Mutex
+	parked   map[string]struct{}
+
+	listenerMu sync.Mutex
+	listener   client.WorkerActionListener
+
+	// drained is closed to stop a cordoned worker once its running actions have finished
+	drained chan struct{}
+
+	// stopped is closed once the worker has unsubscribed
+	stopped chan struct{}
 }
 
 type WorkerOpt func(*WorkerOpts)
@@ -116,6 +136,7 @@ type WorkerOpts struct {
 	integrations []integrations.Integration
 	alerter      errors.Alerter
 	maxRuns      *int
+	slotPools    map[string]int
 
 	actions []string
 
@@ -183,6 +204,15 @@ func WithMaxRuns(maxRuns int) WorkerOpt {
 	}
 }
 
+// WithSlotPools adds named slot pools to the worker, mapped to the maximum number of runs of each pool, for
+// example {"cpu": 2, "io": 20}. Steps which set a slot pool with SetSlotPool only use slots from that pool,
+// while other steps use the slots set with WithMaxRuns.
+func WithSlotPools(pools map[string]int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.slotPools = pools
+	}
+}
+
 func WithLabels(labels map[string]interface{}) WorkerOpt {
 	return func(opts *WorkerOpts) {
 		opts.labels = labels
@@ -221,6 +251,7 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 		alerter:              opts.alerter,
 		middlewares:          mws,
 		maxRuns:              opts.maxRuns,
+		slotPools:            opts.slotPools,
 		initActionNames:      opts.actions,
 		labels:               opts.labels,
 		registered_workflows: map[string]bool{},
@@ -268,12 +299,16 @@ func (w *Worker) NewService(name string) *Service {
 }
 
 func (w *Worker) RegisterWorkflow(workflow workflowConverter) error {
+	if typed, ok := workflow.(interface{ workflowJob() *WorkflowJob }); ok {
+		workflow = typed.workflowJob()
+	}
+
 	wf, ok := workflow.(*WorkflowJob)
 	if ok && wf.On == nil {
 		return fmt.Errorf("workflow must have an trigger defined via the `On` field")
 	}
 
-	w.registered_workflows[wf.Name] = true
+	w.registered_workflows[workflow.ToWorkflow("", "").Name] = true
 
 	return w.On(workflow.ToWorkflowTrigger(), workflow)
 }
@@ -407,6 +442,7 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 		Actions:    actionNames,
 		MaxRuns:    w.maxRuns,
 		Labels:     w.labels,
+		SlotPools:  w.slotPools,
 	})
 
 	w.id = id
@@ -415,6 +451,10 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 		return fmt.Errorf("could not get action listener: %w", err)
 	}
 
+	drained, stopped := w.setListener(listener)
+
+	defer close(stopped)
+
 	defer func() {
 		err := listener.Unregister()
 
@@ -441,7 +481,11 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 					return
 				}
 
+				w.running.Add(1)
+
 				go func(action *client.Action) {
+					defer w.running.Add(-1)
+
 					err := w.executeAction(context.Background(), action)
 
 					if err != nil {
@@ -457,16 +501,183 @@ func (w *Worker) startBlocking(ctx context.Context) error {
 		}
 	}()
 
+	// the worker can be cordoned by the engine, i.e. through the API, in which case we stop the worker
+	// once the running actions have finished
+	go func() {
+		select {
+		case <-listener.Cordoned():
+			w.l.Info().Msgf("worker %s is cordoned, waiting for running actions to finish", w.name)
+
+			parked, err := w.waitForDrained(ctx, listener)
+
+			if err != nil {
+				return
+			}
+
+			if len(parked) > 0 {
+				w.l.Warn().Msgf("worker %s is drained with step runs parked in a durable wait: %s", w.name, strings.Join(parked, ", "))
+			}
+
+			w.stopDrained()
+		case <-ctx.Done():
+		}
+	}()
+
 	select {
 	case <-ctx.Done():
 		w.l.Debug().Msgf("worker %s received context done, stopping", w.name)
 		return nil
+	case <-drained:
+		w.l.Info().Msgf("worker %s is drained, stopping", w.name)
+		return nil
 	case err := <-errCh:
 		w.l.Error().Err(err).Msg("error from listener")
 		return err
 	}
 }
 
+// Drain cordons the worker so the engine stops assigning new runs to it, waits for the running actions to
+// finish, and stops the worker. The worker is only stopped once the engine confirms that no runs are assigned
+// to it anymore, so runs which were assigned to the worker right before it was cordoned are not lost. This is
+// meant for zero-downtime deploys, i.e. in a Kubernetes preStop hook, since runs do not have to be reassigned
+// to other workers. If the context is cancelled before the worker is drained, the worker keeps running but is
+// not assigned new runs.
+//
+// Step runs which are parked in a durable wait, i.e. SleepFor or WaitForEvent, do not block the drain since
+// their wait may not be satisfied for a long time. They are not resumed by this worker once it is stopped, and
+// are returned as a *ParkedStepRunsError after the worker has stopped.
+func (w *Worker) Drain(ctx context.Context) error {
+	w.listenerMu.Lock()
+	listener := w.listener
+	stopped := w.stopped
+	w.listenerMu.Unlock()
+
+	if listener == nil {
+		return fmt.Errorf("worker %s is not running", w.name)
+	}
+
+	if err := listener.Cordon(ctx); err != nil {
+		return fmt.Errorf("could not cordon worker: %w", err)
+	}
+
+	parked, err := w.waitForDrained(ctx, listener)
+
+	if err != nil {
+		return err
+	}
+
+	w.stopDrained()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-stopped:
+	}
+
+	if len(parked) > 0 {
+		return &ParkedStepRunsError{
+			StepRunIds: parked,
+		}
+	}
+
+	return nil
+}
+
+// ParkedStepRunsError is returned by Drain when step runs were parked in a durable wait while the worker was
+// drained.
+type ParkedStepRunsError struct {
+	StepRunIds []string
+}
+
+func (e *ParkedStepRunsError) Error() string {
+	return fmt.Sprintf("worker was drained with %d step runs parked in a durable wait: %s", len(e.StepRunIds), strings.Join(e.StepRunIds, ", "))
+}
+
+func (w *Worker) setListener(listener client.WorkerActionListener) (drained chan struct{}, stopped chan struct{}) {
+	w.listenerMu.Lock()
+	defer w.listenerMu.Unlock()
+
+	w.listener = listener
+	w.drained = make(chan struct{})
+	w.stopped = make(chan struct{})
+
+	return w.drained, w.stopped
+}
+
+func (w *Worker) stopDrained() {
+	w.listenerMu.Lock()
+	defer w.listenerMu.Unlock()
+
+	select {
+	case <-w.drained:
+	default:
+		close(w.drained)
+	}
+}
+
+// waitForDrained waits until the engine reports that the worker is drained and the worker has no running
+// actions, apart from the step runs which are parked in a durable wait. Actions which were assigned to the
+// worker before it was cordoned may still arrive until the worker is drained. It returns the ids of the parked
+// step runs.
+func (w *Worker) waitForDrained(ctx context.Context, listener client.WorkerActionListener) ([]string, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-listener.Drained():
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		parked := w.parkedStepRuns()
+
+		if w.running.Load() <= int64(len(parked)) {
+			return parked, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// park marks a step run as parked in a durable wait until the returned function is called.
+func (w *Worker) park(stepRunId string) (unpark func()) {
+	w.parkedMu.Lock()
+	defer w.parkedMu.Unlock()
+
+	if w.parked == nil {
+		w.parked = make(map[string]struct{})
+	}
+
+	w.parked[stepRunId] = struct{}{}
+
+	return func() {
+		w.parkedMu.Lock()
+		defer w.parkedMu.Unlock()
+
+		delete(w.parked, stepRunId)
+	}
+}
+
+func (w *Worker) parkedStepRuns() []string {
+	w.parkedMu.Lock()
+	defer w.parkedMu.Unlock()
+
+	res := make([]string, 0, len(w.parked))
+
+	for stepRunId := range w.parked {
+		res = append(res, stepRunId)
+	}
+
+	slices.Sort(res)
+
+	return res
+}
+
 func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Action) error {
 	switch assignedAction.ActionType {
 	case client.ActionTypeStartStepRun:
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/client/compute"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
@@ -124,6 +125,35 @@ func (e eventsArr) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace stri
 	}
 }
 
+type filteredEvent struct {
+	key        string
+	expression string
+}
+
+// FilteredEvent triggers the workflow on an event only if the event matches the CEL expression. The expression
+// has access to the event payload as `input`, as well as `additional_metadata` and `event_key`.
+func FilteredEvent(e string, expression string) filteredEvent {
+	return filteredEvent{
+		key:        e,
+		expression: expression,
+	}
+}
+
+func (e filteredEvent) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string) {
+	if wt.Events == nil {
+		wt.Events = []string{}
+	}
+
+	if wt.EventFilters == nil {
+		wt.EventFilters = map[string]string{}
+	}
+
+	key := namespace + e.key
+
+	wt.Events = append(wt.Events, key)
+	wt.EventFilters[key] = e.expression
+}
+
 type workflowConverter interface {
 	ToWorkflow(svcName string, namespace string) types.Workflow
 	ToActionMap(svcName string) ActionMap
@@ -161,6 +191,7 @@ type WorkflowConcurrency struct {
 	expr          *string
 	maxRuns       *int32
 	limitStrategy *types.WorkflowConcurrencyLimitStrategy
+	weightExpr    *string
 }
 
 func Expression(expr string) *WorkflowConcurrency {
@@ -185,6 +216,14 @@ func (c *WorkflowConcurrency) LimitStrategy(limitStrategy types.WorkflowConcurre
 	return c
 }
 
+// WeightExpression sets a CEL expression which returns the weight of a concurrency key for the
+// WeightedRoundRobin strategy, for example `key == "tier=gold" ? 3 : 1`. A key with a weight of N can run N
+// times MaxRuns at the same time. Weights set for the tenant take precedence over the expression.
+func (c *WorkflowConcurrency) WeightExpression(expr string) *WorkflowConcurrency {
+	c.weightExpr = &expr
+	return c
+}
+
 func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflow {
 	apiJob, err := j.ToWorkflowJob(svcName, namespace)
 
@@ -232,6 +271,10 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 		if j.Concurrency.limitStrategy != nil {
 			w.Concurrency.LimitStrategy = *j.Concurrency.limitStrategy
 		}
+
+		if j.Concurrency.weightExpr != nil {
+			w.Concurrency.WeightExpression = j.Concurrency.weightExpr
+		}
 	}
 
 	if j.StickyStrategy != nil {
@@ -326,6 +369,54 @@ type WorkflowStep struct {
 	DesiredLabels map[string]*types.DesiredWorkerLabel
 
 	Compute *compute.Compute
+
+	conditionGroups []stepConditionGroup
+
+	skipIf string
+
+	mapExpr string
+
+	mapMaxParallelism int
+
+	concurrency []*WorkflowConcurrency
+
+	slots int
+
+	slotPool string
+}
+
+// StepCondition is a condition which a step waits on before it is queued, skipped or cancelled. Conditions
+// are created with SleepCondition or UserEventCondition.
+type StepCondition struct {
+	sleepFor *time.Duration
+
+	eventKey   *string
+	expression string
+}
+
+// SleepCondition is satisfied once the duration has passed since the step's conditions were registered, which
+// is when all of the step's parents have completed if the step waits on the condition with WaitFor, and when
+// the workflow run was triggered otherwise. The data of the condition is available to the step with StepOutput
+// under the key "sleep_<duration>", for example "sleep_30s".
+func SleepCondition(duration time.Duration) StepCondition {
+	return StepCondition{
+		sleepFor: &duration,
+	}
+}
+
+// UserEventCondition is satisfied by a user event with the given key which is pushed after the step's conditions
+// were registered (see SleepCondition). If expression is not empty, the event payload must satisfy the CEL
+// expression. The event payload is available to the step with StepOutput under the event key.
+func UserEventCondition(eventKey, expression string) StepCondition {
+	return StepCondition{
+		eventKey:   &eventKey,
+		expression: expression,
+	}
+}
+
+type stepConditionGroup struct {
+	action     types.StepConditionAction
+	conditions []StepCondition
 }
 
 type RateLimit struct {
@@ -337,6 +428,10 @@ type RateLimit struct {
 	Units          *int    `yaml:"units,omitempty"`
 	UnitsExpr      *string `yaml:"unitsExpr,omitempty"`
 	LimitValueExpr *string `yaml:"limitValueExpr,omitempty"`
+
+	// ParentKey is the key of the parent rate limit for dynamic rate limits. Consuming units of this
+	// rate limit also consumes units of the parent.
+	ParentKey *string `yaml:"parentKey,omitempty"`
 }
 
 func Fn(f any) *WorkflowStep {
@@ -392,6 +487,79 @@ func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	return w
 }
 
+// WaitFor queues the step once all of its parents have completed and any of the conditions is satisfied. The
+// step's conditions are only registered once its parents have completed, so a sleep is measured from that point.
+// If WaitFor is called multiple times, one condition from each call must be satisfied.
+func (w *WorkflowStep) WaitFor(conditions ...StepCondition) *WorkflowStep {
+	return w.addConditionGroup(types.StepConditionActionQueue, conditions)
+}
+
+// SkipOn skips the step if any of the conditions is satisfied before the step is queued. If SkipOn is called
+// multiple times, one condition from each call must be satisfied.
+func (w *WorkflowStep) SkipOn(conditions ...StepCondition) *WorkflowStep {
+	return w.addConditionGroup(types.StepConditionActionSkip, conditions)
+}
+
+// CancelOn cancels the step if any of the conditions is satisfied before the step is queued. If CancelOn is
+// called multiple times, one condition from each call must be satisfied.
+func (w *WorkflowStep) CancelOn(conditions ...StepCondition) *WorkflowStep {
+	return w.addConditionGroup(types.StepConditionActionCancel, conditions)
+}
+
+// SkipIf skips the step if the CEL expression evaluates to true when the step would be queued. The expression
+// can reference the workflow input with `input`, the additional metadata with `additional_metadata` and the
+// outputs of the step's parents with `parents`, for example `parents["step-one"].status == "done"`. The
+// children of a skipped step are also skipped.
+func (w *WorkflowStep) SkipIf(expr string) *WorkflowStep {
+	w.skipIf = expr
+	return w
+}
+
+// Map runs the step once for each item of the list returned by the CEL expression, once the step's parents
+// have completed. The expression can reference the same variables as SkipIf. Each run receives the workflow
+// input with the additional `item` and `index` fields as its input, and at most maxParallelism items run at
+// the same time, unless maxParallelism is 0. The children of a map step receive the outputs of the items in
+// order, in the form `{"outputs": [...]}`.
+func (w *WorkflowStep) Map(expr string, maxParallelism int) *WorkflowStep {
+	w.mapExpr = expr
+	w.mapMaxParallelism = maxParallelism
+	return w
+}
+
+// AddConcurrency limits the number of concurrent runs of the step. Limits must be created with Expression,
+// which is evaluated against the step run and can reference the workflow input with `input` and the
+// additional metadata with `additional_metadata`. If AddConcurrency is called multiple times, a step run must
+// acquire a slot from every limit before it runs.
+func (w *WorkflowStep) AddConcurrency(concurrency ...*WorkflowConcurrency) *WorkflowStep {
+	w.concurrency = append(w.concurrency, concurrency...)
+	return w
+}
+
+// SetSlots sets the number of worker slots which a run of the step uses, for steps which need more resources
+// than other steps. A run is only assigned to a worker with enough free slots. Steps use one slot by default.
+func (w *WorkflowStep) SetSlots(slots int) *WorkflowStep {
+	w.slots = slots
+	return w
+}
+
+// SetSlotPool sets the named worker slot pool which a run of the step uses slots from. A run is only assigned
+// to workers which registered the slot pool with WithSlotPools.
+func (w *WorkflowStep) SetSlotPool(pool string) *WorkflowStep {
+	w.slotPool = pool
+	return w
+}
+
+func (w *WorkflowStep) addConditionGroup(action types.StepConditionAction, conditions []StepCondition) *WorkflowStep {
+	if len(conditions) > 0 {
+		w.conditionGroups = append(w.conditionGroups, stepConditionGroup{
+			action:     action,
+			conditions: conditions,
+		})
+	}
+
+	return w
+}
+
 func (w *WorkflowStep) ToWorkflowTrigger() triggerConverter {
 	return NoTrigger()
 }
@@ -454,6 +622,30 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 		RetryMaxBackoffSeconds: w.RetryMaxBackoffSeconds,
 	}
 
+	if w.skipIf != "" {
+		res.APIStep.SkipIf = &w.skipIf
+	}
+
+	if w.mapExpr != "" {
+		res.APIStep.Map = &types.StepMap{
+			Expression: w.mapExpr,
+		}
+
+		if w.mapMaxParallelism > 0 {
+			maxParallelism := int32(w.mapMaxParallelism) // nolint: gosec
+			res.APIStep.Map.MaxParallelism = &maxParallelism
+		}
+	}
+
+	if w.slots > 0 {
+		slots := int32(w.slots) // nolint: gosec
+		res.APIStep.Slots = &slots
+	}
+
+	if w.slotPool != "" {
+		res.APIStep.SlotPool = &w.slotPool
+	}
+
 	for _, rateLimit := range w.RateLimit {
 		res.APIStep.RateLimits = append(res.APIStep.RateLimits, types.RateLimit{
 			Key:            rateLimit.Key,
@@ -461,9 +653,68 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 			Units:          rateLimit.Units,
 			UnitsExpr:      rateLimit.UnitsExpr,
 			LimitValueExpr: rateLimit.LimitValueExpr,
+			ParentKey:      rateLimit.ParentKey,
 		})
 	}
 
+	for _, concurrency := range w.concurrency {
+		if concurrency.expr == nil {
+			return nil, fmt.Errorf("concurrency limit for step %s must be created with an expression", res.Id)
+		}
+
+		apiConcurrency := types.StepConcurrency{
+			Expression: *concurrency.expr,
+		}
+
+		if concurrency.maxRuns != nil {
+			apiConcurrency.MaxRuns = *concurrency.maxRuns
+		}
+
+		if concurrency.limitStrategy != nil {
+			apiConcurrency.LimitStrategy = *concurrency.limitStrategy
+		}
+
+		if concurrency.weightExpr != nil {
+			apiConcurrency.WeightExpression = concurrency.weightExpr
+		}
+
+		res.APIStep.Concurrency = append(res.APIStep.Concurrency, apiConcurrency)
+	}
+
+	for i, group := range w.conditionGroups {
+		// group ids are derived from the order of the groups so that the workflow checksum is stable
+		orGroupId := fmt.Sprintf("%d", i)
+
+		for _, condition := range group.conditions {
+			apiCondition := types.StepCondition{
+				OrGroupId: orGroupId,
+				Action:    group.action,
+			}
+
+			switch {
+			case condition.sleepFor != nil:
+				sleepFor := client.DurationToString(*condition.sleepFor)
+
+				apiCondition.ReadableDataKey = "sleep_" + sleepFor
+				apiCondition.SleepFor = &sleepFor
+			case condition.eventKey != nil:
+				eventKey := namespace + *condition.eventKey
+
+				apiCondition.ReadableDataKey = *condition.eventKey
+				apiCondition.EventKey = &eventKey
+
+				if condition.expression != "" {
+					expression := condition.expression
+					apiCondition.Expression = &expression
+				}
+			default:
+				return nil, fmt.Errorf("condition for step %s must be a sleep or user event condition", res.Id)
+			}
+
+			res.APIStep.Conditions = append(res.APIStep.Conditions, apiCondition)
+		}
+	}
+
 	inputs, err := decodeFnArgTypes(fnType)
 
 	if err != nil {
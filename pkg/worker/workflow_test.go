@@ -3,8 +3,11 @@ package worker
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 func namedFunction() {}
@@ -73,3 +76,194 @@ func TestFnToWorkflow(t *testing.T) {
 
 	assert.Equal(t, "TestFnToWorkflow-func1", workflow.Name)
 }
+
+func TestFilteredEventToWorkflowTriggers(t *testing.T) {
+	triggers := &types.WorkflowTriggers{}
+
+	FilteredEvent("order:updated", `input.status == "shipped"`).ToWorkflowTriggers(triggers, "ns_")
+
+	assert.Equal(t, []string{"ns_order:updated"}, triggers.Events)
+	assert.Equal(t, map[string]string{"ns_order:updated": `input.status == "shipped"`}, triggers.EventFilters)
+}
+
+func TestStepConditionsToWorkflowStep(t *testing.T) {
+	step := Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}).SetName("approve").
+		AddParents("prepare").
+		WaitFor(SleepCondition(time.Hour), UserEventCondition("order:approved", `input.approved == true`)).
+		CancelOn(UserEventCondition("order:cancelled", ""))
+
+	res, err := step.ToWorkflowStep("default", 0, "ns_")
+
+	assert.NoError(t, err)
+
+	sleepFor := "3600s"
+	approvedKey := "ns_order:approved"
+	approvedExpr := `input.approved == true`
+	cancelledKey := "ns_order:cancelled"
+
+	assert.Equal(t, []types.StepCondition{
+		{
+			OrGroupId:       "0",
+			Action:          types.StepConditionActionQueue,
+			ReadableDataKey: "sleep_3600s",
+			SleepFor:        &sleepFor,
+		},
+		{
+			OrGroupId:       "0",
+			Action:          types.StepConditionActionQueue,
+			ReadableDataKey: "order:approved",
+			EventKey:        &approvedKey,
+			Expression:      &approvedExpr,
+		},
+		{
+			OrGroupId:       "1",
+			Action:          types.StepConditionActionCancel,
+			ReadableDataKey: "order:cancelled",
+			EventKey:        &cancelledKey,
+		},
+	}, res.APIStep.Conditions)
+}
+
+func TestStepSkipIfToWorkflowStep(t *testing.T) {
+	step := Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}).SetName("notify").
+		AddParents("prepare").
+		SkipIf(`input.notify == false`)
+
+	res, err := step.ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, res.APIStep.SkipIf) {
+		assert.Equal(t, `input.notify == false`, *res.APIStep.SkipIf)
+	}
+
+	res, err = Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("no-skip").ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, res.APIStep.SkipIf)
+}
+
+func TestStepMapToWorkflowStep(t *testing.T) {
+	step := Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("process-file").
+		AddParents("list-files").
+		Map(`parents["list-files"].files`, 5)
+
+	res, err := step.ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, res.APIStep.Map) {
+		assert.Equal(t, `parents["list-files"].files`, res.APIStep.Map.Expression)
+
+		if assert.NotNil(t, res.APIStep.Map.MaxParallelism) {
+			assert.Equal(t, int32(5), *res.APIStep.Map.MaxParallelism)
+		}
+	}
+
+	res, err = Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("unbounded").Map(`input.items`, 0).ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, res.APIStep.Map) {
+		assert.Nil(t, res.APIStep.Map.MaxParallelism)
+	}
+}
+
+func TestStepSlotsToWorkflowStep(t *testing.T) {
+	res, err := Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("embed").SetSlots(4).ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, res.APIStep.Slots) {
+		assert.Equal(t, int32(4), *res.APIStep.Slots)
+	}
+
+	res, err = Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("default-slots").ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, res.APIStep.Slots)
+}
+
+func TestStepSlotPoolToWorkflowStep(t *testing.T) {
+	res, err := Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("resize").SetSlotPool("cpu").ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, res.APIStep.SlotPool) {
+		assert.Equal(t, "cpu", *res.APIStep.SlotPool)
+	}
+}
+
+func TestStepConcurrencyToWorkflowStep(t *testing.T) {
+	step := Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("charge-card").
+		AddConcurrency(
+			Expression("input.user_id").MaxRuns(2).LimitStrategy(types.GroupRoundRobin),
+			Expression("'global'"),
+		)
+
+	res, err := step.ToWorkflowStep("default", 0, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []types.StepConcurrency{
+		{
+			Expression:    "input.user_id",
+			MaxRuns:       2,
+			LimitStrategy: types.GroupRoundRobin,
+		},
+		{
+			Expression: "'global'",
+		},
+	}, res.APIStep.Concurrency)
+
+	_, err = Fn(func(ctx context.Context) error {
+		return nil
+	}).SetName("fn-concurrency").AddConcurrency(Concurrency(func(ctx HatchetContext) (string, error) {
+		return "key", nil
+	})).ToWorkflowStep("default", 0, "")
+
+	assert.Error(t, err)
+}
+
+func TestWeightedConcurrencyToWorkflow(t *testing.T) {
+	testJob := WorkflowJob{
+		Name: "weighted",
+		Concurrency: Expression("input.tier").
+			MaxRuns(2).
+			LimitStrategy(types.WeightedRoundRobin).
+			WeightExpression(`key == "gold" ? 3 : 1`),
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context) error {
+				return nil
+			}).SetName("step-one"),
+		},
+	}
+
+	workflow := testJob.ToWorkflow("default", "")
+
+	if assert.NotNil(t, workflow.Concurrency) {
+		assert.Equal(t, types.WeightedRoundRobin, workflow.Concurrency.LimitStrategy)
+		assert.Equal(t, int32(2), workflow.Concurrency.MaxRuns)
+
+		if assert.NotNil(t, workflow.Concurrency.WeightExpression) {
+			assert.Equal(t, `key == "gold" ? 3 : 1`, *workflow.Concurrency.WeightExpression)
+		}
+	}
+}
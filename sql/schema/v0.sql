@@ -4,7 +4,8 @@ CREATE TYPE "ConcurrencyLimitStrategy" AS ENUM (
     'DROP_NEWEST', -- DEPRECATED
     'QUEUE_NEWEST', -- DEPRECATED
     'GROUP_ROUND_ROBIN',
-    'CANCEL_NEWEST'
+    'CANCEL_NEWEST',
+    'WEIGHTED_ROUND_ROBIN'
 );
 
 
@@ -48,14 +49,24 @@ CREATE TYPE "LimitResource" AS ENUM (
 -- CreateEnum
 CREATE TYPE "LogLineLevel" AS ENUM ('DEBUG', 'INFO', 'WARN', 'ERROR');
 
+-- CreateEnum
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'TOKEN_BUCKET', 'SLIDING_WINDOW');
+
 -- CreateEnum
 CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
     'DYNAMIC_RATE_LIMIT_VALUE',
     'DYNAMIC_RATE_LIMIT_UNITS',
-    'DYNAMIC_RATE_LIMIT_WINDOW'
+    'DYNAMIC_RATE_LIMIT_WINDOW',
+    'DYNAMIC_RATE_LIMIT_PARENT'
 );
 
+-- CreateEnum
+CREATE TYPE "StepMatchConditionAction" AS ENUM ('QUEUE', 'SKIP', 'CANCEL');
+
+-- CreateEnum
+CREATE TYPE "StepMatchConditionKind" AS ENUM ('SLEEP', 'USER_EVENT');
+
 -- CreateEnum
 CREATE TYPE "StepRateLimitKind" AS ENUM ('STATIC', 'DYNAMIC');
 
@@ -360,7 +371,13 @@ CREATE TABLE "RateLimit" (
     "limitValue" INTEGER NOT NULL,
     "value" INTEGER NOT NULL,
     "window" TEXT NOT NULL,
-    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "parentKey" TEXT,
+    "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    -- the capacity of token bucket rate limits, defaults to the limit value
+    "burst" INTEGER,
+    -- the units consumed in the previous window of sliding window rate limits
+    "previousUsed" INTEGER
 );
 
 -- CreateTable
@@ -448,10 +465,33 @@ CREATE TABLE "Step" (
     -- the maximum amount of time in seconds to wait between retries
     "retryMaxBackoff" INTEGER,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    -- a CEL expression which, when it evaluates to true, skips the step
+    "skipIf" TEXT,
+    -- a CEL expression which returns the list of items that a map step creates a task for
+    "mapExpression" TEXT,
+    -- the maximum number of items of a map step which run at the same time
+    "mapMaxParallelism" INTEGER,
+    -- the number of worker slots which a run of the step uses
+    "slots" INTEGER NOT NULL DEFAULT 1,
+    -- the name of the worker slot pool which a run of the step uses slots from, or NULL for the default pool
+    "slotPool" TEXT,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "StepConcurrency" (
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "stepId" UUID NOT NULL,
+    "expression" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL DEFAULT 1,
+    "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
+    "weightExpression" TEXT,
+
+    CONSTRAINT "StepConcurrency_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "StepDesiredWorkerLabel" (
     "id" BIGSERIAL NOT NULL,
@@ -478,6 +518,22 @@ CREATE TABLE "StepExpression" (
     CONSTRAINT "StepExpression_pkey" PRIMARY KEY ("key","stepId","kind")
 );
 
+-- CreateTable
+CREATE TABLE "StepMatchCondition" (
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "stepId" UUID NOT NULL,
+    "orGroupId" TEXT NOT NULL,
+    "action" "StepMatchConditionAction" NOT NULL,
+    "kind" "StepMatchConditionKind" NOT NULL,
+    "readableDataKey" TEXT NOT NULL,
+    "sleepDuration" TEXT,
+    "eventKey" TEXT,
+    "expression" TEXT,
+
+    CONSTRAINT "StepMatchCondition_pkey" PRIMARY KEY ("id")
+);
+
 -- CreateTable
 CREATE TABLE "StepRateLimit" (
     "units" INTEGER NOT NULL,
@@ -847,6 +903,8 @@ CREATE TABLE "Worker" (
     "os" TEXT,
     "runtimeExtra" TEXT,
     "sdkVersion" TEXT,
+    -- a cordoned worker is not assigned new runs, and unsubscribes once its running tasks are finished
+    "isCordoned" BOOLEAN NOT NULL DEFAULT false,
 
     CONSTRAINT "Worker_pkey" PRIMARY KEY ("id")
 );
@@ -873,6 +931,15 @@ CREATE TABLE "WorkerLabel" (
     CONSTRAINT "WorkerLabel_pkey" PRIMARY KEY ("id")
 );
 
+-- CreateTable
+CREATE TABLE "WorkerSlotPool" (
+    "workerId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkerSlotPool_pkey" PRIMARY KEY ("workerId", "name")
+);
+
 -- CreateTable
 CREATE TABLE "Workflow" (
     "id" UUID NOT NULL,
@@ -897,6 +964,7 @@ CREATE TABLE "WorkflowConcurrency" (
     "maxRuns" INTEGER NOT NULL DEFAULT 1,
     "limitStrategy" "ConcurrencyLimitStrategy" NOT NULL DEFAULT 'CANCEL_IN_PROGRESS',
     "concurrencyGroupExpression" TEXT,
+    "weightExpression" TEXT,
 
     CONSTRAINT "WorkflowConcurrency_pkey" PRIMARY KEY ("id")
 );
@@ -1007,7 +1075,8 @@ CREATE TABLE "WorkflowTriggerCronRef" (
 -- CreateTable
 CREATE TABLE "WorkflowTriggerEventRef" (
     "parentId" UUID NOT NULL,
-    "eventKey" TEXT NOT NULL
+    "eventKey" TEXT NOT NULL,
+    "expression" TEXT
 );
 
 -- CreateEnum
@@ -1265,12 +1334,18 @@ CREATE UNIQUE INDEX "Step_id_key" ON "Step" ("id" ASC);
 -- CreateIndex
 CREATE UNIQUE INDEX "Step_jobId_readableId_key" ON "Step" ("jobId" ASC, "readableId" ASC);
 
+-- CreateIndex
+CREATE INDEX "StepConcurrency_stepId_idx" ON "StepConcurrency" ("stepId" ASC);
+
 -- CreateIndex
 CREATE INDEX "StepDesiredWorkerLabel_stepId_idx" ON "StepDesiredWorkerLabel" ("stepId" ASC);
 
 -- CreateIndex
 CREATE UNIQUE INDEX "StepDesiredWorkerLabel_stepId_key_key" ON "StepDesiredWorkerLabel" ("stepId" ASC, "key" ASC);
 
+-- CreateIndex
+CREATE INDEX "StepMatchCondition_stepId_idx" ON "StepMatchCondition" ("stepId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "StepRateLimit_stepId_rateLimitKey_key" ON "StepRateLimit" ("stepId" ASC, "rateLimitKey" ASC);
 
@@ -1610,9 +1685,15 @@ ALTER TABLE "Step" ADD CONSTRAINT "Step_actionId_tenantId_fkey" FOREIGN KEY ("ac
 -- AddForeignKey
 ALTER TABLE "Step" ADD CONSTRAINT "Step_jobId_fkey" FOREIGN KEY ("jobId") REFERENCES "Job" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "StepConcurrency" ADD CONSTRAINT "StepConcurrency_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "StepDesiredWorkerLabel" ADD CONSTRAINT "StepDesiredWorkerLabel_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "StepMatchCondition" ADD CONSTRAINT "StepMatchCondition_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "StepRateLimit" ADD CONSTRAINT "StepRateLimit_tenantId_rateLimitKey_fkey" FOREIGN KEY ("tenantId", "rateLimitKey") REFERENCES "RateLimit" ("tenantId", "key") ON DELETE RESTRICT ON UPDATE CASCADE;
 
@@ -1691,6 +1772,9 @@ ALTER TABLE "WorkerAssignEvent" ADD CONSTRAINT "WorkerAssignEvent_workerId_fkey"
 -- AddForeignKey
 ALTER TABLE "WorkerLabel" ADD CONSTRAINT "WorkerLabel_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkerSlotPool" ADD CONSTRAINT "WorkerSlotPool_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_getConcurrencyGroupId_fkey" FOREIGN KEY ("getConcurrencyGroupId") REFERENCES "Action" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
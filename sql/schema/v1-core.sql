@@ -81,11 +81,11 @@ CREATE TABLE v1_queue (
 
 CREATE TYPE v1_sticky_strategy AS ENUM ('NONE', 'SOFT', 'HARD');
 
-CREATE TYPE v1_task_initial_state AS ENUM ('QUEUED', 'CANCELLED', 'SKIPPED', 'FAILED');
+CREATE TYPE v1_task_initial_state AS ENUM ('QUEUED', 'CANCELLED', 'SKIPPED', 'FAILED', 'MAPPED');
 
 -- We need a NONE strategy to allow for tasks which were previously using a concurrency strategy to
 -- enqueue if the strategy is removed.
-CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST');
+CREATE TYPE v1_concurrency_strategy AS ENUM ('NONE', 'GROUP_ROUND_ROBIN', 'CANCEL_IN_PROGRESS', 'CANCEL_NEWEST', 'WEIGHTED_ROUND_ROBIN');
 
 CREATE TABLE v1_workflow_concurrency (
     -- We need an id used for stable ordering to prevent deadlocks. We must process all concurrency
@@ -118,9 +118,20 @@ CREATE TABLE v1_step_concurrency (
     expression TEXT NOT NULL,
     tenant_id UUID NOT NULL,
     max_concurrency INTEGER NOT NULL,
+    -- For WEIGHTED_ROUND_ROBIN strategies, a CEL expression which returns the weight of a concurrency key
+    weight_expression TEXT,
     CONSTRAINT v1_step_concurrency_pkey PRIMARY KEY (workflow_id, workflow_version_id, step_id, id)
 );
 
+-- Weights of concurrency keys for WEIGHTED_ROUND_ROBIN strategies. A key with a weight of N can run N times
+-- as many tasks as a key with a weight of 1. These weights take precedence over weight expressions.
+CREATE TABLE v1_concurrency_key_weight (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    weight INTEGER NOT NULL,
+    CONSTRAINT v1_concurrency_key_weight_pkey PRIMARY KEY (tenant_id, key)
+);
+
 CREATE OR REPLACE FUNCTION create_v1_step_concurrency()
 RETURNS trigger AS $$
 DECLARE
@@ -159,7 +170,8 @@ BEGIN
         strategy,
         expression,
         tenant_id,
-        max_concurrency
+        max_concurrency,
+        weight_expression
       )
       SELECT
         wf_concurrency_row.id,
@@ -169,7 +181,8 @@ BEGIN
         NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
         NEW."concurrencyGroupExpression",
         s."tenantId",
-        NEW."maxRuns"
+        NEW."maxRuns",
+        NEW."weightExpression"
       FROM (
         SELECT
           s."id",
@@ -205,6 +218,45 @@ AFTER INSERT ON "WorkflowConcurrency"
 FOR EACH ROW
 EXECUTE FUNCTION create_v1_step_concurrency();
 
+-- Creates the v1 concurrency strategy for a concurrency limit which is defined on a single step. These
+-- strategies don't have a parent strategy.
+CREATE OR REPLACE FUNCTION create_v1_step_concurrency_from_step()
+RETURNS trigger AS $$
+BEGIN
+  INSERT INTO v1_step_concurrency (
+    workflow_id,
+    workflow_version_id,
+    step_id,
+    strategy,
+    expression,
+    tenant_id,
+    max_concurrency,
+    weight_expression
+  )
+  SELECT
+    wf."id",
+    wv."id",
+    s."id",
+    NEW."limitStrategy"::VARCHAR::v1_concurrency_strategy,
+    NEW."expression",
+    NEW."tenantId",
+    NEW."maxRuns",
+    NEW."weightExpression"
+  FROM "Step" s
+  JOIN "Job" j ON s."jobId" = j."id"
+  JOIN "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+  JOIN "Workflow" wf ON wv."workflowId" = wf."id"
+  WHERE s."id" = NEW."stepId";
+
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_create_v1_step_concurrency_from_step
+AFTER INSERT ON "StepConcurrency"
+FOR EACH ROW
+EXECUTE FUNCTION create_v1_step_concurrency_from_step();
+
 -- CreateTable
 CREATE TABLE v1_task (
     id bigint GENERATED ALWAYS AS IDENTITY,
@@ -239,6 +291,7 @@ CREATE TABLE v1_task (
     parent_task_inserted_at TIMESTAMPTZ,
     child_index BIGINT,
     child_key TEXT,
+    idempotency_key TEXT,
     initial_state v1_task_initial_state NOT NULL DEFAULT 'QUEUED',
     initial_state_reason TEXT,
     concurrency_parent_strategy_ids BIGINT[],
@@ -246,6 +299,8 @@ CREATE TABLE v1_task (
     concurrency_keys TEXT[],
     retry_backoff_factor DOUBLE PRECISION,
     retry_max_backoff INTEGER,
+    -- the key of the event which triggered the workflow run, if any
+    event_key TEXT,
     CONSTRAINT v1_task_pkey PRIMARY KEY (id, inserted_at)
 ) PARTITION BY RANGE(inserted_at);
 
@@ -355,6 +410,10 @@ CREATE TABLE v1_task_runtime (
     worker_id UUID,
     tenant_id UUID NOT NULL,
     timeout_at TIMESTAMP(3) NOT NULL,
+    -- the number of worker slots which the task uses
+    slots INTEGER NOT NULL DEFAULT 1,
+    -- the worker slot pool which the slots are taken from, or NULL for the default pool
+    slot_pool TEXT,
 
     CONSTRAINT v1_task_runtime_pkey PRIMARY KEY (task_id, task_inserted_at, retry_count)
 );
@@ -400,6 +459,9 @@ CREATE TABLE v1_match (
     -- references the existing task id, which may be set when we're replaying a task
     trigger_existing_task_id bigint,
     trigger_existing_task_inserted_at timestamptz,
+    -- whether the conditions of the step are registered in a new match once this match is queued, so that
+    -- they only start once the step's parents have completed
+    trigger_deferred_step_conditions BOOLEAN NOT NULL DEFAULT FALSE,
     CONSTRAINT v1_match_pkey PRIMARY KEY (id)
 );
 
@@ -447,6 +509,7 @@ CREATE TABLE v1_dag (
     workflow_id UUID NOT NULL,
     workflow_version_id UUID NOT NULL,
     parent_task_external_id UUID,
+    idempotency_key TEXT,
     CONSTRAINT v1_dag_pkey PRIMARY KEY (id, inserted_at)
 ) PARTITION BY RANGE(inserted_at);
 
@@ -463,6 +526,7 @@ CREATE TABLE v1_dag_data (
     dag_inserted_at TIMESTAMPTZ NOT NULL,
     input JSONB NOT NULL,
     additional_metadata JSONB,
+    event_key TEXT,
     CONSTRAINT v1_dag_input_pkey PRIMARY KEY (dag_id, dag_inserted_at)
 );
 
@@ -716,6 +780,35 @@ CREATE TABLE v1_retry_queue_item (
 
 CREATE INDEX v1_retry_queue_item_tenant_id_retry_after_idx ON v1_retry_queue_item (tenant_id ASC, retry_after ASC);
 
+-- v1_map_task stores the progress of a map task, so that the map expression is only evaluated once and
+-- each completed item only updates the counts of its map task. Partitions are dropped with the partitions
+-- of v1_task.
+CREATE TABLE v1_map_task (
+    tenant_id UUID NOT NULL,
+    task_id BIGINT NOT NULL,
+    task_inserted_at TIMESTAMPTZ NOT NULL,
+    -- the retry count of the map task which the items were created for
+    retry_count INTEGER NOT NULL,
+    -- the list returned by the map expression
+    items JSONB NOT NULL,
+    item_count INTEGER NOT NULL,
+    -- items are created in order, so every item with an index below created_count has been created
+    created_count INTEGER NOT NULL DEFAULT 0,
+    completed_count INTEGER NOT NULL DEFAULT 0,
+    -- set once an item fails or is cancelled
+    error_message TEXT,
+    CONSTRAINT v1_map_task_pkey PRIMARY KEY (task_id, task_inserted_at)
+) PARTITION BY RANGE(task_inserted_at);
+
+-- CreateTable
+CREATE TABLE v1_durable_sleep (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    sleep_until TIMESTAMPTZ NOT NULL,
+    sleep_duration TEXT NOT NULL,
+    CONSTRAINT v1_durable_sleep_pkey PRIMARY KEY (tenant_id, sleep_until, id)
+);
+
 CREATE OR REPLACE FUNCTION v1_task_insert_function()
 RETURNS TRIGGER AS $$
 DECLARE
@@ -1413,3 +1506,115 @@ CREATE TABLE v1_log_line (
 
     PRIMARY KEY (task_id, task_inserted_at, id)
 ) PARTITION BY RANGE(task_inserted_at);
+
+CREATE TYPE v1_bulk_operation_kind AS ENUM ('CANCEL', 'REPLAY');
+
+CREATE TYPE v1_bulk_operation_status AS ENUM ('PENDING', 'RUNNING', 'COMPLETED', 'FAILED');
+
+-- v1_bulk_operation tracks the progress of a cancel or replay which was requested via a filter.
+-- Matching runs are first written to v1_bulk_operation_item (while status is PENDING), and then
+-- processed in batches (while status is RUNNING).
+CREATE TABLE v1_bulk_operation (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    kind v1_bulk_operation_kind NOT NULL,
+    status v1_bulk_operation_status NOT NULL DEFAULT 'PENDING',
+    filter JSONB NOT NULL,
+    matched_count BIGINT NOT NULL DEFAULT 0,
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    failed_count BIGINT NOT NULL DEFAULT 0,
+    -- the id of the last item which was processed
+    last_item_id BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT v1_bulk_operation_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_bulk_operation_tenant_id_created_at_idx ON v1_bulk_operation (tenant_id ASC, created_at DESC);
+
+CREATE TABLE v1_bulk_operation_item (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    bulk_operation_id UUID NOT NULL,
+    external_id UUID NOT NULL,
+    CONSTRAINT v1_bulk_operation_item_pkey PRIMARY KEY (bulk_operation_id, id)
+);
+
+CREATE UNIQUE INDEX v1_bulk_operation_item_external_id_key ON v1_bulk_operation_item (bulk_operation_id ASC, external_id ASC);
+
+-- v1_event_trigger_skip records the workflows which were not triggered by an event because the
+-- event did not match the filter on the workflow's event trigger. Partitions are dropped with the
+-- partitions of v1_task.
+CREATE TABLE v1_event_trigger_skip (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    workflow_id UUID NOT NULL,
+    workflow_version_id UUID NOT NULL,
+    expression TEXT NOT NULL,
+    -- set if the filter could not be evaluated
+    error_message TEXT,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_trigger_skip_pkey PRIMARY KEY (id, inserted_at)
+) PARTITION BY RANGE(inserted_at);
+
+CREATE INDEX v1_event_trigger_skip_tenant_id_event_id_idx ON v1_event_trigger_skip (tenant_id ASC, event_id ASC);
+
+CREATE TYPE v1_event_schema_policy AS ENUM ('REJECT', 'QUARANTINE');
+
+-- v1_event_schema stores the JSON schema which the payloads of events with a given key must match. The
+-- policy determines what happens to events which do not match the schema when they are ingested.
+CREATE TABLE v1_event_schema (
+    tenant_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    schema JSONB NOT NULL,
+    policy v1_event_schema_policy NOT NULL DEFAULT 'REJECT',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_event_schema_pkey PRIMARY KEY (tenant_id, event_key)
+);
+
+-- v1_quarantined_event stores events which did not match the schema for their key, when the schema
+-- has the QUARANTINE policy. Quarantined events do not trigger any workflows.
+CREATE TABLE v1_quarantined_event (
+    id BIGINT GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    error_message TEXT NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT v1_quarantined_event_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX v1_quarantined_event_tenant_id_inserted_at_idx ON v1_quarantined_event (tenant_id ASC, inserted_at DESC);
+
+-- v1_event_deduplication stores the deduplication keys of events, so that events which are pushed with
+-- the same key within the TTL return the original event. Partitions are dropped once all of their keys
+-- have expired, so the TTL must not be longer than a day.
+CREATE TABLE v1_event_deduplication (
+    tenant_id UUID NOT NULL,
+    deduplication_key TEXT NOT NULL,
+    event_id UUID NOT NULL,
+    event_key TEXT NOT NULL,
+    data JSONB,
+    additional_metadata JSONB,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT v1_event_deduplication_pkey PRIMARY KEY (tenant_id, deduplication_key, inserted_at)
+) PARTITION BY RANGE(inserted_at);
+
+-- v1_idempotency_key stores the idempotency keys of workflow runs, so that workflows which are triggered
+-- with the same key within the TTL return the original workflow run. Partitions are dropped once all of
+-- their keys have expired, so the TTL must not be longer than a day.
+CREATE TABLE v1_idempotency_key (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    external_id UUID NOT NULL,
+    inserted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT v1_idempotency_key_pkey PRIMARY KEY (tenant_id, key, inserted_at)
+) PARTITION BY RANGE(inserted_at);
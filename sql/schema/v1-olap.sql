@@ -160,6 +160,7 @@ CREATE TABLE v1_tasks_olap (
     dag_id BIGINT,
     dag_inserted_at TIMESTAMPTZ,
     parent_task_external_id UUID,
+    idempotency_key TEXT,
 
     PRIMARY KEY (inserted_at, id, readable_status)
 ) PARTITION BY RANGE(inserted_at);
@@ -183,6 +184,7 @@ CREATE TABLE v1_dags_olap (
     input JSONB NOT NULL,
     additional_metadata JSONB,
     parent_task_external_id UUID,
+    idempotency_key TEXT,
     PRIMARY KEY (inserted_at, id, readable_status)
 ) PARTITION BY RANGE(inserted_at);
 
@@ -206,6 +208,7 @@ CREATE TABLE v1_runs_olap (
     workflow_version_id UUID NOT NULL,
     additional_metadata JSONB,
     parent_task_external_id UUID,
+    idempotency_key TEXT,
 
     PRIMARY KEY (inserted_at, id, readable_status, kind)
 ) PARTITION BY RANGE(inserted_at);
@@ -372,6 +375,18 @@ BEGIN
 END;
 $$;
 
+-- RATE LIMIT DEFINITIONS --
+-- sampled values of rate limits, used for showing rate limit utilization over time
+CREATE TABLE v1_rate_limit_samples_olap (
+    tenant_id UUID NOT NULL,
+    key TEXT NOT NULL,
+    sampled_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    value INTEGER NOT NULL,
+    limit_value INTEGER NOT NULL,
+
+    PRIMARY KEY (tenant_id, key, sampled_at)
+) PARTITION BY RANGE(sampled_at);
+
 -- TRIGGERS TO LINK TASKS, DAGS AND EVENTS --
 CREATE OR REPLACE FUNCTION v1_tasks_olap_insert_function()
 RETURNS TRIGGER AS
@@ -387,7 +402,8 @@ BEGIN
         workflow_id,
         workflow_version_id,
         additional_metadata,
-        parent_task_external_id
+        parent_task_external_id,
+        idempotency_key
     )
     SELECT
         tenant_id,
@@ -399,7 +415,8 @@ BEGIN
         workflow_id,
         workflow_version_id,
         additional_metadata,
-        parent_task_external_id
+        parent_task_external_id,
+        idempotency_key
     FROM new_rows
     WHERE dag_id IS NULL;
 
@@ -495,7 +512,8 @@ BEGIN
         workflow_id,
         workflow_version_id,
         additional_metadata,
-        parent_task_external_id
+        parent_task_external_id,
+        idempotency_key
     )
     SELECT
         tenant_id,
@@ -507,7 +525,8 @@ BEGIN
         workflow_id,
         workflow_version_id,
         additional_metadata,
-        parent_task_external_id
+        parent_task_external_id,
+        idempotency_key
     FROM new_rows;
 
     INSERT INTO v1_lookup_table_olap (